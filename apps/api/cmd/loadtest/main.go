@@ -0,0 +1,55 @@
+// Command loadtest drives a running API server with simulated users and prints latency
+// percentiles and error codes per procedure. The server must accept the development auth headers.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/example/something-like-sns/apps/api/internal/loadtest"
+)
+
+func main() {
+	cfg := loadtest.Config{}
+	var tenants, mix string
+	flag.StringVar(&cfg.BaseURL, "target", "http://localhost:8080", "base URL of the API server")
+	flag.StringVar(&tenants, "tenants", "gen-1", "comma-separated tenant slugs")
+	flag.IntVar(&cfg.Users, "users", 100, "users per tenant, signed in as <slug>-u1 ... as created by cmd/datagen")
+	flag.StringVar(&mix, "mix", "feed=6,post=1,dm=3", "scenarios and their weights ("+strings.Join(loadtest.ScenarioNames(), ", ")+")")
+	flag.IntVar(&cfg.Concurrency, "concurrency", 20, "virtual users")
+	flag.DurationVar(&cfg.Duration, "duration", 30*time.Second, "test duration")
+	flag.DurationVar(&cfg.Think, "think", 0, "mean pause between scenarios of a virtual user")
+	flag.Uint64Var(&cfg.Seed, "seed", 1, "random seed")
+	flag.Parse()
+
+	cfg.Tenants = strings.Split(tenants, ",")
+	var err error
+	if cfg.Mix, err = loadtest.ParseMix(mix); err != nil {
+		log.Fatalf("-mix: %v", err)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	log.Printf("running %d virtual users against %s for %s", cfg.Concurrency, cfg.BaseURL, cfg.Duration)
+	report, err := loadtest.Run(ctx, cfg)
+	if err != nil {
+		log.Fatalf("loadtest: %v", err)
+	}
+	if err := report.Write(os.Stdout); err != nil {
+		log.Fatalf("write report: %v", err)
+	}
+	calls, failed := 0, 0
+	for _, p := range report.Procedures {
+		calls += p.Calls
+		failed += p.Failed()
+	}
+	fmt.Printf("\n%d calls in %s (%.1f/s), %d failed\n", calls, report.Elapsed.Round(time.Millisecond), float64(calls)/report.Elapsed.Seconds(), failed)
+}
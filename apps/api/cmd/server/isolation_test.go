@@ -0,0 +1,527 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/dynamicpb"
+
+	v1 "github.com/example/something-like-sns/apps/api/gen/sns/v1"
+	"github.com/example/something-like-sns/apps/api/internal/adapter/cursor"
+	"github.com/example/something-like-sns/apps/api/internal/adapter/dns"
+	"github.com/example/something-like-sns/apps/api/internal/adapter/repository/cache"
+	"github.com/example/something-like-sns/apps/api/internal/adapter/repository/sqlite"
+	"github.com/example/something-like-sns/apps/api/internal/application"
+	"github.com/example/something-like-sns/apps/api/internal/domain"
+	"github.com/example/something-like-sns/apps/api/internal/seed"
+)
+
+// acmeMarker is written into every acme fixture, so acme data leaking into a response is
+// spotted whichever field carries it.
+const acmeMarker = "acme-confidential"
+
+// Callers of the isolation test. The beta user owns beta, so no RPC is denied for lack of a
+// role, and belongs to no other tenant. The outsider signs in to acme without being a member;
+// acme only admits invited users.
+var (
+	betaCaller     = caller{tenant: "beta", user: "u_isolation_beta"}
+	acmeCaller     = caller{tenant: "acme", user: "u_alice"}
+	outsiderCaller = caller{tenant: "acme", user: "u_isolation_outsider"}
+)
+
+type caller struct{ tenant, user string }
+
+// memberCodes are the codes of RPCs that cannot succeed for a caller who is already a member;
+// both callers are members of their tenant.
+var memberCodes = map[protoreflect.FullName]connect.Code{
+	"sns.v1.InvitationService.AcceptInvitation": connect.CodeAlreadyExists,
+	"sns.v1.InvitationService.RequestToJoin":    connect.CodeAlreadyExists,
+}
+
+// openMethods are the RPCs a signed-in user may call without being a member of the tenant.
+var openMethods = map[protoreflect.FullName]bool{
+	"sns.v1.TenantService.ResolveTenant":        true,
+	"sns.v1.TenantService.GetMe":                true,
+	"sns.v1.InvitationService.AcceptInvitation": true,
+	"sns.v1.InvitationService.RequestToJoin":    true,
+}
+
+// isolationFixtures are the IDs of acme resources that beta must not reach.
+type isolationFixtures struct {
+	tenantID       uint64
+	postID         uint64
+	commentID      uint64
+	conversationID uint64
+	userID         uint64
+	invitationID   uint64
+	// invitationToken is the token of another invitation than invitationID.
+	invitationToken string
+	// approveRequestID and rejectRequestID are join requests, one for each RPC that consumes one.
+	approveRequestID, rejectRequestID uint64
+	// memberIDs are acme members, one for each RPC that reads or changes a membership.
+	memberIDs map[protoreflect.FullName]uint64
+	// domains are unverified acme domains, one for each RPC that adds or removes one.
+	domains map[protoreflect.FullName]string
+	// reportTargets is acme content by another member than the acme caller, who cannot report
+	// their own, and reportID an open report filed by another member.
+	reportTargets map[v1.ReportTargetType]uint64
+	reportID      uint64
+}
+
+// TestTenantIsolation calls every RPC of every sns.v1 service as a beta user. Requests that
+// carry an ID are filled with the IDs of acme resources and must fail with NotFound or
+// PermissionDenied; the others must succeed without returning acme data. Each request is then
+// repeated as an acme member, which must succeed, so a wrong fixture cannot pass as isolation,
+// and as an acme outsider, which must be denied unless the RPC is one of openMethods.
+//
+// An invitation token and the domain of a domain RPC count as IDs. RPCs that cannot succeed
+// for a member are expected to fail with their memberCodes code instead of succeeding.
+//
+// Services and request fields are found by reflection, so new RPCs are covered without
+// changes here. An ID field the harness has no acme fixture for fails the test until one is
+// added to isolationFixtures.id.
+func TestTenantIsolation(t *testing.T) {
+	fx, url := startIsolationServer(t)
+
+	methods := 0
+	protoregistry.GlobalFiles.RangeFilesByPackage("sns.v1", func(file protoreflect.FileDescriptor) bool {
+		services := file.Services()
+		for i := 0; i < services.Len(); i++ {
+			service := services.Get(i)
+			for j := 0; j < service.Methods().Len(); j++ {
+				method := service.Methods().Get(j)
+				methods++
+				t.Run(string(method.FullName()), func(t *testing.T) {
+					testMethodIsolation(t, url, method, fx)
+				})
+			}
+		}
+		return true
+	})
+	if methods == 0 {
+		t.Fatal("no sns.v1 RPCs are registered")
+	}
+}
+
+func testMethodIsolation(t *testing.T, url string, method protoreflect.MethodDescriptor, fx isolationFixtures) {
+	if method.IsStreamingClient() || method.IsStreamingServer() {
+		t.Fatal("streaming RPCs are not covered by the isolation harness")
+	}
+	want := memberCodes[method.FullName()]
+	for _, req := range isolationRequests(t, method.Input(), fx) {
+		body, _ := protojson.Marshal(req.Interface())
+		name := string(body)
+
+		code, resp := callRPC(t, url, method, req, betaCaller)
+		if targetsAcme(req, fx) {
+			if code != connect.CodeNotFound && code != connect.CodePermissionDenied {
+				t.Errorf("%s as beta: got %v, want not_found or permission_denied", name, codeString(code))
+			}
+		} else {
+			if code != want {
+				t.Errorf("%s as beta: got %v, want %v", name, codeString(code), codeString(want))
+			} else if resp != nil {
+				if leak := findAcmeData(resp, fx); leak != "" {
+					t.Errorf("%s as beta: response leaks acme data in %s", name, leak)
+				}
+			}
+		}
+
+		if code, _ := callRPC(t, url, method, req, acmeCaller); code != want {
+			t.Errorf("%s as acme: got %v, want %v; the fixture does not reach the RPC", name, codeString(code), codeString(want))
+		}
+
+		if !openMethods[method.FullName()] {
+			if code, _ := callRPC(t, url, method, req, outsiderCaller); code != connect.CodePermissionDenied {
+				t.Errorf("%s as outsider: got %v, want permission_denied", name, codeString(code))
+			}
+		}
+	}
+}
+
+// startIsolationServer serves the API on an in-memory SQLite database seeded with acme and
+// beta, plus acme fixtures carrying acmeMarker.
+func startIsolationServer(t *testing.T) (isolationFixtures, string) {
+	t.Helper()
+	ctx := context.Background()
+
+	db, err := sqlite.Open(":memory:")
+	if err != nil {
+		t.Fatalf("open: %v", err)
+	}
+	t.Cleanup(func() { db.Close() })
+	if err := sqlite.Migrate(ctx, db); err != nil {
+		t.Fatalf("migrate: %v", err)
+	}
+	store := sqlite.NewStore(db)
+	if err := seed.Run(ctx, store); err != nil {
+		t.Fatalf("seed: %v", err)
+	}
+
+	auth := store.AuthRepository()
+	acme, err := auth.FindTenantBySlug(ctx, "acme")
+	if err != nil {
+		t.Fatalf("find acme: %v", err)
+	}
+	aliceID, err := auth.FindOrCreateUser(ctx, "u_alice", "Alice")
+	if err != nil {
+		t.Fatalf("find alice: %v", err)
+	}
+	bobID, err := auth.FindOrCreateUser(ctx, "u_bob", "Bob")
+	if err != nil {
+		t.Fatalf("find bob: %v", err)
+	}
+	beta, err := auth.FindTenantBySlug(ctx, betaCaller.tenant)
+	if err != nil {
+		t.Fatalf("find beta: %v", err)
+	}
+	betaUserID, err := auth.FindOrCreateUser(ctx, betaCaller.user, "Isolation Beta")
+	if err != nil {
+		t.Fatalf("create beta user: %v", err)
+	}
+	if err := auth.EnsureMembership(ctx, beta.ID, betaUserID, domain.RoleOwner); err != nil {
+		t.Fatalf("beta membership: %v", err)
+	}
+
+	timeline := store.TimelineRepository()
+	post, err := timeline.CreatePost(ctx, acme.ID, aliceID, acmeMarker+" post")
+	if err != nil {
+		t.Fatalf("create post: %v", err)
+	}
+	comment, err := timeline.CreateComment(ctx, acme.ID, post.ID, aliceID, acmeMarker+" comment")
+	if err != nil {
+		t.Fatalf("create comment: %v", err)
+	}
+	dm := store.DMRepository()
+	conversationID, err := dm.FindDMConversation(ctx, acme.ID, aliceID, bobID)
+	if err != nil || conversationID == 0 {
+		t.Fatalf("find seeded DM: %d, %v", conversationID, err)
+	}
+	if _, err := dm.CreateMessage(ctx, acme.ID, conversationID, aliceID, acmeMarker+" message"); err != nil {
+		t.Fatalf("create message: %v", err)
+	}
+	bobPost, err := timeline.CreatePost(ctx, acme.ID, bobID, acmeMarker+" post")
+	if err != nil {
+		t.Fatalf("create post: %v", err)
+	}
+	bobComment, err := timeline.CreateComment(ctx, acme.ID, post.ID, bobID, acmeMarker+" comment")
+	if err != nil {
+		t.Fatalf("create comment: %v", err)
+	}
+	bobMessage, err := dm.CreateMessage(ctx, acme.ID, conversationID, bobID, acmeMarker+" message")
+	if err != nil {
+		t.Fatalf("create message: %v", err)
+	}
+	reporterID, err := auth.FindOrCreateUser(ctx, "u_isolation_reporter", "Reporter")
+	if err != nil {
+		t.Fatalf("create reporter: %v", err)
+	}
+	if err := auth.EnsureMembership(ctx, acme.ID, reporterID, domain.RoleMember); err != nil {
+		t.Fatalf("acme membership: %v", err)
+	}
+	report, err := store.ModerationRepository().CreateReport(ctx, acme.ID, &domain.Report{
+		TargetType:     domain.ReportTargetPost,
+		TargetID:       bobPost.ID,
+		AuthorUserID:   bobID,
+		ReporterUserID: reporterID,
+		Reason:         acmeMarker + " report",
+		Content:        bobPost.Body,
+	})
+	if err != nil {
+		t.Fatalf("create report: %v", err)
+	}
+
+	invitations := application.NewInvitationUsecase(store)
+	alice := domain.Scope{TenantID: acme.ID, UserID: aliceID, Role: domain.RoleOwner}
+	invitation, _, err := invitations.CreateInvitation(ctx, alice, domain.RoleMember, time.Hour)
+	if err != nil {
+		t.Fatalf("create invitation: %v", err)
+	}
+	_, token, err := invitations.CreateInvitation(ctx, alice, domain.RoleMember, time.Hour)
+	if err != nil {
+		t.Fatalf("create invitation: %v", err)
+	}
+	var joinRequestIDs [2]uint64
+	for i := range joinRequestIDs {
+		applicantID, err := auth.FindOrCreateUser(ctx, fmt.Sprintf("u_isolation_applicant%d", i), "Applicant")
+		if err != nil {
+			t.Fatalf("create applicant: %v", err)
+		}
+		jr, err := store.InvitationRepository().CreateJoinRequest(ctx, acme.ID, applicantID, acmeMarker+" join request")
+		if err != nil {
+			t.Fatalf("create join request: %v", err)
+		}
+		joinRequestIDs[i] = jr.ID
+	}
+	memberIDs := map[protoreflect.FullName]uint64{}
+	domains := map[protoreflect.FullName]string{}
+	for i, name := range []protoreflect.FullName{
+		"sns.v1.UpdateMemberRoleRequest", "sns.v1.RemoveMemberRequest", "sns.v1.TransferOwnershipRequest", "sns.v1.GetProfileRequest",
+		"sns.v1.BlockUserRequest", "sns.v1.UnblockUserRequest", "sns.v1.MuteUserRequest", "sns.v1.UnmuteUserRequest",
+		"sns.v1.ReinstateMemberRequest",
+	} {
+		memberID, err := auth.FindOrCreateUser(ctx, fmt.Sprintf("u_isolation_member%d", i), "Member")
+		if err != nil {
+			t.Fatalf("create member: %v", err)
+		}
+		if err := auth.EnsureMembership(ctx, acme.ID, memberID, domain.RoleMember); err != nil {
+			t.Fatalf("acme membership: %v", err)
+		}
+		memberIDs[name] = memberID
+	}
+	resolver := dns.NewFakeResolver()
+	for i, name := range []protoreflect.FullName{"sns.v1.AddDomainRequest", "sns.v1.VerifyDomainRequest", "sns.v1.RemoveDomainRequest"} {
+		d := &domain.TenantDomain{Host: fmt.Sprintf("isolation%d.acme.example", i), VerificationToken: fmt.Sprintf("%s-token%d", acmeMarker, i)}
+		if err := auth.AddTenantDomain(ctx, acme.ID, d); err != nil {
+			t.Fatalf("add acme domain: %v", err)
+		}
+		resolver.Set(d.ChallengeName(), d.ChallengeValue())
+		domains[name] = d.Host
+	}
+
+	// The server caches lookups as in production, so that cached tenants are covered as well.
+	e, err := newServer(cache.NewStore(store), true, cursor.NewHMACEncoder([]byte("isolation-test")), resolver)
+	if err != nil {
+		t.Fatalf("new server: %v", err)
+	}
+	srv := httptest.NewServer(e)
+	t.Cleanup(srv.Close)
+
+	return isolationFixtures{
+		tenantID:         acme.ID,
+		postID:           post.ID,
+		commentID:        comment.ID,
+		conversationID:   conversationID,
+		userID:           bobID,
+		invitationID:     invitation.ID,
+		invitationToken:  token,
+		approveRequestID: joinRequestIDs[0],
+		rejectRequestID:  joinRequestIDs[1],
+		memberIDs:        memberIDs,
+		domains:          domains,
+		reportTargets: map[v1.ReportTargetType]uint64{
+			v1.ReportTargetType_REPORT_TARGET_POST:    bobPost.ID,
+			v1.ReportTargetType_REPORT_TARGET_COMMENT: bobComment.ID,
+			v1.ReportTargetType_REPORT_TARGET_MESSAGE: bobMessage.ID,
+		},
+		reportID: report.ID,
+	}, srv.URL
+}
+
+// id returns the acme ID to put into an ID field of req.
+func (fx isolationFixtures) id(field protoreflect.FieldDescriptor, req protoreflect.Message) (uint64, bool) {
+	switch field.Name() {
+	case "post_id":
+		return fx.postID, true
+	case "conversation_id":
+		return fx.conversationID, true
+	case "other_user_id":
+		return fx.userID, true
+	case "invitation_id":
+		return fx.invitationID, true
+	case "user_id":
+		id, ok := fx.memberIDs[req.Descriptor().FullName()]
+		return id, ok
+	case "join_request_id":
+		if req.Descriptor().FullName() == "sns.v1.RejectJoinRequestRequest" {
+			return fx.rejectRequestID, true
+		}
+		return fx.approveRequestID, true
+	case "report_id":
+		return fx.reportID, true
+	case "target_id":
+		targetType := req.Descriptor().Fields().ByName("target_type")
+		if req.Descriptor().FullName() == "sns.v1.ReportContentRequest" {
+			id, ok := fx.reportTargets[v1.ReportTargetType(req.Get(targetType).Enum())]
+			return id, ok
+		}
+		switch v1.TargetType(req.Get(targetType).Enum()) {
+		case v1.TargetType_POST:
+			return fx.postID, true
+		case v1.TargetType_COMMENT:
+			return fx.commentID, true
+		}
+	}
+	return 0, false
+}
+
+// probeStrings are the values of string request fields that need a valid value; others get "isolation probe".
+var probeStrings = map[protoreflect.Name]string{
+	"type":       "like",
+	"host":       "beta.localhost",
+	"role":       domain.RoleMember,
+	"policy":     domain.JoinPolicyInvite,
+	"handle":     "isolation_probe",
+	"avatar_url": "https://example.com/avatar.png",
+	"status":     domain.ReportOpen,
+	"action":     string(domain.ModerationDismiss),
+}
+
+// isolationRequests builds the requests to send for input: one per combination of the non-zero
+// values of its enum fields, with ID fields set to acme IDs and string fields set to probe values.
+func isolationRequests(t *testing.T, input protoreflect.MessageDescriptor, fx isolationFixtures) []protoreflect.Message {
+	t.Helper()
+	reqs := []protoreflect.Message{dynamicpb.NewMessage(input)}
+	fields := input.Fields()
+	for i := 0; i < fields.Len(); i++ {
+		field := fields.Get(i)
+		if field.Kind() != protoreflect.EnumKind || field.IsList() {
+			continue
+		}
+		var variants []protoreflect.Message
+		values := field.Enum().Values()
+		for _, req := range reqs {
+			for j := 0; j < values.Len(); j++ {
+				if n := values.Get(j).Number(); n != 0 {
+					variant := proto.Clone(req.Interface()).ProtoReflect()
+					variant.Set(field, protoreflect.ValueOfEnum(n))
+					variants = append(variants, variant)
+				}
+			}
+		}
+		reqs = variants
+	}
+
+	for _, req := range reqs {
+		for i := 0; i < fields.Len(); i++ {
+			field := fields.Get(i)
+			if field.IsList() || field.IsMap() {
+				continue
+			}
+			switch field.Kind() {
+			case protoreflect.StringKind:
+				v, ok := probeStrings[field.Name()]
+				if !ok {
+					v = "isolation probe"
+				}
+				if field.Name() == "token" {
+					v = fx.invitationToken
+				}
+				if host, ok := fx.domains[input.FullName()]; ok && field.Name() == "host" {
+					v = host
+				}
+				req.Set(field, protoreflect.ValueOfString(v))
+			case protoreflect.Uint64Kind, protoreflect.Int64Kind, protoreflect.Uint32Kind, protoreflect.Int32Kind:
+				if !strings.HasSuffix(string(field.Name()), "_id") {
+					continue
+				}
+				id, ok := fx.id(field, req)
+				if !ok {
+					t.Fatalf("no acme fixture for %s; add one to isolationFixtures.id", field.FullName())
+				}
+				switch field.Kind() {
+				case protoreflect.Uint64Kind:
+					req.Set(field, protoreflect.ValueOfUint64(id))
+				case protoreflect.Int64Kind:
+					req.Set(field, protoreflect.ValueOfInt64(int64(id)))
+				case protoreflect.Uint32Kind:
+					req.Set(field, protoreflect.ValueOfUint32(uint32(id)))
+				default:
+					req.Set(field, protoreflect.ValueOfInt32(int32(id)))
+				}
+			}
+		}
+	}
+	return reqs
+}
+
+// targetsAcme reports whether req carries an acme ID, invitation token or domain.
+func targetsAcme(req protoreflect.Message, fx isolationFixtures) bool {
+	// Any tenant may claim a host another tenant has added; the claim is its own.
+	_, hasDomain := fx.domains[req.Descriptor().FullName()]
+	hasDomain = hasDomain && req.Descriptor().FullName() != "sns.v1.AddDomainRequest"
+	targets := false
+	req.Range(func(fd protoreflect.FieldDescriptor, _ protoreflect.Value) bool {
+		targets = targets || strings.HasSuffix(string(fd.Name()), "_id") || fd.Name() == "token" || hasDomain && fd.Name() == "host"
+		return !targets
+	})
+	return targets
+}
+
+// callRPC calls method with the Connect JSON protocol as c. It returns 0 and the response on success.
+func callRPC(t *testing.T, url string, method protoreflect.MethodDescriptor, req protoreflect.Message, c caller) (connect.Code, protoreflect.Message) {
+	t.Helper()
+	body, err := protojson.Marshal(req.Interface())
+	if err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+	procedure := "/" + string(method.Parent().FullName()) + "/" + string(method.Name())
+	httpReq, err := http.NewRequest(http.MethodPost, url+procedure, bytes.NewReader(body))
+	if err != nil {
+		t.Fatalf("new request: %v", err)
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	httpReq.Header.Set("X-Tenant", c.tenant)
+	httpReq.Header.Set("X-User", c.user)
+	res, err := http.DefaultClient.Do(httpReq)
+	if err != nil {
+		t.Fatalf("%s: %v", procedure, err)
+	}
+	defer res.Body.Close()
+	data, err := io.ReadAll(res.Body)
+	if err != nil {
+		t.Fatalf("%s: read: %v", procedure, err)
+	}
+
+	if res.StatusCode != http.StatusOK {
+		var wire struct {
+			Code string `json:"code"`
+		}
+		var code connect.Code
+		if json.Unmarshal(data, &wire) != nil || code.UnmarshalText([]byte(wire.Code)) != nil {
+			t.Fatalf("%s: HTTP %d: %s", procedure, res.StatusCode, data)
+		}
+		return code, nil
+	}
+	resp := dynamicpb.NewMessage(method.Output())
+	if err := protojson.Unmarshal(data, resp); err != nil {
+		t.Fatalf("%s: unmarshal: %v", procedure, err)
+	}
+	return 0, resp
+}
+
+// findAcmeData returns the path of a field of m holding acme data, or "".
+func findAcmeData(m protoreflect.Message, fx isolationFixtures) string {
+	var leak string
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		check := func(v protoreflect.Value) {
+			switch {
+			case fd.Kind() == protoreflect.StringKind && strings.Contains(v.String(), acmeMarker):
+				leak = string(fd.FullName())
+			case fd.Name() == "tenant_id" && fd.Kind() == protoreflect.Uint64Kind && v.Uint() == fx.tenantID:
+				leak = string(fd.FullName())
+			case fd.Kind() == protoreflect.MessageKind:
+				leak = findAcmeData(v.Message(), fx)
+			}
+		}
+		if fd.IsList() {
+			for i := 0; i < v.List().Len() && leak == ""; i++ {
+				check(v.List().Get(i))
+			}
+		} else if !fd.IsMap() {
+			check(v)
+		}
+		return leak == ""
+	})
+	return leak
+}
+
+func codeString(code connect.Code) string {
+	if code == 0 {
+		return "success"
+	}
+	return code.String()
+}
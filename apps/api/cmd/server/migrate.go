@@ -0,0 +1,111 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"io/fs"
+	"os"
+	"os/signal"
+	"strconv"
+	"syscall"
+
+	"github.com/example/something-like-sns/apps/api/internal/adapter/repository/migrate"
+	"github.com/example/something-like-sns/apps/api/internal/adapter/repository/mysql"
+	"github.com/example/something-like-sns/apps/api/internal/adapter/repository/postgres"
+)
+
+const migrateUsage = `usage: server migrate <command>
+
+Applies the embedded schema migrations to the database selected by DB_DRIVER (mysql or postgres).
+
+commands:
+  up             apply all pending migrations
+  down [N]       roll back the last N migrations (default 1)
+  status         print the applied version and pending migrations
+  force VERSION  record VERSION as applied and clean, after repairing a failed migration by hand`
+
+// runMigrate runs the migrate subcommand. Concurrent runs wait for each other on a database lock.
+func runMigrate(args []string) {
+	if len(args) == 0 {
+		fmt.Fprintln(os.Stderr, migrateUsage)
+		os.Exit(2)
+	}
+	driver, db := openDB()
+	defer db.Close()
+	m := newMigrator(driver, db)
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	var err error
+	switch cmd := args[0]; {
+	case cmd == "up" && len(args) == 1:
+		err = m.Up(ctx)
+	case cmd == "down" && len(args) <= 2:
+		n := 1
+		if len(args) == 2 {
+			if n, err = strconv.Atoi(args[1]); err != nil || n < 1 {
+				fatal("invalid number of migrations", "n", args[1])
+			}
+		}
+		err = m.Down(ctx, n)
+	case cmd == "status" && len(args) == 1:
+		var s migrate.Status
+		if s, err = m.Status(ctx); err == nil {
+			printStatus(s)
+		}
+	case cmd == "force" && len(args) == 2:
+		version, perr := strconv.ParseUint(args[1], 10, 64)
+		if perr != nil {
+			fatal("invalid version", "version", args[1])
+		}
+		err = m.Force(ctx, version)
+	default:
+		fmt.Fprintln(os.Stderr, migrateUsage)
+		os.Exit(2)
+	}
+	if err != nil {
+		fatal("migrate failed", "command", args[0], "error", err)
+	}
+}
+
+func newMigrator(driver string, db *sql.DB) *migrate.Migrator {
+	var migrations fs.FS
+	switch driver {
+	case "mysql":
+		migrations = mysql.Migrations()
+	case "postgres":
+		migrations = postgres.Migrations()
+	default:
+		fatal("migrate supports DB_DRIVER mysql and postgres; sqlite databases are migrated when opened", "driver", driver)
+	}
+	m, err := migrate.New(db, driver, migrations)
+	if err != nil {
+		fatal("migrate setup failed", "error", err)
+	}
+	return m
+}
+
+// checkSchema refuses to serve when migrations are pending or the last one failed, as queries
+// would fail against tables or columns that do not exist yet.
+func checkSchema(driver string, db *sql.DB) {
+	if err := newMigrator(driver, db).Check(context.Background()); err != nil {
+		fatal("schema check failed; run `server migrate up`", "error", err)
+	}
+}
+
+func printStatus(s migrate.Status) {
+	dirty := ""
+	if s.Dirty {
+		dirty = " (dirty)"
+	}
+	fmt.Printf("version %d%s, latest %d\n", s.Version, dirty, s.Latest())
+	for _, mig := range s.Migrations {
+		state := "applied"
+		if mig.Version > s.Version {
+			state = "pending"
+		}
+		fmt.Printf("  %-8s %s\n", state, mig)
+	}
+}
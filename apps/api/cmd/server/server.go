@@ -0,0 +1,123 @@
+package main
+
+import (
+	"fmt"
+	"log/slog"
+	"net/http"
+
+	"connectrpc.com/connect"
+	"connectrpc.com/otelconnect"
+	"github.com/labstack/echo/v4"
+	"github.com/labstack/echo/v4/middleware"
+
+	"github.com/example/something-like-sns/apps/api/internal/adapter/handler/rpc"
+	"github.com/example/something-like-sns/apps/api/internal/application"
+	"github.com/example/something-like-sns/apps/api/internal/port"
+)
+
+// newServer wires the use cases, interceptors and RPC handlers on top of store. It is shared by
+// main and the tenant isolation tests, so both exercise the same stack.
+func newServer(store port.Store, allowDev bool, cursorEncoder port.CursorEncoder, resolver port.DomainResolver) (*echo.Echo, error) {
+	e := echo.New()
+	e.HideBanner = true
+	e.Use(middleware.Recover())
+	e.Use(middleware.RequestLoggerWithConfig(middleware.RequestLoggerConfig{
+		LogMethod:  true,
+		LogURI:     true,
+		LogStatus:  true,
+		LogLatency: true,
+		LogValuesFunc: func(c echo.Context, v middleware.RequestLoggerValues) error {
+			slog.LogAttrs(c.Request().Context(), slog.LevelInfo, "request",
+				slog.String("method", v.Method),
+				slog.String("uri", v.URI),
+				slog.Int("status", v.Status),
+				slog.Duration("latency", v.Latency),
+				slog.String("request_id", c.Response().Header().Get(rpc.RequestIDHeader)),
+			)
+			return nil
+		},
+	}))
+	e.Use(middleware.CORSWithConfig(middleware.CORSConfig{
+		AllowOrigins:  []string{"*"},
+		AllowHeaders:  []string{"Content-Type", "X-Tenant", "X-User", "X-Email", "Connect-Protocol-Version", "Traceparent", "Tracestate", rpc.RequestIDHeader, rpc.IdempotencyKeyHeader},
+		ExposeHeaders: []string{rpc.RequestIDHeader, rpc.IdempotentReplayedHeader},
+		AllowMethods:  []string{http.MethodGet, http.MethodPost, http.MethodOptions},
+	}))
+
+	// Health
+	e.GET("/health", func(c echo.Context) error {
+		return c.JSON(http.StatusOK, map[string]string{"status": "ok"})
+	})
+
+	// 2. Create use cases (application core)
+	authUsecase := application.NewAuthUsecase(store)
+	timelineUsecase := application.NewTimelineUsecase(store, cursorEncoder)
+	reactionUsecase := application.NewReactionUsecase(store)
+	dmUsecase := application.NewDMUsecase(store, cursorEncoder)
+	quotaUsecase := application.NewQuotaUsecase(store)
+	idempotencyUsecase := application.NewIdempotencyUsecase(store)
+	invitationUsecase := application.NewInvitationUsecase(store)
+	tenantAdminUsecase := application.NewTenantAdminUsecase(store, resolver)
+	profileUsecase := application.NewProfileUsecase(store, cursorEncoder)
+	relationUsecase := application.NewRelationUsecase(store)
+	moderationUsecase := application.NewModerationUsecase(store, cursorEncoder)
+
+	// 3. Create interceptors (shared adapter logic), outermost first
+	otelInterceptor, err := otelconnect.NewInterceptor(otelconnect.WithoutServerPeerAttributes())
+	if err != nil {
+		return nil, fmt.Errorf("otel interceptor: %w", err)
+	}
+	interceptors := []connect.Interceptor{
+		otelInterceptor,
+		rpc.NewRequestIDInterceptor(),
+		rpc.NewErrorInterceptor(),
+		rpc.NewAuthInterceptor(authUsecase, allowDev),
+		rpc.NewIdempotencyInterceptor(idempotencyUsecase),
+		rpc.NewRateLimitInterceptor(quotaUsecase, rpc.NewRateLimiter()),
+	}
+
+	// 4. Create handlers (driving/primary adapters)
+	tenantHandler := rpc.NewTenantHandler(authUsecase, quotaUsecase, allowDev)
+	timelineHandler := rpc.NewTimelineHandler(timelineUsecase)
+	reactionHandler := rpc.NewReactionHandler(reactionUsecase)
+	dmHandler := rpc.NewDMHandler(dmUsecase)
+	invitationHandler := rpc.NewInvitationHandler(invitationUsecase)
+	tenantAdminHandler := rpc.NewTenantAdminHandler(tenantAdminUsecase)
+	profileHandler := rpc.NewProfileHandler(profileUsecase)
+	directoryHandler := rpc.NewDirectoryHandler(profileUsecase)
+	relationHandler := rpc.NewRelationHandler(relationUsecase)
+	moderationHandler := rpc.NewModerationHandler(moderationUsecase)
+
+	// 5. Mount RPC handlers with interceptors
+	path1, h1 := tenantHandler.MountHandler(interceptors...)
+	e.Any(path1+"*", echo.WrapHandler(h1))
+
+	path2, h2 := timelineHandler.MountHandler(interceptors...)
+	e.Any(path2+"*", echo.WrapHandler(h2))
+
+	path3, h3 := reactionHandler.MountHandler(interceptors...)
+	e.Any(path3+"*", echo.WrapHandler(h3))
+
+	path4, h4 := dmHandler.MountHandler(interceptors...)
+	e.Any(path4+"*", echo.WrapHandler(h4))
+
+	path5, h5 := invitationHandler.MountHandler(interceptors...)
+	e.Any(path5+"*", echo.WrapHandler(h5))
+
+	path6, h6 := tenantAdminHandler.MountHandler(interceptors...)
+	e.Any(path6+"*", echo.WrapHandler(h6))
+
+	path7, h7 := profileHandler.MountHandler(interceptors...)
+	e.Any(path7+"*", echo.WrapHandler(h7))
+
+	path8, h8 := directoryHandler.MountHandler(interceptors...)
+	e.Any(path8+"*", echo.WrapHandler(h8))
+
+	path9, h9 := relationHandler.MountHandler(interceptors...)
+	e.Any(path9+"*", echo.WrapHandler(h9))
+
+	path10, h10 := moderationHandler.MountHandler(interceptors...)
+	e.Any(path10+"*", echo.WrapHandler(h10))
+
+	return e, nil
+}
@@ -0,0 +1,133 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/example/something-like-sns/apps/api/internal/adapter/repository/memory"
+	"github.com/example/something-like-sns/apps/api/internal/domain"
+	"github.com/example/something-like-sns/apps/api/internal/port"
+)
+
+func TestRun(t *testing.T) {
+	ctx := context.Background()
+	store := memory.NewStore()
+	auth := store.AuthRepository()
+
+	exec := func(args ...string) (string, error) {
+		t.Helper()
+		var out bytes.Buffer
+		err := run(ctx, store, args, &out)
+		return out.String(), err
+	}
+	mustExec := func(args ...string) string {
+		t.Helper()
+		out, err := exec(args...)
+		if err != nil {
+			t.Fatalf("%s: %v", strings.Join(args, " "), err)
+		}
+		return out
+	}
+
+	mustExec("tenant", "create", "gamma", "Gamma Inc.")
+	tenant, err := auth.FindTenantBySlug(ctx, "gamma")
+	if err != nil {
+		t.Fatalf("FindTenantBySlug: %v", err)
+	}
+	mustExec("tenant", "rename", "gamma", "Gamma Corp.")
+	mustExec("domain", "add", "gamma", "SNS.Gamma.example")
+	if got, err := auth.FindTenantByHost(ctx, "sns.gamma.example"); err != nil || got.ID != tenant.ID || got.Name != "Gamma Corp." {
+		t.Errorf("FindTenantByHost = %+v, %v; want renamed tenant %d", got, err, tenant.ID)
+	}
+	if err := auth.AddTenantDomain(ctx, tenant.ID, &domain.TenantDomain{Host: "pending.gamma.example"}); err != nil {
+		t.Fatalf("AddTenantDomain: %v", err)
+	}
+	if out := mustExec("domain", "list", "gamma"); !strings.Contains(out, "pending.gamma.example  pending") || !strings.Contains(out, "sns.gamma.example      verified") {
+		t.Errorf("domain list = %q, want pending.gamma.example pending and sns.gamma.example verified", out)
+	}
+	mustExec("domain", "verify", "gamma", "pending.gamma.example")
+	if got, err := auth.FindTenantByHost(ctx, "pending.gamma.example"); err != nil || got.ID != tenant.ID {
+		t.Errorf("FindTenantByHost(verified) = %+v, %v; want tenant %d", got, err, tenant.ID)
+	}
+	mustExec("domain", "remove", "gamma", "sns.gamma.example")
+	if _, err := exec("domain", "remove", "gamma", "sns.gamma.example"); !isNotFound(err) {
+		t.Errorf("removing a removed domain: err = %v, want NotFoundError", err)
+	}
+
+	mustExec("tenant", "disable", "gamma")
+	if got, _ := auth.FindTenantBySlug(ctx, "gamma"); got == nil || !got.Disabled {
+		t.Errorf("tenant after disable = %+v, want disabled", got)
+	}
+	mustExec("tenant", "enable", "gamma")
+	mustExec("tenant", "policy", "gamma", domain.JoinPolicyOpen)
+	if got, _ := auth.FindTenantBySlug(ctx, "gamma"); got == nil || got.JoinPolicy != domain.JoinPolicyOpen {
+		t.Errorf("tenant after policy = %+v, want open", got)
+	}
+	if _, err := exec("tenant", "policy", "gamma", "closed"); err == nil {
+		t.Error("setting an unknown join policy succeeded")
+	}
+
+	owner := addMember(t, auth, tenant.ID, "gamma-owner", domain.RoleOwner)
+	addMember(t, auth, tenant.ID, "gamma-member", domain.RoleMember)
+	if _, err := exec("member", "role", "gamma", "gamma-owner", domain.RoleAdmin); err == nil {
+		t.Error("demoting the last owner succeeded")
+	}
+	if _, err := exec("member", "role", "gamma", "gamma-member", "superuser"); err == nil {
+		t.Error("changing to an unknown role succeeded")
+	}
+	mustExec("member", "role", "gamma", "gamma-member", domain.RoleOwner)
+	mustExec("member", "role", "gamma", "gamma-owner", domain.RoleAdmin)
+
+	mustExec("user", "suspend", "gamma-owner")
+	if u, _ := auth.FindUserByID(ctx, owner); u == nil || !u.Suspended {
+		t.Errorf("user after suspend = %+v, want suspended", u)
+	}
+	out := mustExec("member", "list", "gamma")
+	lines := strings.Split(strings.TrimSpace(out), "\n")
+	if len(lines) != 3 || !strings.Contains(lines[1], "gamma-owner") || !strings.Contains(lines[1], "admin") || !strings.Contains(lines[1], "suspended") || !strings.Contains(lines[2], "owner") {
+		t.Errorf("member list =\n%s\nwant gamma-owner as a suspended admin and gamma-member as owner", out)
+	}
+	mustExec("user", "unsuspend", "gamma-owner")
+
+	if _, err := exec("tenant", "disable", "missing"); !isNotFound(err) {
+		t.Errorf("disabling a missing tenant: err = %v, want NotFoundError", err)
+	}
+	for _, args := range [][]string{{}, {"tenant"}, {"tenant", "create", "only-slug"}, {"tenant", "delete", "gamma"}} {
+		if _, err := exec(args...); !errors.Is(err, errUsage) {
+			t.Errorf("%q: err = %v, want errUsage", args, err)
+		}
+	}
+}
+
+func TestRotateSecret(t *testing.T) {
+	t.Setenv("CURSOR_SECRET", "old-secret")
+	var out bytes.Buffer
+	if err := run(context.Background(), nil, []string{"secret", "rotate"}, &out); err != nil {
+		t.Fatalf("secret rotate: %v", err)
+	}
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	if len(lines) != 2 || !strings.HasPrefix(lines[0], "CURSOR_SECRET=") || len(lines[0]) < len("CURSOR_SECRET=")+32 || lines[1] != "CURSOR_SECRET_PREVIOUS=old-secret" {
+		t.Errorf("secret rotate printed\n%s", out.String())
+	}
+}
+
+func addMember(t *testing.T, auth port.AuthRepository, tenantID uint64, authSub, role string) uint64 {
+	t.Helper()
+	ctx := context.Background()
+	id, err := auth.FindOrCreateUser(ctx, authSub, authSub)
+	if err != nil {
+		t.Fatalf("FindOrCreateUser: %v", err)
+	}
+	if err := auth.EnsureMembership(ctx, tenantID, id, role); err != nil {
+		t.Fatalf("EnsureMembership: %v", err)
+	}
+	return id
+}
+
+func isNotFound(err error) bool {
+	var nf *domain.NotFoundError
+	return errors.As(err, &nf)
+}
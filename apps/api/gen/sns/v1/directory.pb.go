@@ -0,0 +1,301 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: sns/v1/directory.proto
+
+package v1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type DirectoryMember struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Profile       *Profile               `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"`
+	Role          string                 `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
+	JoinedAt      string                 `protobuf:"bytes,3,opt,name=joined_at,json=joinedAt,proto3" json:"joined_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DirectoryMember) Reset() {
+	*x = DirectoryMember{}
+	mi := &file_sns_v1_directory_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DirectoryMember) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DirectoryMember) ProtoMessage() {}
+
+func (x *DirectoryMember) ProtoReflect() protoreflect.Message {
+	mi := &file_sns_v1_directory_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DirectoryMember.ProtoReflect.Descriptor instead.
+func (*DirectoryMember) Descriptor() ([]byte, []int) {
+	return file_sns_v1_directory_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *DirectoryMember) GetProfile() *Profile {
+	if x != nil {
+		return x.Profile
+	}
+	return nil
+}
+
+func (x *DirectoryMember) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *DirectoryMember) GetJoinedAt() string {
+	if x != nil {
+		return x.JoinedAt
+	}
+	return ""
+}
+
+type ListMembersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Query         string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	Role          string                 `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
+	Cursor        *Cursor                `protobuf:"bytes,3,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	PageSize      uint32                 `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMembersRequest) Reset() {
+	*x = ListMembersRequest{}
+	mi := &file_sns_v1_directory_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMembersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMembersRequest) ProtoMessage() {}
+
+func (x *ListMembersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sns_v1_directory_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMembersRequest.ProtoReflect.Descriptor instead.
+func (*ListMembersRequest) Descriptor() ([]byte, []int) {
+	return file_sns_v1_directory_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListMembersRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *ListMembersRequest) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *ListMembersRequest) GetCursor() *Cursor {
+	if x != nil {
+		return x.Cursor
+	}
+	return nil
+}
+
+func (x *ListMembersRequest) GetPageSize() uint32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ListMembersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*DirectoryMember     `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	Next          *Cursor                `protobuf:"bytes,2,opt,name=next,proto3" json:"next,omitempty"`
+	Prev          *Cursor                `protobuf:"bytes,3,opt,name=prev,proto3" json:"prev,omitempty"`
+	HasMore       bool                   `protobuf:"varint,4,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMembersResponse) Reset() {
+	*x = ListMembersResponse{}
+	mi := &file_sns_v1_directory_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMembersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMembersResponse) ProtoMessage() {}
+
+func (x *ListMembersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sns_v1_directory_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMembersResponse.ProtoReflect.Descriptor instead.
+func (*ListMembersResponse) Descriptor() ([]byte, []int) {
+	return file_sns_v1_directory_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListMembersResponse) GetItems() []*DirectoryMember {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *ListMembersResponse) GetNext() *Cursor {
+	if x != nil {
+		return x.Next
+	}
+	return nil
+}
+
+func (x *ListMembersResponse) GetPrev() *Cursor {
+	if x != nil {
+		return x.Prev
+	}
+	return nil
+}
+
+func (x *ListMembersResponse) GetHasMore() bool {
+	if x != nil {
+		return x.HasMore
+	}
+	return false
+}
+
+var File_sns_v1_directory_proto protoreflect.FileDescriptor
+
+const file_sns_v1_directory_proto_rawDesc = "" +
+	"\n" +
+	"\x16sns/v1/directory.proto\x12\x06sns.v1\x1a\x14sns/v1/profile.proto\x1a\x15sns/v1/timeline.proto\"m\n" +
+	"\x0fDirectoryMember\x12)\n" +
+	"\aprofile\x18\x01 \x01(\v2\x0f.sns.v1.ProfileR\aprofile\x12\x12\n" +
+	"\x04role\x18\x02 \x01(\tR\x04role\x12\x1b\n" +
+	"\tjoined_at\x18\x03 \x01(\tR\bjoinedAt\"\x83\x01\n" +
+	"\x12ListMembersRequest\x12\x14\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\x12\x12\n" +
+	"\x04role\x18\x02 \x01(\tR\x04role\x12&\n" +
+	"\x06cursor\x18\x03 \x01(\v2\x0e.sns.v1.CursorR\x06cursor\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\rR\bpageSize\"\xa7\x01\n" +
+	"\x13ListMembersResponse\x12-\n" +
+	"\x05items\x18\x01 \x03(\v2\x17.sns.v1.DirectoryMemberR\x05items\x12\"\n" +
+	"\x04next\x18\x02 \x01(\v2\x0e.sns.v1.CursorR\x04next\x12\"\n" +
+	"\x04prev\x18\x03 \x01(\v2\x0e.sns.v1.CursorR\x04prev\x12\x19\n" +
+	"\bhas_more\x18\x04 \x01(\bR\ahasMore2Z\n" +
+	"\x10DirectoryService\x12F\n" +
+	"\vListMembers\x12\x1a.sns.v1.ListMembersRequest\x1a\x1b.sns.v1.ListMembersResponseB>Z<github.com/example/something-like-sns/apps/api/gen/sns/v1;v1b\x06proto3"
+
+var (
+	file_sns_v1_directory_proto_rawDescOnce sync.Once
+	file_sns_v1_directory_proto_rawDescData []byte
+)
+
+func file_sns_v1_directory_proto_rawDescGZIP() []byte {
+	file_sns_v1_directory_proto_rawDescOnce.Do(func() {
+		file_sns_v1_directory_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_sns_v1_directory_proto_rawDesc), len(file_sns_v1_directory_proto_rawDesc)))
+	})
+	return file_sns_v1_directory_proto_rawDescData
+}
+
+var file_sns_v1_directory_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_sns_v1_directory_proto_goTypes = []any{
+	(*DirectoryMember)(nil),     // 0: sns.v1.DirectoryMember
+	(*ListMembersRequest)(nil),  // 1: sns.v1.ListMembersRequest
+	(*ListMembersResponse)(nil), // 2: sns.v1.ListMembersResponse
+	(*Profile)(nil),             // 3: sns.v1.Profile
+	(*Cursor)(nil),              // 4: sns.v1.Cursor
+}
+var file_sns_v1_directory_proto_depIdxs = []int32{
+	3, // 0: sns.v1.DirectoryMember.profile:type_name -> sns.v1.Profile
+	4, // 1: sns.v1.ListMembersRequest.cursor:type_name -> sns.v1.Cursor
+	0, // 2: sns.v1.ListMembersResponse.items:type_name -> sns.v1.DirectoryMember
+	4, // 3: sns.v1.ListMembersResponse.next:type_name -> sns.v1.Cursor
+	4, // 4: sns.v1.ListMembersResponse.prev:type_name -> sns.v1.Cursor
+	1, // 5: sns.v1.DirectoryService.ListMembers:input_type -> sns.v1.ListMembersRequest
+	2, // 6: sns.v1.DirectoryService.ListMembers:output_type -> sns.v1.ListMembersResponse
+	6, // [6:7] is the sub-list for method output_type
+	5, // [5:6] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_sns_v1_directory_proto_init() }
+func file_sns_v1_directory_proto_init() {
+	if File_sns_v1_directory_proto != nil {
+		return
+	}
+	file_sns_v1_profile_proto_init()
+	file_sns_v1_timeline_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_sns_v1_directory_proto_rawDesc), len(file_sns_v1_directory_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_sns_v1_directory_proto_goTypes,
+		DependencyIndexes: file_sns_v1_directory_proto_depIdxs,
+		MessageInfos:      file_sns_v1_directory_proto_msgTypes,
+	}.Build()
+	File_sns_v1_directory_proto = out.File
+	file_sns_v1_directory_proto_goTypes = nil
+	file_sns_v1_directory_proto_depIdxs = nil
+}
@@ -88,6 +88,7 @@ type Message struct {
 	SenderUserId   uint64                 `protobuf:"varint,3,opt,name=sender_user_id,json=senderUserId,proto3" json:"sender_user_id,omitempty"`
 	Body           string                 `protobuf:"bytes,4,opt,name=body,proto3" json:"body,omitempty"`
 	CreatedAt      string                 `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Sender         *Profile               `protobuf:"bytes,6,opt,name=sender,proto3" json:"sender,omitempty"`
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
@@ -157,6 +158,13 @@ func (x *Message) GetCreatedAt() string {
 	return ""
 }
 
+func (x *Message) GetSender() *Profile {
+	if x != nil {
+		return x.Sender
+	}
+	return nil
+}
+
 type GetOrCreateDMRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	OtherUserId   uint64                 `protobuf:"varint,1,opt,name=other_user_id,json=otherUserId,proto3" json:"other_user_id,omitempty"`
@@ -248,6 +256,7 @@ func (x *GetOrCreateDMResponse) GetConversationId() uint64 {
 type ListConversationsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Cursor        *Cursor                `protobuf:"bytes,1,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	PageSize      uint32                 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -289,10 +298,19 @@ func (x *ListConversationsRequest) GetCursor() *Cursor {
 	return nil
 }
 
+func (x *ListConversationsRequest) GetPageSize() uint32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
 type ListConversationsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Items         []*Conversation        `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
 	Next          *Cursor                `protobuf:"bytes,2,opt,name=next,proto3" json:"next,omitempty"`
+	Prev          *Cursor                `protobuf:"bytes,3,opt,name=prev,proto3" json:"prev,omitempty"`
+	HasMore       bool                   `protobuf:"varint,4,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -341,10 +359,26 @@ func (x *ListConversationsResponse) GetNext() *Cursor {
 	return nil
 }
 
+func (x *ListConversationsResponse) GetPrev() *Cursor {
+	if x != nil {
+		return x.Prev
+	}
+	return nil
+}
+
+func (x *ListConversationsResponse) GetHasMore() bool {
+	if x != nil {
+		return x.HasMore
+	}
+	return false
+}
+
 type ListMessagesRequest struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	ConversationId uint64                 `protobuf:"varint,1,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
 	Cursor         *Cursor                `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	PageSize       uint32                 `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	IncludeTotal   bool                   `protobuf:"varint,4,opt,name=include_total,json=includeTotal,proto3" json:"include_total,omitempty"`
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
@@ -393,10 +427,27 @@ func (x *ListMessagesRequest) GetCursor() *Cursor {
 	return nil
 }
 
+func (x *ListMessagesRequest) GetPageSize() uint32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListMessagesRequest) GetIncludeTotal() bool {
+	if x != nil {
+		return x.IncludeTotal
+	}
+	return false
+}
+
 type ListMessagesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Items         []*Message             `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
 	Next          *Cursor                `protobuf:"bytes,2,opt,name=next,proto3" json:"next,omitempty"`
+	Prev          *Cursor                `protobuf:"bytes,3,opt,name=prev,proto3" json:"prev,omitempty"`
+	HasMore       bool                   `protobuf:"varint,4,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
+	Total         uint64                 `protobuf:"varint,5,opt,name=total,proto3" json:"total,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -445,6 +496,27 @@ func (x *ListMessagesResponse) GetNext() *Cursor {
 	return nil
 }
 
+func (x *ListMessagesResponse) GetPrev() *Cursor {
+	if x != nil {
+		return x.Prev
+	}
+	return nil
+}
+
+func (x *ListMessagesResponse) GetHasMore() bool {
+	if x != nil {
+		return x.HasMore
+	}
+	return false
+}
+
+func (x *ListMessagesResponse) GetTotal() uint64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
 type SendMessageRequest struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	ConversationId uint64                 `protobuf:"varint,1,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
@@ -545,34 +617,43 @@ var File_sns_v1_dm_proto protoreflect.FileDescriptor
 
 const file_sns_v1_dm_proto_rawDesc = "" +
 	"\n" +
-	"\x0fsns/v1/dm.proto\x12\x06sns.v1\x1a\x15sns/v1/timeline.proto\"e\n" +
+	"\x0fsns/v1/dm.proto\x12\x06sns.v1\x1a\x15sns/v1/timeline.proto\x1a\x14sns/v1/profile.proto\"e\n" +
 	"\fConversation\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x1d\n" +
 	"\n" +
 	"created_at\x18\x02 \x01(\tR\tcreatedAt\x12&\n" +
-	"\x0fmember_user_ids\x18\x03 \x03(\x04R\rmemberUserIds\"\x9b\x01\n" +
+	"\x0fmember_user_ids\x18\x03 \x03(\x04R\rmemberUserIds\"\xc4\x01\n" +
 	"\aMessage\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x04R\x02id\x12'\n" +
 	"\x0fconversation_id\x18\x02 \x01(\x04R\x0econversationId\x12$\n" +
 	"\x0esender_user_id\x18\x03 \x01(\x04R\fsenderUserId\x12\x12\n" +
 	"\x04body\x18\x04 \x01(\tR\x04body\x12\x1d\n" +
 	"\n" +
-	"created_at\x18\x05 \x01(\tR\tcreatedAt\":\n" +
+	"created_at\x18\x05 \x01(\tR\tcreatedAt\x12'\n" +
+	"\x06sender\x18\x06 \x01(\v2\x0f.sns.v1.ProfileR\x06sender\":\n" +
 	"\x14GetOrCreateDMRequest\x12\"\n" +
 	"\rother_user_id\x18\x01 \x01(\x04R\votherUserId\"@\n" +
 	"\x15GetOrCreateDMResponse\x12'\n" +
-	"\x0fconversation_id\x18\x01 \x01(\x04R\x0econversationId\"B\n" +
+	"\x0fconversation_id\x18\x01 \x01(\x04R\x0econversationId\"_\n" +
 	"\x18ListConversationsRequest\x12&\n" +
-	"\x06cursor\x18\x01 \x01(\v2\x0e.sns.v1.CursorR\x06cursor\"k\n" +
+	"\x06cursor\x18\x01 \x01(\v2\x0e.sns.v1.CursorR\x06cursor\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\rR\bpageSize\"\xaa\x01\n" +
 	"\x19ListConversationsResponse\x12*\n" +
 	"\x05items\x18\x01 \x03(\v2\x14.sns.v1.ConversationR\x05items\x12\"\n" +
-	"\x04next\x18\x02 \x01(\v2\x0e.sns.v1.CursorR\x04next\"f\n" +
+	"\x04next\x18\x02 \x01(\v2\x0e.sns.v1.CursorR\x04next\x12\"\n" +
+	"\x04prev\x18\x03 \x01(\v2\x0e.sns.v1.CursorR\x04prev\x12\x19\n" +
+	"\bhas_more\x18\x04 \x01(\bR\ahasMore\"\xa8\x01\n" +
 	"\x13ListMessagesRequest\x12'\n" +
 	"\x0fconversation_id\x18\x01 \x01(\x04R\x0econversationId\x12&\n" +
-	"\x06cursor\x18\x02 \x01(\v2\x0e.sns.v1.CursorR\x06cursor\"a\n" +
+	"\x06cursor\x18\x02 \x01(\v2\x0e.sns.v1.CursorR\x06cursor\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\rR\bpageSize\x12#\n" +
+	"\rinclude_total\x18\x04 \x01(\bR\fincludeTotal\"\xb6\x01\n" +
 	"\x14ListMessagesResponse\x12%\n" +
 	"\x05items\x18\x01 \x03(\v2\x0f.sns.v1.MessageR\x05items\x12\"\n" +
-	"\x04next\x18\x02 \x01(\v2\x0e.sns.v1.CursorR\x04next\"Q\n" +
+	"\x04next\x18\x02 \x01(\v2\x0e.sns.v1.CursorR\x04next\x12\"\n" +
+	"\x04prev\x18\x03 \x01(\v2\x0e.sns.v1.CursorR\x04prev\x12\x19\n" +
+	"\bhas_more\x18\x04 \x01(\bR\ahasMore\x12\x14\n" +
+	"\x05total\x18\x05 \x01(\x04R\x05total\"Q\n" +
 	"\x12SendMessageRequest\x12'\n" +
 	"\x0fconversation_id\x18\x01 \x01(\x04R\x0econversationId\x12\x12\n" +
 	"\x04body\x18\x02 \x01(\tR\x04body\"@\n" +
@@ -608,29 +689,33 @@ var file_sns_v1_dm_proto_goTypes = []any{
 	(*ListMessagesResponse)(nil),      // 7: sns.v1.ListMessagesResponse
 	(*SendMessageRequest)(nil),        // 8: sns.v1.SendMessageRequest
 	(*SendMessageResponse)(nil),       // 9: sns.v1.SendMessageResponse
-	(*Cursor)(nil),                    // 10: sns.v1.Cursor
+	(*Profile)(nil),                   // 10: sns.v1.Profile
+	(*Cursor)(nil),                    // 11: sns.v1.Cursor
 }
 var file_sns_v1_dm_proto_depIdxs = []int32{
-	10, // 0: sns.v1.ListConversationsRequest.cursor:type_name -> sns.v1.Cursor
-	0,  // 1: sns.v1.ListConversationsResponse.items:type_name -> sns.v1.Conversation
-	10, // 2: sns.v1.ListConversationsResponse.next:type_name -> sns.v1.Cursor
-	10, // 3: sns.v1.ListMessagesRequest.cursor:type_name -> sns.v1.Cursor
-	1,  // 4: sns.v1.ListMessagesResponse.items:type_name -> sns.v1.Message
-	10, // 5: sns.v1.ListMessagesResponse.next:type_name -> sns.v1.Cursor
-	1,  // 6: sns.v1.SendMessageResponse.message:type_name -> sns.v1.Message
-	2,  // 7: sns.v1.DMService.GetOrCreateDM:input_type -> sns.v1.GetOrCreateDMRequest
-	4,  // 8: sns.v1.DMService.ListConversations:input_type -> sns.v1.ListConversationsRequest
-	6,  // 9: sns.v1.DMService.ListMessages:input_type -> sns.v1.ListMessagesRequest
-	8,  // 10: sns.v1.DMService.SendMessage:input_type -> sns.v1.SendMessageRequest
-	3,  // 11: sns.v1.DMService.GetOrCreateDM:output_type -> sns.v1.GetOrCreateDMResponse
-	5,  // 12: sns.v1.DMService.ListConversations:output_type -> sns.v1.ListConversationsResponse
-	7,  // 13: sns.v1.DMService.ListMessages:output_type -> sns.v1.ListMessagesResponse
-	9,  // 14: sns.v1.DMService.SendMessage:output_type -> sns.v1.SendMessageResponse
-	11, // [11:15] is the sub-list for method output_type
-	7,  // [7:11] is the sub-list for method input_type
-	7,  // [7:7] is the sub-list for extension type_name
-	7,  // [7:7] is the sub-list for extension extendee
-	0,  // [0:7] is the sub-list for field type_name
+	10, // 0: sns.v1.Message.sender:type_name -> sns.v1.Profile
+	11, // 1: sns.v1.ListConversationsRequest.cursor:type_name -> sns.v1.Cursor
+	0,  // 2: sns.v1.ListConversationsResponse.items:type_name -> sns.v1.Conversation
+	11, // 3: sns.v1.ListConversationsResponse.next:type_name -> sns.v1.Cursor
+	11, // 4: sns.v1.ListConversationsResponse.prev:type_name -> sns.v1.Cursor
+	11, // 5: sns.v1.ListMessagesRequest.cursor:type_name -> sns.v1.Cursor
+	1,  // 6: sns.v1.ListMessagesResponse.items:type_name -> sns.v1.Message
+	11, // 7: sns.v1.ListMessagesResponse.next:type_name -> sns.v1.Cursor
+	11, // 8: sns.v1.ListMessagesResponse.prev:type_name -> sns.v1.Cursor
+	1,  // 9: sns.v1.SendMessageResponse.message:type_name -> sns.v1.Message
+	2,  // 10: sns.v1.DMService.GetOrCreateDM:input_type -> sns.v1.GetOrCreateDMRequest
+	4,  // 11: sns.v1.DMService.ListConversations:input_type -> sns.v1.ListConversationsRequest
+	6,  // 12: sns.v1.DMService.ListMessages:input_type -> sns.v1.ListMessagesRequest
+	8,  // 13: sns.v1.DMService.SendMessage:input_type -> sns.v1.SendMessageRequest
+	3,  // 14: sns.v1.DMService.GetOrCreateDM:output_type -> sns.v1.GetOrCreateDMResponse
+	5,  // 15: sns.v1.DMService.ListConversations:output_type -> sns.v1.ListConversationsResponse
+	7,  // 16: sns.v1.DMService.ListMessages:output_type -> sns.v1.ListMessagesResponse
+	9,  // 17: sns.v1.DMService.SendMessage:output_type -> sns.v1.SendMessageResponse
+	14, // [14:18] is the sub-list for method output_type
+	10, // [10:14] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
 }
 
 func init() { file_sns_v1_dm_proto_init() }
@@ -639,6 +724,7 @@ func file_sns_v1_dm_proto_init() {
 		return
 	}
 	file_sns_v1_timeline_proto_init()
+	file_sns_v1_profile_proto_init()
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
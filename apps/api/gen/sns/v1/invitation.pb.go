@@ -0,0 +1,1204 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: sns/v1/invitation.proto
+
+package v1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Invitation struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Role            string                 `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
+	CreatedByUserId uint64                 `protobuf:"varint,3,opt,name=created_by_user_id,json=createdByUserId,proto3" json:"created_by_user_id,omitempty"`
+	CreatedAt       string                 `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ExpiresAt       string                 `protobuf:"bytes,5,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Invitation) Reset() {
+	*x = Invitation{}
+	mi := &file_sns_v1_invitation_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Invitation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Invitation) ProtoMessage() {}
+
+func (x *Invitation) ProtoReflect() protoreflect.Message {
+	mi := &file_sns_v1_invitation_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Invitation.ProtoReflect.Descriptor instead.
+func (*Invitation) Descriptor() ([]byte, []int) {
+	return file_sns_v1_invitation_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Invitation) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Invitation) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *Invitation) GetCreatedByUserId() uint64 {
+	if x != nil {
+		return x.CreatedByUserId
+	}
+	return 0
+}
+
+func (x *Invitation) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *Invitation) GetExpiresAt() string {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return ""
+}
+
+type JoinRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        uint64                 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	DisplayName   string                 `protobuf:"bytes,3,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	Message       string                 `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JoinRequest) Reset() {
+	*x = JoinRequest{}
+	mi := &file_sns_v1_invitation_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JoinRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinRequest) ProtoMessage() {}
+
+func (x *JoinRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sns_v1_invitation_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinRequest.ProtoReflect.Descriptor instead.
+func (*JoinRequest) Descriptor() ([]byte, []int) {
+	return file_sns_v1_invitation_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *JoinRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *JoinRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *JoinRequest) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *JoinRequest) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *JoinRequest) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type CreateInvitationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Role          string                 `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
+	TtlHours      uint32                 `protobuf:"varint,2,opt,name=ttl_hours,json=ttlHours,proto3" json:"ttl_hours,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateInvitationRequest) Reset() {
+	*x = CreateInvitationRequest{}
+	mi := &file_sns_v1_invitation_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateInvitationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateInvitationRequest) ProtoMessage() {}
+
+func (x *CreateInvitationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sns_v1_invitation_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateInvitationRequest.ProtoReflect.Descriptor instead.
+func (*CreateInvitationRequest) Descriptor() ([]byte, []int) {
+	return file_sns_v1_invitation_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CreateInvitationRequest) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *CreateInvitationRequest) GetTtlHours() uint32 {
+	if x != nil {
+		return x.TtlHours
+	}
+	return 0
+}
+
+type CreateInvitationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Invitation    *Invitation            `protobuf:"bytes,1,opt,name=invitation,proto3" json:"invitation,omitempty"`
+	Token         string                 `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateInvitationResponse) Reset() {
+	*x = CreateInvitationResponse{}
+	mi := &file_sns_v1_invitation_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateInvitationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateInvitationResponse) ProtoMessage() {}
+
+func (x *CreateInvitationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sns_v1_invitation_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateInvitationResponse.ProtoReflect.Descriptor instead.
+func (*CreateInvitationResponse) Descriptor() ([]byte, []int) {
+	return file_sns_v1_invitation_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CreateInvitationResponse) GetInvitation() *Invitation {
+	if x != nil {
+		return x.Invitation
+	}
+	return nil
+}
+
+func (x *CreateInvitationResponse) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type ListInvitationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListInvitationsRequest) Reset() {
+	*x = ListInvitationsRequest{}
+	mi := &file_sns_v1_invitation_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListInvitationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInvitationsRequest) ProtoMessage() {}
+
+func (x *ListInvitationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sns_v1_invitation_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInvitationsRequest.ProtoReflect.Descriptor instead.
+func (*ListInvitationsRequest) Descriptor() ([]byte, []int) {
+	return file_sns_v1_invitation_proto_rawDescGZIP(), []int{4}
+}
+
+type ListInvitationsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*Invitation          `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListInvitationsResponse) Reset() {
+	*x = ListInvitationsResponse{}
+	mi := &file_sns_v1_invitation_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListInvitationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInvitationsResponse) ProtoMessage() {}
+
+func (x *ListInvitationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sns_v1_invitation_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInvitationsResponse.ProtoReflect.Descriptor instead.
+func (*ListInvitationsResponse) Descriptor() ([]byte, []int) {
+	return file_sns_v1_invitation_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListInvitationsResponse) GetItems() []*Invitation {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type RevokeInvitationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	InvitationId  uint64                 `protobuf:"varint,1,opt,name=invitation_id,json=invitationId,proto3" json:"invitation_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeInvitationRequest) Reset() {
+	*x = RevokeInvitationRequest{}
+	mi := &file_sns_v1_invitation_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeInvitationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeInvitationRequest) ProtoMessage() {}
+
+func (x *RevokeInvitationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sns_v1_invitation_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeInvitationRequest.ProtoReflect.Descriptor instead.
+func (*RevokeInvitationRequest) Descriptor() ([]byte, []int) {
+	return file_sns_v1_invitation_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *RevokeInvitationRequest) GetInvitationId() uint64 {
+	if x != nil {
+		return x.InvitationId
+	}
+	return 0
+}
+
+type RevokeInvitationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeInvitationResponse) Reset() {
+	*x = RevokeInvitationResponse{}
+	mi := &file_sns_v1_invitation_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeInvitationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeInvitationResponse) ProtoMessage() {}
+
+func (x *RevokeInvitationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sns_v1_invitation_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeInvitationResponse.ProtoReflect.Descriptor instead.
+func (*RevokeInvitationResponse) Descriptor() ([]byte, []int) {
+	return file_sns_v1_invitation_proto_rawDescGZIP(), []int{7}
+}
+
+type AcceptInvitationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcceptInvitationRequest) Reset() {
+	*x = AcceptInvitationRequest{}
+	mi := &file_sns_v1_invitation_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptInvitationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptInvitationRequest) ProtoMessage() {}
+
+func (x *AcceptInvitationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sns_v1_invitation_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptInvitationRequest.ProtoReflect.Descriptor instead.
+func (*AcceptInvitationRequest) Descriptor() ([]byte, []int) {
+	return file_sns_v1_invitation_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *AcceptInvitationRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type AcceptInvitationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Role          string                 `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcceptInvitationResponse) Reset() {
+	*x = AcceptInvitationResponse{}
+	mi := &file_sns_v1_invitation_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptInvitationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptInvitationResponse) ProtoMessage() {}
+
+func (x *AcceptInvitationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sns_v1_invitation_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptInvitationResponse.ProtoReflect.Descriptor instead.
+func (*AcceptInvitationResponse) Descriptor() ([]byte, []int) {
+	return file_sns_v1_invitation_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *AcceptInvitationResponse) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+type RequestToJoinRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestToJoinRequest) Reset() {
+	*x = RequestToJoinRequest{}
+	mi := &file_sns_v1_invitation_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestToJoinRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestToJoinRequest) ProtoMessage() {}
+
+func (x *RequestToJoinRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sns_v1_invitation_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestToJoinRequest.ProtoReflect.Descriptor instead.
+func (*RequestToJoinRequest) Descriptor() ([]byte, []int) {
+	return file_sns_v1_invitation_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *RequestToJoinRequest) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type RequestToJoinResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	JoinRequest   *JoinRequest           `protobuf:"bytes,1,opt,name=join_request,json=joinRequest,proto3" json:"join_request,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestToJoinResponse) Reset() {
+	*x = RequestToJoinResponse{}
+	mi := &file_sns_v1_invitation_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestToJoinResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestToJoinResponse) ProtoMessage() {}
+
+func (x *RequestToJoinResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sns_v1_invitation_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestToJoinResponse.ProtoReflect.Descriptor instead.
+func (*RequestToJoinResponse) Descriptor() ([]byte, []int) {
+	return file_sns_v1_invitation_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *RequestToJoinResponse) GetJoinRequest() *JoinRequest {
+	if x != nil {
+		return x.JoinRequest
+	}
+	return nil
+}
+
+type ListJoinRequestsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListJoinRequestsRequest) Reset() {
+	*x = ListJoinRequestsRequest{}
+	mi := &file_sns_v1_invitation_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListJoinRequestsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListJoinRequestsRequest) ProtoMessage() {}
+
+func (x *ListJoinRequestsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sns_v1_invitation_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListJoinRequestsRequest.ProtoReflect.Descriptor instead.
+func (*ListJoinRequestsRequest) Descriptor() ([]byte, []int) {
+	return file_sns_v1_invitation_proto_rawDescGZIP(), []int{12}
+}
+
+type ListJoinRequestsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*JoinRequest         `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListJoinRequestsResponse) Reset() {
+	*x = ListJoinRequestsResponse{}
+	mi := &file_sns_v1_invitation_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListJoinRequestsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListJoinRequestsResponse) ProtoMessage() {}
+
+func (x *ListJoinRequestsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sns_v1_invitation_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListJoinRequestsResponse.ProtoReflect.Descriptor instead.
+func (*ListJoinRequestsResponse) Descriptor() ([]byte, []int) {
+	return file_sns_v1_invitation_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ListJoinRequestsResponse) GetItems() []*JoinRequest {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type ApproveJoinRequestRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	JoinRequestId uint64                 `protobuf:"varint,1,opt,name=join_request_id,json=joinRequestId,proto3" json:"join_request_id,omitempty"`
+	Role          string                 `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApproveJoinRequestRequest) Reset() {
+	*x = ApproveJoinRequestRequest{}
+	mi := &file_sns_v1_invitation_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApproveJoinRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveJoinRequestRequest) ProtoMessage() {}
+
+func (x *ApproveJoinRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sns_v1_invitation_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveJoinRequestRequest.ProtoReflect.Descriptor instead.
+func (*ApproveJoinRequestRequest) Descriptor() ([]byte, []int) {
+	return file_sns_v1_invitation_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ApproveJoinRequestRequest) GetJoinRequestId() uint64 {
+	if x != nil {
+		return x.JoinRequestId
+	}
+	return 0
+}
+
+func (x *ApproveJoinRequestRequest) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+type ApproveJoinRequestResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApproveJoinRequestResponse) Reset() {
+	*x = ApproveJoinRequestResponse{}
+	mi := &file_sns_v1_invitation_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApproveJoinRequestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveJoinRequestResponse) ProtoMessage() {}
+
+func (x *ApproveJoinRequestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sns_v1_invitation_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveJoinRequestResponse.ProtoReflect.Descriptor instead.
+func (*ApproveJoinRequestResponse) Descriptor() ([]byte, []int) {
+	return file_sns_v1_invitation_proto_rawDescGZIP(), []int{15}
+}
+
+type RejectJoinRequestRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	JoinRequestId uint64                 `protobuf:"varint,1,opt,name=join_request_id,json=joinRequestId,proto3" json:"join_request_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RejectJoinRequestRequest) Reset() {
+	*x = RejectJoinRequestRequest{}
+	mi := &file_sns_v1_invitation_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RejectJoinRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectJoinRequestRequest) ProtoMessage() {}
+
+func (x *RejectJoinRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sns_v1_invitation_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectJoinRequestRequest.ProtoReflect.Descriptor instead.
+func (*RejectJoinRequestRequest) Descriptor() ([]byte, []int) {
+	return file_sns_v1_invitation_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *RejectJoinRequestRequest) GetJoinRequestId() uint64 {
+	if x != nil {
+		return x.JoinRequestId
+	}
+	return 0
+}
+
+type RejectJoinRequestResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RejectJoinRequestResponse) Reset() {
+	*x = RejectJoinRequestResponse{}
+	mi := &file_sns_v1_invitation_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RejectJoinRequestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectJoinRequestResponse) ProtoMessage() {}
+
+func (x *RejectJoinRequestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sns_v1_invitation_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectJoinRequestResponse.ProtoReflect.Descriptor instead.
+func (*RejectJoinRequestResponse) Descriptor() ([]byte, []int) {
+	return file_sns_v1_invitation_proto_rawDescGZIP(), []int{17}
+}
+
+type GetJoinPolicyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetJoinPolicyRequest) Reset() {
+	*x = GetJoinPolicyRequest{}
+	mi := &file_sns_v1_invitation_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetJoinPolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetJoinPolicyRequest) ProtoMessage() {}
+
+func (x *GetJoinPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sns_v1_invitation_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetJoinPolicyRequest.ProtoReflect.Descriptor instead.
+func (*GetJoinPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_sns_v1_invitation_proto_rawDescGZIP(), []int{18}
+}
+
+type GetJoinPolicyResponse struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Policy              string                 `protobuf:"bytes,1,opt,name=policy,proto3" json:"policy,omitempty"`
+	AllowedEmailDomains []string               `protobuf:"bytes,2,rep,name=allowed_email_domains,json=allowedEmailDomains,proto3" json:"allowed_email_domains,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *GetJoinPolicyResponse) Reset() {
+	*x = GetJoinPolicyResponse{}
+	mi := &file_sns_v1_invitation_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetJoinPolicyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetJoinPolicyResponse) ProtoMessage() {}
+
+func (x *GetJoinPolicyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sns_v1_invitation_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetJoinPolicyResponse.ProtoReflect.Descriptor instead.
+func (*GetJoinPolicyResponse) Descriptor() ([]byte, []int) {
+	return file_sns_v1_invitation_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetJoinPolicyResponse) GetPolicy() string {
+	if x != nil {
+		return x.Policy
+	}
+	return ""
+}
+
+func (x *GetJoinPolicyResponse) GetAllowedEmailDomains() []string {
+	if x != nil {
+		return x.AllowedEmailDomains
+	}
+	return nil
+}
+
+type UpdateJoinPolicyRequest struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Policy              string                 `protobuf:"bytes,1,opt,name=policy,proto3" json:"policy,omitempty"`
+	AllowedEmailDomains []string               `protobuf:"bytes,2,rep,name=allowed_email_domains,json=allowedEmailDomains,proto3" json:"allowed_email_domains,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *UpdateJoinPolicyRequest) Reset() {
+	*x = UpdateJoinPolicyRequest{}
+	mi := &file_sns_v1_invitation_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateJoinPolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateJoinPolicyRequest) ProtoMessage() {}
+
+func (x *UpdateJoinPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sns_v1_invitation_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateJoinPolicyRequest.ProtoReflect.Descriptor instead.
+func (*UpdateJoinPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_sns_v1_invitation_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *UpdateJoinPolicyRequest) GetPolicy() string {
+	if x != nil {
+		return x.Policy
+	}
+	return ""
+}
+
+func (x *UpdateJoinPolicyRequest) GetAllowedEmailDomains() []string {
+	if x != nil {
+		return x.AllowedEmailDomains
+	}
+	return nil
+}
+
+type UpdateJoinPolicyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateJoinPolicyResponse) Reset() {
+	*x = UpdateJoinPolicyResponse{}
+	mi := &file_sns_v1_invitation_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateJoinPolicyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateJoinPolicyResponse) ProtoMessage() {}
+
+func (x *UpdateJoinPolicyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sns_v1_invitation_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateJoinPolicyResponse.ProtoReflect.Descriptor instead.
+func (*UpdateJoinPolicyResponse) Descriptor() ([]byte, []int) {
+	return file_sns_v1_invitation_proto_rawDescGZIP(), []int{21}
+}
+
+var File_sns_v1_invitation_proto protoreflect.FileDescriptor
+
+const file_sns_v1_invitation_proto_rawDesc = "" +
+	"\n" +
+	"\x17sns/v1/invitation.proto\x12\x06sns.v1\"\x9b\x01\n" +
+	"\n" +
+	"Invitation\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x12\n" +
+	"\x04role\x18\x02 \x01(\tR\x04role\x12+\n" +
+	"\x12created_by_user_id\x18\x03 \x01(\x04R\x0fcreatedByUserId\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\x05 \x01(\tR\texpiresAt\"\x92\x01\n" +
+	"\vJoinRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x04R\x06userId\x12!\n" +
+	"\fdisplay_name\x18\x03 \x01(\tR\vdisplayName\x12\x18\n" +
+	"\amessage\x18\x04 \x01(\tR\amessage\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\tR\tcreatedAt\"J\n" +
+	"\x17CreateInvitationRequest\x12\x12\n" +
+	"\x04role\x18\x01 \x01(\tR\x04role\x12\x1b\n" +
+	"\tttl_hours\x18\x02 \x01(\rR\bttlHours\"d\n" +
+	"\x18CreateInvitationResponse\x122\n" +
+	"\n" +
+	"invitation\x18\x01 \x01(\v2\x12.sns.v1.InvitationR\n" +
+	"invitation\x12\x14\n" +
+	"\x05token\x18\x02 \x01(\tR\x05token\"\x18\n" +
+	"\x16ListInvitationsRequest\"C\n" +
+	"\x17ListInvitationsResponse\x12(\n" +
+	"\x05items\x18\x01 \x03(\v2\x12.sns.v1.InvitationR\x05items\">\n" +
+	"\x17RevokeInvitationRequest\x12#\n" +
+	"\rinvitation_id\x18\x01 \x01(\x04R\finvitationId\"\x1a\n" +
+	"\x18RevokeInvitationResponse\"/\n" +
+	"\x17AcceptInvitationRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\".\n" +
+	"\x18AcceptInvitationResponse\x12\x12\n" +
+	"\x04role\x18\x01 \x01(\tR\x04role\"0\n" +
+	"\x14RequestToJoinRequest\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"O\n" +
+	"\x15RequestToJoinResponse\x126\n" +
+	"\fjoin_request\x18\x01 \x01(\v2\x13.sns.v1.JoinRequestR\vjoinRequest\"\x19\n" +
+	"\x17ListJoinRequestsRequest\"E\n" +
+	"\x18ListJoinRequestsResponse\x12)\n" +
+	"\x05items\x18\x01 \x03(\v2\x13.sns.v1.JoinRequestR\x05items\"W\n" +
+	"\x19ApproveJoinRequestRequest\x12&\n" +
+	"\x0fjoin_request_id\x18\x01 \x01(\x04R\rjoinRequestId\x12\x12\n" +
+	"\x04role\x18\x02 \x01(\tR\x04role\"\x1c\n" +
+	"\x1aApproveJoinRequestResponse\"B\n" +
+	"\x18RejectJoinRequestRequest\x12&\n" +
+	"\x0fjoin_request_id\x18\x01 \x01(\x04R\rjoinRequestId\"\x1b\n" +
+	"\x19RejectJoinRequestResponse\"\x16\n" +
+	"\x14GetJoinPolicyRequest\"c\n" +
+	"\x15GetJoinPolicyResponse\x12\x16\n" +
+	"\x06policy\x18\x01 \x01(\tR\x06policy\x122\n" +
+	"\x15allowed_email_domains\x18\x02 \x03(\tR\x13allowedEmailDomains\"e\n" +
+	"\x17UpdateJoinPolicyRequest\x12\x16\n" +
+	"\x06policy\x18\x01 \x01(\tR\x06policy\x122\n" +
+	"\x15allowed_email_domains\x18\x02 \x03(\tR\x13allowedEmailDomains\"\x1a\n" +
+	"\x18UpdateJoinPolicyResponse2\xed\x06\n" +
+	"\x11InvitationService\x12U\n" +
+	"\x10CreateInvitation\x12\x1f.sns.v1.CreateInvitationRequest\x1a .sns.v1.CreateInvitationResponse\x12R\n" +
+	"\x0fListInvitations\x12\x1e.sns.v1.ListInvitationsRequest\x1a\x1f.sns.v1.ListInvitationsResponse\x12U\n" +
+	"\x10RevokeInvitation\x12\x1f.sns.v1.RevokeInvitationRequest\x1a .sns.v1.RevokeInvitationResponse\x12U\n" +
+	"\x10AcceptInvitation\x12\x1f.sns.v1.AcceptInvitationRequest\x1a .sns.v1.AcceptInvitationResponse\x12L\n" +
+	"\rRequestToJoin\x12\x1c.sns.v1.RequestToJoinRequest\x1a\x1d.sns.v1.RequestToJoinResponse\x12U\n" +
+	"\x10ListJoinRequests\x12\x1f.sns.v1.ListJoinRequestsRequest\x1a .sns.v1.ListJoinRequestsResponse\x12[\n" +
+	"\x12ApproveJoinRequest\x12!.sns.v1.ApproveJoinRequestRequest\x1a\".sns.v1.ApproveJoinRequestResponse\x12X\n" +
+	"\x11RejectJoinRequest\x12 .sns.v1.RejectJoinRequestRequest\x1a!.sns.v1.RejectJoinRequestResponse\x12L\n" +
+	"\rGetJoinPolicy\x12\x1c.sns.v1.GetJoinPolicyRequest\x1a\x1d.sns.v1.GetJoinPolicyResponse\x12U\n" +
+	"\x10UpdateJoinPolicy\x12\x1f.sns.v1.UpdateJoinPolicyRequest\x1a .sns.v1.UpdateJoinPolicyResponseB>Z<github.com/example/something-like-sns/apps/api/gen/sns/v1;v1b\x06proto3"
+
+var (
+	file_sns_v1_invitation_proto_rawDescOnce sync.Once
+	file_sns_v1_invitation_proto_rawDescData []byte
+)
+
+func file_sns_v1_invitation_proto_rawDescGZIP() []byte {
+	file_sns_v1_invitation_proto_rawDescOnce.Do(func() {
+		file_sns_v1_invitation_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_sns_v1_invitation_proto_rawDesc), len(file_sns_v1_invitation_proto_rawDesc)))
+	})
+	return file_sns_v1_invitation_proto_rawDescData
+}
+
+var file_sns_v1_invitation_proto_msgTypes = make([]protoimpl.MessageInfo, 22)
+var file_sns_v1_invitation_proto_goTypes = []any{
+	(*Invitation)(nil),                 // 0: sns.v1.Invitation
+	(*JoinRequest)(nil),                // 1: sns.v1.JoinRequest
+	(*CreateInvitationRequest)(nil),    // 2: sns.v1.CreateInvitationRequest
+	(*CreateInvitationResponse)(nil),   // 3: sns.v1.CreateInvitationResponse
+	(*ListInvitationsRequest)(nil),     // 4: sns.v1.ListInvitationsRequest
+	(*ListInvitationsResponse)(nil),    // 5: sns.v1.ListInvitationsResponse
+	(*RevokeInvitationRequest)(nil),    // 6: sns.v1.RevokeInvitationRequest
+	(*RevokeInvitationResponse)(nil),   // 7: sns.v1.RevokeInvitationResponse
+	(*AcceptInvitationRequest)(nil),    // 8: sns.v1.AcceptInvitationRequest
+	(*AcceptInvitationResponse)(nil),   // 9: sns.v1.AcceptInvitationResponse
+	(*RequestToJoinRequest)(nil),       // 10: sns.v1.RequestToJoinRequest
+	(*RequestToJoinResponse)(nil),      // 11: sns.v1.RequestToJoinResponse
+	(*ListJoinRequestsRequest)(nil),    // 12: sns.v1.ListJoinRequestsRequest
+	(*ListJoinRequestsResponse)(nil),   // 13: sns.v1.ListJoinRequestsResponse
+	(*ApproveJoinRequestRequest)(nil),  // 14: sns.v1.ApproveJoinRequestRequest
+	(*ApproveJoinRequestResponse)(nil), // 15: sns.v1.ApproveJoinRequestResponse
+	(*RejectJoinRequestRequest)(nil),   // 16: sns.v1.RejectJoinRequestRequest
+	(*RejectJoinRequestResponse)(nil),  // 17: sns.v1.RejectJoinRequestResponse
+	(*GetJoinPolicyRequest)(nil),       // 18: sns.v1.GetJoinPolicyRequest
+	(*GetJoinPolicyResponse)(nil),      // 19: sns.v1.GetJoinPolicyResponse
+	(*UpdateJoinPolicyRequest)(nil),    // 20: sns.v1.UpdateJoinPolicyRequest
+	(*UpdateJoinPolicyResponse)(nil),   // 21: sns.v1.UpdateJoinPolicyResponse
+}
+var file_sns_v1_invitation_proto_depIdxs = []int32{
+	0,  // 0: sns.v1.CreateInvitationResponse.invitation:type_name -> sns.v1.Invitation
+	0,  // 1: sns.v1.ListInvitationsResponse.items:type_name -> sns.v1.Invitation
+	1,  // 2: sns.v1.RequestToJoinResponse.join_request:type_name -> sns.v1.JoinRequest
+	1,  // 3: sns.v1.ListJoinRequestsResponse.items:type_name -> sns.v1.JoinRequest
+	2,  // 4: sns.v1.InvitationService.CreateInvitation:input_type -> sns.v1.CreateInvitationRequest
+	4,  // 5: sns.v1.InvitationService.ListInvitations:input_type -> sns.v1.ListInvitationsRequest
+	6,  // 6: sns.v1.InvitationService.RevokeInvitation:input_type -> sns.v1.RevokeInvitationRequest
+	8,  // 7: sns.v1.InvitationService.AcceptInvitation:input_type -> sns.v1.AcceptInvitationRequest
+	10, // 8: sns.v1.InvitationService.RequestToJoin:input_type -> sns.v1.RequestToJoinRequest
+	12, // 9: sns.v1.InvitationService.ListJoinRequests:input_type -> sns.v1.ListJoinRequestsRequest
+	14, // 10: sns.v1.InvitationService.ApproveJoinRequest:input_type -> sns.v1.ApproveJoinRequestRequest
+	16, // 11: sns.v1.InvitationService.RejectJoinRequest:input_type -> sns.v1.RejectJoinRequestRequest
+	18, // 12: sns.v1.InvitationService.GetJoinPolicy:input_type -> sns.v1.GetJoinPolicyRequest
+	20, // 13: sns.v1.InvitationService.UpdateJoinPolicy:input_type -> sns.v1.UpdateJoinPolicyRequest
+	3,  // 14: sns.v1.InvitationService.CreateInvitation:output_type -> sns.v1.CreateInvitationResponse
+	5,  // 15: sns.v1.InvitationService.ListInvitations:output_type -> sns.v1.ListInvitationsResponse
+	7,  // 16: sns.v1.InvitationService.RevokeInvitation:output_type -> sns.v1.RevokeInvitationResponse
+	9,  // 17: sns.v1.InvitationService.AcceptInvitation:output_type -> sns.v1.AcceptInvitationResponse
+	11, // 18: sns.v1.InvitationService.RequestToJoin:output_type -> sns.v1.RequestToJoinResponse
+	13, // 19: sns.v1.InvitationService.ListJoinRequests:output_type -> sns.v1.ListJoinRequestsResponse
+	15, // 20: sns.v1.InvitationService.ApproveJoinRequest:output_type -> sns.v1.ApproveJoinRequestResponse
+	17, // 21: sns.v1.InvitationService.RejectJoinRequest:output_type -> sns.v1.RejectJoinRequestResponse
+	19, // 22: sns.v1.InvitationService.GetJoinPolicy:output_type -> sns.v1.GetJoinPolicyResponse
+	21, // 23: sns.v1.InvitationService.UpdateJoinPolicy:output_type -> sns.v1.UpdateJoinPolicyResponse
+	14, // [14:24] is the sub-list for method output_type
+	4,  // [4:14] is the sub-list for method input_type
+	4,  // [4:4] is the sub-list for extension type_name
+	4,  // [4:4] is the sub-list for extension extendee
+	0,  // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_sns_v1_invitation_proto_init() }
+func file_sns_v1_invitation_proto_init() {
+	if File_sns_v1_invitation_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_sns_v1_invitation_proto_rawDesc), len(file_sns_v1_invitation_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   22,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_sns_v1_invitation_proto_goTypes,
+		DependencyIndexes: file_sns_v1_invitation_proto_depIdxs,
+		MessageInfos:      file_sns_v1_invitation_proto_msgTypes,
+	}.Build()
+	File_sns_v1_invitation_proto = out.File
+	file_sns_v1_invitation_proto_goTypes = nil
+	file_sns_v1_invitation_proto_depIdxs = nil
+}
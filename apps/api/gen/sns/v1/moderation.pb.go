@@ -0,0 +1,1007 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: sns/v1/moderation.proto
+
+package v1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ReportTargetType int32
+
+const (
+	ReportTargetType_REPORT_TARGET_TYPE_UNSPECIFIED ReportTargetType = 0
+	ReportTargetType_REPORT_TARGET_POST             ReportTargetType = 1
+	ReportTargetType_REPORT_TARGET_COMMENT          ReportTargetType = 2
+	ReportTargetType_REPORT_TARGET_MESSAGE          ReportTargetType = 3
+)
+
+// Enum value maps for ReportTargetType.
+var (
+	ReportTargetType_name = map[int32]string{
+		0: "REPORT_TARGET_TYPE_UNSPECIFIED",
+		1: "REPORT_TARGET_POST",
+		2: "REPORT_TARGET_COMMENT",
+		3: "REPORT_TARGET_MESSAGE",
+	}
+	ReportTargetType_value = map[string]int32{
+		"REPORT_TARGET_TYPE_UNSPECIFIED": 0,
+		"REPORT_TARGET_POST":             1,
+		"REPORT_TARGET_COMMENT":          2,
+		"REPORT_TARGET_MESSAGE":          3,
+	}
+)
+
+func (x ReportTargetType) Enum() *ReportTargetType {
+	p := new(ReportTargetType)
+	*p = x
+	return p
+}
+
+func (x ReportTargetType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ReportTargetType) Descriptor() protoreflect.EnumDescriptor {
+	return file_sns_v1_moderation_proto_enumTypes[0].Descriptor()
+}
+
+func (ReportTargetType) Type() protoreflect.EnumType {
+	return &file_sns_v1_moderation_proto_enumTypes[0]
+}
+
+func (x ReportTargetType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ReportTargetType.Descriptor instead.
+func (ReportTargetType) EnumDescriptor() ([]byte, []int) {
+	return file_sns_v1_moderation_proto_rawDescGZIP(), []int{0}
+}
+
+type Report struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	TargetType       ReportTargetType       `protobuf:"varint,2,opt,name=target_type,json=targetType,proto3,enum=sns.v1.ReportTargetType" json:"target_type,omitempty"`
+	TargetId         uint64                 `protobuf:"varint,3,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	AuthorUserId     uint64                 `protobuf:"varint,4,opt,name=author_user_id,json=authorUserId,proto3" json:"author_user_id,omitempty"`
+	ReporterUserId   uint64                 `protobuf:"varint,5,opt,name=reporter_user_id,json=reporterUserId,proto3" json:"reporter_user_id,omitempty"`
+	Reason           string                 `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+	Content          string                 `protobuf:"bytes,7,opt,name=content,proto3" json:"content,omitempty"`
+	Status           string                 `protobuf:"bytes,8,opt,name=status,proto3" json:"status,omitempty"`
+	CreatedAt        string                 `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Action           string                 `protobuf:"bytes,10,opt,name=action,proto3" json:"action,omitempty"`
+	ResolvedByUserId uint64                 `protobuf:"varint,11,opt,name=resolved_by_user_id,json=resolvedByUserId,proto3" json:"resolved_by_user_id,omitempty"`
+	ResolvedAt       string                 `protobuf:"bytes,12,opt,name=resolved_at,json=resolvedAt,proto3" json:"resolved_at,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *Report) Reset() {
+	*x = Report{}
+	mi := &file_sns_v1_moderation_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Report) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Report) ProtoMessage() {}
+
+func (x *Report) ProtoReflect() protoreflect.Message {
+	mi := &file_sns_v1_moderation_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Report.ProtoReflect.Descriptor instead.
+func (*Report) Descriptor() ([]byte, []int) {
+	return file_sns_v1_moderation_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Report) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Report) GetTargetType() ReportTargetType {
+	if x != nil {
+		return x.TargetType
+	}
+	return ReportTargetType_REPORT_TARGET_TYPE_UNSPECIFIED
+}
+
+func (x *Report) GetTargetId() uint64 {
+	if x != nil {
+		return x.TargetId
+	}
+	return 0
+}
+
+func (x *Report) GetAuthorUserId() uint64 {
+	if x != nil {
+		return x.AuthorUserId
+	}
+	return 0
+}
+
+func (x *Report) GetReporterUserId() uint64 {
+	if x != nil {
+		return x.ReporterUserId
+	}
+	return 0
+}
+
+func (x *Report) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *Report) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *Report) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Report) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *Report) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *Report) GetResolvedByUserId() uint64 {
+	if x != nil {
+		return x.ResolvedByUserId
+	}
+	return 0
+}
+
+func (x *Report) GetResolvedAt() string {
+	if x != nil {
+		return x.ResolvedAt
+	}
+	return ""
+}
+
+type AuditEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ActorUserId   uint64                 `protobuf:"varint,2,opt,name=actor_user_id,json=actorUserId,proto3" json:"actor_user_id,omitempty"`
+	Action        string                 `protobuf:"bytes,3,opt,name=action,proto3" json:"action,omitempty"`
+	TargetType    string                 `protobuf:"bytes,4,opt,name=target_type,json=targetType,proto3" json:"target_type,omitempty"`
+	TargetId      uint64                 `protobuf:"varint,5,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	ReportId      uint64                 `protobuf:"varint,6,opt,name=report_id,json=reportId,proto3" json:"report_id,omitempty"`
+	Note          string                 `protobuf:"bytes,7,opt,name=note,proto3" json:"note,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuditEvent) Reset() {
+	*x = AuditEvent{}
+	mi := &file_sns_v1_moderation_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuditEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditEvent) ProtoMessage() {}
+
+func (x *AuditEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_sns_v1_moderation_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditEvent.ProtoReflect.Descriptor instead.
+func (*AuditEvent) Descriptor() ([]byte, []int) {
+	return file_sns_v1_moderation_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AuditEvent) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AuditEvent) GetActorUserId() uint64 {
+	if x != nil {
+		return x.ActorUserId
+	}
+	return 0
+}
+
+func (x *AuditEvent) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetTargetType() string {
+	if x != nil {
+		return x.TargetType
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetTargetId() uint64 {
+	if x != nil {
+		return x.TargetId
+	}
+	return 0
+}
+
+func (x *AuditEvent) GetReportId() uint64 {
+	if x != nil {
+		return x.ReportId
+	}
+	return 0
+}
+
+func (x *AuditEvent) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type ReportContentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TargetType    ReportTargetType       `protobuf:"varint,1,opt,name=target_type,json=targetType,proto3,enum=sns.v1.ReportTargetType" json:"target_type,omitempty"`
+	TargetId      uint64                 `protobuf:"varint,2,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReportContentRequest) Reset() {
+	*x = ReportContentRequest{}
+	mi := &file_sns_v1_moderation_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReportContentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportContentRequest) ProtoMessage() {}
+
+func (x *ReportContentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sns_v1_moderation_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportContentRequest.ProtoReflect.Descriptor instead.
+func (*ReportContentRequest) Descriptor() ([]byte, []int) {
+	return file_sns_v1_moderation_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ReportContentRequest) GetTargetType() ReportTargetType {
+	if x != nil {
+		return x.TargetType
+	}
+	return ReportTargetType_REPORT_TARGET_TYPE_UNSPECIFIED
+}
+
+func (x *ReportContentRequest) GetTargetId() uint64 {
+	if x != nil {
+		return x.TargetId
+	}
+	return 0
+}
+
+func (x *ReportContentRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type ReportContentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Report        *Report                `protobuf:"bytes,1,opt,name=report,proto3" json:"report,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReportContentResponse) Reset() {
+	*x = ReportContentResponse{}
+	mi := &file_sns_v1_moderation_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReportContentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportContentResponse) ProtoMessage() {}
+
+func (x *ReportContentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sns_v1_moderation_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportContentResponse.ProtoReflect.Descriptor instead.
+func (*ReportContentResponse) Descriptor() ([]byte, []int) {
+	return file_sns_v1_moderation_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ReportContentResponse) GetReport() *Report {
+	if x != nil {
+		return x.Report
+	}
+	return nil
+}
+
+type ListReportsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Cursor        *Cursor                `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	PageSize      uint32                 `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListReportsRequest) Reset() {
+	*x = ListReportsRequest{}
+	mi := &file_sns_v1_moderation_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListReportsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReportsRequest) ProtoMessage() {}
+
+func (x *ListReportsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sns_v1_moderation_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReportsRequest.ProtoReflect.Descriptor instead.
+func (*ListReportsRequest) Descriptor() ([]byte, []int) {
+	return file_sns_v1_moderation_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListReportsRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ListReportsRequest) GetCursor() *Cursor {
+	if x != nil {
+		return x.Cursor
+	}
+	return nil
+}
+
+func (x *ListReportsRequest) GetPageSize() uint32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ListReportsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*Report              `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	Next          *Cursor                `protobuf:"bytes,2,opt,name=next,proto3" json:"next,omitempty"`
+	Prev          *Cursor                `protobuf:"bytes,3,opt,name=prev,proto3" json:"prev,omitempty"`
+	HasMore       bool                   `protobuf:"varint,4,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListReportsResponse) Reset() {
+	*x = ListReportsResponse{}
+	mi := &file_sns_v1_moderation_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListReportsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReportsResponse) ProtoMessage() {}
+
+func (x *ListReportsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sns_v1_moderation_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReportsResponse.ProtoReflect.Descriptor instead.
+func (*ListReportsResponse) Descriptor() ([]byte, []int) {
+	return file_sns_v1_moderation_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListReportsResponse) GetItems() []*Report {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *ListReportsResponse) GetNext() *Cursor {
+	if x != nil {
+		return x.Next
+	}
+	return nil
+}
+
+func (x *ListReportsResponse) GetPrev() *Cursor {
+	if x != nil {
+		return x.Prev
+	}
+	return nil
+}
+
+func (x *ListReportsResponse) GetHasMore() bool {
+	if x != nil {
+		return x.HasMore
+	}
+	return false
+}
+
+type ResolveReportRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReportId      uint64                 `protobuf:"varint,1,opt,name=report_id,json=reportId,proto3" json:"report_id,omitempty"`
+	Action        string                 `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty"`
+	Note          string                 `protobuf:"bytes,3,opt,name=note,proto3" json:"note,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResolveReportRequest) Reset() {
+	*x = ResolveReportRequest{}
+	mi := &file_sns_v1_moderation_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolveReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveReportRequest) ProtoMessage() {}
+
+func (x *ResolveReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sns_v1_moderation_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveReportRequest.ProtoReflect.Descriptor instead.
+func (*ResolveReportRequest) Descriptor() ([]byte, []int) {
+	return file_sns_v1_moderation_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ResolveReportRequest) GetReportId() uint64 {
+	if x != nil {
+		return x.ReportId
+	}
+	return 0
+}
+
+func (x *ResolveReportRequest) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *ResolveReportRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+type ResolveReportResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Report        *Report                `protobuf:"bytes,1,opt,name=report,proto3" json:"report,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResolveReportResponse) Reset() {
+	*x = ResolveReportResponse{}
+	mi := &file_sns_v1_moderation_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolveReportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveReportResponse) ProtoMessage() {}
+
+func (x *ResolveReportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sns_v1_moderation_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveReportResponse.ProtoReflect.Descriptor instead.
+func (*ResolveReportResponse) Descriptor() ([]byte, []int) {
+	return file_sns_v1_moderation_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ResolveReportResponse) GetReport() *Report {
+	if x != nil {
+		return x.Report
+	}
+	return nil
+}
+
+type ReinstateMemberRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReinstateMemberRequest) Reset() {
+	*x = ReinstateMemberRequest{}
+	mi := &file_sns_v1_moderation_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReinstateMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReinstateMemberRequest) ProtoMessage() {}
+
+func (x *ReinstateMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sns_v1_moderation_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReinstateMemberRequest.ProtoReflect.Descriptor instead.
+func (*ReinstateMemberRequest) Descriptor() ([]byte, []int) {
+	return file_sns_v1_moderation_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ReinstateMemberRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type ReinstateMemberResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReinstateMemberResponse) Reset() {
+	*x = ReinstateMemberResponse{}
+	mi := &file_sns_v1_moderation_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReinstateMemberResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReinstateMemberResponse) ProtoMessage() {}
+
+func (x *ReinstateMemberResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sns_v1_moderation_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReinstateMemberResponse.ProtoReflect.Descriptor instead.
+func (*ReinstateMemberResponse) Descriptor() ([]byte, []int) {
+	return file_sns_v1_moderation_proto_rawDescGZIP(), []int{9}
+}
+
+type ListAuditEventsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Cursor        *Cursor                `protobuf:"bytes,1,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	PageSize      uint32                 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAuditEventsRequest) Reset() {
+	*x = ListAuditEventsRequest{}
+	mi := &file_sns_v1_moderation_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAuditEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAuditEventsRequest) ProtoMessage() {}
+
+func (x *ListAuditEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sns_v1_moderation_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAuditEventsRequest.ProtoReflect.Descriptor instead.
+func (*ListAuditEventsRequest) Descriptor() ([]byte, []int) {
+	return file_sns_v1_moderation_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ListAuditEventsRequest) GetCursor() *Cursor {
+	if x != nil {
+		return x.Cursor
+	}
+	return nil
+}
+
+func (x *ListAuditEventsRequest) GetPageSize() uint32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ListAuditEventsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*AuditEvent          `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	Next          *Cursor                `protobuf:"bytes,2,opt,name=next,proto3" json:"next,omitempty"`
+	Prev          *Cursor                `protobuf:"bytes,3,opt,name=prev,proto3" json:"prev,omitempty"`
+	HasMore       bool                   `protobuf:"varint,4,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAuditEventsResponse) Reset() {
+	*x = ListAuditEventsResponse{}
+	mi := &file_sns_v1_moderation_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAuditEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAuditEventsResponse) ProtoMessage() {}
+
+func (x *ListAuditEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sns_v1_moderation_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAuditEventsResponse.ProtoReflect.Descriptor instead.
+func (*ListAuditEventsResponse) Descriptor() ([]byte, []int) {
+	return file_sns_v1_moderation_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ListAuditEventsResponse) GetItems() []*AuditEvent {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *ListAuditEventsResponse) GetNext() *Cursor {
+	if x != nil {
+		return x.Next
+	}
+	return nil
+}
+
+func (x *ListAuditEventsResponse) GetPrev() *Cursor {
+	if x != nil {
+		return x.Prev
+	}
+	return nil
+}
+
+func (x *ListAuditEventsResponse) GetHasMore() bool {
+	if x != nil {
+		return x.HasMore
+	}
+	return false
+}
+
+var File_sns_v1_moderation_proto protoreflect.FileDescriptor
+
+const file_sns_v1_moderation_proto_rawDesc = "" +
+	"\n" +
+	"\x17sns/v1/moderation.proto\x12\x06sns.v1\x1a\x15sns/v1/timeline.proto\"\x91\x03\n" +
+	"\x06Report\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x129\n" +
+	"\vtarget_type\x18\x02 \x01(\x0e2\x18.sns.v1.ReportTargetTypeR\n" +
+	"targetType\x12\x1b\n" +
+	"\ttarget_id\x18\x03 \x01(\x04R\btargetId\x12$\n" +
+	"\x0eauthor_user_id\x18\x04 \x01(\x04R\fauthorUserId\x12(\n" +
+	"\x10reporter_user_id\x18\x05 \x01(\x04R\x0ereporterUserId\x12\x16\n" +
+	"\x06reason\x18\x06 \x01(\tR\x06reason\x12\x18\n" +
+	"\acontent\x18\a \x01(\tR\acontent\x12\x16\n" +
+	"\x06status\x18\b \x01(\tR\x06status\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\t \x01(\tR\tcreatedAt\x12\x16\n" +
+	"\x06action\x18\n" +
+	" \x01(\tR\x06action\x12-\n" +
+	"\x13resolved_by_user_id\x18\v \x01(\x04R\x10resolvedByUserId\x12\x1f\n" +
+	"\vresolved_at\x18\f \x01(\tR\n" +
+	"resolvedAt\"\xe6\x01\n" +
+	"\n" +
+	"AuditEvent\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\"\n" +
+	"\ractor_user_id\x18\x02 \x01(\x04R\vactorUserId\x12\x16\n" +
+	"\x06action\x18\x03 \x01(\tR\x06action\x12\x1f\n" +
+	"\vtarget_type\x18\x04 \x01(\tR\n" +
+	"targetType\x12\x1b\n" +
+	"\ttarget_id\x18\x05 \x01(\x04R\btargetId\x12\x1b\n" +
+	"\treport_id\x18\x06 \x01(\x04R\breportId\x12\x12\n" +
+	"\x04note\x18\a \x01(\tR\x04note\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\b \x01(\tR\tcreatedAt\"\x86\x01\n" +
+	"\x14ReportContentRequest\x129\n" +
+	"\vtarget_type\x18\x01 \x01(\x0e2\x18.sns.v1.ReportTargetTypeR\n" +
+	"targetType\x12\x1b\n" +
+	"\ttarget_id\x18\x02 \x01(\x04R\btargetId\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"?\n" +
+	"\x15ReportContentResponse\x12&\n" +
+	"\x06report\x18\x01 \x01(\v2\x0e.sns.v1.ReportR\x06report\"q\n" +
+	"\x12ListReportsRequest\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\x12&\n" +
+	"\x06cursor\x18\x02 \x01(\v2\x0e.sns.v1.CursorR\x06cursor\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\rR\bpageSize\"\x9e\x01\n" +
+	"\x13ListReportsResponse\x12$\n" +
+	"\x05items\x18\x01 \x03(\v2\x0e.sns.v1.ReportR\x05items\x12\"\n" +
+	"\x04next\x18\x02 \x01(\v2\x0e.sns.v1.CursorR\x04next\x12\"\n" +
+	"\x04prev\x18\x03 \x01(\v2\x0e.sns.v1.CursorR\x04prev\x12\x19\n" +
+	"\bhas_more\x18\x04 \x01(\bR\ahasMore\"_\n" +
+	"\x14ResolveReportRequest\x12\x1b\n" +
+	"\treport_id\x18\x01 \x01(\x04R\breportId\x12\x16\n" +
+	"\x06action\x18\x02 \x01(\tR\x06action\x12\x12\n" +
+	"\x04note\x18\x03 \x01(\tR\x04note\"?\n" +
+	"\x15ResolveReportResponse\x12&\n" +
+	"\x06report\x18\x01 \x01(\v2\x0e.sns.v1.ReportR\x06report\"1\n" +
+	"\x16ReinstateMemberRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x04R\x06userId\"\x19\n" +
+	"\x17ReinstateMemberResponse\"]\n" +
+	"\x16ListAuditEventsRequest\x12&\n" +
+	"\x06cursor\x18\x01 \x01(\v2\x0e.sns.v1.CursorR\x06cursor\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\rR\bpageSize\"\xa6\x01\n" +
+	"\x17ListAuditEventsResponse\x12(\n" +
+	"\x05items\x18\x01 \x03(\v2\x12.sns.v1.AuditEventR\x05items\x12\"\n" +
+	"\x04next\x18\x02 \x01(\v2\x0e.sns.v1.CursorR\x04next\x12\"\n" +
+	"\x04prev\x18\x03 \x01(\v2\x0e.sns.v1.CursorR\x04prev\x12\x19\n" +
+	"\bhas_more\x18\x04 \x01(\bR\ahasMore*\x84\x01\n" +
+	"\x10ReportTargetType\x12\"\n" +
+	"\x1eREPORT_TARGET_TYPE_UNSPECIFIED\x10\x00\x12\x16\n" +
+	"\x12REPORT_TARGET_POST\x10\x01\x12\x19\n" +
+	"\x15REPORT_TARGET_COMMENT\x10\x02\x12\x19\n" +
+	"\x15REPORT_TARGET_MESSAGE\x10\x032\x9f\x03\n" +
+	"\x11ModerationService\x12L\n" +
+	"\rReportContent\x12\x1c.sns.v1.ReportContentRequest\x1a\x1d.sns.v1.ReportContentResponse\x12F\n" +
+	"\vListReports\x12\x1a.sns.v1.ListReportsRequest\x1a\x1b.sns.v1.ListReportsResponse\x12L\n" +
+	"\rResolveReport\x12\x1c.sns.v1.ResolveReportRequest\x1a\x1d.sns.v1.ResolveReportResponse\x12R\n" +
+	"\x0fReinstateMember\x12\x1e.sns.v1.ReinstateMemberRequest\x1a\x1f.sns.v1.ReinstateMemberResponse\x12R\n" +
+	"\x0fListAuditEvents\x12\x1e.sns.v1.ListAuditEventsRequest\x1a\x1f.sns.v1.ListAuditEventsResponseB>Z<github.com/example/something-like-sns/apps/api/gen/sns/v1;v1b\x06proto3"
+
+var (
+	file_sns_v1_moderation_proto_rawDescOnce sync.Once
+	file_sns_v1_moderation_proto_rawDescData []byte
+)
+
+func file_sns_v1_moderation_proto_rawDescGZIP() []byte {
+	file_sns_v1_moderation_proto_rawDescOnce.Do(func() {
+		file_sns_v1_moderation_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_sns_v1_moderation_proto_rawDesc), len(file_sns_v1_moderation_proto_rawDesc)))
+	})
+	return file_sns_v1_moderation_proto_rawDescData
+}
+
+var file_sns_v1_moderation_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_sns_v1_moderation_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_sns_v1_moderation_proto_goTypes = []any{
+	(ReportTargetType)(0),           // 0: sns.v1.ReportTargetType
+	(*Report)(nil),                  // 1: sns.v1.Report
+	(*AuditEvent)(nil),              // 2: sns.v1.AuditEvent
+	(*ReportContentRequest)(nil),    // 3: sns.v1.ReportContentRequest
+	(*ReportContentResponse)(nil),   // 4: sns.v1.ReportContentResponse
+	(*ListReportsRequest)(nil),      // 5: sns.v1.ListReportsRequest
+	(*ListReportsResponse)(nil),     // 6: sns.v1.ListReportsResponse
+	(*ResolveReportRequest)(nil),    // 7: sns.v1.ResolveReportRequest
+	(*ResolveReportResponse)(nil),   // 8: sns.v1.ResolveReportResponse
+	(*ReinstateMemberRequest)(nil),  // 9: sns.v1.ReinstateMemberRequest
+	(*ReinstateMemberResponse)(nil), // 10: sns.v1.ReinstateMemberResponse
+	(*ListAuditEventsRequest)(nil),  // 11: sns.v1.ListAuditEventsRequest
+	(*ListAuditEventsResponse)(nil), // 12: sns.v1.ListAuditEventsResponse
+	(*Cursor)(nil),                  // 13: sns.v1.Cursor
+}
+var file_sns_v1_moderation_proto_depIdxs = []int32{
+	0,  // 0: sns.v1.Report.target_type:type_name -> sns.v1.ReportTargetType
+	0,  // 1: sns.v1.ReportContentRequest.target_type:type_name -> sns.v1.ReportTargetType
+	1,  // 2: sns.v1.ReportContentResponse.report:type_name -> sns.v1.Report
+	13, // 3: sns.v1.ListReportsRequest.cursor:type_name -> sns.v1.Cursor
+	1,  // 4: sns.v1.ListReportsResponse.items:type_name -> sns.v1.Report
+	13, // 5: sns.v1.ListReportsResponse.next:type_name -> sns.v1.Cursor
+	13, // 6: sns.v1.ListReportsResponse.prev:type_name -> sns.v1.Cursor
+	1,  // 7: sns.v1.ResolveReportResponse.report:type_name -> sns.v1.Report
+	13, // 8: sns.v1.ListAuditEventsRequest.cursor:type_name -> sns.v1.Cursor
+	2,  // 9: sns.v1.ListAuditEventsResponse.items:type_name -> sns.v1.AuditEvent
+	13, // 10: sns.v1.ListAuditEventsResponse.next:type_name -> sns.v1.Cursor
+	13, // 11: sns.v1.ListAuditEventsResponse.prev:type_name -> sns.v1.Cursor
+	3,  // 12: sns.v1.ModerationService.ReportContent:input_type -> sns.v1.ReportContentRequest
+	5,  // 13: sns.v1.ModerationService.ListReports:input_type -> sns.v1.ListReportsRequest
+	7,  // 14: sns.v1.ModerationService.ResolveReport:input_type -> sns.v1.ResolveReportRequest
+	9,  // 15: sns.v1.ModerationService.ReinstateMember:input_type -> sns.v1.ReinstateMemberRequest
+	11, // 16: sns.v1.ModerationService.ListAuditEvents:input_type -> sns.v1.ListAuditEventsRequest
+	4,  // 17: sns.v1.ModerationService.ReportContent:output_type -> sns.v1.ReportContentResponse
+	6,  // 18: sns.v1.ModerationService.ListReports:output_type -> sns.v1.ListReportsResponse
+	8,  // 19: sns.v1.ModerationService.ResolveReport:output_type -> sns.v1.ResolveReportResponse
+	10, // 20: sns.v1.ModerationService.ReinstateMember:output_type -> sns.v1.ReinstateMemberResponse
+	12, // 21: sns.v1.ModerationService.ListAuditEvents:output_type -> sns.v1.ListAuditEventsResponse
+	17, // [17:22] is the sub-list for method output_type
+	12, // [12:17] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
+}
+
+func init() { file_sns_v1_moderation_proto_init() }
+func file_sns_v1_moderation_proto_init() {
+	if File_sns_v1_moderation_proto != nil {
+		return
+	}
+	file_sns_v1_timeline_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_sns_v1_moderation_proto_rawDesc), len(file_sns_v1_moderation_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   12,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_sns_v1_moderation_proto_goTypes,
+		DependencyIndexes: file_sns_v1_moderation_proto_depIdxs,
+		EnumInfos:         file_sns_v1_moderation_proto_enumTypes,
+		MessageInfos:      file_sns_v1_moderation_proto_msgTypes,
+	}.Build()
+	File_sns_v1_moderation_proto = out.File
+	file_sns_v1_moderation_proto_goTypes = nil
+	file_sns_v1_moderation_proto_depIdxs = nil
+}
@@ -0,0 +1,649 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: sns/v1/profile.proto
+
+package v1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Profile struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	DisplayName   string                 `protobuf:"bytes,2,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	Handle        string                 `protobuf:"bytes,3,opt,name=handle,proto3" json:"handle,omitempty"`
+	Bio           string                 `protobuf:"bytes,4,opt,name=bio,proto3" json:"bio,omitempty"`
+	AvatarUrl     string                 `protobuf:"bytes,5,opt,name=avatar_url,json=avatarUrl,proto3" json:"avatar_url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Profile) Reset() {
+	*x = Profile{}
+	mi := &file_sns_v1_profile_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Profile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Profile) ProtoMessage() {}
+
+func (x *Profile) ProtoReflect() protoreflect.Message {
+	mi := &file_sns_v1_profile_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Profile.ProtoReflect.Descriptor instead.
+func (*Profile) Descriptor() ([]byte, []int) {
+	return file_sns_v1_profile_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Profile) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *Profile) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *Profile) GetHandle() string {
+	if x != nil {
+		return x.Handle
+	}
+	return ""
+}
+
+func (x *Profile) GetBio() string {
+	if x != nil {
+		return x.Bio
+	}
+	return ""
+}
+
+func (x *Profile) GetAvatarUrl() string {
+	if x != nil {
+		return x.AvatarUrl
+	}
+	return ""
+}
+
+type TenantProfile struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DisplayName   string                 `protobuf:"bytes,1,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	AvatarUrl     string                 `protobuf:"bytes,2,opt,name=avatar_url,json=avatarUrl,proto3" json:"avatar_url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TenantProfile) Reset() {
+	*x = TenantProfile{}
+	mi := &file_sns_v1_profile_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TenantProfile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TenantProfile) ProtoMessage() {}
+
+func (x *TenantProfile) ProtoReflect() protoreflect.Message {
+	mi := &file_sns_v1_profile_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TenantProfile.ProtoReflect.Descriptor instead.
+func (*TenantProfile) Descriptor() ([]byte, []int) {
+	return file_sns_v1_profile_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *TenantProfile) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *TenantProfile) GetAvatarUrl() string {
+	if x != nil {
+		return x.AvatarUrl
+	}
+	return ""
+}
+
+type GetProfileRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProfileRequest) Reset() {
+	*x = GetProfileRequest{}
+	mi := &file_sns_v1_profile_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProfileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProfileRequest) ProtoMessage() {}
+
+func (x *GetProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sns_v1_profile_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProfileRequest.ProtoReflect.Descriptor instead.
+func (*GetProfileRequest) Descriptor() ([]byte, []int) {
+	return file_sns_v1_profile_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetProfileRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type GetProfileResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Profile       *Profile               `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProfileResponse) Reset() {
+	*x = GetProfileResponse{}
+	mi := &file_sns_v1_profile_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProfileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProfileResponse) ProtoMessage() {}
+
+func (x *GetProfileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sns_v1_profile_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProfileResponse.ProtoReflect.Descriptor instead.
+func (*GetProfileResponse) Descriptor() ([]byte, []int) {
+	return file_sns_v1_profile_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetProfileResponse) GetProfile() *Profile {
+	if x != nil {
+		return x.Profile
+	}
+	return nil
+}
+
+type UpdateProfileRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DisplayName   string                 `protobuf:"bytes,1,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	Handle        string                 `protobuf:"bytes,2,opt,name=handle,proto3" json:"handle,omitempty"`
+	Bio           string                 `protobuf:"bytes,3,opt,name=bio,proto3" json:"bio,omitempty"`
+	AvatarUrl     string                 `protobuf:"bytes,4,opt,name=avatar_url,json=avatarUrl,proto3" json:"avatar_url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateProfileRequest) Reset() {
+	*x = UpdateProfileRequest{}
+	mi := &file_sns_v1_profile_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateProfileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProfileRequest) ProtoMessage() {}
+
+func (x *UpdateProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sns_v1_profile_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateProfileRequest.ProtoReflect.Descriptor instead.
+func (*UpdateProfileRequest) Descriptor() ([]byte, []int) {
+	return file_sns_v1_profile_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *UpdateProfileRequest) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *UpdateProfileRequest) GetHandle() string {
+	if x != nil {
+		return x.Handle
+	}
+	return ""
+}
+
+func (x *UpdateProfileRequest) GetBio() string {
+	if x != nil {
+		return x.Bio
+	}
+	return ""
+}
+
+func (x *UpdateProfileRequest) GetAvatarUrl() string {
+	if x != nil {
+		return x.AvatarUrl
+	}
+	return ""
+}
+
+type UpdateProfileResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Profile       *Profile               `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateProfileResponse) Reset() {
+	*x = UpdateProfileResponse{}
+	mi := &file_sns_v1_profile_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateProfileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProfileResponse) ProtoMessage() {}
+
+func (x *UpdateProfileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sns_v1_profile_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateProfileResponse.ProtoReflect.Descriptor instead.
+func (*UpdateProfileResponse) Descriptor() ([]byte, []int) {
+	return file_sns_v1_profile_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *UpdateProfileResponse) GetProfile() *Profile {
+	if x != nil {
+		return x.Profile
+	}
+	return nil
+}
+
+type GetProfileSettingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProfileSettingsRequest) Reset() {
+	*x = GetProfileSettingsRequest{}
+	mi := &file_sns_v1_profile_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProfileSettingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProfileSettingsRequest) ProtoMessage() {}
+
+func (x *GetProfileSettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sns_v1_profile_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProfileSettingsRequest.ProtoReflect.Descriptor instead.
+func (*GetProfileSettingsRequest) Descriptor() ([]byte, []int) {
+	return file_sns_v1_profile_proto_rawDescGZIP(), []int{6}
+}
+
+type GetProfileSettingsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Profile       *Profile               `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"`
+	TenantProfile *TenantProfile         `protobuf:"bytes,2,opt,name=tenant_profile,json=tenantProfile,proto3" json:"tenant_profile,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProfileSettingsResponse) Reset() {
+	*x = GetProfileSettingsResponse{}
+	mi := &file_sns_v1_profile_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProfileSettingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProfileSettingsResponse) ProtoMessage() {}
+
+func (x *GetProfileSettingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sns_v1_profile_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProfileSettingsResponse.ProtoReflect.Descriptor instead.
+func (*GetProfileSettingsResponse) Descriptor() ([]byte, []int) {
+	return file_sns_v1_profile_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetProfileSettingsResponse) GetProfile() *Profile {
+	if x != nil {
+		return x.Profile
+	}
+	return nil
+}
+
+func (x *GetProfileSettingsResponse) GetTenantProfile() *TenantProfile {
+	if x != nil {
+		return x.TenantProfile
+	}
+	return nil
+}
+
+type UpdateTenantProfileRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DisplayName   string                 `protobuf:"bytes,1,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	AvatarUrl     string                 `protobuf:"bytes,2,opt,name=avatar_url,json=avatarUrl,proto3" json:"avatar_url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTenantProfileRequest) Reset() {
+	*x = UpdateTenantProfileRequest{}
+	mi := &file_sns_v1_profile_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTenantProfileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTenantProfileRequest) ProtoMessage() {}
+
+func (x *UpdateTenantProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sns_v1_profile_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTenantProfileRequest.ProtoReflect.Descriptor instead.
+func (*UpdateTenantProfileRequest) Descriptor() ([]byte, []int) {
+	return file_sns_v1_profile_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *UpdateTenantProfileRequest) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *UpdateTenantProfileRequest) GetAvatarUrl() string {
+	if x != nil {
+		return x.AvatarUrl
+	}
+	return ""
+}
+
+type UpdateTenantProfileResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Profile       *Profile               `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTenantProfileResponse) Reset() {
+	*x = UpdateTenantProfileResponse{}
+	mi := &file_sns_v1_profile_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTenantProfileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTenantProfileResponse) ProtoMessage() {}
+
+func (x *UpdateTenantProfileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sns_v1_profile_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTenantProfileResponse.ProtoReflect.Descriptor instead.
+func (*UpdateTenantProfileResponse) Descriptor() ([]byte, []int) {
+	return file_sns_v1_profile_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *UpdateTenantProfileResponse) GetProfile() *Profile {
+	if x != nil {
+		return x.Profile
+	}
+	return nil
+}
+
+var File_sns_v1_profile_proto protoreflect.FileDescriptor
+
+const file_sns_v1_profile_proto_rawDesc = "" +
+	"\n" +
+	"\x14sns/v1/profile.proto\x12\x06sns.v1\"\x8e\x01\n" +
+	"\aProfile\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x04R\x06userId\x12!\n" +
+	"\fdisplay_name\x18\x02 \x01(\tR\vdisplayName\x12\x16\n" +
+	"\x06handle\x18\x03 \x01(\tR\x06handle\x12\x10\n" +
+	"\x03bio\x18\x04 \x01(\tR\x03bio\x12\x1d\n" +
+	"\n" +
+	"avatar_url\x18\x05 \x01(\tR\tavatarUrl\"Q\n" +
+	"\rTenantProfile\x12!\n" +
+	"\fdisplay_name\x18\x01 \x01(\tR\vdisplayName\x12\x1d\n" +
+	"\n" +
+	"avatar_url\x18\x02 \x01(\tR\tavatarUrl\",\n" +
+	"\x11GetProfileRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x04R\x06userId\"?\n" +
+	"\x12GetProfileResponse\x12)\n" +
+	"\aprofile\x18\x01 \x01(\v2\x0f.sns.v1.ProfileR\aprofile\"\x82\x01\n" +
+	"\x14UpdateProfileRequest\x12!\n" +
+	"\fdisplay_name\x18\x01 \x01(\tR\vdisplayName\x12\x16\n" +
+	"\x06handle\x18\x02 \x01(\tR\x06handle\x12\x10\n" +
+	"\x03bio\x18\x03 \x01(\tR\x03bio\x12\x1d\n" +
+	"\n" +
+	"avatar_url\x18\x04 \x01(\tR\tavatarUrl\"B\n" +
+	"\x15UpdateProfileResponse\x12)\n" +
+	"\aprofile\x18\x01 \x01(\v2\x0f.sns.v1.ProfileR\aprofile\"\x1b\n" +
+	"\x19GetProfileSettingsRequest\"\x85\x01\n" +
+	"\x1aGetProfileSettingsResponse\x12)\n" +
+	"\aprofile\x18\x01 \x01(\v2\x0f.sns.v1.ProfileR\aprofile\x12<\n" +
+	"\x0etenant_profile\x18\x02 \x01(\v2\x15.sns.v1.TenantProfileR\rtenantProfile\"^\n" +
+	"\x1aUpdateTenantProfileRequest\x12!\n" +
+	"\fdisplay_name\x18\x01 \x01(\tR\vdisplayName\x12\x1d\n" +
+	"\n" +
+	"avatar_url\x18\x02 \x01(\tR\tavatarUrl\"H\n" +
+	"\x1bUpdateTenantProfileResponse\x12)\n" +
+	"\aprofile\x18\x01 \x01(\v2\x0f.sns.v1.ProfileR\aprofile2\xe0\x02\n" +
+	"\x0eProfileService\x12C\n" +
+	"\n" +
+	"GetProfile\x12\x19.sns.v1.GetProfileRequest\x1a\x1a.sns.v1.GetProfileResponse\x12L\n" +
+	"\rUpdateProfile\x12\x1c.sns.v1.UpdateProfileRequest\x1a\x1d.sns.v1.UpdateProfileResponse\x12[\n" +
+	"\x12GetProfileSettings\x12!.sns.v1.GetProfileSettingsRequest\x1a\".sns.v1.GetProfileSettingsResponse\x12^\n" +
+	"\x13UpdateTenantProfile\x12\".sns.v1.UpdateTenantProfileRequest\x1a#.sns.v1.UpdateTenantProfileResponseB>Z<github.com/example/something-like-sns/apps/api/gen/sns/v1;v1b\x06proto3"
+
+var (
+	file_sns_v1_profile_proto_rawDescOnce sync.Once
+	file_sns_v1_profile_proto_rawDescData []byte
+)
+
+func file_sns_v1_profile_proto_rawDescGZIP() []byte {
+	file_sns_v1_profile_proto_rawDescOnce.Do(func() {
+		file_sns_v1_profile_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_sns_v1_profile_proto_rawDesc), len(file_sns_v1_profile_proto_rawDesc)))
+	})
+	return file_sns_v1_profile_proto_rawDescData
+}
+
+var file_sns_v1_profile_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_sns_v1_profile_proto_goTypes = []any{
+	(*Profile)(nil),                     // 0: sns.v1.Profile
+	(*TenantProfile)(nil),               // 1: sns.v1.TenantProfile
+	(*GetProfileRequest)(nil),           // 2: sns.v1.GetProfileRequest
+	(*GetProfileResponse)(nil),          // 3: sns.v1.GetProfileResponse
+	(*UpdateProfileRequest)(nil),        // 4: sns.v1.UpdateProfileRequest
+	(*UpdateProfileResponse)(nil),       // 5: sns.v1.UpdateProfileResponse
+	(*GetProfileSettingsRequest)(nil),   // 6: sns.v1.GetProfileSettingsRequest
+	(*GetProfileSettingsResponse)(nil),  // 7: sns.v1.GetProfileSettingsResponse
+	(*UpdateTenantProfileRequest)(nil),  // 8: sns.v1.UpdateTenantProfileRequest
+	(*UpdateTenantProfileResponse)(nil), // 9: sns.v1.UpdateTenantProfileResponse
+}
+var file_sns_v1_profile_proto_depIdxs = []int32{
+	0, // 0: sns.v1.GetProfileResponse.profile:type_name -> sns.v1.Profile
+	0, // 1: sns.v1.UpdateProfileResponse.profile:type_name -> sns.v1.Profile
+	0, // 2: sns.v1.GetProfileSettingsResponse.profile:type_name -> sns.v1.Profile
+	1, // 3: sns.v1.GetProfileSettingsResponse.tenant_profile:type_name -> sns.v1.TenantProfile
+	0, // 4: sns.v1.UpdateTenantProfileResponse.profile:type_name -> sns.v1.Profile
+	2, // 5: sns.v1.ProfileService.GetProfile:input_type -> sns.v1.GetProfileRequest
+	4, // 6: sns.v1.ProfileService.UpdateProfile:input_type -> sns.v1.UpdateProfileRequest
+	6, // 7: sns.v1.ProfileService.GetProfileSettings:input_type -> sns.v1.GetProfileSettingsRequest
+	8, // 8: sns.v1.ProfileService.UpdateTenantProfile:input_type -> sns.v1.UpdateTenantProfileRequest
+	3, // 9: sns.v1.ProfileService.GetProfile:output_type -> sns.v1.GetProfileResponse
+	5, // 10: sns.v1.ProfileService.UpdateProfile:output_type -> sns.v1.UpdateProfileResponse
+	7, // 11: sns.v1.ProfileService.GetProfileSettings:output_type -> sns.v1.GetProfileSettingsResponse
+	9, // 12: sns.v1.ProfileService.UpdateTenantProfile:output_type -> sns.v1.UpdateTenantProfileResponse
+	9, // [9:13] is the sub-list for method output_type
+	5, // [5:9] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_sns_v1_profile_proto_init() }
+func file_sns_v1_profile_proto_init() {
+	if File_sns_v1_profile_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_sns_v1_profile_proto_rawDesc), len(file_sns_v1_profile_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   10,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_sns_v1_profile_proto_goTypes,
+		DependencyIndexes: file_sns_v1_profile_proto_depIdxs,
+		MessageInfos:      file_sns_v1_profile_proto_msgTypes,
+	}.Build()
+	File_sns_v1_profile_proto = out.File
+	file_sns_v1_profile_proto_goTypes = nil
+	file_sns_v1_profile_proto_depIdxs = nil
+}
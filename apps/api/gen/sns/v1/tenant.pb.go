@@ -158,6 +158,7 @@ type GetMeResponse struct {
 	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	DisplayName   string                 `protobuf:"bytes,2,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
 	Memberships   []*TenantMembership    `protobuf:"bytes,3,rep,name=memberships,proto3" json:"memberships,omitempty"`
+	AvatarUrl     string                 `protobuf:"bytes,4,opt,name=avatar_url,json=avatarUrl,proto3" json:"avatar_url,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -213,6 +214,13 @@ func (x *GetMeResponse) GetMemberships() []*TenantMembership {
 	return nil
 }
 
+func (x *GetMeResponse) GetAvatarUrl() string {
+	if x != nil {
+		return x.AvatarUrl
+	}
+	return ""
+}
+
 type TenantMembership struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	TenantId      uint64                 `protobuf:"varint,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
@@ -273,6 +281,246 @@ func (x *TenantMembership) GetTenantSlug() string {
 	return ""
 }
 
+type PlanLimits struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	PostsPerMinute    uint32                 `protobuf:"varint,1,opt,name=posts_per_minute,json=postsPerMinute,proto3" json:"posts_per_minute,omitempty"`
+	CommentsPerMinute uint32                 `protobuf:"varint,2,opt,name=comments_per_minute,json=commentsPerMinute,proto3" json:"comments_per_minute,omitempty"`
+	MessagesPerMinute uint32                 `protobuf:"varint,3,opt,name=messages_per_minute,json=messagesPerMinute,proto3" json:"messages_per_minute,omitempty"`
+	DailyPosts        uint32                 `protobuf:"varint,4,opt,name=daily_posts,json=dailyPosts,proto3" json:"daily_posts,omitempty"`
+	StorageBytes      uint64                 `protobuf:"varint,5,opt,name=storage_bytes,json=storageBytes,proto3" json:"storage_bytes,omitempty"`
+	MaxMembers        uint32                 `protobuf:"varint,6,opt,name=max_members,json=maxMembers,proto3" json:"max_members,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *PlanLimits) Reset() {
+	*x = PlanLimits{}
+	mi := &file_sns_v1_tenant_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlanLimits) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlanLimits) ProtoMessage() {}
+
+func (x *PlanLimits) ProtoReflect() protoreflect.Message {
+	mi := &file_sns_v1_tenant_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlanLimits.ProtoReflect.Descriptor instead.
+func (*PlanLimits) Descriptor() ([]byte, []int) {
+	return file_sns_v1_tenant_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *PlanLimits) GetPostsPerMinute() uint32 {
+	if x != nil {
+		return x.PostsPerMinute
+	}
+	return 0
+}
+
+func (x *PlanLimits) GetCommentsPerMinute() uint32 {
+	if x != nil {
+		return x.CommentsPerMinute
+	}
+	return 0
+}
+
+func (x *PlanLimits) GetMessagesPerMinute() uint32 {
+	if x != nil {
+		return x.MessagesPerMinute
+	}
+	return 0
+}
+
+func (x *PlanLimits) GetDailyPosts() uint32 {
+	if x != nil {
+		return x.DailyPosts
+	}
+	return 0
+}
+
+func (x *PlanLimits) GetStorageBytes() uint64 {
+	if x != nil {
+		return x.StorageBytes
+	}
+	return 0
+}
+
+func (x *PlanLimits) GetMaxMembers() uint32 {
+	if x != nil {
+		return x.MaxMembers
+	}
+	return 0
+}
+
+type TenantUsage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PostsToday    uint32                 `protobuf:"varint,1,opt,name=posts_today,json=postsToday,proto3" json:"posts_today,omitempty"`
+	StorageBytes  uint64                 `protobuf:"varint,2,opt,name=storage_bytes,json=storageBytes,proto3" json:"storage_bytes,omitempty"`
+	Members       uint32                 `protobuf:"varint,3,opt,name=members,proto3" json:"members,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TenantUsage) Reset() {
+	*x = TenantUsage{}
+	mi := &file_sns_v1_tenant_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TenantUsage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TenantUsage) ProtoMessage() {}
+
+func (x *TenantUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_sns_v1_tenant_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TenantUsage.ProtoReflect.Descriptor instead.
+func (*TenantUsage) Descriptor() ([]byte, []int) {
+	return file_sns_v1_tenant_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *TenantUsage) GetPostsToday() uint32 {
+	if x != nil {
+		return x.PostsToday
+	}
+	return 0
+}
+
+func (x *TenantUsage) GetStorageBytes() uint64 {
+	if x != nil {
+		return x.StorageBytes
+	}
+	return 0
+}
+
+func (x *TenantUsage) GetMembers() uint32 {
+	if x != nil {
+		return x.Members
+	}
+	return 0
+}
+
+type GetTenantUsageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTenantUsageRequest) Reset() {
+	*x = GetTenantUsageRequest{}
+	mi := &file_sns_v1_tenant_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTenantUsageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTenantUsageRequest) ProtoMessage() {}
+
+func (x *GetTenantUsageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sns_v1_tenant_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTenantUsageRequest.ProtoReflect.Descriptor instead.
+func (*GetTenantUsageRequest) Descriptor() ([]byte, []int) {
+	return file_sns_v1_tenant_proto_rawDescGZIP(), []int{7}
+}
+
+type GetTenantUsageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Plan          string                 `protobuf:"bytes,1,opt,name=plan,proto3" json:"plan,omitempty"`
+	Limits        *PlanLimits            `protobuf:"bytes,2,opt,name=limits,proto3" json:"limits,omitempty"`
+	Usage         *TenantUsage           `protobuf:"bytes,3,opt,name=usage,proto3" json:"usage,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTenantUsageResponse) Reset() {
+	*x = GetTenantUsageResponse{}
+	mi := &file_sns_v1_tenant_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTenantUsageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTenantUsageResponse) ProtoMessage() {}
+
+func (x *GetTenantUsageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sns_v1_tenant_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTenantUsageResponse.ProtoReflect.Descriptor instead.
+func (*GetTenantUsageResponse) Descriptor() ([]byte, []int) {
+	return file_sns_v1_tenant_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetTenantUsageResponse) GetPlan() string {
+	if x != nil {
+		return x.Plan
+	}
+	return ""
+}
+
+func (x *GetTenantUsageResponse) GetLimits() *PlanLimits {
+	if x != nil {
+		return x.Limits
+	}
+	return nil
+}
+
+func (x *GetTenantUsageResponse) GetUsage() *TenantUsage {
+	if x != nil {
+		return x.Usage
+	}
+	return nil
+}
+
 var File_sns_v1_tenant_proto protoreflect.FileDescriptor
 
 const file_sns_v1_tenant_proto_rawDesc = "" +
@@ -283,19 +531,42 @@ const file_sns_v1_tenant_proto_rawDesc = "" +
 	"\x15ResolveTenantResponse\x12\x1b\n" +
 	"\ttenant_id\x18\x01 \x01(\x04R\btenantId\x12\x12\n" +
 	"\x04slug\x18\x02 \x01(\tR\x04slug\"\x0e\n" +
-	"\fGetMeRequest\"\x87\x01\n" +
+	"\fGetMeRequest\"\xa6\x01\n" +
 	"\rGetMeResponse\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x04R\x06userId\x12!\n" +
 	"\fdisplay_name\x18\x02 \x01(\tR\vdisplayName\x12:\n" +
-	"\vmemberships\x18\x03 \x03(\v2\x18.sns.v1.TenantMembershipR\vmemberships\"d\n" +
+	"\vmemberships\x18\x03 \x03(\v2\x18.sns.v1.TenantMembershipR\vmemberships\x12\x1d\n" +
+	"\n" +
+	"avatar_url\x18\x04 \x01(\tR\tavatarUrl\"d\n" +
 	"\x10TenantMembership\x12\x1b\n" +
 	"\ttenant_id\x18\x01 \x01(\x04R\btenantId\x12\x12\n" +
 	"\x04role\x18\x02 \x01(\tR\x04role\x12\x1f\n" +
 	"\vtenant_slug\x18\x03 \x01(\tR\n" +
-	"tenantSlug2\x93\x01\n" +
+	"tenantSlug\"\xfd\x01\n" +
+	"\n" +
+	"PlanLimits\x12(\n" +
+	"\x10posts_per_minute\x18\x01 \x01(\rR\x0epostsPerMinute\x12.\n" +
+	"\x13comments_per_minute\x18\x02 \x01(\rR\x11commentsPerMinute\x12.\n" +
+	"\x13messages_per_minute\x18\x03 \x01(\rR\x11messagesPerMinute\x12\x1f\n" +
+	"\vdaily_posts\x18\x04 \x01(\rR\n" +
+	"dailyPosts\x12#\n" +
+	"\rstorage_bytes\x18\x05 \x01(\x04R\fstorageBytes\x12\x1f\n" +
+	"\vmax_members\x18\x06 \x01(\rR\n" +
+	"maxMembers\"m\n" +
+	"\vTenantUsage\x12\x1f\n" +
+	"\vposts_today\x18\x01 \x01(\rR\n" +
+	"postsToday\x12#\n" +
+	"\rstorage_bytes\x18\x02 \x01(\x04R\fstorageBytes\x12\x18\n" +
+	"\amembers\x18\x03 \x01(\rR\amembers\"\x17\n" +
+	"\x15GetTenantUsageRequest\"\x83\x01\n" +
+	"\x16GetTenantUsageResponse\x12\x12\n" +
+	"\x04plan\x18\x01 \x01(\tR\x04plan\x12*\n" +
+	"\x06limits\x18\x02 \x01(\v2\x12.sns.v1.PlanLimitsR\x06limits\x12)\n" +
+	"\x05usage\x18\x03 \x01(\v2\x13.sns.v1.TenantUsageR\x05usage2\xe4\x01\n" +
 	"\rTenantService\x12L\n" +
 	"\rResolveTenant\x12\x1c.sns.v1.ResolveTenantRequest\x1a\x1d.sns.v1.ResolveTenantResponse\x124\n" +
-	"\x05GetMe\x12\x14.sns.v1.GetMeRequest\x1a\x15.sns.v1.GetMeResponseB>Z<github.com/example/something-like-sns/apps/api/gen/sns/v1;v1b\x06proto3"
+	"\x05GetMe\x12\x14.sns.v1.GetMeRequest\x1a\x15.sns.v1.GetMeResponse\x12O\n" +
+	"\x0eGetTenantUsage\x12\x1d.sns.v1.GetTenantUsageRequest\x1a\x1e.sns.v1.GetTenantUsageResponseB>Z<github.com/example/something-like-sns/apps/api/gen/sns/v1;v1b\x06proto3"
 
 var (
 	file_sns_v1_tenant_proto_rawDescOnce sync.Once
@@ -309,25 +580,33 @@ func file_sns_v1_tenant_proto_rawDescGZIP() []byte {
 	return file_sns_v1_tenant_proto_rawDescData
 }
 
-var file_sns_v1_tenant_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_sns_v1_tenant_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
 var file_sns_v1_tenant_proto_goTypes = []any{
-	(*ResolveTenantRequest)(nil),  // 0: sns.v1.ResolveTenantRequest
-	(*ResolveTenantResponse)(nil), // 1: sns.v1.ResolveTenantResponse
-	(*GetMeRequest)(nil),          // 2: sns.v1.GetMeRequest
-	(*GetMeResponse)(nil),         // 3: sns.v1.GetMeResponse
-	(*TenantMembership)(nil),      // 4: sns.v1.TenantMembership
+	(*ResolveTenantRequest)(nil),   // 0: sns.v1.ResolveTenantRequest
+	(*ResolveTenantResponse)(nil),  // 1: sns.v1.ResolveTenantResponse
+	(*GetMeRequest)(nil),           // 2: sns.v1.GetMeRequest
+	(*GetMeResponse)(nil),          // 3: sns.v1.GetMeResponse
+	(*TenantMembership)(nil),       // 4: sns.v1.TenantMembership
+	(*PlanLimits)(nil),             // 5: sns.v1.PlanLimits
+	(*TenantUsage)(nil),            // 6: sns.v1.TenantUsage
+	(*GetTenantUsageRequest)(nil),  // 7: sns.v1.GetTenantUsageRequest
+	(*GetTenantUsageResponse)(nil), // 8: sns.v1.GetTenantUsageResponse
 }
 var file_sns_v1_tenant_proto_depIdxs = []int32{
 	4, // 0: sns.v1.GetMeResponse.memberships:type_name -> sns.v1.TenantMembership
-	0, // 1: sns.v1.TenantService.ResolveTenant:input_type -> sns.v1.ResolveTenantRequest
-	2, // 2: sns.v1.TenantService.GetMe:input_type -> sns.v1.GetMeRequest
-	1, // 3: sns.v1.TenantService.ResolveTenant:output_type -> sns.v1.ResolveTenantResponse
-	3, // 4: sns.v1.TenantService.GetMe:output_type -> sns.v1.GetMeResponse
-	3, // [3:5] is the sub-list for method output_type
-	1, // [1:3] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	5, // 1: sns.v1.GetTenantUsageResponse.limits:type_name -> sns.v1.PlanLimits
+	6, // 2: sns.v1.GetTenantUsageResponse.usage:type_name -> sns.v1.TenantUsage
+	0, // 3: sns.v1.TenantService.ResolveTenant:input_type -> sns.v1.ResolveTenantRequest
+	2, // 4: sns.v1.TenantService.GetMe:input_type -> sns.v1.GetMeRequest
+	7, // 5: sns.v1.TenantService.GetTenantUsage:input_type -> sns.v1.GetTenantUsageRequest
+	1, // 6: sns.v1.TenantService.ResolveTenant:output_type -> sns.v1.ResolveTenantResponse
+	3, // 7: sns.v1.TenantService.GetMe:output_type -> sns.v1.GetMeResponse
+	8, // 8: sns.v1.TenantService.GetTenantUsage:output_type -> sns.v1.GetTenantUsageResponse
+	6, // [6:9] is the sub-list for method output_type
+	3, // [3:6] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
 }
 
 func init() { file_sns_v1_tenant_proto_init() }
@@ -341,7 +620,7 @@ func file_sns_v1_tenant_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_sns_v1_tenant_proto_rawDesc), len(file_sns_v1_tenant_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   5,
+			NumMessages:   9,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -0,0 +1,1346 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: sns/v1/tenant_admin.proto
+
+package v1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type TenantSettings struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	Id                     uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Slug                   string                 `protobuf:"bytes,2,opt,name=slug,proto3" json:"slug,omitempty"`
+	Name                   string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Plan                   string                 `protobuf:"bytes,4,opt,name=plan,proto3" json:"plan,omitempty"`
+	JoinPolicy             string                 `protobuf:"bytes,5,opt,name=join_policy,json=joinPolicy,proto3" json:"join_policy,omitempty"`
+	AllowSubdomainFallback bool                   `protobuf:"varint,6,opt,name=allow_subdomain_fallback,json=allowSubdomainFallback,proto3" json:"allow_subdomain_fallback,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *TenantSettings) Reset() {
+	*x = TenantSettings{}
+	mi := &file_sns_v1_tenant_admin_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TenantSettings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TenantSettings) ProtoMessage() {}
+
+func (x *TenantSettings) ProtoReflect() protoreflect.Message {
+	mi := &file_sns_v1_tenant_admin_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TenantSettings.ProtoReflect.Descriptor instead.
+func (*TenantSettings) Descriptor() ([]byte, []int) {
+	return file_sns_v1_tenant_admin_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *TenantSettings) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *TenantSettings) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *TenantSettings) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TenantSettings) GetPlan() string {
+	if x != nil {
+		return x.Plan
+	}
+	return ""
+}
+
+func (x *TenantSettings) GetJoinPolicy() string {
+	if x != nil {
+		return x.JoinPolicy
+	}
+	return ""
+}
+
+func (x *TenantSettings) GetAllowSubdomainFallback() bool {
+	if x != nil {
+		return x.AllowSubdomainFallback
+	}
+	return false
+}
+
+type TenantMember struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	DisplayName   string                 `protobuf:"bytes,2,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	Role          string                 `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`
+	JoinedAt      string                 `protobuf:"bytes,4,opt,name=joined_at,json=joinedAt,proto3" json:"joined_at,omitempty"`
+	Suspended     bool                   `protobuf:"varint,5,opt,name=suspended,proto3" json:"suspended,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TenantMember) Reset() {
+	*x = TenantMember{}
+	mi := &file_sns_v1_tenant_admin_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TenantMember) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TenantMember) ProtoMessage() {}
+
+func (x *TenantMember) ProtoReflect() protoreflect.Message {
+	mi := &file_sns_v1_tenant_admin_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TenantMember.ProtoReflect.Descriptor instead.
+func (*TenantMember) Descriptor() ([]byte, []int) {
+	return file_sns_v1_tenant_admin_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *TenantMember) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *TenantMember) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *TenantMember) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *TenantMember) GetJoinedAt() string {
+	if x != nil {
+		return x.JoinedAt
+	}
+	return ""
+}
+
+func (x *TenantMember) GetSuspended() bool {
+	if x != nil {
+		return x.Suspended
+	}
+	return false
+}
+
+type TenantDomain struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Host           string                 `protobuf:"bytes,1,opt,name=host,proto3" json:"host,omitempty"`
+	Verified       bool                   `protobuf:"varint,2,opt,name=verified,proto3" json:"verified,omitempty"`
+	CreatedAt      string                 `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ChallengeName  string                 `protobuf:"bytes,4,opt,name=challenge_name,json=challengeName,proto3" json:"challenge_name,omitempty"`
+	ChallengeValue string                 `protobuf:"bytes,5,opt,name=challenge_value,json=challengeValue,proto3" json:"challenge_value,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *TenantDomain) Reset() {
+	*x = TenantDomain{}
+	mi := &file_sns_v1_tenant_admin_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TenantDomain) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TenantDomain) ProtoMessage() {}
+
+func (x *TenantDomain) ProtoReflect() protoreflect.Message {
+	mi := &file_sns_v1_tenant_admin_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TenantDomain.ProtoReflect.Descriptor instead.
+func (*TenantDomain) Descriptor() ([]byte, []int) {
+	return file_sns_v1_tenant_admin_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *TenantDomain) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+func (x *TenantDomain) GetVerified() bool {
+	if x != nil {
+		return x.Verified
+	}
+	return false
+}
+
+func (x *TenantDomain) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *TenantDomain) GetChallengeName() string {
+	if x != nil {
+		return x.ChallengeName
+	}
+	return ""
+}
+
+func (x *TenantDomain) GetChallengeValue() string {
+	if x != nil {
+		return x.ChallengeValue
+	}
+	return ""
+}
+
+type GetTenantRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTenantRequest) Reset() {
+	*x = GetTenantRequest{}
+	mi := &file_sns_v1_tenant_admin_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTenantRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTenantRequest) ProtoMessage() {}
+
+func (x *GetTenantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sns_v1_tenant_admin_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTenantRequest.ProtoReflect.Descriptor instead.
+func (*GetTenantRequest) Descriptor() ([]byte, []int) {
+	return file_sns_v1_tenant_admin_proto_rawDescGZIP(), []int{3}
+}
+
+type GetTenantResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tenant        *TenantSettings        `protobuf:"bytes,1,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTenantResponse) Reset() {
+	*x = GetTenantResponse{}
+	mi := &file_sns_v1_tenant_admin_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTenantResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTenantResponse) ProtoMessage() {}
+
+func (x *GetTenantResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sns_v1_tenant_admin_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTenantResponse.ProtoReflect.Descriptor instead.
+func (*GetTenantResponse) Descriptor() ([]byte, []int) {
+	return file_sns_v1_tenant_admin_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetTenantResponse) GetTenant() *TenantSettings {
+	if x != nil {
+		return x.Tenant
+	}
+	return nil
+}
+
+type UpdateTenantRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTenantRequest) Reset() {
+	*x = UpdateTenantRequest{}
+	mi := &file_sns_v1_tenant_admin_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTenantRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTenantRequest) ProtoMessage() {}
+
+func (x *UpdateTenantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sns_v1_tenant_admin_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTenantRequest.ProtoReflect.Descriptor instead.
+func (*UpdateTenantRequest) Descriptor() ([]byte, []int) {
+	return file_sns_v1_tenant_admin_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *UpdateTenantRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type UpdateTenantResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tenant        *TenantSettings        `protobuf:"bytes,1,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTenantResponse) Reset() {
+	*x = UpdateTenantResponse{}
+	mi := &file_sns_v1_tenant_admin_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTenantResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTenantResponse) ProtoMessage() {}
+
+func (x *UpdateTenantResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sns_v1_tenant_admin_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTenantResponse.ProtoReflect.Descriptor instead.
+func (*UpdateTenantResponse) Descriptor() ([]byte, []int) {
+	return file_sns_v1_tenant_admin_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *UpdateTenantResponse) GetTenant() *TenantSettings {
+	if x != nil {
+		return x.Tenant
+	}
+	return nil
+}
+
+type ListTenantMembersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTenantMembersRequest) Reset() {
+	*x = ListTenantMembersRequest{}
+	mi := &file_sns_v1_tenant_admin_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTenantMembersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTenantMembersRequest) ProtoMessage() {}
+
+func (x *ListTenantMembersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sns_v1_tenant_admin_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTenantMembersRequest.ProtoReflect.Descriptor instead.
+func (*ListTenantMembersRequest) Descriptor() ([]byte, []int) {
+	return file_sns_v1_tenant_admin_proto_rawDescGZIP(), []int{7}
+}
+
+type ListTenantMembersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*TenantMember        `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTenantMembersResponse) Reset() {
+	*x = ListTenantMembersResponse{}
+	mi := &file_sns_v1_tenant_admin_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTenantMembersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTenantMembersResponse) ProtoMessage() {}
+
+func (x *ListTenantMembersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sns_v1_tenant_admin_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTenantMembersResponse.ProtoReflect.Descriptor instead.
+func (*ListTenantMembersResponse) Descriptor() ([]byte, []int) {
+	return file_sns_v1_tenant_admin_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ListTenantMembersResponse) GetItems() []*TenantMember {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type UpdateMemberRoleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Role          string                 `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateMemberRoleRequest) Reset() {
+	*x = UpdateMemberRoleRequest{}
+	mi := &file_sns_v1_tenant_admin_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateMemberRoleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateMemberRoleRequest) ProtoMessage() {}
+
+func (x *UpdateMemberRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sns_v1_tenant_admin_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateMemberRoleRequest.ProtoReflect.Descriptor instead.
+func (*UpdateMemberRoleRequest) Descriptor() ([]byte, []int) {
+	return file_sns_v1_tenant_admin_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *UpdateMemberRoleRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *UpdateMemberRoleRequest) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+type UpdateMemberRoleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateMemberRoleResponse) Reset() {
+	*x = UpdateMemberRoleResponse{}
+	mi := &file_sns_v1_tenant_admin_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateMemberRoleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateMemberRoleResponse) ProtoMessage() {}
+
+func (x *UpdateMemberRoleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sns_v1_tenant_admin_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateMemberRoleResponse.ProtoReflect.Descriptor instead.
+func (*UpdateMemberRoleResponse) Descriptor() ([]byte, []int) {
+	return file_sns_v1_tenant_admin_proto_rawDescGZIP(), []int{10}
+}
+
+type RemoveMemberRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveMemberRequest) Reset() {
+	*x = RemoveMemberRequest{}
+	mi := &file_sns_v1_tenant_admin_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveMemberRequest) ProtoMessage() {}
+
+func (x *RemoveMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sns_v1_tenant_admin_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveMemberRequest.ProtoReflect.Descriptor instead.
+func (*RemoveMemberRequest) Descriptor() ([]byte, []int) {
+	return file_sns_v1_tenant_admin_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *RemoveMemberRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type RemoveMemberResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveMemberResponse) Reset() {
+	*x = RemoveMemberResponse{}
+	mi := &file_sns_v1_tenant_admin_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveMemberResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveMemberResponse) ProtoMessage() {}
+
+func (x *RemoveMemberResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sns_v1_tenant_admin_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveMemberResponse.ProtoReflect.Descriptor instead.
+func (*RemoveMemberResponse) Descriptor() ([]byte, []int) {
+	return file_sns_v1_tenant_admin_proto_rawDescGZIP(), []int{12}
+}
+
+type TransferOwnershipRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransferOwnershipRequest) Reset() {
+	*x = TransferOwnershipRequest{}
+	mi := &file_sns_v1_tenant_admin_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransferOwnershipRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferOwnershipRequest) ProtoMessage() {}
+
+func (x *TransferOwnershipRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sns_v1_tenant_admin_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferOwnershipRequest.ProtoReflect.Descriptor instead.
+func (*TransferOwnershipRequest) Descriptor() ([]byte, []int) {
+	return file_sns_v1_tenant_admin_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *TransferOwnershipRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type TransferOwnershipResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransferOwnershipResponse) Reset() {
+	*x = TransferOwnershipResponse{}
+	mi := &file_sns_v1_tenant_admin_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransferOwnershipResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferOwnershipResponse) ProtoMessage() {}
+
+func (x *TransferOwnershipResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sns_v1_tenant_admin_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferOwnershipResponse.ProtoReflect.Descriptor instead.
+func (*TransferOwnershipResponse) Descriptor() ([]byte, []int) {
+	return file_sns_v1_tenant_admin_proto_rawDescGZIP(), []int{14}
+}
+
+type ListDomainsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDomainsRequest) Reset() {
+	*x = ListDomainsRequest{}
+	mi := &file_sns_v1_tenant_admin_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDomainsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDomainsRequest) ProtoMessage() {}
+
+func (x *ListDomainsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sns_v1_tenant_admin_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDomainsRequest.ProtoReflect.Descriptor instead.
+func (*ListDomainsRequest) Descriptor() ([]byte, []int) {
+	return file_sns_v1_tenant_admin_proto_rawDescGZIP(), []int{15}
+}
+
+type ListDomainsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*TenantDomain        `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDomainsResponse) Reset() {
+	*x = ListDomainsResponse{}
+	mi := &file_sns_v1_tenant_admin_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDomainsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDomainsResponse) ProtoMessage() {}
+
+func (x *ListDomainsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sns_v1_tenant_admin_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDomainsResponse.ProtoReflect.Descriptor instead.
+func (*ListDomainsResponse) Descriptor() ([]byte, []int) {
+	return file_sns_v1_tenant_admin_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ListDomainsResponse) GetItems() []*TenantDomain {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type AddDomainRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Host          string                 `protobuf:"bytes,1,opt,name=host,proto3" json:"host,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddDomainRequest) Reset() {
+	*x = AddDomainRequest{}
+	mi := &file_sns_v1_tenant_admin_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddDomainRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddDomainRequest) ProtoMessage() {}
+
+func (x *AddDomainRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sns_v1_tenant_admin_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddDomainRequest.ProtoReflect.Descriptor instead.
+func (*AddDomainRequest) Descriptor() ([]byte, []int) {
+	return file_sns_v1_tenant_admin_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *AddDomainRequest) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+type AddDomainResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Domain        *TenantDomain          `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddDomainResponse) Reset() {
+	*x = AddDomainResponse{}
+	mi := &file_sns_v1_tenant_admin_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddDomainResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddDomainResponse) ProtoMessage() {}
+
+func (x *AddDomainResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sns_v1_tenant_admin_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddDomainResponse.ProtoReflect.Descriptor instead.
+func (*AddDomainResponse) Descriptor() ([]byte, []int) {
+	return file_sns_v1_tenant_admin_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *AddDomainResponse) GetDomain() *TenantDomain {
+	if x != nil {
+		return x.Domain
+	}
+	return nil
+}
+
+type VerifyDomainRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Host          string                 `protobuf:"bytes,1,opt,name=host,proto3" json:"host,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyDomainRequest) Reset() {
+	*x = VerifyDomainRequest{}
+	mi := &file_sns_v1_tenant_admin_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyDomainRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyDomainRequest) ProtoMessage() {}
+
+func (x *VerifyDomainRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sns_v1_tenant_admin_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyDomainRequest.ProtoReflect.Descriptor instead.
+func (*VerifyDomainRequest) Descriptor() ([]byte, []int) {
+	return file_sns_v1_tenant_admin_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *VerifyDomainRequest) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+type VerifyDomainResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Domain        *TenantDomain          `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyDomainResponse) Reset() {
+	*x = VerifyDomainResponse{}
+	mi := &file_sns_v1_tenant_admin_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyDomainResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyDomainResponse) ProtoMessage() {}
+
+func (x *VerifyDomainResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sns_v1_tenant_admin_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyDomainResponse.ProtoReflect.Descriptor instead.
+func (*VerifyDomainResponse) Descriptor() ([]byte, []int) {
+	return file_sns_v1_tenant_admin_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *VerifyDomainResponse) GetDomain() *TenantDomain {
+	if x != nil {
+		return x.Domain
+	}
+	return nil
+}
+
+type RemoveDomainRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Host          string                 `protobuf:"bytes,1,opt,name=host,proto3" json:"host,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveDomainRequest) Reset() {
+	*x = RemoveDomainRequest{}
+	mi := &file_sns_v1_tenant_admin_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveDomainRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveDomainRequest) ProtoMessage() {}
+
+func (x *RemoveDomainRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sns_v1_tenant_admin_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveDomainRequest.ProtoReflect.Descriptor instead.
+func (*RemoveDomainRequest) Descriptor() ([]byte, []int) {
+	return file_sns_v1_tenant_admin_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *RemoveDomainRequest) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+type RemoveDomainResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveDomainResponse) Reset() {
+	*x = RemoveDomainResponse{}
+	mi := &file_sns_v1_tenant_admin_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveDomainResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveDomainResponse) ProtoMessage() {}
+
+func (x *RemoveDomainResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sns_v1_tenant_admin_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveDomainResponse.ProtoReflect.Descriptor instead.
+func (*RemoveDomainResponse) Descriptor() ([]byte, []int) {
+	return file_sns_v1_tenant_admin_proto_rawDescGZIP(), []int{22}
+}
+
+type SetSubdomainFallbackRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Allowed       bool                   `protobuf:"varint,1,opt,name=allowed,proto3" json:"allowed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetSubdomainFallbackRequest) Reset() {
+	*x = SetSubdomainFallbackRequest{}
+	mi := &file_sns_v1_tenant_admin_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetSubdomainFallbackRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetSubdomainFallbackRequest) ProtoMessage() {}
+
+func (x *SetSubdomainFallbackRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sns_v1_tenant_admin_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetSubdomainFallbackRequest.ProtoReflect.Descriptor instead.
+func (*SetSubdomainFallbackRequest) Descriptor() ([]byte, []int) {
+	return file_sns_v1_tenant_admin_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *SetSubdomainFallbackRequest) GetAllowed() bool {
+	if x != nil {
+		return x.Allowed
+	}
+	return false
+}
+
+type SetSubdomainFallbackResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetSubdomainFallbackResponse) Reset() {
+	*x = SetSubdomainFallbackResponse{}
+	mi := &file_sns_v1_tenant_admin_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetSubdomainFallbackResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetSubdomainFallbackResponse) ProtoMessage() {}
+
+func (x *SetSubdomainFallbackResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sns_v1_tenant_admin_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetSubdomainFallbackResponse.ProtoReflect.Descriptor instead.
+func (*SetSubdomainFallbackResponse) Descriptor() ([]byte, []int) {
+	return file_sns_v1_tenant_admin_proto_rawDescGZIP(), []int{24}
+}
+
+var File_sns_v1_tenant_admin_proto protoreflect.FileDescriptor
+
+const file_sns_v1_tenant_admin_proto_rawDesc = "" +
+	"\n" +
+	"\x19sns/v1/tenant_admin.proto\x12\x06sns.v1\"\xb7\x01\n" +
+	"\x0eTenantSettings\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x12\n" +
+	"\x04slug\x18\x02 \x01(\tR\x04slug\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x12\n" +
+	"\x04plan\x18\x04 \x01(\tR\x04plan\x12\x1f\n" +
+	"\vjoin_policy\x18\x05 \x01(\tR\n" +
+	"joinPolicy\x128\n" +
+	"\x18allow_subdomain_fallback\x18\x06 \x01(\bR\x16allowSubdomainFallback\"\x99\x01\n" +
+	"\fTenantMember\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x04R\x06userId\x12!\n" +
+	"\fdisplay_name\x18\x02 \x01(\tR\vdisplayName\x12\x12\n" +
+	"\x04role\x18\x03 \x01(\tR\x04role\x12\x1b\n" +
+	"\tjoined_at\x18\x04 \x01(\tR\bjoinedAt\x12\x1c\n" +
+	"\tsuspended\x18\x05 \x01(\bR\tsuspended\"\xad\x01\n" +
+	"\fTenantDomain\x12\x12\n" +
+	"\x04host\x18\x01 \x01(\tR\x04host\x12\x1a\n" +
+	"\bverified\x18\x02 \x01(\bR\bverified\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x03 \x01(\tR\tcreatedAt\x12%\n" +
+	"\x0echallenge_name\x18\x04 \x01(\tR\rchallengeName\x12'\n" +
+	"\x0fchallenge_value\x18\x05 \x01(\tR\x0echallengeValue\"\x12\n" +
+	"\x10GetTenantRequest\"C\n" +
+	"\x11GetTenantResponse\x12.\n" +
+	"\x06tenant\x18\x01 \x01(\v2\x16.sns.v1.TenantSettingsR\x06tenant\")\n" +
+	"\x13UpdateTenantRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"F\n" +
+	"\x14UpdateTenantResponse\x12.\n" +
+	"\x06tenant\x18\x01 \x01(\v2\x16.sns.v1.TenantSettingsR\x06tenant\"\x1a\n" +
+	"\x18ListTenantMembersRequest\"G\n" +
+	"\x19ListTenantMembersResponse\x12*\n" +
+	"\x05items\x18\x01 \x03(\v2\x14.sns.v1.TenantMemberR\x05items\"F\n" +
+	"\x17UpdateMemberRoleRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x04R\x06userId\x12\x12\n" +
+	"\x04role\x18\x02 \x01(\tR\x04role\"\x1a\n" +
+	"\x18UpdateMemberRoleResponse\".\n" +
+	"\x13RemoveMemberRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x04R\x06userId\"\x16\n" +
+	"\x14RemoveMemberResponse\"3\n" +
+	"\x18TransferOwnershipRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x04R\x06userId\"\x1b\n" +
+	"\x19TransferOwnershipResponse\"\x14\n" +
+	"\x12ListDomainsRequest\"A\n" +
+	"\x13ListDomainsResponse\x12*\n" +
+	"\x05items\x18\x01 \x03(\v2\x14.sns.v1.TenantDomainR\x05items\"&\n" +
+	"\x10AddDomainRequest\x12\x12\n" +
+	"\x04host\x18\x01 \x01(\tR\x04host\"A\n" +
+	"\x11AddDomainResponse\x12,\n" +
+	"\x06domain\x18\x01 \x01(\v2\x14.sns.v1.TenantDomainR\x06domain\")\n" +
+	"\x13VerifyDomainRequest\x12\x12\n" +
+	"\x04host\x18\x01 \x01(\tR\x04host\"D\n" +
+	"\x14VerifyDomainResponse\x12,\n" +
+	"\x06domain\x18\x01 \x01(\v2\x14.sns.v1.TenantDomainR\x06domain\")\n" +
+	"\x13RemoveDomainRequest\x12\x12\n" +
+	"\x04host\x18\x01 \x01(\tR\x04host\"\x16\n" +
+	"\x14RemoveDomainResponse\"7\n" +
+	"\x1bSetSubdomainFallbackRequest\x12\x18\n" +
+	"\aallowed\x18\x01 \x01(\bR\aallowed\"\x1e\n" +
+	"\x1cSetSubdomainFallbackResponse2\xfa\x06\n" +
+	"\x12TenantAdminService\x12@\n" +
+	"\tGetTenant\x12\x18.sns.v1.GetTenantRequest\x1a\x19.sns.v1.GetTenantResponse\x12I\n" +
+	"\fUpdateTenant\x12\x1b.sns.v1.UpdateTenantRequest\x1a\x1c.sns.v1.UpdateTenantResponse\x12X\n" +
+	"\x11ListTenantMembers\x12 .sns.v1.ListTenantMembersRequest\x1a!.sns.v1.ListTenantMembersResponse\x12U\n" +
+	"\x10UpdateMemberRole\x12\x1f.sns.v1.UpdateMemberRoleRequest\x1a .sns.v1.UpdateMemberRoleResponse\x12I\n" +
+	"\fRemoveMember\x12\x1b.sns.v1.RemoveMemberRequest\x1a\x1c.sns.v1.RemoveMemberResponse\x12X\n" +
+	"\x11TransferOwnership\x12 .sns.v1.TransferOwnershipRequest\x1a!.sns.v1.TransferOwnershipResponse\x12F\n" +
+	"\vListDomains\x12\x1a.sns.v1.ListDomainsRequest\x1a\x1b.sns.v1.ListDomainsResponse\x12@\n" +
+	"\tAddDomain\x12\x18.sns.v1.AddDomainRequest\x1a\x19.sns.v1.AddDomainResponse\x12I\n" +
+	"\fVerifyDomain\x12\x1b.sns.v1.VerifyDomainRequest\x1a\x1c.sns.v1.VerifyDomainResponse\x12I\n" +
+	"\fRemoveDomain\x12\x1b.sns.v1.RemoveDomainRequest\x1a\x1c.sns.v1.RemoveDomainResponse\x12a\n" +
+	"\x14SetSubdomainFallback\x12#.sns.v1.SetSubdomainFallbackRequest\x1a$.sns.v1.SetSubdomainFallbackResponseB>Z<github.com/example/something-like-sns/apps/api/gen/sns/v1;v1b\x06proto3"
+
+var (
+	file_sns_v1_tenant_admin_proto_rawDescOnce sync.Once
+	file_sns_v1_tenant_admin_proto_rawDescData []byte
+)
+
+func file_sns_v1_tenant_admin_proto_rawDescGZIP() []byte {
+	file_sns_v1_tenant_admin_proto_rawDescOnce.Do(func() {
+		file_sns_v1_tenant_admin_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_sns_v1_tenant_admin_proto_rawDesc), len(file_sns_v1_tenant_admin_proto_rawDesc)))
+	})
+	return file_sns_v1_tenant_admin_proto_rawDescData
+}
+
+var file_sns_v1_tenant_admin_proto_msgTypes = make([]protoimpl.MessageInfo, 25)
+var file_sns_v1_tenant_admin_proto_goTypes = []any{
+	(*TenantSettings)(nil),               // 0: sns.v1.TenantSettings
+	(*TenantMember)(nil),                 // 1: sns.v1.TenantMember
+	(*TenantDomain)(nil),                 // 2: sns.v1.TenantDomain
+	(*GetTenantRequest)(nil),             // 3: sns.v1.GetTenantRequest
+	(*GetTenantResponse)(nil),            // 4: sns.v1.GetTenantResponse
+	(*UpdateTenantRequest)(nil),          // 5: sns.v1.UpdateTenantRequest
+	(*UpdateTenantResponse)(nil),         // 6: sns.v1.UpdateTenantResponse
+	(*ListTenantMembersRequest)(nil),     // 7: sns.v1.ListTenantMembersRequest
+	(*ListTenantMembersResponse)(nil),    // 8: sns.v1.ListTenantMembersResponse
+	(*UpdateMemberRoleRequest)(nil),      // 9: sns.v1.UpdateMemberRoleRequest
+	(*UpdateMemberRoleResponse)(nil),     // 10: sns.v1.UpdateMemberRoleResponse
+	(*RemoveMemberRequest)(nil),          // 11: sns.v1.RemoveMemberRequest
+	(*RemoveMemberResponse)(nil),         // 12: sns.v1.RemoveMemberResponse
+	(*TransferOwnershipRequest)(nil),     // 13: sns.v1.TransferOwnershipRequest
+	(*TransferOwnershipResponse)(nil),    // 14: sns.v1.TransferOwnershipResponse
+	(*ListDomainsRequest)(nil),           // 15: sns.v1.ListDomainsRequest
+	(*ListDomainsResponse)(nil),          // 16: sns.v1.ListDomainsResponse
+	(*AddDomainRequest)(nil),             // 17: sns.v1.AddDomainRequest
+	(*AddDomainResponse)(nil),            // 18: sns.v1.AddDomainResponse
+	(*VerifyDomainRequest)(nil),          // 19: sns.v1.VerifyDomainRequest
+	(*VerifyDomainResponse)(nil),         // 20: sns.v1.VerifyDomainResponse
+	(*RemoveDomainRequest)(nil),          // 21: sns.v1.RemoveDomainRequest
+	(*RemoveDomainResponse)(nil),         // 22: sns.v1.RemoveDomainResponse
+	(*SetSubdomainFallbackRequest)(nil),  // 23: sns.v1.SetSubdomainFallbackRequest
+	(*SetSubdomainFallbackResponse)(nil), // 24: sns.v1.SetSubdomainFallbackResponse
+}
+var file_sns_v1_tenant_admin_proto_depIdxs = []int32{
+	0,  // 0: sns.v1.GetTenantResponse.tenant:type_name -> sns.v1.TenantSettings
+	0,  // 1: sns.v1.UpdateTenantResponse.tenant:type_name -> sns.v1.TenantSettings
+	1,  // 2: sns.v1.ListTenantMembersResponse.items:type_name -> sns.v1.TenantMember
+	2,  // 3: sns.v1.ListDomainsResponse.items:type_name -> sns.v1.TenantDomain
+	2,  // 4: sns.v1.AddDomainResponse.domain:type_name -> sns.v1.TenantDomain
+	2,  // 5: sns.v1.VerifyDomainResponse.domain:type_name -> sns.v1.TenantDomain
+	3,  // 6: sns.v1.TenantAdminService.GetTenant:input_type -> sns.v1.GetTenantRequest
+	5,  // 7: sns.v1.TenantAdminService.UpdateTenant:input_type -> sns.v1.UpdateTenantRequest
+	7,  // 8: sns.v1.TenantAdminService.ListTenantMembers:input_type -> sns.v1.ListTenantMembersRequest
+	9,  // 9: sns.v1.TenantAdminService.UpdateMemberRole:input_type -> sns.v1.UpdateMemberRoleRequest
+	11, // 10: sns.v1.TenantAdminService.RemoveMember:input_type -> sns.v1.RemoveMemberRequest
+	13, // 11: sns.v1.TenantAdminService.TransferOwnership:input_type -> sns.v1.TransferOwnershipRequest
+	15, // 12: sns.v1.TenantAdminService.ListDomains:input_type -> sns.v1.ListDomainsRequest
+	17, // 13: sns.v1.TenantAdminService.AddDomain:input_type -> sns.v1.AddDomainRequest
+	19, // 14: sns.v1.TenantAdminService.VerifyDomain:input_type -> sns.v1.VerifyDomainRequest
+	21, // 15: sns.v1.TenantAdminService.RemoveDomain:input_type -> sns.v1.RemoveDomainRequest
+	23, // 16: sns.v1.TenantAdminService.SetSubdomainFallback:input_type -> sns.v1.SetSubdomainFallbackRequest
+	4,  // 17: sns.v1.TenantAdminService.GetTenant:output_type -> sns.v1.GetTenantResponse
+	6,  // 18: sns.v1.TenantAdminService.UpdateTenant:output_type -> sns.v1.UpdateTenantResponse
+	8,  // 19: sns.v1.TenantAdminService.ListTenantMembers:output_type -> sns.v1.ListTenantMembersResponse
+	10, // 20: sns.v1.TenantAdminService.UpdateMemberRole:output_type -> sns.v1.UpdateMemberRoleResponse
+	12, // 21: sns.v1.TenantAdminService.RemoveMember:output_type -> sns.v1.RemoveMemberResponse
+	14, // 22: sns.v1.TenantAdminService.TransferOwnership:output_type -> sns.v1.TransferOwnershipResponse
+	16, // 23: sns.v1.TenantAdminService.ListDomains:output_type -> sns.v1.ListDomainsResponse
+	18, // 24: sns.v1.TenantAdminService.AddDomain:output_type -> sns.v1.AddDomainResponse
+	20, // 25: sns.v1.TenantAdminService.VerifyDomain:output_type -> sns.v1.VerifyDomainResponse
+	22, // 26: sns.v1.TenantAdminService.RemoveDomain:output_type -> sns.v1.RemoveDomainResponse
+	24, // 27: sns.v1.TenantAdminService.SetSubdomainFallback:output_type -> sns.v1.SetSubdomainFallbackResponse
+	17, // [17:28] is the sub-list for method output_type
+	6,  // [6:17] is the sub-list for method input_type
+	6,  // [6:6] is the sub-list for extension type_name
+	6,  // [6:6] is the sub-list for extension extendee
+	0,  // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_sns_v1_tenant_admin_proto_init() }
+func file_sns_v1_tenant_admin_proto_init() {
+	if File_sns_v1_tenant_admin_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_sns_v1_tenant_admin_proto_rawDesc), len(file_sns_v1_tenant_admin_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   25,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_sns_v1_tenant_admin_proto_goTypes,
+		DependencyIndexes: file_sns_v1_tenant_admin_proto_depIdxs,
+		MessageInfos:      file_sns_v1_tenant_admin_proto_msgTypes,
+	}.Build()
+	File_sns_v1_tenant_admin_proto = out.File
+	file_sns_v1_tenant_admin_proto_goTypes = nil
+	file_sns_v1_tenant_admin_proto_depIdxs = nil
+}
@@ -74,6 +74,7 @@ type Post struct {
 	LikedByMe     bool                   `protobuf:"varint,5,opt,name=liked_by_me,json=likedByMe,proto3" json:"liked_by_me,omitempty"`
 	LikeCount     uint32                 `protobuf:"varint,6,opt,name=like_count,json=likeCount,proto3" json:"like_count,omitempty"`
 	CommentCount  uint32                 `protobuf:"varint,7,opt,name=comment_count,json=commentCount,proto3" json:"comment_count,omitempty"`
+	Author        *Profile               `protobuf:"bytes,8,opt,name=author,proto3" json:"author,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -157,6 +158,13 @@ func (x *Post) GetCommentCount() uint32 {
 	return 0
 }
 
+func (x *Post) GetAuthor() *Profile {
+	if x != nil {
+		return x.Author
+	}
+	return nil
+}
+
 type Comment struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -164,6 +172,7 @@ type Comment struct {
 	AuthorUserId  uint64                 `protobuf:"varint,3,opt,name=author_user_id,json=authorUserId,proto3" json:"author_user_id,omitempty"`
 	Body          string                 `protobuf:"bytes,4,opt,name=body,proto3" json:"body,omitempty"`
 	CreatedAt     string                 `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Author        *Profile               `protobuf:"bytes,6,opt,name=author,proto3" json:"author,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -233,9 +242,17 @@ func (x *Comment) GetCreatedAt() string {
 	return ""
 }
 
+func (x *Comment) GetAuthor() *Profile {
+	if x != nil {
+		return x.Author
+	}
+	return nil
+}
+
 type ListFeedRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Cursor        *Cursor                `protobuf:"bytes,1,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	PageSize      uint32                 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -277,10 +294,19 @@ func (x *ListFeedRequest) GetCursor() *Cursor {
 	return nil
 }
 
+func (x *ListFeedRequest) GetPageSize() uint32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
 type ListFeedResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Items         []*Post                `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
 	Next          *Cursor                `protobuf:"bytes,2,opt,name=next,proto3" json:"next,omitempty"`
+	Prev          *Cursor                `protobuf:"bytes,3,opt,name=prev,proto3" json:"prev,omitempty"`
+	HasMore       bool                   `protobuf:"varint,4,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -329,6 +355,20 @@ func (x *ListFeedResponse) GetNext() *Cursor {
 	return nil
 }
 
+func (x *ListFeedResponse) GetPrev() *Cursor {
+	if x != nil {
+		return x.Prev
+	}
+	return nil
+}
+
+func (x *ListFeedResponse) GetHasMore() bool {
+	if x != nil {
+		return x.HasMore
+	}
+	return false
+}
+
 type CreatePostRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Body          string                 `protobuf:"bytes,1,opt,name=body,proto3" json:"body,omitempty"`
@@ -421,6 +461,8 @@ type ListCommentsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	PostId        uint64                 `protobuf:"varint,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
 	Cursor        *Cursor                `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	PageSize      uint32                 `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	IncludeTotal  bool                   `protobuf:"varint,4,opt,name=include_total,json=includeTotal,proto3" json:"include_total,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -469,10 +511,27 @@ func (x *ListCommentsRequest) GetCursor() *Cursor {
 	return nil
 }
 
+func (x *ListCommentsRequest) GetPageSize() uint32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListCommentsRequest) GetIncludeTotal() bool {
+	if x != nil {
+		return x.IncludeTotal
+	}
+	return false
+}
+
 type ListCommentsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Items         []*Comment             `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
 	Next          *Cursor                `protobuf:"bytes,2,opt,name=next,proto3" json:"next,omitempty"`
+	Prev          *Cursor                `protobuf:"bytes,3,opt,name=prev,proto3" json:"prev,omitempty"`
+	HasMore       bool                   `protobuf:"varint,4,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
+	Total         uint64                 `protobuf:"varint,5,opt,name=total,proto3" json:"total,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -521,6 +580,27 @@ func (x *ListCommentsResponse) GetNext() *Cursor {
 	return nil
 }
 
+func (x *ListCommentsResponse) GetPrev() *Cursor {
+	if x != nil {
+		return x.Prev
+	}
+	return nil
+}
+
+func (x *ListCommentsResponse) GetHasMore() bool {
+	if x != nil {
+		return x.HasMore
+	}
+	return false
+}
+
+func (x *ListCommentsResponse) GetTotal() uint64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
 type CreateCommentRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	PostId        uint64                 `protobuf:"varint,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
@@ -621,9 +701,9 @@ var File_sns_v1_timeline_proto protoreflect.FileDescriptor
 
 const file_sns_v1_timeline_proto_rawDesc = "" +
 	"\n" +
-	"\x15sns/v1/timeline.proto\x12\x06sns.v1\"\x1e\n" +
+	"\x15sns/v1/timeline.proto\x12\x06sns.v1\x1a\x14sns/v1/profile.proto\"\x1e\n" +
 	"\x06Cursor\x12\x14\n" +
-	"\x05token\x18\x01 \x01(\tR\x05token\"\xd3\x01\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\"\xfc\x01\n" +
 	"\x04Post\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x04R\x02id\x12$\n" +
 	"\x0eauthor_user_id\x18\x02 \x01(\x04R\fauthorUserId\x12\x12\n" +
@@ -633,29 +713,39 @@ const file_sns_v1_timeline_proto_rawDesc = "" +
 	"\vliked_by_me\x18\x05 \x01(\bR\tlikedByMe\x12\x1d\n" +
 	"\n" +
 	"like_count\x18\x06 \x01(\rR\tlikeCount\x12#\n" +
-	"\rcomment_count\x18\a \x01(\rR\fcommentCount\"\x8b\x01\n" +
+	"\rcomment_count\x18\a \x01(\rR\fcommentCount\x12'\n" +
+	"\x06author\x18\b \x01(\v2\x0f.sns.v1.ProfileR\x06author\"\xb4\x01\n" +
 	"\aComment\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x17\n" +
 	"\apost_id\x18\x02 \x01(\x04R\x06postId\x12$\n" +
 	"\x0eauthor_user_id\x18\x03 \x01(\x04R\fauthorUserId\x12\x12\n" +
 	"\x04body\x18\x04 \x01(\tR\x04body\x12\x1d\n" +
 	"\n" +
-	"created_at\x18\x05 \x01(\tR\tcreatedAt\"9\n" +
+	"created_at\x18\x05 \x01(\tR\tcreatedAt\x12'\n" +
+	"\x06author\x18\x06 \x01(\v2\x0f.sns.v1.ProfileR\x06author\"V\n" +
 	"\x0fListFeedRequest\x12&\n" +
-	"\x06cursor\x18\x01 \x01(\v2\x0e.sns.v1.CursorR\x06cursor\"Z\n" +
+	"\x06cursor\x18\x01 \x01(\v2\x0e.sns.v1.CursorR\x06cursor\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\rR\bpageSize\"\x99\x01\n" +
 	"\x10ListFeedResponse\x12\"\n" +
 	"\x05items\x18\x01 \x03(\v2\f.sns.v1.PostR\x05items\x12\"\n" +
-	"\x04next\x18\x02 \x01(\v2\x0e.sns.v1.CursorR\x04next\"'\n" +
+	"\x04next\x18\x02 \x01(\v2\x0e.sns.v1.CursorR\x04next\x12\"\n" +
+	"\x04prev\x18\x03 \x01(\v2\x0e.sns.v1.CursorR\x04prev\x12\x19\n" +
+	"\bhas_more\x18\x04 \x01(\bR\ahasMore\"'\n" +
 	"\x11CreatePostRequest\x12\x12\n" +
 	"\x04body\x18\x01 \x01(\tR\x04body\"6\n" +
 	"\x12CreatePostResponse\x12 \n" +
-	"\x04post\x18\x01 \x01(\v2\f.sns.v1.PostR\x04post\"V\n" +
+	"\x04post\x18\x01 \x01(\v2\f.sns.v1.PostR\x04post\"\x98\x01\n" +
 	"\x13ListCommentsRequest\x12\x17\n" +
 	"\apost_id\x18\x01 \x01(\x04R\x06postId\x12&\n" +
-	"\x06cursor\x18\x02 \x01(\v2\x0e.sns.v1.CursorR\x06cursor\"a\n" +
+	"\x06cursor\x18\x02 \x01(\v2\x0e.sns.v1.CursorR\x06cursor\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\rR\bpageSize\x12#\n" +
+	"\rinclude_total\x18\x04 \x01(\bR\fincludeTotal\"\xb6\x01\n" +
 	"\x14ListCommentsResponse\x12%\n" +
 	"\x05items\x18\x01 \x03(\v2\x0f.sns.v1.CommentR\x05items\x12\"\n" +
-	"\x04next\x18\x02 \x01(\v2\x0e.sns.v1.CursorR\x04next\"C\n" +
+	"\x04next\x18\x02 \x01(\v2\x0e.sns.v1.CursorR\x04next\x12\"\n" +
+	"\x04prev\x18\x03 \x01(\v2\x0e.sns.v1.CursorR\x04prev\x12\x19\n" +
+	"\bhas_more\x18\x04 \x01(\bR\ahasMore\x12\x14\n" +
+	"\x05total\x18\x05 \x01(\x04R\x05total\"C\n" +
 	"\x14CreateCommentRequest\x12\x17\n" +
 	"\apost_id\x18\x01 \x01(\x04R\x06postId\x12\x12\n" +
 	"\x04body\x18\x02 \x01(\tR\x04body\"B\n" +
@@ -693,29 +783,34 @@ var file_sns_v1_timeline_proto_goTypes = []any{
 	(*ListCommentsResponse)(nil),  // 8: sns.v1.ListCommentsResponse
 	(*CreateCommentRequest)(nil),  // 9: sns.v1.CreateCommentRequest
 	(*CreateCommentResponse)(nil), // 10: sns.v1.CreateCommentResponse
+	(*Profile)(nil),               // 11: sns.v1.Profile
 }
 var file_sns_v1_timeline_proto_depIdxs = []int32{
-	0,  // 0: sns.v1.ListFeedRequest.cursor:type_name -> sns.v1.Cursor
-	1,  // 1: sns.v1.ListFeedResponse.items:type_name -> sns.v1.Post
-	0,  // 2: sns.v1.ListFeedResponse.next:type_name -> sns.v1.Cursor
-	1,  // 3: sns.v1.CreatePostResponse.post:type_name -> sns.v1.Post
-	0,  // 4: sns.v1.ListCommentsRequest.cursor:type_name -> sns.v1.Cursor
-	2,  // 5: sns.v1.ListCommentsResponse.items:type_name -> sns.v1.Comment
-	0,  // 6: sns.v1.ListCommentsResponse.next:type_name -> sns.v1.Cursor
-	2,  // 7: sns.v1.CreateCommentResponse.comment:type_name -> sns.v1.Comment
-	3,  // 8: sns.v1.TimelineService.ListFeed:input_type -> sns.v1.ListFeedRequest
-	5,  // 9: sns.v1.TimelineService.CreatePost:input_type -> sns.v1.CreatePostRequest
-	7,  // 10: sns.v1.TimelineService.ListComments:input_type -> sns.v1.ListCommentsRequest
-	9,  // 11: sns.v1.TimelineService.CreateComment:input_type -> sns.v1.CreateCommentRequest
-	4,  // 12: sns.v1.TimelineService.ListFeed:output_type -> sns.v1.ListFeedResponse
-	6,  // 13: sns.v1.TimelineService.CreatePost:output_type -> sns.v1.CreatePostResponse
-	8,  // 14: sns.v1.TimelineService.ListComments:output_type -> sns.v1.ListCommentsResponse
-	10, // 15: sns.v1.TimelineService.CreateComment:output_type -> sns.v1.CreateCommentResponse
-	12, // [12:16] is the sub-list for method output_type
-	8,  // [8:12] is the sub-list for method input_type
-	8,  // [8:8] is the sub-list for extension type_name
-	8,  // [8:8] is the sub-list for extension extendee
-	0,  // [0:8] is the sub-list for field type_name
+	11, // 0: sns.v1.Post.author:type_name -> sns.v1.Profile
+	11, // 1: sns.v1.Comment.author:type_name -> sns.v1.Profile
+	0,  // 2: sns.v1.ListFeedRequest.cursor:type_name -> sns.v1.Cursor
+	1,  // 3: sns.v1.ListFeedResponse.items:type_name -> sns.v1.Post
+	0,  // 4: sns.v1.ListFeedResponse.next:type_name -> sns.v1.Cursor
+	0,  // 5: sns.v1.ListFeedResponse.prev:type_name -> sns.v1.Cursor
+	1,  // 6: sns.v1.CreatePostResponse.post:type_name -> sns.v1.Post
+	0,  // 7: sns.v1.ListCommentsRequest.cursor:type_name -> sns.v1.Cursor
+	2,  // 8: sns.v1.ListCommentsResponse.items:type_name -> sns.v1.Comment
+	0,  // 9: sns.v1.ListCommentsResponse.next:type_name -> sns.v1.Cursor
+	0,  // 10: sns.v1.ListCommentsResponse.prev:type_name -> sns.v1.Cursor
+	2,  // 11: sns.v1.CreateCommentResponse.comment:type_name -> sns.v1.Comment
+	3,  // 12: sns.v1.TimelineService.ListFeed:input_type -> sns.v1.ListFeedRequest
+	5,  // 13: sns.v1.TimelineService.CreatePost:input_type -> sns.v1.CreatePostRequest
+	7,  // 14: sns.v1.TimelineService.ListComments:input_type -> sns.v1.ListCommentsRequest
+	9,  // 15: sns.v1.TimelineService.CreateComment:input_type -> sns.v1.CreateCommentRequest
+	4,  // 16: sns.v1.TimelineService.ListFeed:output_type -> sns.v1.ListFeedResponse
+	6,  // 17: sns.v1.TimelineService.CreatePost:output_type -> sns.v1.CreatePostResponse
+	8,  // 18: sns.v1.TimelineService.ListComments:output_type -> sns.v1.ListCommentsResponse
+	10, // 19: sns.v1.TimelineService.CreateComment:output_type -> sns.v1.CreateCommentResponse
+	16, // [16:20] is the sub-list for method output_type
+	12, // [12:16] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
 }
 
 func init() { file_sns_v1_timeline_proto_init() }
@@ -723,6 +818,7 @@ func file_sns_v1_timeline_proto_init() {
 	if File_sns_v1_timeline_proto != nil {
 		return
 	}
+	file_sns_v1_profile_proto_init()
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
@@ -0,0 +1,109 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: sns/v1/directory.proto
+
+package v1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/example/something-like-sns/apps/api/gen/sns/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// DirectoryServiceName is the fully-qualified name of the DirectoryService service.
+	DirectoryServiceName = "sns.v1.DirectoryService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// DirectoryServiceListMembersProcedure is the fully-qualified name of the DirectoryService's
+	// ListMembers RPC.
+	DirectoryServiceListMembersProcedure = "/sns.v1.DirectoryService/ListMembers"
+)
+
+// DirectoryServiceClient is a client for the sns.v1.DirectoryService service.
+type DirectoryServiceClient interface {
+	ListMembers(context.Context, *connect.Request[v1.ListMembersRequest]) (*connect.Response[v1.ListMembersResponse], error)
+}
+
+// NewDirectoryServiceClient constructs a client for the sns.v1.DirectoryService service. By
+// default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses,
+// and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewDirectoryServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) DirectoryServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	directoryServiceMethods := v1.File_sns_v1_directory_proto.Services().ByName("DirectoryService").Methods()
+	return &directoryServiceClient{
+		listMembers: connect.NewClient[v1.ListMembersRequest, v1.ListMembersResponse](
+			httpClient,
+			baseURL+DirectoryServiceListMembersProcedure,
+			connect.WithSchema(directoryServiceMethods.ByName("ListMembers")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// directoryServiceClient implements DirectoryServiceClient.
+type directoryServiceClient struct {
+	listMembers *connect.Client[v1.ListMembersRequest, v1.ListMembersResponse]
+}
+
+// ListMembers calls sns.v1.DirectoryService.ListMembers.
+func (c *directoryServiceClient) ListMembers(ctx context.Context, req *connect.Request[v1.ListMembersRequest]) (*connect.Response[v1.ListMembersResponse], error) {
+	return c.listMembers.CallUnary(ctx, req)
+}
+
+// DirectoryServiceHandler is an implementation of the sns.v1.DirectoryService service.
+type DirectoryServiceHandler interface {
+	ListMembers(context.Context, *connect.Request[v1.ListMembersRequest]) (*connect.Response[v1.ListMembersResponse], error)
+}
+
+// NewDirectoryServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewDirectoryServiceHandler(svc DirectoryServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	directoryServiceMethods := v1.File_sns_v1_directory_proto.Services().ByName("DirectoryService").Methods()
+	directoryServiceListMembersHandler := connect.NewUnaryHandler(
+		DirectoryServiceListMembersProcedure,
+		svc.ListMembers,
+		connect.WithSchema(directoryServiceMethods.ByName("ListMembers")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/sns.v1.DirectoryService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case DirectoryServiceListMembersProcedure:
+			directoryServiceListMembersHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedDirectoryServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedDirectoryServiceHandler struct{}
+
+func (UnimplementedDirectoryServiceHandler) ListMembers(context.Context, *connect.Request[v1.ListMembersRequest]) (*connect.Response[v1.ListMembersResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("sns.v1.DirectoryService.ListMembers is not implemented"))
+}
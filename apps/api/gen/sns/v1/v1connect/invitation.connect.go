@@ -0,0 +1,370 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: sns/v1/invitation.proto
+
+package v1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/example/something-like-sns/apps/api/gen/sns/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// InvitationServiceName is the fully-qualified name of the InvitationService service.
+	InvitationServiceName = "sns.v1.InvitationService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// InvitationServiceCreateInvitationProcedure is the fully-qualified name of the InvitationService's
+	// CreateInvitation RPC.
+	InvitationServiceCreateInvitationProcedure = "/sns.v1.InvitationService/CreateInvitation"
+	// InvitationServiceListInvitationsProcedure is the fully-qualified name of the InvitationService's
+	// ListInvitations RPC.
+	InvitationServiceListInvitationsProcedure = "/sns.v1.InvitationService/ListInvitations"
+	// InvitationServiceRevokeInvitationProcedure is the fully-qualified name of the InvitationService's
+	// RevokeInvitation RPC.
+	InvitationServiceRevokeInvitationProcedure = "/sns.v1.InvitationService/RevokeInvitation"
+	// InvitationServiceAcceptInvitationProcedure is the fully-qualified name of the InvitationService's
+	// AcceptInvitation RPC.
+	InvitationServiceAcceptInvitationProcedure = "/sns.v1.InvitationService/AcceptInvitation"
+	// InvitationServiceRequestToJoinProcedure is the fully-qualified name of the InvitationService's
+	// RequestToJoin RPC.
+	InvitationServiceRequestToJoinProcedure = "/sns.v1.InvitationService/RequestToJoin"
+	// InvitationServiceListJoinRequestsProcedure is the fully-qualified name of the InvitationService's
+	// ListJoinRequests RPC.
+	InvitationServiceListJoinRequestsProcedure = "/sns.v1.InvitationService/ListJoinRequests"
+	// InvitationServiceApproveJoinRequestProcedure is the fully-qualified name of the
+	// InvitationService's ApproveJoinRequest RPC.
+	InvitationServiceApproveJoinRequestProcedure = "/sns.v1.InvitationService/ApproveJoinRequest"
+	// InvitationServiceRejectJoinRequestProcedure is the fully-qualified name of the
+	// InvitationService's RejectJoinRequest RPC.
+	InvitationServiceRejectJoinRequestProcedure = "/sns.v1.InvitationService/RejectJoinRequest"
+	// InvitationServiceGetJoinPolicyProcedure is the fully-qualified name of the InvitationService's
+	// GetJoinPolicy RPC.
+	InvitationServiceGetJoinPolicyProcedure = "/sns.v1.InvitationService/GetJoinPolicy"
+	// InvitationServiceUpdateJoinPolicyProcedure is the fully-qualified name of the InvitationService's
+	// UpdateJoinPolicy RPC.
+	InvitationServiceUpdateJoinPolicyProcedure = "/sns.v1.InvitationService/UpdateJoinPolicy"
+)
+
+// InvitationServiceClient is a client for the sns.v1.InvitationService service.
+type InvitationServiceClient interface {
+	CreateInvitation(context.Context, *connect.Request[v1.CreateInvitationRequest]) (*connect.Response[v1.CreateInvitationResponse], error)
+	ListInvitations(context.Context, *connect.Request[v1.ListInvitationsRequest]) (*connect.Response[v1.ListInvitationsResponse], error)
+	RevokeInvitation(context.Context, *connect.Request[v1.RevokeInvitationRequest]) (*connect.Response[v1.RevokeInvitationResponse], error)
+	AcceptInvitation(context.Context, *connect.Request[v1.AcceptInvitationRequest]) (*connect.Response[v1.AcceptInvitationResponse], error)
+	RequestToJoin(context.Context, *connect.Request[v1.RequestToJoinRequest]) (*connect.Response[v1.RequestToJoinResponse], error)
+	ListJoinRequests(context.Context, *connect.Request[v1.ListJoinRequestsRequest]) (*connect.Response[v1.ListJoinRequestsResponse], error)
+	ApproveJoinRequest(context.Context, *connect.Request[v1.ApproveJoinRequestRequest]) (*connect.Response[v1.ApproveJoinRequestResponse], error)
+	RejectJoinRequest(context.Context, *connect.Request[v1.RejectJoinRequestRequest]) (*connect.Response[v1.RejectJoinRequestResponse], error)
+	GetJoinPolicy(context.Context, *connect.Request[v1.GetJoinPolicyRequest]) (*connect.Response[v1.GetJoinPolicyResponse], error)
+	UpdateJoinPolicy(context.Context, *connect.Request[v1.UpdateJoinPolicyRequest]) (*connect.Response[v1.UpdateJoinPolicyResponse], error)
+}
+
+// NewInvitationServiceClient constructs a client for the sns.v1.InvitationService service. By
+// default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses,
+// and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewInvitationServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) InvitationServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	invitationServiceMethods := v1.File_sns_v1_invitation_proto.Services().ByName("InvitationService").Methods()
+	return &invitationServiceClient{
+		createInvitation: connect.NewClient[v1.CreateInvitationRequest, v1.CreateInvitationResponse](
+			httpClient,
+			baseURL+InvitationServiceCreateInvitationProcedure,
+			connect.WithSchema(invitationServiceMethods.ByName("CreateInvitation")),
+			connect.WithClientOptions(opts...),
+		),
+		listInvitations: connect.NewClient[v1.ListInvitationsRequest, v1.ListInvitationsResponse](
+			httpClient,
+			baseURL+InvitationServiceListInvitationsProcedure,
+			connect.WithSchema(invitationServiceMethods.ByName("ListInvitations")),
+			connect.WithClientOptions(opts...),
+		),
+		revokeInvitation: connect.NewClient[v1.RevokeInvitationRequest, v1.RevokeInvitationResponse](
+			httpClient,
+			baseURL+InvitationServiceRevokeInvitationProcedure,
+			connect.WithSchema(invitationServiceMethods.ByName("RevokeInvitation")),
+			connect.WithClientOptions(opts...),
+		),
+		acceptInvitation: connect.NewClient[v1.AcceptInvitationRequest, v1.AcceptInvitationResponse](
+			httpClient,
+			baseURL+InvitationServiceAcceptInvitationProcedure,
+			connect.WithSchema(invitationServiceMethods.ByName("AcceptInvitation")),
+			connect.WithClientOptions(opts...),
+		),
+		requestToJoin: connect.NewClient[v1.RequestToJoinRequest, v1.RequestToJoinResponse](
+			httpClient,
+			baseURL+InvitationServiceRequestToJoinProcedure,
+			connect.WithSchema(invitationServiceMethods.ByName("RequestToJoin")),
+			connect.WithClientOptions(opts...),
+		),
+		listJoinRequests: connect.NewClient[v1.ListJoinRequestsRequest, v1.ListJoinRequestsResponse](
+			httpClient,
+			baseURL+InvitationServiceListJoinRequestsProcedure,
+			connect.WithSchema(invitationServiceMethods.ByName("ListJoinRequests")),
+			connect.WithClientOptions(opts...),
+		),
+		approveJoinRequest: connect.NewClient[v1.ApproveJoinRequestRequest, v1.ApproveJoinRequestResponse](
+			httpClient,
+			baseURL+InvitationServiceApproveJoinRequestProcedure,
+			connect.WithSchema(invitationServiceMethods.ByName("ApproveJoinRequest")),
+			connect.WithClientOptions(opts...),
+		),
+		rejectJoinRequest: connect.NewClient[v1.RejectJoinRequestRequest, v1.RejectJoinRequestResponse](
+			httpClient,
+			baseURL+InvitationServiceRejectJoinRequestProcedure,
+			connect.WithSchema(invitationServiceMethods.ByName("RejectJoinRequest")),
+			connect.WithClientOptions(opts...),
+		),
+		getJoinPolicy: connect.NewClient[v1.GetJoinPolicyRequest, v1.GetJoinPolicyResponse](
+			httpClient,
+			baseURL+InvitationServiceGetJoinPolicyProcedure,
+			connect.WithSchema(invitationServiceMethods.ByName("GetJoinPolicy")),
+			connect.WithClientOptions(opts...),
+		),
+		updateJoinPolicy: connect.NewClient[v1.UpdateJoinPolicyRequest, v1.UpdateJoinPolicyResponse](
+			httpClient,
+			baseURL+InvitationServiceUpdateJoinPolicyProcedure,
+			connect.WithSchema(invitationServiceMethods.ByName("UpdateJoinPolicy")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// invitationServiceClient implements InvitationServiceClient.
+type invitationServiceClient struct {
+	createInvitation   *connect.Client[v1.CreateInvitationRequest, v1.CreateInvitationResponse]
+	listInvitations    *connect.Client[v1.ListInvitationsRequest, v1.ListInvitationsResponse]
+	revokeInvitation   *connect.Client[v1.RevokeInvitationRequest, v1.RevokeInvitationResponse]
+	acceptInvitation   *connect.Client[v1.AcceptInvitationRequest, v1.AcceptInvitationResponse]
+	requestToJoin      *connect.Client[v1.RequestToJoinRequest, v1.RequestToJoinResponse]
+	listJoinRequests   *connect.Client[v1.ListJoinRequestsRequest, v1.ListJoinRequestsResponse]
+	approveJoinRequest *connect.Client[v1.ApproveJoinRequestRequest, v1.ApproveJoinRequestResponse]
+	rejectJoinRequest  *connect.Client[v1.RejectJoinRequestRequest, v1.RejectJoinRequestResponse]
+	getJoinPolicy      *connect.Client[v1.GetJoinPolicyRequest, v1.GetJoinPolicyResponse]
+	updateJoinPolicy   *connect.Client[v1.UpdateJoinPolicyRequest, v1.UpdateJoinPolicyResponse]
+}
+
+// CreateInvitation calls sns.v1.InvitationService.CreateInvitation.
+func (c *invitationServiceClient) CreateInvitation(ctx context.Context, req *connect.Request[v1.CreateInvitationRequest]) (*connect.Response[v1.CreateInvitationResponse], error) {
+	return c.createInvitation.CallUnary(ctx, req)
+}
+
+// ListInvitations calls sns.v1.InvitationService.ListInvitations.
+func (c *invitationServiceClient) ListInvitations(ctx context.Context, req *connect.Request[v1.ListInvitationsRequest]) (*connect.Response[v1.ListInvitationsResponse], error) {
+	return c.listInvitations.CallUnary(ctx, req)
+}
+
+// RevokeInvitation calls sns.v1.InvitationService.RevokeInvitation.
+func (c *invitationServiceClient) RevokeInvitation(ctx context.Context, req *connect.Request[v1.RevokeInvitationRequest]) (*connect.Response[v1.RevokeInvitationResponse], error) {
+	return c.revokeInvitation.CallUnary(ctx, req)
+}
+
+// AcceptInvitation calls sns.v1.InvitationService.AcceptInvitation.
+func (c *invitationServiceClient) AcceptInvitation(ctx context.Context, req *connect.Request[v1.AcceptInvitationRequest]) (*connect.Response[v1.AcceptInvitationResponse], error) {
+	return c.acceptInvitation.CallUnary(ctx, req)
+}
+
+// RequestToJoin calls sns.v1.InvitationService.RequestToJoin.
+func (c *invitationServiceClient) RequestToJoin(ctx context.Context, req *connect.Request[v1.RequestToJoinRequest]) (*connect.Response[v1.RequestToJoinResponse], error) {
+	return c.requestToJoin.CallUnary(ctx, req)
+}
+
+// ListJoinRequests calls sns.v1.InvitationService.ListJoinRequests.
+func (c *invitationServiceClient) ListJoinRequests(ctx context.Context, req *connect.Request[v1.ListJoinRequestsRequest]) (*connect.Response[v1.ListJoinRequestsResponse], error) {
+	return c.listJoinRequests.CallUnary(ctx, req)
+}
+
+// ApproveJoinRequest calls sns.v1.InvitationService.ApproveJoinRequest.
+func (c *invitationServiceClient) ApproveJoinRequest(ctx context.Context, req *connect.Request[v1.ApproveJoinRequestRequest]) (*connect.Response[v1.ApproveJoinRequestResponse], error) {
+	return c.approveJoinRequest.CallUnary(ctx, req)
+}
+
+// RejectJoinRequest calls sns.v1.InvitationService.RejectJoinRequest.
+func (c *invitationServiceClient) RejectJoinRequest(ctx context.Context, req *connect.Request[v1.RejectJoinRequestRequest]) (*connect.Response[v1.RejectJoinRequestResponse], error) {
+	return c.rejectJoinRequest.CallUnary(ctx, req)
+}
+
+// GetJoinPolicy calls sns.v1.InvitationService.GetJoinPolicy.
+func (c *invitationServiceClient) GetJoinPolicy(ctx context.Context, req *connect.Request[v1.GetJoinPolicyRequest]) (*connect.Response[v1.GetJoinPolicyResponse], error) {
+	return c.getJoinPolicy.CallUnary(ctx, req)
+}
+
+// UpdateJoinPolicy calls sns.v1.InvitationService.UpdateJoinPolicy.
+func (c *invitationServiceClient) UpdateJoinPolicy(ctx context.Context, req *connect.Request[v1.UpdateJoinPolicyRequest]) (*connect.Response[v1.UpdateJoinPolicyResponse], error) {
+	return c.updateJoinPolicy.CallUnary(ctx, req)
+}
+
+// InvitationServiceHandler is an implementation of the sns.v1.InvitationService service.
+type InvitationServiceHandler interface {
+	CreateInvitation(context.Context, *connect.Request[v1.CreateInvitationRequest]) (*connect.Response[v1.CreateInvitationResponse], error)
+	ListInvitations(context.Context, *connect.Request[v1.ListInvitationsRequest]) (*connect.Response[v1.ListInvitationsResponse], error)
+	RevokeInvitation(context.Context, *connect.Request[v1.RevokeInvitationRequest]) (*connect.Response[v1.RevokeInvitationResponse], error)
+	AcceptInvitation(context.Context, *connect.Request[v1.AcceptInvitationRequest]) (*connect.Response[v1.AcceptInvitationResponse], error)
+	RequestToJoin(context.Context, *connect.Request[v1.RequestToJoinRequest]) (*connect.Response[v1.RequestToJoinResponse], error)
+	ListJoinRequests(context.Context, *connect.Request[v1.ListJoinRequestsRequest]) (*connect.Response[v1.ListJoinRequestsResponse], error)
+	ApproveJoinRequest(context.Context, *connect.Request[v1.ApproveJoinRequestRequest]) (*connect.Response[v1.ApproveJoinRequestResponse], error)
+	RejectJoinRequest(context.Context, *connect.Request[v1.RejectJoinRequestRequest]) (*connect.Response[v1.RejectJoinRequestResponse], error)
+	GetJoinPolicy(context.Context, *connect.Request[v1.GetJoinPolicyRequest]) (*connect.Response[v1.GetJoinPolicyResponse], error)
+	UpdateJoinPolicy(context.Context, *connect.Request[v1.UpdateJoinPolicyRequest]) (*connect.Response[v1.UpdateJoinPolicyResponse], error)
+}
+
+// NewInvitationServiceHandler builds an HTTP handler from the service implementation. It returns
+// the path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewInvitationServiceHandler(svc InvitationServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	invitationServiceMethods := v1.File_sns_v1_invitation_proto.Services().ByName("InvitationService").Methods()
+	invitationServiceCreateInvitationHandler := connect.NewUnaryHandler(
+		InvitationServiceCreateInvitationProcedure,
+		svc.CreateInvitation,
+		connect.WithSchema(invitationServiceMethods.ByName("CreateInvitation")),
+		connect.WithHandlerOptions(opts...),
+	)
+	invitationServiceListInvitationsHandler := connect.NewUnaryHandler(
+		InvitationServiceListInvitationsProcedure,
+		svc.ListInvitations,
+		connect.WithSchema(invitationServiceMethods.ByName("ListInvitations")),
+		connect.WithHandlerOptions(opts...),
+	)
+	invitationServiceRevokeInvitationHandler := connect.NewUnaryHandler(
+		InvitationServiceRevokeInvitationProcedure,
+		svc.RevokeInvitation,
+		connect.WithSchema(invitationServiceMethods.ByName("RevokeInvitation")),
+		connect.WithHandlerOptions(opts...),
+	)
+	invitationServiceAcceptInvitationHandler := connect.NewUnaryHandler(
+		InvitationServiceAcceptInvitationProcedure,
+		svc.AcceptInvitation,
+		connect.WithSchema(invitationServiceMethods.ByName("AcceptInvitation")),
+		connect.WithHandlerOptions(opts...),
+	)
+	invitationServiceRequestToJoinHandler := connect.NewUnaryHandler(
+		InvitationServiceRequestToJoinProcedure,
+		svc.RequestToJoin,
+		connect.WithSchema(invitationServiceMethods.ByName("RequestToJoin")),
+		connect.WithHandlerOptions(opts...),
+	)
+	invitationServiceListJoinRequestsHandler := connect.NewUnaryHandler(
+		InvitationServiceListJoinRequestsProcedure,
+		svc.ListJoinRequests,
+		connect.WithSchema(invitationServiceMethods.ByName("ListJoinRequests")),
+		connect.WithHandlerOptions(opts...),
+	)
+	invitationServiceApproveJoinRequestHandler := connect.NewUnaryHandler(
+		InvitationServiceApproveJoinRequestProcedure,
+		svc.ApproveJoinRequest,
+		connect.WithSchema(invitationServiceMethods.ByName("ApproveJoinRequest")),
+		connect.WithHandlerOptions(opts...),
+	)
+	invitationServiceRejectJoinRequestHandler := connect.NewUnaryHandler(
+		InvitationServiceRejectJoinRequestProcedure,
+		svc.RejectJoinRequest,
+		connect.WithSchema(invitationServiceMethods.ByName("RejectJoinRequest")),
+		connect.WithHandlerOptions(opts...),
+	)
+	invitationServiceGetJoinPolicyHandler := connect.NewUnaryHandler(
+		InvitationServiceGetJoinPolicyProcedure,
+		svc.GetJoinPolicy,
+		connect.WithSchema(invitationServiceMethods.ByName("GetJoinPolicy")),
+		connect.WithHandlerOptions(opts...),
+	)
+	invitationServiceUpdateJoinPolicyHandler := connect.NewUnaryHandler(
+		InvitationServiceUpdateJoinPolicyProcedure,
+		svc.UpdateJoinPolicy,
+		connect.WithSchema(invitationServiceMethods.ByName("UpdateJoinPolicy")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/sns.v1.InvitationService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case InvitationServiceCreateInvitationProcedure:
+			invitationServiceCreateInvitationHandler.ServeHTTP(w, r)
+		case InvitationServiceListInvitationsProcedure:
+			invitationServiceListInvitationsHandler.ServeHTTP(w, r)
+		case InvitationServiceRevokeInvitationProcedure:
+			invitationServiceRevokeInvitationHandler.ServeHTTP(w, r)
+		case InvitationServiceAcceptInvitationProcedure:
+			invitationServiceAcceptInvitationHandler.ServeHTTP(w, r)
+		case InvitationServiceRequestToJoinProcedure:
+			invitationServiceRequestToJoinHandler.ServeHTTP(w, r)
+		case InvitationServiceListJoinRequestsProcedure:
+			invitationServiceListJoinRequestsHandler.ServeHTTP(w, r)
+		case InvitationServiceApproveJoinRequestProcedure:
+			invitationServiceApproveJoinRequestHandler.ServeHTTP(w, r)
+		case InvitationServiceRejectJoinRequestProcedure:
+			invitationServiceRejectJoinRequestHandler.ServeHTTP(w, r)
+		case InvitationServiceGetJoinPolicyProcedure:
+			invitationServiceGetJoinPolicyHandler.ServeHTTP(w, r)
+		case InvitationServiceUpdateJoinPolicyProcedure:
+			invitationServiceUpdateJoinPolicyHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedInvitationServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedInvitationServiceHandler struct{}
+
+func (UnimplementedInvitationServiceHandler) CreateInvitation(context.Context, *connect.Request[v1.CreateInvitationRequest]) (*connect.Response[v1.CreateInvitationResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("sns.v1.InvitationService.CreateInvitation is not implemented"))
+}
+
+func (UnimplementedInvitationServiceHandler) ListInvitations(context.Context, *connect.Request[v1.ListInvitationsRequest]) (*connect.Response[v1.ListInvitationsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("sns.v1.InvitationService.ListInvitations is not implemented"))
+}
+
+func (UnimplementedInvitationServiceHandler) RevokeInvitation(context.Context, *connect.Request[v1.RevokeInvitationRequest]) (*connect.Response[v1.RevokeInvitationResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("sns.v1.InvitationService.RevokeInvitation is not implemented"))
+}
+
+func (UnimplementedInvitationServiceHandler) AcceptInvitation(context.Context, *connect.Request[v1.AcceptInvitationRequest]) (*connect.Response[v1.AcceptInvitationResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("sns.v1.InvitationService.AcceptInvitation is not implemented"))
+}
+
+func (UnimplementedInvitationServiceHandler) RequestToJoin(context.Context, *connect.Request[v1.RequestToJoinRequest]) (*connect.Response[v1.RequestToJoinResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("sns.v1.InvitationService.RequestToJoin is not implemented"))
+}
+
+func (UnimplementedInvitationServiceHandler) ListJoinRequests(context.Context, *connect.Request[v1.ListJoinRequestsRequest]) (*connect.Response[v1.ListJoinRequestsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("sns.v1.InvitationService.ListJoinRequests is not implemented"))
+}
+
+func (UnimplementedInvitationServiceHandler) ApproveJoinRequest(context.Context, *connect.Request[v1.ApproveJoinRequestRequest]) (*connect.Response[v1.ApproveJoinRequestResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("sns.v1.InvitationService.ApproveJoinRequest is not implemented"))
+}
+
+func (UnimplementedInvitationServiceHandler) RejectJoinRequest(context.Context, *connect.Request[v1.RejectJoinRequestRequest]) (*connect.Response[v1.RejectJoinRequestResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("sns.v1.InvitationService.RejectJoinRequest is not implemented"))
+}
+
+func (UnimplementedInvitationServiceHandler) GetJoinPolicy(context.Context, *connect.Request[v1.GetJoinPolicyRequest]) (*connect.Response[v1.GetJoinPolicyResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("sns.v1.InvitationService.GetJoinPolicy is not implemented"))
+}
+
+func (UnimplementedInvitationServiceHandler) UpdateJoinPolicy(context.Context, *connect.Request[v1.UpdateJoinPolicyRequest]) (*connect.Response[v1.UpdateJoinPolicyResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("sns.v1.InvitationService.UpdateJoinPolicy is not implemented"))
+}
@@ -0,0 +1,225 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: sns/v1/moderation.proto
+
+package v1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/example/something-like-sns/apps/api/gen/sns/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// ModerationServiceName is the fully-qualified name of the ModerationService service.
+	ModerationServiceName = "sns.v1.ModerationService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// ModerationServiceReportContentProcedure is the fully-qualified name of the ModerationService's
+	// ReportContent RPC.
+	ModerationServiceReportContentProcedure = "/sns.v1.ModerationService/ReportContent"
+	// ModerationServiceListReportsProcedure is the fully-qualified name of the ModerationService's
+	// ListReports RPC.
+	ModerationServiceListReportsProcedure = "/sns.v1.ModerationService/ListReports"
+	// ModerationServiceResolveReportProcedure is the fully-qualified name of the ModerationService's
+	// ResolveReport RPC.
+	ModerationServiceResolveReportProcedure = "/sns.v1.ModerationService/ResolveReport"
+	// ModerationServiceReinstateMemberProcedure is the fully-qualified name of the ModerationService's
+	// ReinstateMember RPC.
+	ModerationServiceReinstateMemberProcedure = "/sns.v1.ModerationService/ReinstateMember"
+	// ModerationServiceListAuditEventsProcedure is the fully-qualified name of the ModerationService's
+	// ListAuditEvents RPC.
+	ModerationServiceListAuditEventsProcedure = "/sns.v1.ModerationService/ListAuditEvents"
+)
+
+// ModerationServiceClient is a client for the sns.v1.ModerationService service.
+type ModerationServiceClient interface {
+	ReportContent(context.Context, *connect.Request[v1.ReportContentRequest]) (*connect.Response[v1.ReportContentResponse], error)
+	ListReports(context.Context, *connect.Request[v1.ListReportsRequest]) (*connect.Response[v1.ListReportsResponse], error)
+	ResolveReport(context.Context, *connect.Request[v1.ResolveReportRequest]) (*connect.Response[v1.ResolveReportResponse], error)
+	ReinstateMember(context.Context, *connect.Request[v1.ReinstateMemberRequest]) (*connect.Response[v1.ReinstateMemberResponse], error)
+	ListAuditEvents(context.Context, *connect.Request[v1.ListAuditEventsRequest]) (*connect.Response[v1.ListAuditEventsResponse], error)
+}
+
+// NewModerationServiceClient constructs a client for the sns.v1.ModerationService service. By
+// default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses,
+// and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewModerationServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) ModerationServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	moderationServiceMethods := v1.File_sns_v1_moderation_proto.Services().ByName("ModerationService").Methods()
+	return &moderationServiceClient{
+		reportContent: connect.NewClient[v1.ReportContentRequest, v1.ReportContentResponse](
+			httpClient,
+			baseURL+ModerationServiceReportContentProcedure,
+			connect.WithSchema(moderationServiceMethods.ByName("ReportContent")),
+			connect.WithClientOptions(opts...),
+		),
+		listReports: connect.NewClient[v1.ListReportsRequest, v1.ListReportsResponse](
+			httpClient,
+			baseURL+ModerationServiceListReportsProcedure,
+			connect.WithSchema(moderationServiceMethods.ByName("ListReports")),
+			connect.WithClientOptions(opts...),
+		),
+		resolveReport: connect.NewClient[v1.ResolveReportRequest, v1.ResolveReportResponse](
+			httpClient,
+			baseURL+ModerationServiceResolveReportProcedure,
+			connect.WithSchema(moderationServiceMethods.ByName("ResolveReport")),
+			connect.WithClientOptions(opts...),
+		),
+		reinstateMember: connect.NewClient[v1.ReinstateMemberRequest, v1.ReinstateMemberResponse](
+			httpClient,
+			baseURL+ModerationServiceReinstateMemberProcedure,
+			connect.WithSchema(moderationServiceMethods.ByName("ReinstateMember")),
+			connect.WithClientOptions(opts...),
+		),
+		listAuditEvents: connect.NewClient[v1.ListAuditEventsRequest, v1.ListAuditEventsResponse](
+			httpClient,
+			baseURL+ModerationServiceListAuditEventsProcedure,
+			connect.WithSchema(moderationServiceMethods.ByName("ListAuditEvents")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// moderationServiceClient implements ModerationServiceClient.
+type moderationServiceClient struct {
+	reportContent   *connect.Client[v1.ReportContentRequest, v1.ReportContentResponse]
+	listReports     *connect.Client[v1.ListReportsRequest, v1.ListReportsResponse]
+	resolveReport   *connect.Client[v1.ResolveReportRequest, v1.ResolveReportResponse]
+	reinstateMember *connect.Client[v1.ReinstateMemberRequest, v1.ReinstateMemberResponse]
+	listAuditEvents *connect.Client[v1.ListAuditEventsRequest, v1.ListAuditEventsResponse]
+}
+
+// ReportContent calls sns.v1.ModerationService.ReportContent.
+func (c *moderationServiceClient) ReportContent(ctx context.Context, req *connect.Request[v1.ReportContentRequest]) (*connect.Response[v1.ReportContentResponse], error) {
+	return c.reportContent.CallUnary(ctx, req)
+}
+
+// ListReports calls sns.v1.ModerationService.ListReports.
+func (c *moderationServiceClient) ListReports(ctx context.Context, req *connect.Request[v1.ListReportsRequest]) (*connect.Response[v1.ListReportsResponse], error) {
+	return c.listReports.CallUnary(ctx, req)
+}
+
+// ResolveReport calls sns.v1.ModerationService.ResolveReport.
+func (c *moderationServiceClient) ResolveReport(ctx context.Context, req *connect.Request[v1.ResolveReportRequest]) (*connect.Response[v1.ResolveReportResponse], error) {
+	return c.resolveReport.CallUnary(ctx, req)
+}
+
+// ReinstateMember calls sns.v1.ModerationService.ReinstateMember.
+func (c *moderationServiceClient) ReinstateMember(ctx context.Context, req *connect.Request[v1.ReinstateMemberRequest]) (*connect.Response[v1.ReinstateMemberResponse], error) {
+	return c.reinstateMember.CallUnary(ctx, req)
+}
+
+// ListAuditEvents calls sns.v1.ModerationService.ListAuditEvents.
+func (c *moderationServiceClient) ListAuditEvents(ctx context.Context, req *connect.Request[v1.ListAuditEventsRequest]) (*connect.Response[v1.ListAuditEventsResponse], error) {
+	return c.listAuditEvents.CallUnary(ctx, req)
+}
+
+// ModerationServiceHandler is an implementation of the sns.v1.ModerationService service.
+type ModerationServiceHandler interface {
+	ReportContent(context.Context, *connect.Request[v1.ReportContentRequest]) (*connect.Response[v1.ReportContentResponse], error)
+	ListReports(context.Context, *connect.Request[v1.ListReportsRequest]) (*connect.Response[v1.ListReportsResponse], error)
+	ResolveReport(context.Context, *connect.Request[v1.ResolveReportRequest]) (*connect.Response[v1.ResolveReportResponse], error)
+	ReinstateMember(context.Context, *connect.Request[v1.ReinstateMemberRequest]) (*connect.Response[v1.ReinstateMemberResponse], error)
+	ListAuditEvents(context.Context, *connect.Request[v1.ListAuditEventsRequest]) (*connect.Response[v1.ListAuditEventsResponse], error)
+}
+
+// NewModerationServiceHandler builds an HTTP handler from the service implementation. It returns
+// the path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewModerationServiceHandler(svc ModerationServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	moderationServiceMethods := v1.File_sns_v1_moderation_proto.Services().ByName("ModerationService").Methods()
+	moderationServiceReportContentHandler := connect.NewUnaryHandler(
+		ModerationServiceReportContentProcedure,
+		svc.ReportContent,
+		connect.WithSchema(moderationServiceMethods.ByName("ReportContent")),
+		connect.WithHandlerOptions(opts...),
+	)
+	moderationServiceListReportsHandler := connect.NewUnaryHandler(
+		ModerationServiceListReportsProcedure,
+		svc.ListReports,
+		connect.WithSchema(moderationServiceMethods.ByName("ListReports")),
+		connect.WithHandlerOptions(opts...),
+	)
+	moderationServiceResolveReportHandler := connect.NewUnaryHandler(
+		ModerationServiceResolveReportProcedure,
+		svc.ResolveReport,
+		connect.WithSchema(moderationServiceMethods.ByName("ResolveReport")),
+		connect.WithHandlerOptions(opts...),
+	)
+	moderationServiceReinstateMemberHandler := connect.NewUnaryHandler(
+		ModerationServiceReinstateMemberProcedure,
+		svc.ReinstateMember,
+		connect.WithSchema(moderationServiceMethods.ByName("ReinstateMember")),
+		connect.WithHandlerOptions(opts...),
+	)
+	moderationServiceListAuditEventsHandler := connect.NewUnaryHandler(
+		ModerationServiceListAuditEventsProcedure,
+		svc.ListAuditEvents,
+		connect.WithSchema(moderationServiceMethods.ByName("ListAuditEvents")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/sns.v1.ModerationService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case ModerationServiceReportContentProcedure:
+			moderationServiceReportContentHandler.ServeHTTP(w, r)
+		case ModerationServiceListReportsProcedure:
+			moderationServiceListReportsHandler.ServeHTTP(w, r)
+		case ModerationServiceResolveReportProcedure:
+			moderationServiceResolveReportHandler.ServeHTTP(w, r)
+		case ModerationServiceReinstateMemberProcedure:
+			moderationServiceReinstateMemberHandler.ServeHTTP(w, r)
+		case ModerationServiceListAuditEventsProcedure:
+			moderationServiceListAuditEventsHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedModerationServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedModerationServiceHandler struct{}
+
+func (UnimplementedModerationServiceHandler) ReportContent(context.Context, *connect.Request[v1.ReportContentRequest]) (*connect.Response[v1.ReportContentResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("sns.v1.ModerationService.ReportContent is not implemented"))
+}
+
+func (UnimplementedModerationServiceHandler) ListReports(context.Context, *connect.Request[v1.ListReportsRequest]) (*connect.Response[v1.ListReportsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("sns.v1.ModerationService.ListReports is not implemented"))
+}
+
+func (UnimplementedModerationServiceHandler) ResolveReport(context.Context, *connect.Request[v1.ResolveReportRequest]) (*connect.Response[v1.ResolveReportResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("sns.v1.ModerationService.ResolveReport is not implemented"))
+}
+
+func (UnimplementedModerationServiceHandler) ReinstateMember(context.Context, *connect.Request[v1.ReinstateMemberRequest]) (*connect.Response[v1.ReinstateMemberResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("sns.v1.ModerationService.ReinstateMember is not implemented"))
+}
+
+func (UnimplementedModerationServiceHandler) ListAuditEvents(context.Context, *connect.Request[v1.ListAuditEventsRequest]) (*connect.Response[v1.ListAuditEventsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("sns.v1.ModerationService.ListAuditEvents is not implemented"))
+}
@@ -0,0 +1,196 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: sns/v1/profile.proto
+
+package v1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/example/something-like-sns/apps/api/gen/sns/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// ProfileServiceName is the fully-qualified name of the ProfileService service.
+	ProfileServiceName = "sns.v1.ProfileService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// ProfileServiceGetProfileProcedure is the fully-qualified name of the ProfileService's GetProfile
+	// RPC.
+	ProfileServiceGetProfileProcedure = "/sns.v1.ProfileService/GetProfile"
+	// ProfileServiceUpdateProfileProcedure is the fully-qualified name of the ProfileService's
+	// UpdateProfile RPC.
+	ProfileServiceUpdateProfileProcedure = "/sns.v1.ProfileService/UpdateProfile"
+	// ProfileServiceGetProfileSettingsProcedure is the fully-qualified name of the ProfileService's
+	// GetProfileSettings RPC.
+	ProfileServiceGetProfileSettingsProcedure = "/sns.v1.ProfileService/GetProfileSettings"
+	// ProfileServiceUpdateTenantProfileProcedure is the fully-qualified name of the ProfileService's
+	// UpdateTenantProfile RPC.
+	ProfileServiceUpdateTenantProfileProcedure = "/sns.v1.ProfileService/UpdateTenantProfile"
+)
+
+// ProfileServiceClient is a client for the sns.v1.ProfileService service.
+type ProfileServiceClient interface {
+	GetProfile(context.Context, *connect.Request[v1.GetProfileRequest]) (*connect.Response[v1.GetProfileResponse], error)
+	UpdateProfile(context.Context, *connect.Request[v1.UpdateProfileRequest]) (*connect.Response[v1.UpdateProfileResponse], error)
+	GetProfileSettings(context.Context, *connect.Request[v1.GetProfileSettingsRequest]) (*connect.Response[v1.GetProfileSettingsResponse], error)
+	UpdateTenantProfile(context.Context, *connect.Request[v1.UpdateTenantProfileRequest]) (*connect.Response[v1.UpdateTenantProfileResponse], error)
+}
+
+// NewProfileServiceClient constructs a client for the sns.v1.ProfileService service. By default, it
+// uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses, and sends
+// uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC() or
+// connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewProfileServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) ProfileServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	profileServiceMethods := v1.File_sns_v1_profile_proto.Services().ByName("ProfileService").Methods()
+	return &profileServiceClient{
+		getProfile: connect.NewClient[v1.GetProfileRequest, v1.GetProfileResponse](
+			httpClient,
+			baseURL+ProfileServiceGetProfileProcedure,
+			connect.WithSchema(profileServiceMethods.ByName("GetProfile")),
+			connect.WithClientOptions(opts...),
+		),
+		updateProfile: connect.NewClient[v1.UpdateProfileRequest, v1.UpdateProfileResponse](
+			httpClient,
+			baseURL+ProfileServiceUpdateProfileProcedure,
+			connect.WithSchema(profileServiceMethods.ByName("UpdateProfile")),
+			connect.WithClientOptions(opts...),
+		),
+		getProfileSettings: connect.NewClient[v1.GetProfileSettingsRequest, v1.GetProfileSettingsResponse](
+			httpClient,
+			baseURL+ProfileServiceGetProfileSettingsProcedure,
+			connect.WithSchema(profileServiceMethods.ByName("GetProfileSettings")),
+			connect.WithClientOptions(opts...),
+		),
+		updateTenantProfile: connect.NewClient[v1.UpdateTenantProfileRequest, v1.UpdateTenantProfileResponse](
+			httpClient,
+			baseURL+ProfileServiceUpdateTenantProfileProcedure,
+			connect.WithSchema(profileServiceMethods.ByName("UpdateTenantProfile")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// profileServiceClient implements ProfileServiceClient.
+type profileServiceClient struct {
+	getProfile          *connect.Client[v1.GetProfileRequest, v1.GetProfileResponse]
+	updateProfile       *connect.Client[v1.UpdateProfileRequest, v1.UpdateProfileResponse]
+	getProfileSettings  *connect.Client[v1.GetProfileSettingsRequest, v1.GetProfileSettingsResponse]
+	updateTenantProfile *connect.Client[v1.UpdateTenantProfileRequest, v1.UpdateTenantProfileResponse]
+}
+
+// GetProfile calls sns.v1.ProfileService.GetProfile.
+func (c *profileServiceClient) GetProfile(ctx context.Context, req *connect.Request[v1.GetProfileRequest]) (*connect.Response[v1.GetProfileResponse], error) {
+	return c.getProfile.CallUnary(ctx, req)
+}
+
+// UpdateProfile calls sns.v1.ProfileService.UpdateProfile.
+func (c *profileServiceClient) UpdateProfile(ctx context.Context, req *connect.Request[v1.UpdateProfileRequest]) (*connect.Response[v1.UpdateProfileResponse], error) {
+	return c.updateProfile.CallUnary(ctx, req)
+}
+
+// GetProfileSettings calls sns.v1.ProfileService.GetProfileSettings.
+func (c *profileServiceClient) GetProfileSettings(ctx context.Context, req *connect.Request[v1.GetProfileSettingsRequest]) (*connect.Response[v1.GetProfileSettingsResponse], error) {
+	return c.getProfileSettings.CallUnary(ctx, req)
+}
+
+// UpdateTenantProfile calls sns.v1.ProfileService.UpdateTenantProfile.
+func (c *profileServiceClient) UpdateTenantProfile(ctx context.Context, req *connect.Request[v1.UpdateTenantProfileRequest]) (*connect.Response[v1.UpdateTenantProfileResponse], error) {
+	return c.updateTenantProfile.CallUnary(ctx, req)
+}
+
+// ProfileServiceHandler is an implementation of the sns.v1.ProfileService service.
+type ProfileServiceHandler interface {
+	GetProfile(context.Context, *connect.Request[v1.GetProfileRequest]) (*connect.Response[v1.GetProfileResponse], error)
+	UpdateProfile(context.Context, *connect.Request[v1.UpdateProfileRequest]) (*connect.Response[v1.UpdateProfileResponse], error)
+	GetProfileSettings(context.Context, *connect.Request[v1.GetProfileSettingsRequest]) (*connect.Response[v1.GetProfileSettingsResponse], error)
+	UpdateTenantProfile(context.Context, *connect.Request[v1.UpdateTenantProfileRequest]) (*connect.Response[v1.UpdateTenantProfileResponse], error)
+}
+
+// NewProfileServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewProfileServiceHandler(svc ProfileServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	profileServiceMethods := v1.File_sns_v1_profile_proto.Services().ByName("ProfileService").Methods()
+	profileServiceGetProfileHandler := connect.NewUnaryHandler(
+		ProfileServiceGetProfileProcedure,
+		svc.GetProfile,
+		connect.WithSchema(profileServiceMethods.ByName("GetProfile")),
+		connect.WithHandlerOptions(opts...),
+	)
+	profileServiceUpdateProfileHandler := connect.NewUnaryHandler(
+		ProfileServiceUpdateProfileProcedure,
+		svc.UpdateProfile,
+		connect.WithSchema(profileServiceMethods.ByName("UpdateProfile")),
+		connect.WithHandlerOptions(opts...),
+	)
+	profileServiceGetProfileSettingsHandler := connect.NewUnaryHandler(
+		ProfileServiceGetProfileSettingsProcedure,
+		svc.GetProfileSettings,
+		connect.WithSchema(profileServiceMethods.ByName("GetProfileSettings")),
+		connect.WithHandlerOptions(opts...),
+	)
+	profileServiceUpdateTenantProfileHandler := connect.NewUnaryHandler(
+		ProfileServiceUpdateTenantProfileProcedure,
+		svc.UpdateTenantProfile,
+		connect.WithSchema(profileServiceMethods.ByName("UpdateTenantProfile")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/sns.v1.ProfileService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case ProfileServiceGetProfileProcedure:
+			profileServiceGetProfileHandler.ServeHTTP(w, r)
+		case ProfileServiceUpdateProfileProcedure:
+			profileServiceUpdateProfileHandler.ServeHTTP(w, r)
+		case ProfileServiceGetProfileSettingsProcedure:
+			profileServiceGetProfileSettingsHandler.ServeHTTP(w, r)
+		case ProfileServiceUpdateTenantProfileProcedure:
+			profileServiceUpdateTenantProfileHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedProfileServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedProfileServiceHandler struct{}
+
+func (UnimplementedProfileServiceHandler) GetProfile(context.Context, *connect.Request[v1.GetProfileRequest]) (*connect.Response[v1.GetProfileResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("sns.v1.ProfileService.GetProfile is not implemented"))
+}
+
+func (UnimplementedProfileServiceHandler) UpdateProfile(context.Context, *connect.Request[v1.UpdateProfileRequest]) (*connect.Response[v1.UpdateProfileResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("sns.v1.ProfileService.UpdateProfile is not implemented"))
+}
+
+func (UnimplementedProfileServiceHandler) GetProfileSettings(context.Context, *connect.Request[v1.GetProfileSettingsRequest]) (*connect.Response[v1.GetProfileSettingsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("sns.v1.ProfileService.GetProfileSettings is not implemented"))
+}
+
+func (UnimplementedProfileServiceHandler) UpdateTenantProfile(context.Context, *connect.Request[v1.UpdateTenantProfileRequest]) (*connect.Response[v1.UpdateTenantProfileResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("sns.v1.ProfileService.UpdateTenantProfile is not implemented"))
+}
@@ -0,0 +1,196 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: sns/v1/relation.proto
+
+package v1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/example/something-like-sns/apps/api/gen/sns/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// RelationServiceName is the fully-qualified name of the RelationService service.
+	RelationServiceName = "sns.v1.RelationService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// RelationServiceBlockUserProcedure is the fully-qualified name of the RelationService's BlockUser
+	// RPC.
+	RelationServiceBlockUserProcedure = "/sns.v1.RelationService/BlockUser"
+	// RelationServiceUnblockUserProcedure is the fully-qualified name of the RelationService's
+	// UnblockUser RPC.
+	RelationServiceUnblockUserProcedure = "/sns.v1.RelationService/UnblockUser"
+	// RelationServiceMuteUserProcedure is the fully-qualified name of the RelationService's MuteUser
+	// RPC.
+	RelationServiceMuteUserProcedure = "/sns.v1.RelationService/MuteUser"
+	// RelationServiceUnmuteUserProcedure is the fully-qualified name of the RelationService's
+	// UnmuteUser RPC.
+	RelationServiceUnmuteUserProcedure = "/sns.v1.RelationService/UnmuteUser"
+)
+
+// RelationServiceClient is a client for the sns.v1.RelationService service.
+type RelationServiceClient interface {
+	BlockUser(context.Context, *connect.Request[v1.BlockUserRequest]) (*connect.Response[v1.BlockUserResponse], error)
+	UnblockUser(context.Context, *connect.Request[v1.UnblockUserRequest]) (*connect.Response[v1.UnblockUserResponse], error)
+	MuteUser(context.Context, *connect.Request[v1.MuteUserRequest]) (*connect.Response[v1.MuteUserResponse], error)
+	UnmuteUser(context.Context, *connect.Request[v1.UnmuteUserRequest]) (*connect.Response[v1.UnmuteUserResponse], error)
+}
+
+// NewRelationServiceClient constructs a client for the sns.v1.RelationService service. By default,
+// it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses, and
+// sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC()
+// or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewRelationServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) RelationServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	relationServiceMethods := v1.File_sns_v1_relation_proto.Services().ByName("RelationService").Methods()
+	return &relationServiceClient{
+		blockUser: connect.NewClient[v1.BlockUserRequest, v1.BlockUserResponse](
+			httpClient,
+			baseURL+RelationServiceBlockUserProcedure,
+			connect.WithSchema(relationServiceMethods.ByName("BlockUser")),
+			connect.WithClientOptions(opts...),
+		),
+		unblockUser: connect.NewClient[v1.UnblockUserRequest, v1.UnblockUserResponse](
+			httpClient,
+			baseURL+RelationServiceUnblockUserProcedure,
+			connect.WithSchema(relationServiceMethods.ByName("UnblockUser")),
+			connect.WithClientOptions(opts...),
+		),
+		muteUser: connect.NewClient[v1.MuteUserRequest, v1.MuteUserResponse](
+			httpClient,
+			baseURL+RelationServiceMuteUserProcedure,
+			connect.WithSchema(relationServiceMethods.ByName("MuteUser")),
+			connect.WithClientOptions(opts...),
+		),
+		unmuteUser: connect.NewClient[v1.UnmuteUserRequest, v1.UnmuteUserResponse](
+			httpClient,
+			baseURL+RelationServiceUnmuteUserProcedure,
+			connect.WithSchema(relationServiceMethods.ByName("UnmuteUser")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// relationServiceClient implements RelationServiceClient.
+type relationServiceClient struct {
+	blockUser   *connect.Client[v1.BlockUserRequest, v1.BlockUserResponse]
+	unblockUser *connect.Client[v1.UnblockUserRequest, v1.UnblockUserResponse]
+	muteUser    *connect.Client[v1.MuteUserRequest, v1.MuteUserResponse]
+	unmuteUser  *connect.Client[v1.UnmuteUserRequest, v1.UnmuteUserResponse]
+}
+
+// BlockUser calls sns.v1.RelationService.BlockUser.
+func (c *relationServiceClient) BlockUser(ctx context.Context, req *connect.Request[v1.BlockUserRequest]) (*connect.Response[v1.BlockUserResponse], error) {
+	return c.blockUser.CallUnary(ctx, req)
+}
+
+// UnblockUser calls sns.v1.RelationService.UnblockUser.
+func (c *relationServiceClient) UnblockUser(ctx context.Context, req *connect.Request[v1.UnblockUserRequest]) (*connect.Response[v1.UnblockUserResponse], error) {
+	return c.unblockUser.CallUnary(ctx, req)
+}
+
+// MuteUser calls sns.v1.RelationService.MuteUser.
+func (c *relationServiceClient) MuteUser(ctx context.Context, req *connect.Request[v1.MuteUserRequest]) (*connect.Response[v1.MuteUserResponse], error) {
+	return c.muteUser.CallUnary(ctx, req)
+}
+
+// UnmuteUser calls sns.v1.RelationService.UnmuteUser.
+func (c *relationServiceClient) UnmuteUser(ctx context.Context, req *connect.Request[v1.UnmuteUserRequest]) (*connect.Response[v1.UnmuteUserResponse], error) {
+	return c.unmuteUser.CallUnary(ctx, req)
+}
+
+// RelationServiceHandler is an implementation of the sns.v1.RelationService service.
+type RelationServiceHandler interface {
+	BlockUser(context.Context, *connect.Request[v1.BlockUserRequest]) (*connect.Response[v1.BlockUserResponse], error)
+	UnblockUser(context.Context, *connect.Request[v1.UnblockUserRequest]) (*connect.Response[v1.UnblockUserResponse], error)
+	MuteUser(context.Context, *connect.Request[v1.MuteUserRequest]) (*connect.Response[v1.MuteUserResponse], error)
+	UnmuteUser(context.Context, *connect.Request[v1.UnmuteUserRequest]) (*connect.Response[v1.UnmuteUserResponse], error)
+}
+
+// NewRelationServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewRelationServiceHandler(svc RelationServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	relationServiceMethods := v1.File_sns_v1_relation_proto.Services().ByName("RelationService").Methods()
+	relationServiceBlockUserHandler := connect.NewUnaryHandler(
+		RelationServiceBlockUserProcedure,
+		svc.BlockUser,
+		connect.WithSchema(relationServiceMethods.ByName("BlockUser")),
+		connect.WithHandlerOptions(opts...),
+	)
+	relationServiceUnblockUserHandler := connect.NewUnaryHandler(
+		RelationServiceUnblockUserProcedure,
+		svc.UnblockUser,
+		connect.WithSchema(relationServiceMethods.ByName("UnblockUser")),
+		connect.WithHandlerOptions(opts...),
+	)
+	relationServiceMuteUserHandler := connect.NewUnaryHandler(
+		RelationServiceMuteUserProcedure,
+		svc.MuteUser,
+		connect.WithSchema(relationServiceMethods.ByName("MuteUser")),
+		connect.WithHandlerOptions(opts...),
+	)
+	relationServiceUnmuteUserHandler := connect.NewUnaryHandler(
+		RelationServiceUnmuteUserProcedure,
+		svc.UnmuteUser,
+		connect.WithSchema(relationServiceMethods.ByName("UnmuteUser")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/sns.v1.RelationService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case RelationServiceBlockUserProcedure:
+			relationServiceBlockUserHandler.ServeHTTP(w, r)
+		case RelationServiceUnblockUserProcedure:
+			relationServiceUnblockUserHandler.ServeHTTP(w, r)
+		case RelationServiceMuteUserProcedure:
+			relationServiceMuteUserHandler.ServeHTTP(w, r)
+		case RelationServiceUnmuteUserProcedure:
+			relationServiceUnmuteUserHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedRelationServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedRelationServiceHandler struct{}
+
+func (UnimplementedRelationServiceHandler) BlockUser(context.Context, *connect.Request[v1.BlockUserRequest]) (*connect.Response[v1.BlockUserResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("sns.v1.RelationService.BlockUser is not implemented"))
+}
+
+func (UnimplementedRelationServiceHandler) UnblockUser(context.Context, *connect.Request[v1.UnblockUserRequest]) (*connect.Response[v1.UnblockUserResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("sns.v1.RelationService.UnblockUser is not implemented"))
+}
+
+func (UnimplementedRelationServiceHandler) MuteUser(context.Context, *connect.Request[v1.MuteUserRequest]) (*connect.Response[v1.MuteUserResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("sns.v1.RelationService.MuteUser is not implemented"))
+}
+
+func (UnimplementedRelationServiceHandler) UnmuteUser(context.Context, *connect.Request[v1.UnmuteUserRequest]) (*connect.Response[v1.UnmuteUserResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("sns.v1.RelationService.UnmuteUser is not implemented"))
+}
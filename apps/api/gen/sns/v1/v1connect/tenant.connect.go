@@ -38,12 +38,16 @@ const (
 	TenantServiceResolveTenantProcedure = "/sns.v1.TenantService/ResolveTenant"
 	// TenantServiceGetMeProcedure is the fully-qualified name of the TenantService's GetMe RPC.
 	TenantServiceGetMeProcedure = "/sns.v1.TenantService/GetMe"
+	// TenantServiceGetTenantUsageProcedure is the fully-qualified name of the TenantService's
+	// GetTenantUsage RPC.
+	TenantServiceGetTenantUsageProcedure = "/sns.v1.TenantService/GetTenantUsage"
 )
 
 // TenantServiceClient is a client for the sns.v1.TenantService service.
 type TenantServiceClient interface {
 	ResolveTenant(context.Context, *connect.Request[v1.ResolveTenantRequest]) (*connect.Response[v1.ResolveTenantResponse], error)
 	GetMe(context.Context, *connect.Request[v1.GetMeRequest]) (*connect.Response[v1.GetMeResponse], error)
+	GetTenantUsage(context.Context, *connect.Request[v1.GetTenantUsageRequest]) (*connect.Response[v1.GetTenantUsageResponse], error)
 }
 
 // NewTenantServiceClient constructs a client for the sns.v1.TenantService service. By default, it
@@ -69,13 +73,20 @@ func NewTenantServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 			connect.WithSchema(tenantServiceMethods.ByName("GetMe")),
 			connect.WithClientOptions(opts...),
 		),
+		getTenantUsage: connect.NewClient[v1.GetTenantUsageRequest, v1.GetTenantUsageResponse](
+			httpClient,
+			baseURL+TenantServiceGetTenantUsageProcedure,
+			connect.WithSchema(tenantServiceMethods.ByName("GetTenantUsage")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // tenantServiceClient implements TenantServiceClient.
 type tenantServiceClient struct {
-	resolveTenant *connect.Client[v1.ResolveTenantRequest, v1.ResolveTenantResponse]
-	getMe         *connect.Client[v1.GetMeRequest, v1.GetMeResponse]
+	resolveTenant  *connect.Client[v1.ResolveTenantRequest, v1.ResolveTenantResponse]
+	getMe          *connect.Client[v1.GetMeRequest, v1.GetMeResponse]
+	getTenantUsage *connect.Client[v1.GetTenantUsageRequest, v1.GetTenantUsageResponse]
 }
 
 // ResolveTenant calls sns.v1.TenantService.ResolveTenant.
@@ -88,10 +99,16 @@ func (c *tenantServiceClient) GetMe(ctx context.Context, req *connect.Request[v1
 	return c.getMe.CallUnary(ctx, req)
 }
 
+// GetTenantUsage calls sns.v1.TenantService.GetTenantUsage.
+func (c *tenantServiceClient) GetTenantUsage(ctx context.Context, req *connect.Request[v1.GetTenantUsageRequest]) (*connect.Response[v1.GetTenantUsageResponse], error) {
+	return c.getTenantUsage.CallUnary(ctx, req)
+}
+
 // TenantServiceHandler is an implementation of the sns.v1.TenantService service.
 type TenantServiceHandler interface {
 	ResolveTenant(context.Context, *connect.Request[v1.ResolveTenantRequest]) (*connect.Response[v1.ResolveTenantResponse], error)
 	GetMe(context.Context, *connect.Request[v1.GetMeRequest]) (*connect.Response[v1.GetMeResponse], error)
+	GetTenantUsage(context.Context, *connect.Request[v1.GetTenantUsageRequest]) (*connect.Response[v1.GetTenantUsageResponse], error)
 }
 
 // NewTenantServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -113,12 +130,20 @@ func NewTenantServiceHandler(svc TenantServiceHandler, opts ...connect.HandlerOp
 		connect.WithSchema(tenantServiceMethods.ByName("GetMe")),
 		connect.WithHandlerOptions(opts...),
 	)
+	tenantServiceGetTenantUsageHandler := connect.NewUnaryHandler(
+		TenantServiceGetTenantUsageProcedure,
+		svc.GetTenantUsage,
+		connect.WithSchema(tenantServiceMethods.ByName("GetTenantUsage")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/sns.v1.TenantService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case TenantServiceResolveTenantProcedure:
 			tenantServiceResolveTenantHandler.ServeHTTP(w, r)
 		case TenantServiceGetMeProcedure:
 			tenantServiceGetMeHandler.ServeHTTP(w, r)
+		case TenantServiceGetTenantUsageProcedure:
+			tenantServiceGetTenantUsageHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -135,3 +160,7 @@ func (UnimplementedTenantServiceHandler) ResolveTenant(context.Context, *connect
 func (UnimplementedTenantServiceHandler) GetMe(context.Context, *connect.Request[v1.GetMeRequest]) (*connect.Response[v1.GetMeResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("sns.v1.TenantService.GetMe is not implemented"))
 }
+
+func (UnimplementedTenantServiceHandler) GetTenantUsage(context.Context, *connect.Request[v1.GetTenantUsageRequest]) (*connect.Response[v1.GetTenantUsageResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("sns.v1.TenantService.GetTenantUsage is not implemented"))
+}
@@ -0,0 +1,399 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: sns/v1/tenant_admin.proto
+
+package v1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/example/something-like-sns/apps/api/gen/sns/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// TenantAdminServiceName is the fully-qualified name of the TenantAdminService service.
+	TenantAdminServiceName = "sns.v1.TenantAdminService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// TenantAdminServiceGetTenantProcedure is the fully-qualified name of the TenantAdminService's
+	// GetTenant RPC.
+	TenantAdminServiceGetTenantProcedure = "/sns.v1.TenantAdminService/GetTenant"
+	// TenantAdminServiceUpdateTenantProcedure is the fully-qualified name of the TenantAdminService's
+	// UpdateTenant RPC.
+	TenantAdminServiceUpdateTenantProcedure = "/sns.v1.TenantAdminService/UpdateTenant"
+	// TenantAdminServiceListTenantMembersProcedure is the fully-qualified name of the
+	// TenantAdminService's ListTenantMembers RPC.
+	TenantAdminServiceListTenantMembersProcedure = "/sns.v1.TenantAdminService/ListTenantMembers"
+	// TenantAdminServiceUpdateMemberRoleProcedure is the fully-qualified name of the
+	// TenantAdminService's UpdateMemberRole RPC.
+	TenantAdminServiceUpdateMemberRoleProcedure = "/sns.v1.TenantAdminService/UpdateMemberRole"
+	// TenantAdminServiceRemoveMemberProcedure is the fully-qualified name of the TenantAdminService's
+	// RemoveMember RPC.
+	TenantAdminServiceRemoveMemberProcedure = "/sns.v1.TenantAdminService/RemoveMember"
+	// TenantAdminServiceTransferOwnershipProcedure is the fully-qualified name of the
+	// TenantAdminService's TransferOwnership RPC.
+	TenantAdminServiceTransferOwnershipProcedure = "/sns.v1.TenantAdminService/TransferOwnership"
+	// TenantAdminServiceListDomainsProcedure is the fully-qualified name of the TenantAdminService's
+	// ListDomains RPC.
+	TenantAdminServiceListDomainsProcedure = "/sns.v1.TenantAdminService/ListDomains"
+	// TenantAdminServiceAddDomainProcedure is the fully-qualified name of the TenantAdminService's
+	// AddDomain RPC.
+	TenantAdminServiceAddDomainProcedure = "/sns.v1.TenantAdminService/AddDomain"
+	// TenantAdminServiceVerifyDomainProcedure is the fully-qualified name of the TenantAdminService's
+	// VerifyDomain RPC.
+	TenantAdminServiceVerifyDomainProcedure = "/sns.v1.TenantAdminService/VerifyDomain"
+	// TenantAdminServiceRemoveDomainProcedure is the fully-qualified name of the TenantAdminService's
+	// RemoveDomain RPC.
+	TenantAdminServiceRemoveDomainProcedure = "/sns.v1.TenantAdminService/RemoveDomain"
+	// TenantAdminServiceSetSubdomainFallbackProcedure is the fully-qualified name of the
+	// TenantAdminService's SetSubdomainFallback RPC.
+	TenantAdminServiceSetSubdomainFallbackProcedure = "/sns.v1.TenantAdminService/SetSubdomainFallback"
+)
+
+// TenantAdminServiceClient is a client for the sns.v1.TenantAdminService service.
+type TenantAdminServiceClient interface {
+	GetTenant(context.Context, *connect.Request[v1.GetTenantRequest]) (*connect.Response[v1.GetTenantResponse], error)
+	UpdateTenant(context.Context, *connect.Request[v1.UpdateTenantRequest]) (*connect.Response[v1.UpdateTenantResponse], error)
+	ListTenantMembers(context.Context, *connect.Request[v1.ListTenantMembersRequest]) (*connect.Response[v1.ListTenantMembersResponse], error)
+	UpdateMemberRole(context.Context, *connect.Request[v1.UpdateMemberRoleRequest]) (*connect.Response[v1.UpdateMemberRoleResponse], error)
+	RemoveMember(context.Context, *connect.Request[v1.RemoveMemberRequest]) (*connect.Response[v1.RemoveMemberResponse], error)
+	TransferOwnership(context.Context, *connect.Request[v1.TransferOwnershipRequest]) (*connect.Response[v1.TransferOwnershipResponse], error)
+	ListDomains(context.Context, *connect.Request[v1.ListDomainsRequest]) (*connect.Response[v1.ListDomainsResponse], error)
+	AddDomain(context.Context, *connect.Request[v1.AddDomainRequest]) (*connect.Response[v1.AddDomainResponse], error)
+	VerifyDomain(context.Context, *connect.Request[v1.VerifyDomainRequest]) (*connect.Response[v1.VerifyDomainResponse], error)
+	RemoveDomain(context.Context, *connect.Request[v1.RemoveDomainRequest]) (*connect.Response[v1.RemoveDomainResponse], error)
+	SetSubdomainFallback(context.Context, *connect.Request[v1.SetSubdomainFallbackRequest]) (*connect.Response[v1.SetSubdomainFallbackResponse], error)
+}
+
+// NewTenantAdminServiceClient constructs a client for the sns.v1.TenantAdminService service. By
+// default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses,
+// and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewTenantAdminServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) TenantAdminServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	tenantAdminServiceMethods := v1.File_sns_v1_tenant_admin_proto.Services().ByName("TenantAdminService").Methods()
+	return &tenantAdminServiceClient{
+		getTenant: connect.NewClient[v1.GetTenantRequest, v1.GetTenantResponse](
+			httpClient,
+			baseURL+TenantAdminServiceGetTenantProcedure,
+			connect.WithSchema(tenantAdminServiceMethods.ByName("GetTenant")),
+			connect.WithClientOptions(opts...),
+		),
+		updateTenant: connect.NewClient[v1.UpdateTenantRequest, v1.UpdateTenantResponse](
+			httpClient,
+			baseURL+TenantAdminServiceUpdateTenantProcedure,
+			connect.WithSchema(tenantAdminServiceMethods.ByName("UpdateTenant")),
+			connect.WithClientOptions(opts...),
+		),
+		listTenantMembers: connect.NewClient[v1.ListTenantMembersRequest, v1.ListTenantMembersResponse](
+			httpClient,
+			baseURL+TenantAdminServiceListTenantMembersProcedure,
+			connect.WithSchema(tenantAdminServiceMethods.ByName("ListTenantMembers")),
+			connect.WithClientOptions(opts...),
+		),
+		updateMemberRole: connect.NewClient[v1.UpdateMemberRoleRequest, v1.UpdateMemberRoleResponse](
+			httpClient,
+			baseURL+TenantAdminServiceUpdateMemberRoleProcedure,
+			connect.WithSchema(tenantAdminServiceMethods.ByName("UpdateMemberRole")),
+			connect.WithClientOptions(opts...),
+		),
+		removeMember: connect.NewClient[v1.RemoveMemberRequest, v1.RemoveMemberResponse](
+			httpClient,
+			baseURL+TenantAdminServiceRemoveMemberProcedure,
+			connect.WithSchema(tenantAdminServiceMethods.ByName("RemoveMember")),
+			connect.WithClientOptions(opts...),
+		),
+		transferOwnership: connect.NewClient[v1.TransferOwnershipRequest, v1.TransferOwnershipResponse](
+			httpClient,
+			baseURL+TenantAdminServiceTransferOwnershipProcedure,
+			connect.WithSchema(tenantAdminServiceMethods.ByName("TransferOwnership")),
+			connect.WithClientOptions(opts...),
+		),
+		listDomains: connect.NewClient[v1.ListDomainsRequest, v1.ListDomainsResponse](
+			httpClient,
+			baseURL+TenantAdminServiceListDomainsProcedure,
+			connect.WithSchema(tenantAdminServiceMethods.ByName("ListDomains")),
+			connect.WithClientOptions(opts...),
+		),
+		addDomain: connect.NewClient[v1.AddDomainRequest, v1.AddDomainResponse](
+			httpClient,
+			baseURL+TenantAdminServiceAddDomainProcedure,
+			connect.WithSchema(tenantAdminServiceMethods.ByName("AddDomain")),
+			connect.WithClientOptions(opts...),
+		),
+		verifyDomain: connect.NewClient[v1.VerifyDomainRequest, v1.VerifyDomainResponse](
+			httpClient,
+			baseURL+TenantAdminServiceVerifyDomainProcedure,
+			connect.WithSchema(tenantAdminServiceMethods.ByName("VerifyDomain")),
+			connect.WithClientOptions(opts...),
+		),
+		removeDomain: connect.NewClient[v1.RemoveDomainRequest, v1.RemoveDomainResponse](
+			httpClient,
+			baseURL+TenantAdminServiceRemoveDomainProcedure,
+			connect.WithSchema(tenantAdminServiceMethods.ByName("RemoveDomain")),
+			connect.WithClientOptions(opts...),
+		),
+		setSubdomainFallback: connect.NewClient[v1.SetSubdomainFallbackRequest, v1.SetSubdomainFallbackResponse](
+			httpClient,
+			baseURL+TenantAdminServiceSetSubdomainFallbackProcedure,
+			connect.WithSchema(tenantAdminServiceMethods.ByName("SetSubdomainFallback")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// tenantAdminServiceClient implements TenantAdminServiceClient.
+type tenantAdminServiceClient struct {
+	getTenant            *connect.Client[v1.GetTenantRequest, v1.GetTenantResponse]
+	updateTenant         *connect.Client[v1.UpdateTenantRequest, v1.UpdateTenantResponse]
+	listTenantMembers    *connect.Client[v1.ListTenantMembersRequest, v1.ListTenantMembersResponse]
+	updateMemberRole     *connect.Client[v1.UpdateMemberRoleRequest, v1.UpdateMemberRoleResponse]
+	removeMember         *connect.Client[v1.RemoveMemberRequest, v1.RemoveMemberResponse]
+	transferOwnership    *connect.Client[v1.TransferOwnershipRequest, v1.TransferOwnershipResponse]
+	listDomains          *connect.Client[v1.ListDomainsRequest, v1.ListDomainsResponse]
+	addDomain            *connect.Client[v1.AddDomainRequest, v1.AddDomainResponse]
+	verifyDomain         *connect.Client[v1.VerifyDomainRequest, v1.VerifyDomainResponse]
+	removeDomain         *connect.Client[v1.RemoveDomainRequest, v1.RemoveDomainResponse]
+	setSubdomainFallback *connect.Client[v1.SetSubdomainFallbackRequest, v1.SetSubdomainFallbackResponse]
+}
+
+// GetTenant calls sns.v1.TenantAdminService.GetTenant.
+func (c *tenantAdminServiceClient) GetTenant(ctx context.Context, req *connect.Request[v1.GetTenantRequest]) (*connect.Response[v1.GetTenantResponse], error) {
+	return c.getTenant.CallUnary(ctx, req)
+}
+
+// UpdateTenant calls sns.v1.TenantAdminService.UpdateTenant.
+func (c *tenantAdminServiceClient) UpdateTenant(ctx context.Context, req *connect.Request[v1.UpdateTenantRequest]) (*connect.Response[v1.UpdateTenantResponse], error) {
+	return c.updateTenant.CallUnary(ctx, req)
+}
+
+// ListTenantMembers calls sns.v1.TenantAdminService.ListTenantMembers.
+func (c *tenantAdminServiceClient) ListTenantMembers(ctx context.Context, req *connect.Request[v1.ListTenantMembersRequest]) (*connect.Response[v1.ListTenantMembersResponse], error) {
+	return c.listTenantMembers.CallUnary(ctx, req)
+}
+
+// UpdateMemberRole calls sns.v1.TenantAdminService.UpdateMemberRole.
+func (c *tenantAdminServiceClient) UpdateMemberRole(ctx context.Context, req *connect.Request[v1.UpdateMemberRoleRequest]) (*connect.Response[v1.UpdateMemberRoleResponse], error) {
+	return c.updateMemberRole.CallUnary(ctx, req)
+}
+
+// RemoveMember calls sns.v1.TenantAdminService.RemoveMember.
+func (c *tenantAdminServiceClient) RemoveMember(ctx context.Context, req *connect.Request[v1.RemoveMemberRequest]) (*connect.Response[v1.RemoveMemberResponse], error) {
+	return c.removeMember.CallUnary(ctx, req)
+}
+
+// TransferOwnership calls sns.v1.TenantAdminService.TransferOwnership.
+func (c *tenantAdminServiceClient) TransferOwnership(ctx context.Context, req *connect.Request[v1.TransferOwnershipRequest]) (*connect.Response[v1.TransferOwnershipResponse], error) {
+	return c.transferOwnership.CallUnary(ctx, req)
+}
+
+// ListDomains calls sns.v1.TenantAdminService.ListDomains.
+func (c *tenantAdminServiceClient) ListDomains(ctx context.Context, req *connect.Request[v1.ListDomainsRequest]) (*connect.Response[v1.ListDomainsResponse], error) {
+	return c.listDomains.CallUnary(ctx, req)
+}
+
+// AddDomain calls sns.v1.TenantAdminService.AddDomain.
+func (c *tenantAdminServiceClient) AddDomain(ctx context.Context, req *connect.Request[v1.AddDomainRequest]) (*connect.Response[v1.AddDomainResponse], error) {
+	return c.addDomain.CallUnary(ctx, req)
+}
+
+// VerifyDomain calls sns.v1.TenantAdminService.VerifyDomain.
+func (c *tenantAdminServiceClient) VerifyDomain(ctx context.Context, req *connect.Request[v1.VerifyDomainRequest]) (*connect.Response[v1.VerifyDomainResponse], error) {
+	return c.verifyDomain.CallUnary(ctx, req)
+}
+
+// RemoveDomain calls sns.v1.TenantAdminService.RemoveDomain.
+func (c *tenantAdminServiceClient) RemoveDomain(ctx context.Context, req *connect.Request[v1.RemoveDomainRequest]) (*connect.Response[v1.RemoveDomainResponse], error) {
+	return c.removeDomain.CallUnary(ctx, req)
+}
+
+// SetSubdomainFallback calls sns.v1.TenantAdminService.SetSubdomainFallback.
+func (c *tenantAdminServiceClient) SetSubdomainFallback(ctx context.Context, req *connect.Request[v1.SetSubdomainFallbackRequest]) (*connect.Response[v1.SetSubdomainFallbackResponse], error) {
+	return c.setSubdomainFallback.CallUnary(ctx, req)
+}
+
+// TenantAdminServiceHandler is an implementation of the sns.v1.TenantAdminService service.
+type TenantAdminServiceHandler interface {
+	GetTenant(context.Context, *connect.Request[v1.GetTenantRequest]) (*connect.Response[v1.GetTenantResponse], error)
+	UpdateTenant(context.Context, *connect.Request[v1.UpdateTenantRequest]) (*connect.Response[v1.UpdateTenantResponse], error)
+	ListTenantMembers(context.Context, *connect.Request[v1.ListTenantMembersRequest]) (*connect.Response[v1.ListTenantMembersResponse], error)
+	UpdateMemberRole(context.Context, *connect.Request[v1.UpdateMemberRoleRequest]) (*connect.Response[v1.UpdateMemberRoleResponse], error)
+	RemoveMember(context.Context, *connect.Request[v1.RemoveMemberRequest]) (*connect.Response[v1.RemoveMemberResponse], error)
+	TransferOwnership(context.Context, *connect.Request[v1.TransferOwnershipRequest]) (*connect.Response[v1.TransferOwnershipResponse], error)
+	ListDomains(context.Context, *connect.Request[v1.ListDomainsRequest]) (*connect.Response[v1.ListDomainsResponse], error)
+	AddDomain(context.Context, *connect.Request[v1.AddDomainRequest]) (*connect.Response[v1.AddDomainResponse], error)
+	VerifyDomain(context.Context, *connect.Request[v1.VerifyDomainRequest]) (*connect.Response[v1.VerifyDomainResponse], error)
+	RemoveDomain(context.Context, *connect.Request[v1.RemoveDomainRequest]) (*connect.Response[v1.RemoveDomainResponse], error)
+	SetSubdomainFallback(context.Context, *connect.Request[v1.SetSubdomainFallbackRequest]) (*connect.Response[v1.SetSubdomainFallbackResponse], error)
+}
+
+// NewTenantAdminServiceHandler builds an HTTP handler from the service implementation. It returns
+// the path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewTenantAdminServiceHandler(svc TenantAdminServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	tenantAdminServiceMethods := v1.File_sns_v1_tenant_admin_proto.Services().ByName("TenantAdminService").Methods()
+	tenantAdminServiceGetTenantHandler := connect.NewUnaryHandler(
+		TenantAdminServiceGetTenantProcedure,
+		svc.GetTenant,
+		connect.WithSchema(tenantAdminServiceMethods.ByName("GetTenant")),
+		connect.WithHandlerOptions(opts...),
+	)
+	tenantAdminServiceUpdateTenantHandler := connect.NewUnaryHandler(
+		TenantAdminServiceUpdateTenantProcedure,
+		svc.UpdateTenant,
+		connect.WithSchema(tenantAdminServiceMethods.ByName("UpdateTenant")),
+		connect.WithHandlerOptions(opts...),
+	)
+	tenantAdminServiceListTenantMembersHandler := connect.NewUnaryHandler(
+		TenantAdminServiceListTenantMembersProcedure,
+		svc.ListTenantMembers,
+		connect.WithSchema(tenantAdminServiceMethods.ByName("ListTenantMembers")),
+		connect.WithHandlerOptions(opts...),
+	)
+	tenantAdminServiceUpdateMemberRoleHandler := connect.NewUnaryHandler(
+		TenantAdminServiceUpdateMemberRoleProcedure,
+		svc.UpdateMemberRole,
+		connect.WithSchema(tenantAdminServiceMethods.ByName("UpdateMemberRole")),
+		connect.WithHandlerOptions(opts...),
+	)
+	tenantAdminServiceRemoveMemberHandler := connect.NewUnaryHandler(
+		TenantAdminServiceRemoveMemberProcedure,
+		svc.RemoveMember,
+		connect.WithSchema(tenantAdminServiceMethods.ByName("RemoveMember")),
+		connect.WithHandlerOptions(opts...),
+	)
+	tenantAdminServiceTransferOwnershipHandler := connect.NewUnaryHandler(
+		TenantAdminServiceTransferOwnershipProcedure,
+		svc.TransferOwnership,
+		connect.WithSchema(tenantAdminServiceMethods.ByName("TransferOwnership")),
+		connect.WithHandlerOptions(opts...),
+	)
+	tenantAdminServiceListDomainsHandler := connect.NewUnaryHandler(
+		TenantAdminServiceListDomainsProcedure,
+		svc.ListDomains,
+		connect.WithSchema(tenantAdminServiceMethods.ByName("ListDomains")),
+		connect.WithHandlerOptions(opts...),
+	)
+	tenantAdminServiceAddDomainHandler := connect.NewUnaryHandler(
+		TenantAdminServiceAddDomainProcedure,
+		svc.AddDomain,
+		connect.WithSchema(tenantAdminServiceMethods.ByName("AddDomain")),
+		connect.WithHandlerOptions(opts...),
+	)
+	tenantAdminServiceVerifyDomainHandler := connect.NewUnaryHandler(
+		TenantAdminServiceVerifyDomainProcedure,
+		svc.VerifyDomain,
+		connect.WithSchema(tenantAdminServiceMethods.ByName("VerifyDomain")),
+		connect.WithHandlerOptions(opts...),
+	)
+	tenantAdminServiceRemoveDomainHandler := connect.NewUnaryHandler(
+		TenantAdminServiceRemoveDomainProcedure,
+		svc.RemoveDomain,
+		connect.WithSchema(tenantAdminServiceMethods.ByName("RemoveDomain")),
+		connect.WithHandlerOptions(opts...),
+	)
+	tenantAdminServiceSetSubdomainFallbackHandler := connect.NewUnaryHandler(
+		TenantAdminServiceSetSubdomainFallbackProcedure,
+		svc.SetSubdomainFallback,
+		connect.WithSchema(tenantAdminServiceMethods.ByName("SetSubdomainFallback")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/sns.v1.TenantAdminService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case TenantAdminServiceGetTenantProcedure:
+			tenantAdminServiceGetTenantHandler.ServeHTTP(w, r)
+		case TenantAdminServiceUpdateTenantProcedure:
+			tenantAdminServiceUpdateTenantHandler.ServeHTTP(w, r)
+		case TenantAdminServiceListTenantMembersProcedure:
+			tenantAdminServiceListTenantMembersHandler.ServeHTTP(w, r)
+		case TenantAdminServiceUpdateMemberRoleProcedure:
+			tenantAdminServiceUpdateMemberRoleHandler.ServeHTTP(w, r)
+		case TenantAdminServiceRemoveMemberProcedure:
+			tenantAdminServiceRemoveMemberHandler.ServeHTTP(w, r)
+		case TenantAdminServiceTransferOwnershipProcedure:
+			tenantAdminServiceTransferOwnershipHandler.ServeHTTP(w, r)
+		case TenantAdminServiceListDomainsProcedure:
+			tenantAdminServiceListDomainsHandler.ServeHTTP(w, r)
+		case TenantAdminServiceAddDomainProcedure:
+			tenantAdminServiceAddDomainHandler.ServeHTTP(w, r)
+		case TenantAdminServiceVerifyDomainProcedure:
+			tenantAdminServiceVerifyDomainHandler.ServeHTTP(w, r)
+		case TenantAdminServiceRemoveDomainProcedure:
+			tenantAdminServiceRemoveDomainHandler.ServeHTTP(w, r)
+		case TenantAdminServiceSetSubdomainFallbackProcedure:
+			tenantAdminServiceSetSubdomainFallbackHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedTenantAdminServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedTenantAdminServiceHandler struct{}
+
+func (UnimplementedTenantAdminServiceHandler) GetTenant(context.Context, *connect.Request[v1.GetTenantRequest]) (*connect.Response[v1.GetTenantResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("sns.v1.TenantAdminService.GetTenant is not implemented"))
+}
+
+func (UnimplementedTenantAdminServiceHandler) UpdateTenant(context.Context, *connect.Request[v1.UpdateTenantRequest]) (*connect.Response[v1.UpdateTenantResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("sns.v1.TenantAdminService.UpdateTenant is not implemented"))
+}
+
+func (UnimplementedTenantAdminServiceHandler) ListTenantMembers(context.Context, *connect.Request[v1.ListTenantMembersRequest]) (*connect.Response[v1.ListTenantMembersResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("sns.v1.TenantAdminService.ListTenantMembers is not implemented"))
+}
+
+func (UnimplementedTenantAdminServiceHandler) UpdateMemberRole(context.Context, *connect.Request[v1.UpdateMemberRoleRequest]) (*connect.Response[v1.UpdateMemberRoleResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("sns.v1.TenantAdminService.UpdateMemberRole is not implemented"))
+}
+
+func (UnimplementedTenantAdminServiceHandler) RemoveMember(context.Context, *connect.Request[v1.RemoveMemberRequest]) (*connect.Response[v1.RemoveMemberResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("sns.v1.TenantAdminService.RemoveMember is not implemented"))
+}
+
+func (UnimplementedTenantAdminServiceHandler) TransferOwnership(context.Context, *connect.Request[v1.TransferOwnershipRequest]) (*connect.Response[v1.TransferOwnershipResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("sns.v1.TenantAdminService.TransferOwnership is not implemented"))
+}
+
+func (UnimplementedTenantAdminServiceHandler) ListDomains(context.Context, *connect.Request[v1.ListDomainsRequest]) (*connect.Response[v1.ListDomainsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("sns.v1.TenantAdminService.ListDomains is not implemented"))
+}
+
+func (UnimplementedTenantAdminServiceHandler) AddDomain(context.Context, *connect.Request[v1.AddDomainRequest]) (*connect.Response[v1.AddDomainResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("sns.v1.TenantAdminService.AddDomain is not implemented"))
+}
+
+func (UnimplementedTenantAdminServiceHandler) VerifyDomain(context.Context, *connect.Request[v1.VerifyDomainRequest]) (*connect.Response[v1.VerifyDomainResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("sns.v1.TenantAdminService.VerifyDomain is not implemented"))
+}
+
+func (UnimplementedTenantAdminServiceHandler) RemoveDomain(context.Context, *connect.Request[v1.RemoveDomainRequest]) (*connect.Response[v1.RemoveDomainResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("sns.v1.TenantAdminService.RemoveDomain is not implemented"))
+}
+
+func (UnimplementedTenantAdminServiceHandler) SetSubdomainFallback(context.Context, *connect.Request[v1.SetSubdomainFallbackRequest]) (*connect.Response[v1.SetSubdomainFallbackResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("sns.v1.TenantAdminService.SetSubdomainFallback is not implemented"))
+}
@@ -0,0 +1,98 @@
+// Package cursor implements port.CursorEncoder with HMAC-SHA256 signed tokens.
+package cursor
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"time"
+
+	"github.com/example/something-like-sns/apps/api/internal/domain"
+	"github.com/example/something-like-sns/apps/api/internal/port"
+)
+
+const (
+	version = 1
+	// payloadSize is version (1) + direction (1) + unix nanoseconds (8) + id (8).
+	payloadSize = 18
+	macSize     = 16
+)
+
+// ErrInvalidCursor is returned for tokens that are malformed, forged or issued for another tenant or list.
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+type hmacEncoder struct {
+	secret   []byte
+	previous [][]byte
+}
+
+// NewHMACEncoder creates an encoder signing tokens with secret. Tokens stay valid as long as
+// the secret does, so every server instance must share it. Tokens signed with one of the
+// previous secrets are still accepted, so that a secret can be rotated without invalidating the
+// cursors clients hold.
+func NewHMACEncoder(secret []byte, previous ...[]byte) port.CursorEncoder {
+	return &hmacEncoder{secret: secret, previous: previous}
+}
+
+func (e *hmacEncoder) Encode(tenantID uint64, kind string, c domain.Cursor) string {
+	if c.IsZero() {
+		return ""
+	}
+	buf := make([]byte, payloadSize, payloadSize+macSize)
+	buf[0] = version
+	buf[1] = byte(c.Direction)
+	binary.BigEndian.PutUint64(buf[2:10], uint64(c.Time.UnixNano()))
+	binary.BigEndian.PutUint64(buf[10:18], c.ID)
+	buf = append(buf, sign(e.secret, tenantID, kind, buf)...)
+	return base64.RawURLEncoding.EncodeToString(buf)
+}
+
+func (e *hmacEncoder) Decode(tenantID uint64, kind string, token string) (domain.Cursor, error) {
+	if token == "" {
+		return domain.Cursor{}, nil
+	}
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil || len(raw) != payloadSize+macSize || raw[0] != version {
+		return domain.Cursor{}, ErrInvalidCursor
+	}
+	payload, mac := raw[:payloadSize], raw[payloadSize:]
+	if !e.verify(tenantID, kind, payload, mac) {
+		return domain.Cursor{}, ErrInvalidCursor
+	}
+	dir := domain.PageDirection(payload[1])
+	if dir != domain.PageForward && dir != domain.PageBackward {
+		return domain.Cursor{}, ErrInvalidCursor
+	}
+	return domain.Cursor{
+		Time:      time.Unix(0, int64(binary.BigEndian.Uint64(payload[2:10]))).UTC(),
+		ID:        binary.BigEndian.Uint64(payload[10:18]),
+		Direction: dir,
+	}, nil
+}
+
+// verify reports whether mac was made by sign with the current or a previous secret.
+func (e *hmacEncoder) verify(tenantID uint64, kind string, payload, mac []byte) bool {
+	if hmac.Equal(mac, sign(e.secret, tenantID, kind, payload)) {
+		return true
+	}
+	for _, secret := range e.previous {
+		if hmac.Equal(mac, sign(secret, tenantID, kind, payload)) {
+			return true
+		}
+	}
+	return false
+}
+
+// sign returns the truncated MAC of payload bound to the tenant and list kind.
+func sign(secret []byte, tenantID uint64, kind string, payload []byte) []byte {
+	m := hmac.New(sha256.New, secret)
+	var tenant [8]byte
+	binary.BigEndian.PutUint64(tenant[:], tenantID)
+	m.Write(tenant[:])
+	m.Write([]byte(kind))
+	m.Write([]byte{0})
+	m.Write(payload)
+	return m.Sum(nil)[:macSize]
+}
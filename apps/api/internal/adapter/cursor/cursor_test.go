@@ -0,0 +1,76 @@
+package cursor_test
+
+import (
+	"encoding/base64"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/example/something-like-sns/apps/api/internal/adapter/cursor"
+	"github.com/example/something-like-sns/apps/api/internal/domain"
+)
+
+func TestHMACEncoder(t *testing.T) {
+	e := cursor.NewHMACEncoder([]byte("secret"))
+	c := domain.Cursor{Time: time.Date(2024, 1, 2, 3, 4, 5, 6, time.UTC), ID: 42, Direction: domain.PageBackward}
+	token := e.Encode(1, "feed", c)
+
+	if got, err := e.Decode(1, "feed", token); err != nil || got != c {
+		t.Fatalf("Decode = %+v, %v; want %+v", got, err, c)
+	}
+	if token := e.Encode(1, "feed", domain.Cursor{}); token != "" {
+		t.Errorf("Encode(zero cursor) = %q, want empty", token)
+	}
+	if got, err := e.Decode(1, "feed", ""); err != nil || !got.IsZero() {
+		t.Errorf("Decode(empty) = %+v, %v; want the zero cursor", got, err)
+	}
+
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		t.Fatalf("token is not base64: %v", err)
+	}
+	tamperedMAC := append([]byte(nil), raw...)
+	tamperedMAC[len(tamperedMAC)-1] ^= 1
+	tamperedID := append([]byte(nil), raw...)
+	tamperedID[17] ^= 1
+	tests := []struct {
+		name     string
+		tenantID uint64
+		kind     string
+		token    string
+	}{
+		{"tampered MAC", 1, "feed", base64.RawURLEncoding.EncodeToString(tamperedMAC)},
+		{"tampered ID", 1, "feed", base64.RawURLEncoding.EncodeToString(tamperedID)},
+		{"truncated", 1, "feed", base64.RawURLEncoding.EncodeToString(raw[:len(raw)-1])},
+		{"not base64", 1, "feed", "!" + token},
+		{"another tenant", 2, "feed", token},
+		{"another list", 1, "comments:7", token},
+		{"another secret", 1, "feed", cursor.NewHMACEncoder([]byte("other")).Encode(1, "feed", c)},
+	}
+	for _, tt := range tests {
+		if _, err := e.Decode(tt.tenantID, tt.kind, tt.token); !errors.Is(err, cursor.ErrInvalidCursor) {
+			t.Errorf("Decode(%s): err = %v, want ErrInvalidCursor", tt.name, err)
+		}
+	}
+}
+
+func TestHMACEncoderRotation(t *testing.T) {
+	c := domain.Cursor{Time: time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC), ID: 7, Direction: domain.PageForward}
+	old := cursor.NewHMACEncoder([]byte("old")).Encode(1, "feed", c)
+
+	rotated := cursor.NewHMACEncoder([]byte("new"), []byte("old"))
+	if got, err := rotated.Decode(1, "feed", old); err != nil || got != c {
+		t.Errorf("Decode(token signed with the previous secret) = %+v, %v; want %+v", got, err, c)
+	}
+	// The binding to tenant and list holds for the previous secret too.
+	if _, err := rotated.Decode(2, "feed", old); !errors.Is(err, cursor.ErrInvalidCursor) {
+		t.Errorf("Decode(previous secret, another tenant): err = %v, want ErrInvalidCursor", err)
+	}
+	// New tokens are signed with the current secret only.
+	if _, err := cursor.NewHMACEncoder([]byte("old")).Decode(1, "feed", rotated.Encode(1, "feed", c)); !errors.Is(err, cursor.ErrInvalidCursor) {
+		t.Errorf("Decode(new token with only the previous secret): err = %v, want ErrInvalidCursor", err)
+	}
+	if _, err := cursor.NewHMACEncoder([]byte("new")).Decode(1, "feed", old); !errors.Is(err, cursor.ErrInvalidCursor) {
+		t.Errorf("Decode(old token after the previous secret is dropped): err = %v, want ErrInvalidCursor", err)
+	}
+}
@@ -0,0 +1,75 @@
+// Package dns implements port.DomainResolver, which checks the TXT challenges of custom domains.
+package dns
+
+import (
+	"context"
+	"errors"
+	"net"
+	"sync"
+	"time"
+)
+
+// lookupTimeout bounds a lookup, so that a slow name server does not hold a request for long.
+const lookupTimeout = 5 * time.Second
+
+type netResolver struct {
+	r *net.Resolver
+}
+
+// NewResolver creates a resolver querying the name server at addr (host:port), or the system's
+// name servers if addr is empty. Asking a public server directly avoids waiting out the caches
+// of the local one after a tenant publishes a challenge.
+func NewResolver(addr string) *netResolver {
+	if addr == "" {
+		return &netResolver{r: net.DefaultResolver}
+	}
+	return &netResolver{r: &net.Resolver{
+		PreferGo: true,
+		Dial: func(ctx context.Context, network, _ string) (net.Conn, error) {
+			var d net.Dialer
+			return d.DialContext(ctx, network, addr)
+		},
+	}}
+}
+
+func (n *netResolver) LookupTXT(ctx context.Context, name string) ([]string, error) {
+	ctx, cancel := context.WithTimeout(ctx, lookupTimeout)
+	defer cancel()
+
+	records, err := n.r.LookupTXT(ctx, name)
+	var dnsErr *net.DNSError
+	if errors.As(err, &dnsErr) && dnsErr.IsNotFound {
+		return nil, nil
+	}
+	return records, err
+}
+
+// FakeResolver serves TXT records from memory, for tests and local development. It is safe for
+// concurrent use.
+type FakeResolver struct {
+	mu      sync.Mutex
+	records map[string][]string
+}
+
+func NewFakeResolver() *FakeResolver {
+	return &FakeResolver{records: map[string][]string{}}
+}
+
+// Set replaces the TXT records of name; setting none removes the name.
+func (f *FakeResolver) Set(name string, records ...string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if len(records) == 0 {
+		delete(f.records, name)
+		return
+	}
+	f.records[name] = append([]string(nil), records...)
+}
+
+func (f *FakeResolver) LookupTXT(ctx context.Context, name string) ([]string, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return append([]string(nil), f.records[name]...), nil
+}
@@ -5,13 +5,19 @@ import (
 	"errors"
 
 	"connectrpc.com/connect"
+	"github.com/example/something-like-sns/apps/api/internal/adapter/logging"
 	"github.com/example/something-like-sns/apps/api/internal/domain"
 	"github.com/example/something-like-sns/apps/api/internal/port"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 )
 
-type contextKey string
-
-const scopeContextKey = contextKey("scope")
+// guestProcedures may be called by signed-in users who are not members of the tenant.
+var guestProcedures = map[string]bool{
+	"/sns.v1.TenantService/GetMe":                true,
+	"/sns.v1.InvitationService/AcceptInvitation": true,
+	"/sns.v1.InvitationService/RequestToJoin":    true,
+}
 
 // NewAuthInterceptor creates a new connect.Interceptor for handling authentication.
 func NewAuthInterceptor(authUsecase port.AuthUsecase, allowDevHeaders bool) connect.Interceptor {
@@ -28,14 +34,25 @@ func NewAuthInterceptor(authUsecase port.AuthUsecase, allowDevHeaders bool) conn
 
 			tenantSlug := req.Header().Get("X-Tenant")
 			authSub := req.Header().Get("X-User")
+			// In development the email is taken on trust; an identity provider would verify it.
+			email := req.Header().Get("X-Email")
 
-			scope, err := authUsecase.ResolveScope(ctx, tenantSlug, authSub)
+			scope, err := authUsecase.ResolveScope(ctx, tenantSlug, authSub, email)
 			if err != nil {
-				return nil, connect.NewError(connect.CodeUnauthenticated, err)
+				return nil, err
 			}
+			if !scope.IsMember() && !guestProcedures[req.Spec().Procedure] {
+				return nil, domain.NewPermissionDeniedError("not a member of this tenant")
+			}
+
+			trace.SpanFromContext(ctx).SetAttributes(
+				attribute.Int64("sns.tenant_id", int64(scope.TenantID)),
+				attribute.Int64("enduser.id", int64(scope.UserID)),
+			)
+			logging.SetScope(ctx, *scope)
 
 			// Add scope to context
-			newCtx := context.WithValue(ctx, scopeContextKey, *scope)
+			newCtx := domain.ContextWithScope(ctx, *scope)
 			return next(newCtx, req)
 		}
 	})
@@ -44,7 +61,7 @@ func NewAuthInterceptor(authUsecase port.AuthUsecase, allowDevHeaders bool) conn
 // GetScopeFromContext retrieves the domain.Scope from the context.
 // It panics if the scope is not found, as it should always be present after the AuthInterceptor.
 func GetScopeFromContext(ctx context.Context) domain.Scope {
-	scope, ok := ctx.Value(scopeContextKey).(domain.Scope)
+	scope, ok := domain.ScopeFromContext(ctx)
 	if !ok {
 		panic("scope not found in context")
 	}
@@ -0,0 +1,47 @@
+package rpc
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"connectrpc.com/connect"
+	v1 "github.com/example/something-like-sns/apps/api/gen/sns/v1"
+	"github.com/example/something-like-sns/apps/api/gen/sns/v1/v1connect"
+	"github.com/example/something-like-sns/apps/api/internal/domain"
+	"github.com/example/something-like-sns/apps/api/internal/port"
+)
+
+type DirectoryHandler struct {
+	profileUsecase port.ProfileUsecase
+}
+
+func NewDirectoryHandler(pu port.ProfileUsecase) *DirectoryHandler {
+	return &DirectoryHandler{profileUsecase: pu}
+}
+
+func (s *DirectoryHandler) MountHandler(interceptors ...connect.Interceptor) (string, http.Handler) {
+	path, h := v1connect.NewDirectoryServiceHandler(s, connect.WithInterceptors(interceptors...))
+	return path, h
+}
+
+func (s *DirectoryHandler) ListMembers(ctx context.Context, req *connect.Request[v1.ListMembersRequest]) (*connect.Response[v1.ListMembersResponse], error) {
+	scope := GetScopeFromContext(ctx)
+
+	filter := domain.MemberFilter{Prefix: req.Msg.GetQuery(), Role: req.Msg.GetRole()}
+	members, page, err := s.profileUsecase.ListMembers(ctx, scope, filter, pageParams(req.Msg))
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]*v1.DirectoryMember, len(members))
+	for i, m := range members {
+		items[i] = &v1.DirectoryMember{
+			Profile:  profileToProto(&m.Profile),
+			Role:     m.Role,
+			JoinedAt: m.JoinedAt.Format(time.RFC3339Nano),
+		}
+	}
+
+	return connect.NewResponse(&v1.ListMembersResponse{Items: items, Next: toCursor(page.Next), Prev: toCursor(page.Prev), HasMore: page.HasMore}), nil
+}
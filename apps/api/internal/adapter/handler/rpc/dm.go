@@ -1,16 +1,14 @@
 package rpc
 
 import (
-    "context"
-    "errors"
-    "fmt"
-    "net/http"
-    "time"
-
-    "connectrpc.com/connect"
-    v1 "github.com/example/something-like-sns/apps/api/gen/sns/v1"
-    "github.com/example/something-like-sns/apps/api/gen/sns/v1/v1connect"
-    "github.com/example/something-like-sns/apps/api/internal/port"
+	"context"
+	"net/http"
+	"time"
+
+	"connectrpc.com/connect"
+	v1 "github.com/example/something-like-sns/apps/api/gen/sns/v1"
+	"github.com/example/something-like-sns/apps/api/gen/sns/v1/v1connect"
+	"github.com/example/something-like-sns/apps/api/internal/port"
 )
 
 type DMHandler struct {
@@ -21,8 +19,8 @@ func NewDMHandler(du port.DMUsecase) *DMHandler {
 	return &DMHandler{dmUsecase: du}
 }
 
-func (s *DMHandler) MountHandler(authInterceptor connect.Interceptor) (string, http.Handler) {
-	path, h := v1connect.NewDMServiceHandler(s, connect.WithInterceptors(authInterceptor))
+func (s *DMHandler) MountHandler(interceptors ...connect.Interceptor) (string, http.Handler) {
+	path, h := v1connect.NewDMServiceHandler(s, connect.WithInterceptors(interceptors...))
 	return path, h
 }
 
@@ -31,7 +29,7 @@ func (s *DMHandler) GetOrCreateDM(ctx context.Context, req *connect.Request[v1.G
 
 	convID, err := s.dmUsecase.GetOrCreateDM(ctx, scope, req.Msg.GetOtherUserId())
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInternal, err)
+		return nil, err
 	}
 
 	return connect.NewResponse(&v1.GetOrCreateDMResponse{ConversationId: convID}), nil
@@ -40,9 +38,9 @@ func (s *DMHandler) GetOrCreateDM(ctx context.Context, req *connect.Request[v1.G
 func (s *DMHandler) ListConversations(ctx context.Context, req *connect.Request[v1.ListConversationsRequest]) (*connect.Response[v1.ListConversationsResponse], error) {
 	scope := GetScopeFromContext(ctx)
 
-	convos, nextToken, err := s.dmUsecase.ListConversations(ctx, scope, req.Msg.GetCursor().GetToken())
+	convos, page, err := s.dmUsecase.ListConversations(ctx, scope, pageParams(req.Msg))
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInternal, err)
+		return nil, err
 	}
 
 	items := make([]*v1.Conversation, len(convos))
@@ -54,19 +52,15 @@ func (s *DMHandler) ListConversations(ctx context.Context, req *connect.Request[
 		}
 	}
 
-	res := &v1.ListConversationsResponse{Items: items}
-	if nextToken != "" {
-		res.Next = &v1.Cursor{Token: nextToken}
-	}
-	return connect.NewResponse(res), nil
+	return connect.NewResponse(&v1.ListConversationsResponse{Items: items, Next: toCursor(page.Next), Prev: toCursor(page.Prev), HasMore: page.HasMore}), nil
 }
 
 func (s *DMHandler) ListMessages(ctx context.Context, req *connect.Request[v1.ListMessagesRequest]) (*connect.Response[v1.ListMessagesResponse], error) {
 	scope := GetScopeFromContext(ctx)
 
-	messages, nextToken, err := s.dmUsecase.ListMessages(ctx, scope, req.Msg.GetConversationId(), req.Msg.GetCursor().GetToken())
+	messages, page, err := s.dmUsecase.ListMessages(ctx, scope, req.Msg.GetConversationId(), pageParams(req.Msg))
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInternal, err)
+		return nil, err
 	}
 
 	items := make([]*v1.Message, len(messages))
@@ -77,28 +71,19 @@ func (s *DMHandler) ListMessages(ctx context.Context, req *connect.Request[v1.Li
 			SenderUserId:   m.SenderUserID,
 			Body:           m.Body,
 			CreatedAt:      m.CreatedAt.Format(time.RFC3339Nano),
+			Sender:         profileToProto(m.Sender),
 		}
 	}
 
-	res := &v1.ListMessagesResponse{Items: items}
-	if nextToken != "" {
-		res.Next = &v1.Cursor{Token: nextToken}
-	}
-	return connect.NewResponse(res), nil
+	return connect.NewResponse(&v1.ListMessagesResponse{Items: items, Next: toCursor(page.Next), Prev: toCursor(page.Prev), HasMore: page.HasMore, Total: page.Total}), nil
 }
 
 func (s *DMHandler) SendMessage(ctx context.Context, req *connect.Request[v1.SendMessageRequest]) (*connect.Response[v1.SendMessageResponse], error) {
 	scope := GetScopeFromContext(ctx)
 
-    // Rate limit: DM send 20/min per user per tenant
-    key := fmt.Sprintf("dm:%d:%d", scope.TenantID, scope.UserID)
-    if !defaultRateLimiter.Allow(key, 20, 20) {
-        return nil, connect.NewError(connect.CodeResourceExhausted, errors.New("rate limit exceeded"))
-    }
-
 	msg, err := s.dmUsecase.SendMessage(ctx, scope, req.Msg.GetConversationId(), req.Msg.GetBody())
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		return nil, err
 	}
 
 	return connect.NewResponse(&v1.SendMessageResponse{
@@ -108,6 +93,7 @@ func (s *DMHandler) SendMessage(ctx context.Context, req *connect.Request[v1.Sen
 			SenderUserId:   msg.SenderUserID,
 			Body:           msg.Body,
 			CreatedAt:      msg.CreatedAt.Format(time.RFC3339Nano),
+			Sender:         profileToProto(msg.Sender),
 		},
 	}), nil
-}
\ No newline at end of file
+}
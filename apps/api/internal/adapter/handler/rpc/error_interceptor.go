@@ -0,0 +1,95 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"connectrpc.com/connect"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/example/something-like-sns/apps/api/internal/domain"
+)
+
+// errorDomain is the ErrorInfo domain attached to errors produced by this API.
+const errorDomain = "sns.v1"
+
+// NewErrorInterceptor creates a new connect.Interceptor that maps domain errors to Connect codes
+// with error details. Unrecognized errors become CodeInternal and their message is only logged.
+// It must run outside the auth and rate limit interceptors so that their errors are mapped as well.
+func NewErrorInterceptor() connect.Interceptor {
+	return connect.UnaryInterceptorFunc(func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			res, err := next(ctx, req)
+			if err != nil {
+				return nil, toConnectError(ctx, req.Spec().Procedure, err)
+			}
+			return res, nil
+		}
+	})
+}
+
+func toConnectError(ctx context.Context, procedure string, err error) error {
+	var (
+		connectErr *connect.Error
+		notFound   *domain.NotFoundError
+		denied     *domain.PermissionDeniedError
+		validation *domain.ValidationError
+		conflict   *domain.ConflictError
+		failed     *domain.FailedPreconditionError
+	)
+	switch {
+	case errors.As(err, &connectErr):
+		return connectErr
+	case errors.As(err, &notFound):
+		return withDetail(connect.NewError(connect.CodeNotFound, notFound), &errdetails.ResourceInfo{
+			ResourceType: notFound.Resource,
+			ResourceName: notFound.ID,
+		})
+	case errors.As(err, &denied):
+		return withDetail(connect.NewError(connect.CodePermissionDenied, denied), &errdetails.ErrorInfo{
+			Reason: "PERMISSION_DENIED",
+			Domain: errorDomain,
+		})
+	case errors.As(err, &validation):
+		violations := make([]*errdetails.BadRequest_FieldViolation, len(validation.Violations))
+		for i, v := range validation.Violations {
+			violations[i] = &errdetails.BadRequest_FieldViolation{Field: v.Field, Description: v.Description}
+		}
+		return withDetail(connect.NewError(connect.CodeInvalidArgument, validation), &errdetails.BadRequest{
+			FieldViolations: violations,
+		})
+	case errors.As(err, &conflict):
+		return withDetail(connect.NewError(connect.CodeAlreadyExists, conflict), &errdetails.ErrorInfo{
+			Reason:   "CONFLICT",
+			Domain:   errorDomain,
+			Metadata: map[string]string{"resource": conflict.Resource},
+		})
+	case errors.As(err, &failed):
+		return withDetail(connect.NewError(connect.CodeFailedPrecondition, failed), &errdetails.ErrorInfo{
+			Reason: "FAILED_PRECONDITION",
+			Domain: errorDomain,
+		})
+	case errors.Is(err, domain.ErrQuotaExceeded):
+		return withDetail(connect.NewError(connect.CodeResourceExhausted, err), &errdetails.ErrorInfo{
+			Reason: "QUOTA_EXCEEDED",
+			Domain: errorDomain,
+		})
+	case errors.Is(err, domain.ErrUnauthenticated):
+		return connect.NewError(connect.CodeUnauthenticated, err)
+	case errors.Is(err, context.Canceled):
+		return connect.NewError(connect.CodeCanceled, err)
+	case errors.Is(err, context.DeadlineExceeded):
+		return connect.NewError(connect.CodeDeadlineExceeded, err)
+	}
+	slog.ErrorContext(ctx, "internal error", "procedure", procedure, "error", err)
+	return connect.NewError(connect.CodeInternal, errors.New("internal error"))
+}
+
+func withDetail(err *connect.Error, detail proto.Message) *connect.Error {
+	if d, derr := connect.NewErrorDetail(detail); derr == nil {
+		err.AddDetail(d)
+	}
+	return err
+}
@@ -0,0 +1,96 @@
+package rpc
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"log/slog"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/proto"
+
+	v1 "github.com/example/something-like-sns/apps/api/gen/sns/v1"
+	"github.com/example/something-like-sns/apps/api/gen/sns/v1/v1connect"
+	"github.com/example/something-like-sns/apps/api/internal/port"
+)
+
+const (
+	// IdempotencyKeyHeader is the request header carrying the client's idempotency key.
+	IdempotencyKeyHeader = "Idempotency-Key"
+	// IdempotentReplayedHeader is set on responses replayed from a previous request.
+	IdempotentReplayedHeader = "Idempotent-Replayed"
+)
+
+// idempotentProcedures maps the procedures that honor idempotency keys to a function
+// decoding a stored response of that procedure.
+var idempotentProcedures = map[string]func([]byte) (connect.AnyResponse, error){
+	v1connect.TimelineServiceCreatePostProcedure:    decodeResponse[v1.CreatePostResponse],
+	v1connect.TimelineServiceCreateCommentProcedure: decodeResponse[v1.CreateCommentResponse],
+	v1connect.DMServiceSendMessageProcedure:         decodeResponse[v1.SendMessageResponse],
+}
+
+// NewIdempotencyInterceptor creates a new connect.Interceptor that stores the responses of write
+// procedures called with an Idempotency-Key header and replays them when the same request is retried.
+// It must run after the AuthInterceptor and before the RateLimitInterceptor, so replays are not counted.
+func NewIdempotencyInterceptor(idempotencyUsecase port.IdempotencyUsecase) connect.Interceptor {
+	return connect.UnaryInterceptorFunc(func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			procedure := req.Spec().Procedure
+			decode, ok := idempotentProcedures[procedure]
+			key := req.Header().Get(IdempotencyKeyHeader)
+			if !ok || key == "" {
+				return next(ctx, req)
+			}
+			scope := GetScopeFromContext(ctx)
+
+			payload, err := proto.MarshalOptions{Deterministic: true}.Marshal(req.Any().(proto.Message))
+			if err != nil {
+				return nil, err
+			}
+			hash := sha256.Sum256(payload)
+			stored, err := idempotencyUsecase.Begin(ctx, scope, procedure, key, hash[:])
+			if err != nil {
+				return nil, err
+			}
+			if stored != nil {
+				res, err := decode(stored)
+				if err != nil {
+					return nil, err
+				}
+				res.Header().Set(IdempotentReplayedHeader, "true")
+				return res, nil
+			}
+
+			// Release or complete the key even if the client has gone away.
+			bg := context.WithoutCancel(ctx)
+			res, err := next(ctx, req)
+			if err != nil {
+				if abortErr := idempotencyUsecase.Abort(bg, scope, procedure, key); abortErr != nil {
+					slog.ErrorContext(ctx, "idempotency key abort failed", "procedure", procedure, "error", abortErr)
+				}
+				return nil, err
+			}
+			response, err := proto.Marshal(res.Any().(proto.Message))
+			if err == nil {
+				err = idempotencyUsecase.Complete(bg, scope, procedure, key, response)
+			}
+			if err != nil {
+				// The write succeeded; a retry will see the key as in progress until it expires.
+				slog.ErrorContext(ctx, "idempotency key completion failed", "procedure", procedure, "error", err)
+			}
+			return res, nil
+		}
+	})
+}
+
+// decodeResponse unmarshals a stored response message of type T.
+func decodeResponse[T any, PT interface {
+	*T
+	proto.Message
+}](b []byte) (connect.AnyResponse, error) {
+	msg := PT(new(T))
+	if err := proto.Unmarshal(b, msg); err != nil {
+		return nil, fmt.Errorf("decode stored response: %w", err)
+	}
+	return connect.NewResponse((*T)(msg)), nil
+}
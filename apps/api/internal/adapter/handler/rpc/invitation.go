@@ -0,0 +1,141 @@
+package rpc
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"connectrpc.com/connect"
+	v1 "github.com/example/something-like-sns/apps/api/gen/sns/v1"
+	"github.com/example/something-like-sns/apps/api/gen/sns/v1/v1connect"
+	"github.com/example/something-like-sns/apps/api/internal/domain"
+	"github.com/example/something-like-sns/apps/api/internal/port"
+)
+
+type InvitationHandler struct {
+	invitationUsecase port.InvitationUsecase
+}
+
+func NewInvitationHandler(iu port.InvitationUsecase) *InvitationHandler {
+	return &InvitationHandler{invitationUsecase: iu}
+}
+
+func (s *InvitationHandler) MountHandler(interceptors ...connect.Interceptor) (string, http.Handler) {
+	path, h := v1connect.NewInvitationServiceHandler(s, connect.WithInterceptors(interceptors...))
+	return path, h
+}
+
+func (s *InvitationHandler) CreateInvitation(ctx context.Context, req *connect.Request[v1.CreateInvitationRequest]) (*connect.Response[v1.CreateInvitationResponse], error) {
+	scope := GetScopeFromContext(ctx)
+	ttl := time.Duration(req.Msg.GetTtlHours()) * time.Hour
+	inv, token, err := s.invitationUsecase.CreateInvitation(ctx, scope, req.Msg.GetRole(), ttl)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&v1.CreateInvitationResponse{Invitation: invitationToProto(inv), Token: token}), nil
+}
+
+func (s *InvitationHandler) ListInvitations(ctx context.Context, req *connect.Request[v1.ListInvitationsRequest]) (*connect.Response[v1.ListInvitationsResponse], error) {
+	scope := GetScopeFromContext(ctx)
+	invs, err := s.invitationUsecase.ListInvitations(ctx, scope)
+	if err != nil {
+		return nil, err
+	}
+	items := make([]*v1.Invitation, len(invs))
+	for i, inv := range invs {
+		items[i] = invitationToProto(inv)
+	}
+	return connect.NewResponse(&v1.ListInvitationsResponse{Items: items}), nil
+}
+
+func (s *InvitationHandler) RevokeInvitation(ctx context.Context, req *connect.Request[v1.RevokeInvitationRequest]) (*connect.Response[v1.RevokeInvitationResponse], error) {
+	scope := GetScopeFromContext(ctx)
+	if err := s.invitationUsecase.RevokeInvitation(ctx, scope, req.Msg.GetInvitationId()); err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&v1.RevokeInvitationResponse{}), nil
+}
+
+func (s *InvitationHandler) AcceptInvitation(ctx context.Context, req *connect.Request[v1.AcceptInvitationRequest]) (*connect.Response[v1.AcceptInvitationResponse], error) {
+	scope := GetScopeFromContext(ctx)
+	role, err := s.invitationUsecase.AcceptInvitation(ctx, scope, req.Msg.GetToken())
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&v1.AcceptInvitationResponse{Role: role}), nil
+}
+
+func (s *InvitationHandler) RequestToJoin(ctx context.Context, req *connect.Request[v1.RequestToJoinRequest]) (*connect.Response[v1.RequestToJoinResponse], error) {
+	scope := GetScopeFromContext(ctx)
+	jr, err := s.invitationUsecase.RequestToJoin(ctx, scope, req.Msg.GetMessage())
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&v1.RequestToJoinResponse{JoinRequest: joinRequestToProto(jr)}), nil
+}
+
+func (s *InvitationHandler) ListJoinRequests(ctx context.Context, req *connect.Request[v1.ListJoinRequestsRequest]) (*connect.Response[v1.ListJoinRequestsResponse], error) {
+	scope := GetScopeFromContext(ctx)
+	reqs, err := s.invitationUsecase.ListJoinRequests(ctx, scope)
+	if err != nil {
+		return nil, err
+	}
+	items := make([]*v1.JoinRequest, len(reqs))
+	for i, jr := range reqs {
+		items[i] = joinRequestToProto(jr)
+	}
+	return connect.NewResponse(&v1.ListJoinRequestsResponse{Items: items}), nil
+}
+
+func (s *InvitationHandler) ApproveJoinRequest(ctx context.Context, req *connect.Request[v1.ApproveJoinRequestRequest]) (*connect.Response[v1.ApproveJoinRequestResponse], error) {
+	scope := GetScopeFromContext(ctx)
+	if err := s.invitationUsecase.ApproveJoinRequest(ctx, scope, req.Msg.GetJoinRequestId(), req.Msg.GetRole()); err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&v1.ApproveJoinRequestResponse{}), nil
+}
+
+func (s *InvitationHandler) RejectJoinRequest(ctx context.Context, req *connect.Request[v1.RejectJoinRequestRequest]) (*connect.Response[v1.RejectJoinRequestResponse], error) {
+	scope := GetScopeFromContext(ctx)
+	if err := s.invitationUsecase.RejectJoinRequest(ctx, scope, req.Msg.GetJoinRequestId()); err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&v1.RejectJoinRequestResponse{}), nil
+}
+
+func (s *InvitationHandler) GetJoinPolicy(ctx context.Context, req *connect.Request[v1.GetJoinPolicyRequest]) (*connect.Response[v1.GetJoinPolicyResponse], error) {
+	scope := GetScopeFromContext(ctx)
+	policy, domains, err := s.invitationUsecase.GetJoinPolicy(ctx, scope)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&v1.GetJoinPolicyResponse{Policy: policy, AllowedEmailDomains: domains}), nil
+}
+
+func (s *InvitationHandler) UpdateJoinPolicy(ctx context.Context, req *connect.Request[v1.UpdateJoinPolicyRequest]) (*connect.Response[v1.UpdateJoinPolicyResponse], error) {
+	scope := GetScopeFromContext(ctx)
+	if err := s.invitationUsecase.UpdateJoinPolicy(ctx, scope, req.Msg.GetPolicy(), req.Msg.GetAllowedEmailDomains()); err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&v1.UpdateJoinPolicyResponse{}), nil
+}
+
+func invitationToProto(inv *domain.Invitation) *v1.Invitation {
+	return &v1.Invitation{
+		Id:              inv.ID,
+		Role:            inv.Role,
+		CreatedByUserId: inv.CreatedByUserID,
+		CreatedAt:       inv.CreatedAt.Format(time.RFC3339Nano),
+		ExpiresAt:       inv.ExpiresAt.Format(time.RFC3339Nano),
+	}
+}
+
+func joinRequestToProto(jr *domain.JoinRequest) *v1.JoinRequest {
+	return &v1.JoinRequest{
+		Id:          jr.ID,
+		UserId:      jr.UserID,
+		DisplayName: jr.DisplayName,
+		Message:     jr.Message,
+		CreatedAt:   jr.CreatedAt.Format(time.RFC3339Nano),
+	}
+}
@@ -0,0 +1,134 @@
+package rpc
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"connectrpc.com/connect"
+	v1 "github.com/example/something-like-sns/apps/api/gen/sns/v1"
+	"github.com/example/something-like-sns/apps/api/gen/sns/v1/v1connect"
+	"github.com/example/something-like-sns/apps/api/internal/domain"
+	"github.com/example/something-like-sns/apps/api/internal/port"
+)
+
+type ModerationHandler struct {
+	moderationUsecase port.ModerationUsecase
+}
+
+func NewModerationHandler(mu port.ModerationUsecase) *ModerationHandler {
+	return &ModerationHandler{moderationUsecase: mu}
+}
+
+func (s *ModerationHandler) MountHandler(interceptors ...connect.Interceptor) (string, http.Handler) {
+	path, h := v1connect.NewModerationServiceHandler(s, connect.WithInterceptors(interceptors...))
+	return path, h
+}
+
+func (s *ModerationHandler) ReportContent(ctx context.Context, req *connect.Request[v1.ReportContentRequest]) (*connect.Response[v1.ReportContentResponse], error) {
+	scope := GetScopeFromContext(ctx)
+	report, err := s.moderationUsecase.ReportContent(ctx, scope, reportTargetTypeFromProto(req.Msg.GetTargetType()), req.Msg.GetTargetId(), req.Msg.GetReason())
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&v1.ReportContentResponse{Report: reportToProto(report)}), nil
+}
+
+func (s *ModerationHandler) ListReports(ctx context.Context, req *connect.Request[v1.ListReportsRequest]) (*connect.Response[v1.ListReportsResponse], error) {
+	scope := GetScopeFromContext(ctx)
+	reports, page, err := s.moderationUsecase.ListReports(ctx, scope, req.Msg.GetStatus(), pageParams(req.Msg))
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]*v1.Report, len(reports))
+	for i, r := range reports {
+		items[i] = reportToProto(r)
+	}
+	return connect.NewResponse(&v1.ListReportsResponse{Items: items, Next: toCursor(page.Next), Prev: toCursor(page.Prev), HasMore: page.HasMore}), nil
+}
+
+func (s *ModerationHandler) ResolveReport(ctx context.Context, req *connect.Request[v1.ResolveReportRequest]) (*connect.Response[v1.ResolveReportResponse], error) {
+	scope := GetScopeFromContext(ctx)
+	report, err := s.moderationUsecase.ResolveReport(ctx, scope, req.Msg.GetReportId(), domain.ModerationAction(req.Msg.GetAction()), req.Msg.GetNote())
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&v1.ResolveReportResponse{Report: reportToProto(report)}), nil
+}
+
+func (s *ModerationHandler) ReinstateMember(ctx context.Context, req *connect.Request[v1.ReinstateMemberRequest]) (*connect.Response[v1.ReinstateMemberResponse], error) {
+	scope := GetScopeFromContext(ctx)
+	if err := s.moderationUsecase.ReinstateMember(ctx, scope, req.Msg.GetUserId()); err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&v1.ReinstateMemberResponse{}), nil
+}
+
+func (s *ModerationHandler) ListAuditEvents(ctx context.Context, req *connect.Request[v1.ListAuditEventsRequest]) (*connect.Response[v1.ListAuditEventsResponse], error) {
+	scope := GetScopeFromContext(ctx)
+	events, page, err := s.moderationUsecase.ListAuditEvents(ctx, scope, pageParams(req.Msg))
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]*v1.AuditEvent, len(events))
+	for i, e := range events {
+		items[i] = &v1.AuditEvent{
+			Id:          e.ID,
+			ActorUserId: e.ActorUserID,
+			Action:      e.Action,
+			TargetType:  e.TargetType,
+			TargetId:    e.TargetID,
+			ReportId:    e.ReportID,
+			Note:        e.Note,
+			CreatedAt:   e.CreatedAt.Format(time.RFC3339Nano),
+		}
+	}
+	return connect.NewResponse(&v1.ListAuditEventsResponse{Items: items, Next: toCursor(page.Next), Prev: toCursor(page.Prev), HasMore: page.HasMore}), nil
+}
+
+// reportTargetTypeFromProto maps an unspecified target type to "", which the usecase rejects.
+func reportTargetTypeFromProto(t v1.ReportTargetType) domain.ReportTargetType {
+	switch t {
+	case v1.ReportTargetType_REPORT_TARGET_POST:
+		return domain.ReportTargetPost
+	case v1.ReportTargetType_REPORT_TARGET_COMMENT:
+		return domain.ReportTargetComment
+	case v1.ReportTargetType_REPORT_TARGET_MESSAGE:
+		return domain.ReportTargetMessage
+	}
+	return ""
+}
+
+func reportTargetTypeToProto(t domain.ReportTargetType) v1.ReportTargetType {
+	switch t {
+	case domain.ReportTargetPost:
+		return v1.ReportTargetType_REPORT_TARGET_POST
+	case domain.ReportTargetComment:
+		return v1.ReportTargetType_REPORT_TARGET_COMMENT
+	case domain.ReportTargetMessage:
+		return v1.ReportTargetType_REPORT_TARGET_MESSAGE
+	}
+	return v1.ReportTargetType_REPORT_TARGET_TYPE_UNSPECIFIED
+}
+
+func reportToProto(r *domain.Report) *v1.Report {
+	pr := &v1.Report{
+		Id:               r.ID,
+		TargetType:       reportTargetTypeToProto(r.TargetType),
+		TargetId:         r.TargetID,
+		AuthorUserId:     r.AuthorUserID,
+		ReporterUserId:   r.ReporterUserID,
+		Reason:           r.Reason,
+		Content:          r.Content,
+		Status:           r.Status,
+		CreatedAt:        r.CreatedAt.Format(time.RFC3339Nano),
+		Action:           string(r.Action),
+		ResolvedByUserId: r.ResolvedByUserID,
+	}
+	if !r.ResolvedAt.IsZero() {
+		pr.ResolvedAt = r.ResolvedAt.Format(time.RFC3339Nano)
+	}
+	return pr
+}
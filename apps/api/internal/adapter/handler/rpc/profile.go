@@ -0,0 +1,86 @@
+package rpc
+
+import (
+	"context"
+	"net/http"
+
+	"connectrpc.com/connect"
+	v1 "github.com/example/something-like-sns/apps/api/gen/sns/v1"
+	"github.com/example/something-like-sns/apps/api/gen/sns/v1/v1connect"
+	"github.com/example/something-like-sns/apps/api/internal/domain"
+	"github.com/example/something-like-sns/apps/api/internal/port"
+)
+
+type ProfileHandler struct {
+	profileUsecase port.ProfileUsecase
+}
+
+func NewProfileHandler(pu port.ProfileUsecase) *ProfileHandler {
+	return &ProfileHandler{profileUsecase: pu}
+}
+
+func (s *ProfileHandler) MountHandler(interceptors ...connect.Interceptor) (string, http.Handler) {
+	path, h := v1connect.NewProfileServiceHandler(s, connect.WithInterceptors(interceptors...))
+	return path, h
+}
+
+func (s *ProfileHandler) GetProfile(ctx context.Context, req *connect.Request[v1.GetProfileRequest]) (*connect.Response[v1.GetProfileResponse], error) {
+	scope := GetScopeFromContext(ctx)
+	p, err := s.profileUsecase.GetProfile(ctx, scope, req.Msg.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&v1.GetProfileResponse{Profile: profileToProto(p)}), nil
+}
+
+func (s *ProfileHandler) UpdateProfile(ctx context.Context, req *connect.Request[v1.UpdateProfileRequest]) (*connect.Response[v1.UpdateProfileResponse], error) {
+	scope := GetScopeFromContext(ctx)
+	p, err := s.profileUsecase.UpdateProfile(ctx, scope, &domain.Profile{
+		DisplayName: req.Msg.GetDisplayName(),
+		Handle:      req.Msg.GetHandle(),
+		Bio:         req.Msg.GetBio(),
+		AvatarURL:   req.Msg.GetAvatarUrl(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&v1.UpdateProfileResponse{Profile: profileToProto(p)}), nil
+}
+
+func (s *ProfileHandler) GetProfileSettings(ctx context.Context, req *connect.Request[v1.GetProfileSettingsRequest]) (*connect.Response[v1.GetProfileSettingsResponse], error) {
+	scope := GetScopeFromContext(ctx)
+	ps, err := s.profileUsecase.GetProfileSettings(ctx, scope)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&v1.GetProfileSettingsResponse{
+		Profile:       profileToProto(&ps.Profile),
+		TenantProfile: &v1.TenantProfile{DisplayName: ps.Override.DisplayName, AvatarUrl: ps.Override.AvatarURL},
+	}), nil
+}
+
+func (s *ProfileHandler) UpdateTenantProfile(ctx context.Context, req *connect.Request[v1.UpdateTenantProfileRequest]) (*connect.Response[v1.UpdateTenantProfileResponse], error) {
+	scope := GetScopeFromContext(ctx)
+	p, err := s.profileUsecase.UpdateTenantProfile(ctx, scope, domain.ProfileOverride{
+		DisplayName: req.Msg.GetDisplayName(),
+		AvatarURL:   req.Msg.GetAvatarUrl(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&v1.UpdateTenantProfileResponse{Profile: profileToProto(p)}), nil
+}
+
+// profileToProto returns nil for a nil profile, such as the author of an item whose user is gone.
+func profileToProto(p *domain.Profile) *v1.Profile {
+	if p == nil {
+		return nil
+	}
+	return &v1.Profile{
+		UserId:      p.UserID,
+		DisplayName: p.DisplayName,
+		Handle:      p.Handle,
+		Bio:         p.Bio,
+		AvatarUrl:   p.AvatarURL,
+	}
+}
@@ -0,0 +1,57 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"connectrpc.com/connect"
+	"github.com/example/something-like-sns/apps/api/gen/sns/v1/v1connect"
+	"github.com/example/something-like-sns/apps/api/internal/domain"
+	"github.com/example/something-like-sns/apps/api/internal/port"
+)
+
+// rateLimitedProcedures maps the write procedures that are subject to plan limits to their quota action.
+var rateLimitedProcedures = map[string]domain.QuotaAction{
+	v1connect.TimelineServiceCreatePostProcedure:    domain.QuotaActionPost,
+	v1connect.TimelineServiceCreateCommentProcedure: domain.QuotaActionComment,
+	v1connect.DMServiceSendMessageProcedure:         domain.QuotaActionMessage,
+}
+
+// NewRateLimitInterceptor creates a new connect.Interceptor that enforces the per-minute rate limits
+// of the caller's tenant plan. It must run after the AuthInterceptor. The plan is passed on in the
+// context, for the usecases to check the daily and storage quotas against without loading it again.
+func NewRateLimitInterceptor(quotaUsecase port.QuotaUsecase, limiter *RateLimiter) connect.Interceptor {
+	return connect.UnaryInterceptorFunc(func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			action, ok := rateLimitedProcedures[req.Spec().Procedure]
+			if !ok {
+				return next(ctx, req)
+			}
+			scope := GetScopeFromContext(ctx)
+
+			plan, err := quotaUsecase.GetPlan(ctx, scope.TenantID)
+			if err != nil {
+				return nil, err
+			}
+			perMinute := perMinuteLimit(plan, action)
+			key := fmt.Sprintf("%s:%d:%d", action, scope.TenantID, scope.UserID)
+			if !limiter.Allow(key, perMinute, perMinute) {
+				return nil, connect.NewError(connect.CodeResourceExhausted, errors.New("rate limit exceeded"))
+			}
+			return next(domain.ContextWithPlan(ctx, scope.TenantID, plan), req)
+		}
+	})
+}
+
+func perMinuteLimit(plan *domain.Plan, action domain.QuotaAction) int {
+	switch action {
+	case domain.QuotaActionPost:
+		return int(plan.PostsPerMinute)
+	case domain.QuotaActionComment:
+		return int(plan.CommentsPerMinute)
+	case domain.QuotaActionMessage:
+		return int(plan.MessagesPerMinute)
+	}
+	return 0
+}
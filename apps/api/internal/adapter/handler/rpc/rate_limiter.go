@@ -58,5 +58,3 @@ func (l *RateLimiter) Allow(key string, capacity int, refillPerMinute int) bool
 	}
 	return false
 }
-
-var defaultRateLimiter = NewRateLimiter()
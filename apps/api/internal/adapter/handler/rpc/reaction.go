@@ -1,13 +1,13 @@
 package rpc
 
 import (
-    "context"
-    "net/http"
+	"context"
+	"net/http"
 
-    "connectrpc.com/connect"
-    v1 "github.com/example/something-like-sns/apps/api/gen/sns/v1"
-    "github.com/example/something-like-sns/apps/api/gen/sns/v1/v1connect"
-    "github.com/example/something-like-sns/apps/api/internal/port"
+	"connectrpc.com/connect"
+	v1 "github.com/example/something-like-sns/apps/api/gen/sns/v1"
+	"github.com/example/something-like-sns/apps/api/gen/sns/v1/v1connect"
+	"github.com/example/something-like-sns/apps/api/internal/port"
 )
 
 type ReactionHandler struct {
@@ -18,8 +18,8 @@ func NewReactionHandler(ru port.ReactionUsecase) *ReactionHandler {
 	return &ReactionHandler{reactionUsecase: ru}
 }
 
-func (s *ReactionHandler) MountHandler(authInterceptor connect.Interceptor) (string, http.Handler) {
-	path, h := v1connect.NewReactionServiceHandler(s, connect.WithInterceptors(authInterceptor))
+func (s *ReactionHandler) MountHandler(interceptors ...connect.Interceptor) (string, http.Handler) {
+	path, h := v1connect.NewReactionServiceHandler(s, connect.WithInterceptors(interceptors...))
 	return path, h
 }
 
@@ -28,7 +28,7 @@ func (s *ReactionHandler) ToggleReaction(ctx context.Context, req *connect.Reque
 
 	reaction, err := s.reactionUsecase.ToggleReaction(ctx, scope, req.Msg.GetTargetType(), req.Msg.GetTargetId(), req.Msg.GetType())
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInternal, err)
+		return nil, err
 	}
 
 	return connect.NewResponse(&v1.ToggleReactionResponse{
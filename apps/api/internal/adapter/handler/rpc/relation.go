@@ -0,0 +1,56 @@
+package rpc
+
+import (
+	"context"
+	"net/http"
+
+	"connectrpc.com/connect"
+	v1 "github.com/example/something-like-sns/apps/api/gen/sns/v1"
+	"github.com/example/something-like-sns/apps/api/gen/sns/v1/v1connect"
+	"github.com/example/something-like-sns/apps/api/internal/port"
+)
+
+type RelationHandler struct {
+	relationUsecase port.RelationUsecase
+}
+
+func NewRelationHandler(ru port.RelationUsecase) *RelationHandler {
+	return &RelationHandler{relationUsecase: ru}
+}
+
+func (s *RelationHandler) MountHandler(interceptors ...connect.Interceptor) (string, http.Handler) {
+	path, h := v1connect.NewRelationServiceHandler(s, connect.WithInterceptors(interceptors...))
+	return path, h
+}
+
+func (s *RelationHandler) BlockUser(ctx context.Context, req *connect.Request[v1.BlockUserRequest]) (*connect.Response[v1.BlockUserResponse], error) {
+	scope := GetScopeFromContext(ctx)
+	if err := s.relationUsecase.BlockUser(ctx, scope, req.Msg.GetUserId()); err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&v1.BlockUserResponse{}), nil
+}
+
+func (s *RelationHandler) UnblockUser(ctx context.Context, req *connect.Request[v1.UnblockUserRequest]) (*connect.Response[v1.UnblockUserResponse], error) {
+	scope := GetScopeFromContext(ctx)
+	if err := s.relationUsecase.UnblockUser(ctx, scope, req.Msg.GetUserId()); err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&v1.UnblockUserResponse{}), nil
+}
+
+func (s *RelationHandler) MuteUser(ctx context.Context, req *connect.Request[v1.MuteUserRequest]) (*connect.Response[v1.MuteUserResponse], error) {
+	scope := GetScopeFromContext(ctx)
+	if err := s.relationUsecase.MuteUser(ctx, scope, req.Msg.GetUserId()); err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&v1.MuteUserResponse{}), nil
+}
+
+func (s *RelationHandler) UnmuteUser(ctx context.Context, req *connect.Request[v1.UnmuteUserRequest]) (*connect.Response[v1.UnmuteUserResponse], error) {
+	scope := GetScopeFromContext(ctx)
+	if err := s.relationUsecase.UnmuteUser(ctx, scope, req.Msg.GetUserId()); err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&v1.UnmuteUserResponse{}), nil
+}
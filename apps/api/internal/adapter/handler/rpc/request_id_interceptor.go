@@ -0,0 +1,39 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+
+	"connectrpc.com/connect"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/example/something-like-sns/apps/api/internal/adapter/logging"
+)
+
+// RequestIDHeader is the header carrying the request ID in both directions.
+const RequestIDHeader = "X-Request-Id"
+
+// NewRequestIDInterceptor creates a new connect.Interceptor that assigns every request an ID,
+// reusing the caller's X-Request-Id when it is well-formed, and returns it in the response headers.
+// The ID is attached to the context for logging, so it must run outside the interceptors that log.
+func NewRequestIDInterceptor() connect.Interceptor {
+	return connect.UnaryInterceptorFunc(func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			id := logging.RequestID(req.Header().Get(RequestIDHeader))
+			ctx = logging.WithRequestID(ctx, id)
+			trace.SpanFromContext(ctx).SetAttributes(attribute.String("sns.request_id", id))
+
+			res, err := next(ctx, req)
+			if err != nil {
+				var connectErr *connect.Error
+				if errors.As(err, &connectErr) {
+					connectErr.Meta().Set(RequestIDHeader, id)
+				}
+				return nil, err
+			}
+			res.Header().Set(RequestIDHeader, id)
+			return res, nil
+		}
+	})
+}
@@ -1,36 +1,36 @@
 package rpc
 
 import (
-    "context"
-    "net/http"
+	"context"
+	"net/http"
 
-    "connectrpc.com/connect"
-    v1 "github.com/example/something-like-sns/apps/api/gen/sns/v1"
-    "github.com/example/something-like-sns/apps/api/gen/sns/v1/v1connect"
-    "github.com/example/something-like-sns/apps/api/internal/port"
+	"connectrpc.com/connect"
+	v1 "github.com/example/something-like-sns/apps/api/gen/sns/v1"
+	"github.com/example/something-like-sns/apps/api/gen/sns/v1/v1connect"
+	"github.com/example/something-like-sns/apps/api/internal/port"
 )
 
 type TenantHandler struct {
 	authUsecase     port.AuthUsecase
+	quotaUsecase    port.QuotaUsecase
 	allowDevHeaders bool
 }
 
-func NewTenantHandler(au port.AuthUsecase, allowDev bool) *TenantHandler {
-	return &TenantHandler{authUsecase: au, allowDevHeaders: allowDev}
+func NewTenantHandler(au port.AuthUsecase, qu port.QuotaUsecase, allowDev bool) *TenantHandler {
+	return &TenantHandler{authUsecase: au, quotaUsecase: qu, allowDevHeaders: allowDev}
 }
 
-func (s *TenantHandler) MountHandler(authInterceptor connect.Interceptor) (string, http.Handler) {
+func (s *TenantHandler) MountHandler(interceptors ...connect.Interceptor) (string, http.Handler) {
 	// Auth interceptor is not applied to the tenant service itself, as it handles public tenant resolution.
 	// However, GetMe method will rely on the scope being present from the interceptor.
-	path, handler := v1connect.NewTenantServiceHandler(s, connect.WithInterceptors(authInterceptor))
+	path, handler := v1connect.NewTenantServiceHandler(s, connect.WithInterceptors(interceptors...))
 	return path, handler
 }
 
 func (s *TenantHandler) ResolveTenant(ctx context.Context, req *connect.Request[v1.ResolveTenantRequest]) (*connect.Response[v1.ResolveTenantResponse], error) {
 	tenant, err := s.authUsecase.ResolveTenant(ctx, req.Msg.GetHost())
 	if err != nil {
-		// TODO: Map domain errors to connect errors
-		return nil, connect.NewError(connect.CodeNotFound, err)
+		return nil, err
 	}
 	return connect.NewResponse(&v1.ResolveTenantResponse{TenantId: tenant.ID, Slug: tenant.Slug}), nil
 }
@@ -38,12 +38,10 @@ func (s *TenantHandler) ResolveTenant(ctx context.Context, req *connect.Request[
 func (s *TenantHandler) GetMe(ctx context.Context, req *connect.Request[v1.GetMeRequest]) (*connect.Response[v1.GetMeResponse], error) {
 	// The interceptor has already run and resolved the scope.
 	scope := GetScopeFromContext(ctx)
-	displayName := req.Header().Get("X-User") // DisplayName is the auth sub for now.
 
-	user, err := s.authUsecase.GetMe(ctx, scope.UserID)
+	user, profile, err := s.authUsecase.GetMe(ctx, scope)
 	if err != nil {
-		// TODO: Map domain errors to connect errors
-		return nil, connect.NewError(connect.CodeUnauthenticated, err)
+		return nil, err
 	}
 
 	memberships := make([]*v1.TenantMembership, len(user.Memberships))
@@ -57,7 +55,34 @@ func (s *TenantHandler) GetMe(ctx context.Context, req *connect.Request[v1.GetMe
 
 	return connect.NewResponse(&v1.GetMeResponse{
 		UserId:      user.ID,
-		DisplayName: displayName,
+		DisplayName: profile.DisplayName,
 		Memberships: memberships,
+		AvatarUrl:   profile.AvatarURL,
 	}), nil
-}
\ No newline at end of file
+}
+
+func (s *TenantHandler) GetTenantUsage(ctx context.Context, req *connect.Request[v1.GetTenantUsageRequest]) (*connect.Response[v1.GetTenantUsageResponse], error) {
+	scope := GetScopeFromContext(ctx)
+
+	plan, usage, err := s.quotaUsecase.GetUsage(ctx, scope)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&v1.GetTenantUsageResponse{
+		Plan: plan.Name,
+		Limits: &v1.PlanLimits{
+			PostsPerMinute:    plan.PostsPerMinute,
+			CommentsPerMinute: plan.CommentsPerMinute,
+			MessagesPerMinute: plan.MessagesPerMinute,
+			DailyPosts:        plan.DailyPosts,
+			StorageBytes:      plan.StorageBytes,
+			MaxMembers:        plan.MaxMembers,
+		},
+		Usage: &v1.TenantUsage{
+			PostsToday:   usage.PostsToday,
+			StorageBytes: usage.StorageBytes,
+			Members:      usage.Members,
+		},
+	}), nil
+}
@@ -0,0 +1,155 @@
+package rpc
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"connectrpc.com/connect"
+	v1 "github.com/example/something-like-sns/apps/api/gen/sns/v1"
+	"github.com/example/something-like-sns/apps/api/gen/sns/v1/v1connect"
+	"github.com/example/something-like-sns/apps/api/internal/domain"
+	"github.com/example/something-like-sns/apps/api/internal/port"
+)
+
+type TenantAdminHandler struct {
+	tenantAdminUsecase port.TenantAdminUsecase
+}
+
+func NewTenantAdminHandler(tu port.TenantAdminUsecase) *TenantAdminHandler {
+	return &TenantAdminHandler{tenantAdminUsecase: tu}
+}
+
+func (s *TenantAdminHandler) MountHandler(interceptors ...connect.Interceptor) (string, http.Handler) {
+	path, h := v1connect.NewTenantAdminServiceHandler(s, connect.WithInterceptors(interceptors...))
+	return path, h
+}
+
+func (s *TenantAdminHandler) GetTenant(ctx context.Context, req *connect.Request[v1.GetTenantRequest]) (*connect.Response[v1.GetTenantResponse], error) {
+	scope := GetScopeFromContext(ctx)
+	t, err := s.tenantAdminUsecase.GetTenant(ctx, scope)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&v1.GetTenantResponse{Tenant: tenantSettingsToProto(t)}), nil
+}
+
+func (s *TenantAdminHandler) UpdateTenant(ctx context.Context, req *connect.Request[v1.UpdateTenantRequest]) (*connect.Response[v1.UpdateTenantResponse], error) {
+	scope := GetScopeFromContext(ctx)
+	t, err := s.tenantAdminUsecase.UpdateTenant(ctx, scope, req.Msg.GetName())
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&v1.UpdateTenantResponse{Tenant: tenantSettingsToProto(t)}), nil
+}
+
+func (s *TenantAdminHandler) ListTenantMembers(ctx context.Context, req *connect.Request[v1.ListTenantMembersRequest]) (*connect.Response[v1.ListTenantMembersResponse], error) {
+	scope := GetScopeFromContext(ctx)
+	members, err := s.tenantAdminUsecase.ListTenantMembers(ctx, scope)
+	if err != nil {
+		return nil, err
+	}
+	items := make([]*v1.TenantMember, len(members))
+	for i, m := range members {
+		items[i] = &v1.TenantMember{
+			UserId:      m.UserID,
+			DisplayName: m.DisplayName,
+			Role:        m.Role,
+			JoinedAt:    m.JoinedAt.Format(time.RFC3339Nano),
+			Suspended:   m.Suspended,
+		}
+	}
+	return connect.NewResponse(&v1.ListTenantMembersResponse{Items: items}), nil
+}
+
+func (s *TenantAdminHandler) UpdateMemberRole(ctx context.Context, req *connect.Request[v1.UpdateMemberRoleRequest]) (*connect.Response[v1.UpdateMemberRoleResponse], error) {
+	scope := GetScopeFromContext(ctx)
+	if err := s.tenantAdminUsecase.UpdateMemberRole(ctx, scope, req.Msg.GetUserId(), req.Msg.GetRole()); err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&v1.UpdateMemberRoleResponse{}), nil
+}
+
+func (s *TenantAdminHandler) RemoveMember(ctx context.Context, req *connect.Request[v1.RemoveMemberRequest]) (*connect.Response[v1.RemoveMemberResponse], error) {
+	scope := GetScopeFromContext(ctx)
+	if err := s.tenantAdminUsecase.RemoveMember(ctx, scope, req.Msg.GetUserId()); err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&v1.RemoveMemberResponse{}), nil
+}
+
+func (s *TenantAdminHandler) TransferOwnership(ctx context.Context, req *connect.Request[v1.TransferOwnershipRequest]) (*connect.Response[v1.TransferOwnershipResponse], error) {
+	scope := GetScopeFromContext(ctx)
+	if err := s.tenantAdminUsecase.TransferOwnership(ctx, scope, req.Msg.GetUserId()); err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&v1.TransferOwnershipResponse{}), nil
+}
+
+func (s *TenantAdminHandler) ListDomains(ctx context.Context, req *connect.Request[v1.ListDomainsRequest]) (*connect.Response[v1.ListDomainsResponse], error) {
+	scope := GetScopeFromContext(ctx)
+	domains, err := s.tenantAdminUsecase.ListDomains(ctx, scope)
+	if err != nil {
+		return nil, err
+	}
+	items := make([]*v1.TenantDomain, len(domains))
+	for i, d := range domains {
+		items[i] = tenantDomainToProto(d)
+	}
+	return connect.NewResponse(&v1.ListDomainsResponse{Items: items}), nil
+}
+
+func (s *TenantAdminHandler) AddDomain(ctx context.Context, req *connect.Request[v1.AddDomainRequest]) (*connect.Response[v1.AddDomainResponse], error) {
+	scope := GetScopeFromContext(ctx)
+	d, err := s.tenantAdminUsecase.AddDomain(ctx, scope, req.Msg.GetHost())
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&v1.AddDomainResponse{Domain: tenantDomainToProto(d)}), nil
+}
+
+func (s *TenantAdminHandler) VerifyDomain(ctx context.Context, req *connect.Request[v1.VerifyDomainRequest]) (*connect.Response[v1.VerifyDomainResponse], error) {
+	scope := GetScopeFromContext(ctx)
+	d, err := s.tenantAdminUsecase.VerifyDomain(ctx, scope, req.Msg.GetHost())
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&v1.VerifyDomainResponse{Domain: tenantDomainToProto(d)}), nil
+}
+
+func (s *TenantAdminHandler) RemoveDomain(ctx context.Context, req *connect.Request[v1.RemoveDomainRequest]) (*connect.Response[v1.RemoveDomainResponse], error) {
+	scope := GetScopeFromContext(ctx)
+	if err := s.tenantAdminUsecase.RemoveDomain(ctx, scope, req.Msg.GetHost()); err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&v1.RemoveDomainResponse{}), nil
+}
+
+func (s *TenantAdminHandler) SetSubdomainFallback(ctx context.Context, req *connect.Request[v1.SetSubdomainFallbackRequest]) (*connect.Response[v1.SetSubdomainFallbackResponse], error) {
+	scope := GetScopeFromContext(ctx)
+	if err := s.tenantAdminUsecase.SetSubdomainFallback(ctx, scope, req.Msg.GetAllowed()); err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&v1.SetSubdomainFallbackResponse{}), nil
+}
+
+func tenantSettingsToProto(t *domain.Tenant) *v1.TenantSettings {
+	return &v1.TenantSettings{
+		Id:                     t.ID,
+		Slug:                   t.Slug,
+		Name:                   t.Name,
+		Plan:                   t.Plan,
+		JoinPolicy:             t.JoinPolicy,
+		AllowSubdomainFallback: t.AllowSubdomainFallback,
+	}
+}
+
+// tenantDomainToProto includes the DNS challenge only while the domain is pending.
+func tenantDomainToProto(d *domain.TenantDomain) *v1.TenantDomain {
+	pd := &v1.TenantDomain{Host: d.Host, Verified: d.Verified, CreatedAt: d.CreatedAt.Format(time.RFC3339Nano)}
+	if !d.Verified && d.VerificationToken != "" {
+		pd.ChallengeName = d.ChallengeName()
+		pd.ChallengeValue = d.ChallengeValue()
+	}
+	return pd
+}
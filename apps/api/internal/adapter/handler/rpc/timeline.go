@@ -1,16 +1,15 @@
 package rpc
 
 import (
-    "context"
-    "errors"
-    "fmt"
-    "net/http"
-    "time"
-
-    "connectrpc.com/connect"
-    v1 "github.com/example/something-like-sns/apps/api/gen/sns/v1"
-    "github.com/example/something-like-sns/apps/api/gen/sns/v1/v1connect"
-    "github.com/example/something-like-sns/apps/api/internal/port"
+	"context"
+	"net/http"
+	"time"
+
+	"connectrpc.com/connect"
+	v1 "github.com/example/something-like-sns/apps/api/gen/sns/v1"
+	"github.com/example/something-like-sns/apps/api/gen/sns/v1/v1connect"
+	"github.com/example/something-like-sns/apps/api/internal/domain"
+	"github.com/example/something-like-sns/apps/api/internal/port"
 )
 
 type TimelineHandler struct {
@@ -21,17 +20,17 @@ func NewTimelineHandler(tu port.TimelineUsecase) *TimelineHandler {
 	return &TimelineHandler{timelineUsecase: tu}
 }
 
-func (s *TimelineHandler) MountHandler(authInterceptor connect.Interceptor) (string, http.Handler) {
-	path, h := v1connect.NewTimelineServiceHandler(s, connect.WithInterceptors(authInterceptor))
+func (s *TimelineHandler) MountHandler(interceptors ...connect.Interceptor) (string, http.Handler) {
+	path, h := v1connect.NewTimelineServiceHandler(s, connect.WithInterceptors(interceptors...))
 	return path, h
 }
 
 func (s *TimelineHandler) ListFeed(ctx context.Context, req *connect.Request[v1.ListFeedRequest]) (*connect.Response[v1.ListFeedResponse], error) {
 	scope := GetScopeFromContext(ctx)
 
-	posts, nextToken, err := s.timelineUsecase.ListFeed(ctx, scope, req.Msg.GetCursor().GetToken())
+	posts, page, err := s.timelineUsecase.ListFeed(ctx, scope, pageParams(req.Msg))
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInternal, err)
+		return nil, err
 	}
 
 	items := make([]*v1.Post, len(posts))
@@ -44,28 +43,19 @@ func (s *TimelineHandler) ListFeed(ctx context.Context, req *connect.Request[v1.
 			LikedByMe:    p.LikedByMe,
 			LikeCount:    p.LikeCount,
 			CommentCount: p.CommentCount,
+			Author:       profileToProto(p.Author),
 		}
 	}
 
-	res := &v1.ListFeedResponse{Items: items}
-	if nextToken != "" {
-		res.Next = &v1.Cursor{Token: nextToken}
-	}
-	return connect.NewResponse(res), nil
+	return connect.NewResponse(&v1.ListFeedResponse{Items: items, Next: toCursor(page.Next), Prev: toCursor(page.Prev), HasMore: page.HasMore}), nil
 }
 
 func (s *TimelineHandler) CreatePost(ctx context.Context, req *connect.Request[v1.CreatePostRequest]) (*connect.Response[v1.CreatePostResponse], error) {
 	scope := GetScopeFromContext(ctx)
 
-    // Rate limit: posts 10/min per user per tenant
-    key := fmt.Sprintf("post:%d:%d", scope.TenantID, scope.UserID)
-    if !defaultRateLimiter.Allow(key, 10, 10) {
-        return nil, connect.NewError(connect.CodeResourceExhausted, errors.New("rate limit exceeded"))
-    }
-
 	post, err := s.timelineUsecase.CreatePost(ctx, scope, req.Msg.GetBody())
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		return nil, err
 	}
 
 	return connect.NewResponse(&v1.CreatePostResponse{
@@ -74,6 +64,7 @@ func (s *TimelineHandler) CreatePost(ctx context.Context, req *connect.Request[v
 			AuthorUserId: post.AuthorUserID,
 			Body:         post.Body,
 			CreatedAt:    post.CreatedAt.Format(time.RFC3339Nano),
+			Author:       profileToProto(post.Author),
 		},
 	}), nil
 }
@@ -81,9 +72,9 @@ func (s *TimelineHandler) CreatePost(ctx context.Context, req *connect.Request[v
 func (s *TimelineHandler) ListComments(ctx context.Context, req *connect.Request[v1.ListCommentsRequest]) (*connect.Response[v1.ListCommentsResponse], error) {
 	scope := GetScopeFromContext(ctx)
 
-    comments, nextToken, err := s.timelineUsecase.ListComments(ctx, scope, req.Msg.GetPostId(), req.Msg.GetCursor().GetToken())
+	comments, page, err := s.timelineUsecase.ListComments(ctx, scope, req.Msg.GetPostId(), pageParams(req.Msg))
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInternal, err)
+		return nil, err
 	}
 
 	items := make([]*v1.Comment, len(comments))
@@ -94,28 +85,19 @@ func (s *TimelineHandler) ListComments(ctx context.Context, req *connect.Request
 			AuthorUserId: c.AuthorUserID,
 			Body:         c.Body,
 			CreatedAt:    c.CreatedAt.Format(time.RFC3339Nano),
+			Author:       profileToProto(c.Author),
 		}
 	}
 
-    res := &v1.ListCommentsResponse{Items: items}
-    if nextToken != "" {
-        res.Next = &v1.Cursor{Token: nextToken}
-    }
-    return connect.NewResponse(res), nil
+	return connect.NewResponse(&v1.ListCommentsResponse{Items: items, Next: toCursor(page.Next), Prev: toCursor(page.Prev), HasMore: page.HasMore, Total: page.Total}), nil
 }
 
 func (s *TimelineHandler) CreateComment(ctx context.Context, req *connect.Request[v1.CreateCommentRequest]) (*connect.Response[v1.CreateCommentResponse], error) {
 	scope := GetScopeFromContext(ctx)
 
-    // Rate limit: comments 20/min per user per tenant
-    key := fmt.Sprintf("comment:%d:%d", scope.TenantID, scope.UserID)
-    if !defaultRateLimiter.Allow(key, 20, 20) {
-        return nil, connect.NewError(connect.CodeResourceExhausted, errors.New("rate limit exceeded"))
-    }
-
 	comment, err := s.timelineUsecase.CreateComment(ctx, scope, req.Msg.GetPostId(), req.Msg.GetBody())
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		return nil, err
 	}
 
 	return connect.NewResponse(&v1.CreateCommentResponse{
@@ -125,6 +107,27 @@ func (s *TimelineHandler) CreateComment(ctx context.Context, req *connect.Reques
 			AuthorUserId: comment.AuthorUserID,
 			Body:         comment.Body,
 			CreatedAt:    comment.CreatedAt.Format(time.RFC3339Nano),
+			Author:       profileToProto(comment.Author),
 		},
 	}), nil
 }
+
+// pageParams extracts the pagination parameters of a list request.
+func pageParams(req interface {
+	GetCursor() *v1.Cursor
+	GetPageSize() uint32
+}) domain.PageParams {
+	page := domain.PageParams{Token: req.GetCursor().GetToken(), Size: req.GetPageSize()}
+	if r, ok := req.(interface{ GetIncludeTotal() bool }); ok {
+		page.IncludeTotal = r.GetIncludeTotal()
+	}
+	return page
+}
+
+// toCursor returns the Cursor message for token, or nil if token is empty.
+func toCursor(token string) *v1.Cursor {
+	if token == "" {
+		return nil
+	}
+	return &v1.Cursor{Token: token}
+}
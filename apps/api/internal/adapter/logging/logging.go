@@ -0,0 +1,116 @@
+// Package logging configures structured JSON logging with log/slog and carries per-request
+// attributes (request ID, tenant and user) through the context so every log line can be correlated.
+package logging
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"io"
+	"log/slog"
+	"os"
+	"strings"
+
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/example/something-like-sns/apps/api/internal/domain"
+)
+
+// New returns a logger writing JSON lines to w that adds the request attributes found in the context.
+func New(w io.Writer, level slog.Leveler) *slog.Logger {
+	return slog.New(&contextHandler{Handler: slog.NewJSONHandler(w, &slog.HandlerOptions{Level: level})})
+}
+
+// Setup installs a JSON logger on stderr as the slog default, at the level named by LOG_LEVEL
+// (debug, info, warn or error; info when unset). Output of the standard log package goes through it as well.
+func Setup() *slog.Logger {
+	var level slog.Level
+	if err := level.UnmarshalText([]byte(os.Getenv("LOG_LEVEL"))); err != nil {
+		level = slog.LevelInfo
+	}
+	logger := New(os.Stderr, level)
+	slog.SetDefault(logger)
+	return logger
+}
+
+type requestInfoKey struct{}
+
+// requestInfo is shared by all contexts derived from the one returned by WithRequestID, so the
+// scope resolved deep in the interceptor chain is also visible to the outer interceptors.
+type requestInfo struct {
+	id    string
+	scope domain.Scope
+}
+
+// WithRequestID returns a context carrying requestID for log lines written with it.
+func WithRequestID(ctx context.Context, requestID string) context.Context {
+	return context.WithValue(ctx, requestInfoKey{}, &requestInfo{id: requestID})
+}
+
+// RequestIDFromContext returns the request ID set by WithRequestID, or "" if there is none.
+func RequestIDFromContext(ctx context.Context) string {
+	if info, ok := ctx.Value(requestInfoKey{}).(*requestInfo); ok {
+		return info.id
+	}
+	return ""
+}
+
+// SetScope records the caller's tenant and user on the request started by WithRequestID.
+// It is a no-op when ctx does not carry a request.
+func SetScope(ctx context.Context, scope domain.Scope) {
+	if info, ok := ctx.Value(requestInfoKey{}).(*requestInfo); ok {
+		info.scope = scope
+	}
+}
+
+// contextHandler adds request_id, tenant_id, user_id and trace_id attributes taken from the context.
+type contextHandler struct {
+	slog.Handler
+}
+
+func (h *contextHandler) Handle(ctx context.Context, r slog.Record) error {
+	scope, hasScope := domain.ScopeFromContext(ctx)
+	if info, ok := ctx.Value(requestInfoKey{}).(*requestInfo); ok {
+		r.AddAttrs(slog.String("request_id", info.id))
+		if !hasScope && info.scope.TenantID != 0 {
+			scope, hasScope = info.scope, true
+		}
+	}
+	if hasScope {
+		r.AddAttrs(slog.Uint64("tenant_id", scope.TenantID), slog.Uint64("user_id", scope.UserID))
+	}
+	if sc := trace.SpanContextFromContext(ctx); sc.HasTraceID() {
+		r.AddAttrs(slog.String("trace_id", sc.TraceID().String()))
+	}
+	return h.Handler.Handle(ctx, r)
+}
+
+func (h *contextHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return &contextHandler{Handler: h.Handler.WithAttrs(attrs)}
+}
+
+func (h *contextHandler) WithGroup(name string) slog.Handler {
+	return &contextHandler{Handler: h.Handler.WithGroup(name)}
+}
+
+// validRequestID reports whether an incoming request ID is safe to echo back and log.
+func validRequestID(id string) bool {
+	if id == "" || len(id) > 128 {
+		return false
+	}
+	return strings.IndexFunc(id, func(r rune) bool { return r < 0x21 || r > 0x7e }) < 0
+}
+
+// RequestID returns id if it is a usable client-supplied request ID, or a new random one otherwise.
+func RequestID(id string) string {
+	if validRequestID(id) {
+		return id
+	}
+	return newRequestID()
+}
+
+func newRequestID() string {
+	var b [16]byte
+	_, _ = rand.Read(b[:])
+	return hex.EncodeToString(b[:])
+}
@@ -0,0 +1,120 @@
+package cache
+
+import (
+	"context"
+
+	"github.com/example/something-like-sns/apps/api/internal/domain"
+	"github.com/example/something-like-sns/apps/api/internal/port"
+)
+
+// authRepository caches tenant and user lookups. Methods it does not override go straight to
+// the wrapped repository; a new method writing tenants or users must invalidate here.
+type authRepository struct {
+	port.AuthRepository
+	s *store
+}
+
+func (r *authRepository) FindTenantBySlug(ctx context.Context, slug string) (*domain.Tenant, error) {
+	return r.findTenant("slug:"+slug, func() (*domain.Tenant, error) {
+		return r.AuthRepository.FindTenantBySlug(ctx, slug)
+	})
+}
+
+func (r *authRepository) FindTenantByHost(ctx context.Context, host string) (*domain.Tenant, error) {
+	return r.findTenant("host:"+host, func() (*domain.Tenant, error) {
+		return r.AuthRepository.FindTenantByHost(ctx, host)
+	})
+}
+
+// findTenant returns a copy of the cached tenant, or loads it with find. Missing tenants are
+// not cached, so that a tenant resolves as soon as it is created.
+func (r *authRepository) findTenant(key string, find func() (*domain.Tenant, error)) (*domain.Tenant, error) {
+	if r.s.tx != nil {
+		return find()
+	}
+	if t, ok := r.s.c.tenants.get(key); ok {
+		return &t, nil
+	}
+	gen := r.s.c.tenants.gen()
+	t, err := find()
+	if err != nil {
+		return nil, err
+	}
+	r.s.c.tenants.add(key, *t, gen)
+	return t, nil
+}
+
+// FindOrCreateUser only reaches the repository for subjects it has not seen. Existing users are
+// not updated, so there is nothing to miss.
+func (r *authRepository) FindOrCreateUser(ctx context.Context, authSub, displayName string) (uint64, error) {
+	if r.s.tx != nil {
+		return r.AuthRepository.FindOrCreateUser(ctx, authSub, displayName)
+	}
+	if id, ok := r.s.c.userIDs.get(authSub); ok {
+		return id, nil
+	}
+	gen := r.s.c.userIDs.gen()
+	id, err := r.AuthRepository.FindOrCreateUser(ctx, authSub, displayName)
+	if err != nil {
+		return 0, err
+	}
+	r.s.c.userIDs.add(authSub, id, gen)
+	return id, nil
+}
+
+func (r *authRepository) FindUserByID(ctx context.Context, userID uint64) (*domain.User, error) {
+	if r.s.tx != nil {
+		return r.AuthRepository.FindUserByID(ctx, userID)
+	}
+	if u, ok := r.s.c.users.get(userID); ok {
+		return &u, nil
+	}
+	gen := r.s.c.users.gen()
+	u, err := r.AuthRepository.FindUserByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	r.s.c.users.add(userID, *u, gen)
+	return u, nil
+}
+
+func (r *authRepository) RenameTenant(ctx context.Context, tenantID uint64, name string) error {
+	defer r.s.invalidateTenant(tenantID)
+	return r.AuthRepository.RenameTenant(ctx, tenantID, name)
+}
+
+func (r *authRepository) SetTenantDisabled(ctx context.Context, tenantID uint64, disabled bool) error {
+	defer r.s.invalidateTenant(tenantID)
+	return r.AuthRepository.SetTenantDisabled(ctx, tenantID, disabled)
+}
+
+func (r *authRepository) SetTenantJoinPolicy(ctx context.Context, tenantID uint64, policy string) error {
+	defer r.s.invalidateTenant(tenantID)
+	return r.AuthRepository.SetTenantJoinPolicy(ctx, tenantID, policy)
+}
+
+func (r *authRepository) SetTenantSubdomainFallback(ctx context.Context, tenantID uint64, allowed bool) error {
+	defer r.s.invalidateTenant(tenantID)
+	return r.AuthRepository.SetTenantSubdomainFallback(ctx, tenantID, allowed)
+}
+
+// AddTenantDomain also drops the host, which may have resolved to another tenant by its slug.
+func (r *authRepository) AddTenantDomain(ctx context.Context, tenantID uint64, d *domain.TenantDomain) error {
+	defer r.s.invalidateTenant(tenantID, d.Host)
+	return r.AuthRepository.AddTenantDomain(ctx, tenantID, d)
+}
+
+func (r *authRepository) VerifyTenantDomain(ctx context.Context, tenantID uint64, host string) error {
+	defer r.s.invalidateTenant(tenantID, host)
+	return r.AuthRepository.VerifyTenantDomain(ctx, tenantID, host)
+}
+
+func (r *authRepository) RemoveTenantDomain(ctx context.Context, tenantID uint64, host string) error {
+	defer r.s.invalidateTenant(tenantID, host)
+	return r.AuthRepository.RemoveTenantDomain(ctx, tenantID, host)
+}
+
+func (r *authRepository) SetUserSuspended(ctx context.Context, userID uint64, suspended bool) error {
+	defer r.s.invalidateUser(userID)
+	return r.AuthRepository.SetUserSuspended(ctx, userID, suspended)
+}
@@ -0,0 +1,50 @@
+package cache
+
+import (
+	"testing"
+	"time"
+)
+
+func TestLRU(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	c := newLRU[string, int](2, time.Minute, func() time.Time { return now })
+
+	c.add("a", 1, c.gen())
+	c.add("b", 2, c.gen())
+	if v, ok := c.get("a"); !ok || v != 1 {
+		t.Fatalf("get(a) = %d, %v; want 1", v, ok)
+	}
+	// b is now the least recently used.
+	c.add("c", 3, c.gen())
+	if _, ok := c.get("b"); ok {
+		t.Error("get(b) found an evicted entry")
+	}
+	if c.len() != 2 {
+		t.Errorf("len = %d, want 2", c.len())
+	}
+
+	// A value loaded before a removal is not added.
+	gen := c.gen()
+	c.remove("a")
+	c.add("a", 10, gen)
+	if _, ok := c.get("a"); ok {
+		t.Error("get(a) found a value loaded before its removal")
+	}
+
+	c.add("d", 4, c.gen())
+	c.removeFunc(func(_ string, v int) bool { return v > 3 })
+	if _, ok := c.get("d"); ok {
+		t.Error("get(d) found an entry removeFunc matched")
+	}
+	if _, ok := c.get("c"); !ok {
+		t.Error("get(c) lost an entry removeFunc did not match")
+	}
+
+	now = now.Add(time.Minute)
+	if _, ok := c.get("c"); ok {
+		t.Error("get(c) found an expired entry")
+	}
+	if c.len() != 0 {
+		t.Errorf("len after expiry = %d, want 0", c.len())
+	}
+}
@@ -0,0 +1,19 @@
+package cache
+
+import (
+	"context"
+
+	"github.com/example/something-like-sns/apps/api/internal/domain"
+	"github.com/example/something-like-sns/apps/api/internal/port"
+)
+
+// profileRepository drops the cached user of an updated profile, whose display name changes.
+type profileRepository struct {
+	port.ProfileRepository
+	s *store
+}
+
+func (r *profileRepository) UpdateProfile(ctx context.Context, tenantID uint64, p *domain.Profile) error {
+	defer r.s.invalidateUser(p.UserID)
+	return r.ProfileRepository.UpdateProfile(ctx, tenantID, p)
+}
@@ -0,0 +1,141 @@
+// Package cache wraps a port.Store with in-process caches of the lookups every request makes:
+// tenants by slug and host, user IDs by auth subject and users by ID.
+//
+// Writes made through the wrapped store invalidate the entries they affect. Writes made
+// elsewhere, by snsctl or another API instance, show once the entries expire, so the TTL bounds
+// how stale a tenant or user can be. Reads inside a transaction bypass the caches, so that
+// uncommitted data is never cached, and its invalidations are applied again when it ends.
+package cache
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/example/something-like-sns/apps/api/internal/domain"
+	"github.com/example/something-like-sns/apps/api/internal/port"
+)
+
+const (
+	defaultTTL  = 30 * time.Second
+	defaultSize = 10_000
+)
+
+type caches struct {
+	// tenants is keyed by "slug:<slug>" and "host:<host>".
+	tenants *lru[string, domain.Tenant]
+	// userIDs maps auth subjects to user IDs, which never change.
+	userIDs *lru[string, uint64]
+	users   *lru[uint64, domain.User]
+}
+
+type options struct {
+	ttl  time.Duration
+	size int
+	now  func() time.Time
+}
+
+// StoreOption configures a Store created by NewStore.
+type StoreOption func(*options)
+
+// WithTTL sets how long entries are used before they are looked up again. It defaults to 30s.
+func WithTTL(d time.Duration) StoreOption {
+	return func(o *options) {
+		o.ttl = d
+	}
+}
+
+// WithSize sets how many entries each cache holds. It defaults to 10000.
+func WithSize(n int) StoreOption {
+	return func(o *options) {
+		o.size = n
+	}
+}
+
+// WithClock sets the function used to expire entries. It defaults to time.Now.
+func WithClock(now func() time.Time) StoreOption {
+	return func(o *options) {
+		o.now = now
+	}
+}
+
+type store struct {
+	port.Store
+	c *caches
+	// tx is set on the store passed to ExecTx callbacks.
+	tx *txInvalidations
+}
+
+// txInvalidations collects the invalidations of a transaction, to apply again once it has
+// ended: until then, other requests can still load the data it is changing.
+type txInvalidations struct {
+	mu  sync.Mutex
+	fns []func()
+}
+
+// NewStore wraps next with caches.
+func NewStore(next port.Store, opts ...StoreOption) port.Store {
+	o := options{ttl: defaultTTL, size: defaultSize, now: time.Now}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return &store{Store: next, c: &caches{
+		tenants: newLRU[string, domain.Tenant](o.size, o.ttl, o.now),
+		userIDs: newLRU[string, uint64](o.size, o.ttl, o.now),
+		users:   newLRU[uint64, domain.User](o.size, o.ttl, o.now),
+	}}
+}
+
+func (s *store) ExecTx(ctx context.Context, fn func(port.Store) error) error {
+	tx := s.tx
+	if tx == nil {
+		tx = &txInvalidations{}
+		defer tx.apply()
+	}
+	return s.Store.ExecTx(ctx, func(next port.Store) error {
+		return fn(&store{Store: next, c: s.c, tx: tx})
+	})
+}
+
+func (s *store) AuthRepository() port.AuthRepository {
+	return &authRepository{AuthRepository: s.Store.AuthRepository(), s: s}
+}
+
+func (s *store) ProfileRepository() port.ProfileRepository {
+	return &profileRepository{ProfileRepository: s.Store.ProfileRepository(), s: s}
+}
+
+// invalidate applies fn now and, in a transaction, again when it ends.
+func (s *store) invalidate(fn func()) {
+	fn()
+	if s.tx != nil {
+		s.tx.mu.Lock()
+		s.tx.fns = append(s.tx.fns, fn)
+		s.tx.mu.Unlock()
+	}
+}
+
+func (t *txInvalidations) apply() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	for _, fn := range t.fns {
+		fn()
+	}
+}
+
+// invalidateTenant drops the entries of the tenant, and of hosts that may now resolve to
+// another tenant.
+func (s *store) invalidateTenant(tenantID uint64, hosts ...string) {
+	s.invalidate(func() {
+		s.c.tenants.removeFunc(func(_ string, t domain.Tenant) bool { return t.ID == tenantID })
+		for _, host := range hosts {
+			s.c.tenants.remove("host:" + host)
+		}
+	})
+}
+
+func (s *store) invalidateUser(userID uint64) {
+	s.invalidate(func() {
+		s.c.users.remove(userID)
+	})
+}
@@ -0,0 +1,273 @@
+package cache_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/example/something-like-sns/apps/api/internal/adapter/repository/cache"
+	"github.com/example/something-like-sns/apps/api/internal/adapter/repository/memory"
+	"github.com/example/something-like-sns/apps/api/internal/adapter/repository/repotest"
+	"github.com/example/something-like-sns/apps/api/internal/domain"
+	"github.com/example/something-like-sns/apps/api/internal/port"
+)
+
+func TestStoreContract(t *testing.T) {
+	repotest.Run(t, func(t *testing.T) port.Store {
+		return cache.NewStore(memory.NewStore())
+	})
+}
+
+// countingStore counts the cached lookups that reach the wrapped store.
+type countingStore struct {
+	port.Store
+	mu    *sync.Mutex
+	calls map[string]int
+}
+
+func (s *countingStore) AuthRepository() port.AuthRepository {
+	return &countingAuth{AuthRepository: s.Store.AuthRepository(), s: s}
+}
+
+func (s *countingStore) ExecTx(ctx context.Context, fn func(port.Store) error) error {
+	return s.Store.ExecTx(ctx, func(tx port.Store) error {
+		return fn(&countingStore{Store: tx, mu: s.mu, calls: s.calls})
+	})
+}
+
+func (s *countingStore) count(method string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.calls[method]
+}
+
+func (s *countingStore) add(method string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.calls[method]++
+}
+
+type countingAuth struct {
+	port.AuthRepository
+	s *countingStore
+}
+
+func (r *countingAuth) FindTenantBySlug(ctx context.Context, slug string) (*domain.Tenant, error) {
+	r.s.add("FindTenantBySlug")
+	return r.AuthRepository.FindTenantBySlug(ctx, slug)
+}
+
+func (r *countingAuth) FindTenantByHost(ctx context.Context, host string) (*domain.Tenant, error) {
+	r.s.add("FindTenantByHost")
+	return r.AuthRepository.FindTenantByHost(ctx, host)
+}
+
+func (r *countingAuth) FindOrCreateUser(ctx context.Context, authSub, displayName string) (uint64, error) {
+	r.s.add("FindOrCreateUser")
+	return r.AuthRepository.FindOrCreateUser(ctx, authSub, displayName)
+}
+
+func (r *countingAuth) FindUserByID(ctx context.Context, userID uint64) (*domain.User, error) {
+	r.s.add("FindUserByID")
+	return r.AuthRepository.FindUserByID(ctx, userID)
+}
+
+type fakeClock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+func (c *fakeClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+}
+
+func newStore(t *testing.T) (port.Store, *countingStore, *fakeClock) {
+	t.Helper()
+	clock := &fakeClock{now: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)}
+	next := &countingStore{Store: memory.NewStore(), mu: &sync.Mutex{}, calls: map[string]int{}}
+	return cache.NewStore(next, cache.WithTTL(time.Minute), cache.WithClock(clock.Now)), next, clock
+}
+
+func TestStore_Tenants(t *testing.T) {
+	ctx := context.Background()
+	store, next, clock := newStore(t)
+	auth := store.AuthRepository()
+
+	acme, err := auth.CreateTenant(ctx, "acme", "Acme")
+	if err != nil {
+		t.Fatalf("CreateTenant: %v", err)
+	}
+	for i := 0; i < 3; i++ {
+		got, err := auth.FindTenantBySlug(ctx, "acme")
+		if err != nil || got.ID != acme.ID {
+			t.Fatalf("FindTenantBySlug = %+v, %v; want tenant %d", got, err, acme.ID)
+		}
+		// Callers may modify what they get back.
+		got.Name = "Modified"
+		if _, err := auth.FindTenantByHost(ctx, "acme.localhost"); err != nil {
+			t.Fatalf("FindTenantByHost: %v", err)
+		}
+	}
+	if n, m := next.count("FindTenantBySlug"), next.count("FindTenantByHost"); n != 1 || m != 1 {
+		t.Errorf("lookups reaching the store = %d by slug, %d by host; want 1 each", n, m)
+	}
+	if got, _ := auth.FindTenantBySlug(ctx, "acme"); got.Name != "Acme" {
+		t.Errorf("cached tenant name = %q, want Acme", got.Name)
+	}
+
+	// Missing tenants are not cached.
+	for i := 0; i < 2; i++ {
+		var notFound *domain.NotFoundError
+		if _, err := auth.FindTenantBySlug(ctx, "beta"); !errors.As(err, &notFound) {
+			t.Fatalf("FindTenantBySlug(missing): err = %v, want NotFoundError", err)
+		}
+	}
+	if _, err := auth.CreateTenant(ctx, "beta", "Beta"); err != nil {
+		t.Fatalf("CreateTenant: %v", err)
+	}
+	if _, err := auth.FindTenantBySlug(ctx, "beta"); err != nil {
+		t.Errorf("FindTenantBySlug(created): %v", err)
+	}
+
+	// Writes through the store drop the tenant's entries, by slug and by host.
+	if err := auth.SetTenantDisabled(ctx, acme.ID, true); err != nil {
+		t.Fatalf("SetTenantDisabled: %v", err)
+	}
+	if got, _ := auth.FindTenantBySlug(ctx, "acme"); got == nil || !got.Disabled {
+		t.Errorf("FindTenantBySlug after disable = %+v, want disabled", got)
+	}
+	if got, _ := auth.FindTenantByHost(ctx, "acme.localhost"); got == nil || !got.Disabled {
+		t.Errorf("FindTenantByHost after disable = %+v, want disabled", got)
+	}
+
+	// A verified domain takes a host over from the tenant it resolved to by slug.
+	beta, _ := auth.FindTenantBySlug(ctx, "beta")
+	if got, _ := auth.FindTenantByHost(ctx, "acme.beta.example"); got == nil || got.ID != acme.ID {
+		t.Fatalf("FindTenantByHost(slug subdomain) = %+v, want tenant %d", got, acme.ID)
+	}
+	if err := auth.AddTenantDomain(ctx, beta.ID, &domain.TenantDomain{Host: "acme.beta.example", Verified: true}); err != nil {
+		t.Fatalf("AddTenantDomain: %v", err)
+	}
+	if got, _ := auth.FindTenantByHost(ctx, "acme.beta.example"); got == nil || got.ID != beta.ID {
+		t.Errorf("FindTenantByHost(verified domain) = %+v, want tenant %d", got, beta.ID)
+	}
+
+	// Writes elsewhere show once the entry expires.
+	before := next.count("FindTenantBySlug")
+	if err := next.AuthRepository().RenameTenant(ctx, acme.ID, "Renamed"); err != nil {
+		t.Fatalf("RenameTenant: %v", err)
+	}
+	if got, _ := auth.FindTenantBySlug(ctx, "acme"); got.Name != "Acme" {
+		t.Errorf("FindTenantBySlug before expiry = %q, want the cached name Acme", got.Name)
+	}
+	clock.Advance(time.Minute)
+	if got, _ := auth.FindTenantBySlug(ctx, "acme"); got.Name != "Renamed" {
+		t.Errorf("FindTenantBySlug after expiry = %q, want Renamed", got.Name)
+	}
+	if n := next.count("FindTenantBySlug") - before; n != 1 {
+		t.Errorf("lookups reaching the store after expiry = %d, want 1", n)
+	}
+}
+
+func TestStore_Users(t *testing.T) {
+	ctx := context.Background()
+	store, next, _ := newStore(t)
+	auth := store.AuthRepository()
+
+	id, err := auth.FindOrCreateUser(ctx, "u_alice", "Alice")
+	if err != nil {
+		t.Fatalf("FindOrCreateUser: %v", err)
+	}
+	for i := 0; i < 3; i++ {
+		if again, err := auth.FindOrCreateUser(ctx, "u_alice", "u_alice"); err != nil || again != id {
+			t.Fatalf("FindOrCreateUser again = %d, %v; want %d", again, err, id)
+		}
+		u, err := auth.FindUserByID(ctx, id)
+		if err != nil || u.DisplayName != "Alice" {
+			t.Fatalf("FindUserByID = %+v, %v; want Alice", u, err)
+		}
+		u.Memberships = append(u.Memberships, &domain.TenantMembership{})
+	}
+	if n, m := next.count("FindOrCreateUser"), next.count("FindUserByID"); n != 1 || m != 1 {
+		t.Errorf("lookups reaching the store = %d by subject, %d by ID; want 1 each", n, m)
+	}
+	if u, _ := auth.FindUserByID(ctx, id); len(u.Memberships) != 0 {
+		t.Errorf("cached user memberships = %v, want none", u.Memberships)
+	}
+
+	// Profile updates change the display name.
+	acme, err := auth.CreateTenant(ctx, "acme", "Acme")
+	if err != nil {
+		t.Fatalf("CreateTenant: %v", err)
+	}
+	if err := auth.EnsureMembership(ctx, acme.ID, id, domain.RoleMember); err != nil {
+		t.Fatalf("EnsureMembership: %v", err)
+	}
+	if err := store.ProfileRepository().UpdateProfile(ctx, acme.ID, &domain.Profile{UserID: id, DisplayName: "Alice A."}); err != nil {
+		t.Fatalf("UpdateProfile: %v", err)
+	}
+	if u, _ := auth.FindUserByID(ctx, id); u == nil || u.DisplayName != "Alice A." {
+		t.Errorf("FindUserByID after UpdateProfile = %+v, want display name Alice A.", u)
+	}
+
+	if err := auth.SetUserSuspended(ctx, id, true); err != nil {
+		t.Fatalf("SetUserSuspended: %v", err)
+	}
+	if u, _ := auth.FindUserByID(ctx, id); u == nil || !u.Suspended {
+		t.Errorf("FindUserByID after suspend = %+v, want suspended", u)
+	}
+}
+
+func TestStore_ExecTx(t *testing.T) {
+	ctx := context.Background()
+	store, next, _ := newStore(t)
+
+	acme, err := store.AuthRepository().CreateTenant(ctx, "acme", "Acme")
+	if err != nil {
+		t.Fatalf("CreateTenant: %v", err)
+	}
+	if _, err := store.AuthRepository().FindTenantBySlug(ctx, "acme"); err != nil {
+		t.Fatalf("FindTenantBySlug: %v", err)
+	}
+
+	// Uncommitted data is never cached: reads in a transaction go to the store.
+	errRollback := errors.New("rollback")
+	err = store.ExecTx(ctx, func(tx port.Store) error {
+		if err := tx.AuthRepository().RenameTenant(ctx, acme.ID, "Uncommitted"); err != nil {
+			return err
+		}
+		if got, err := tx.AuthRepository().FindTenantBySlug(ctx, "acme"); err != nil || got.Name != "Uncommitted" {
+			t.Errorf("FindTenantBySlug in tx = %+v, %v; want the uncommitted name", got, err)
+		}
+		return errRollback
+	})
+	if !errors.Is(err, errRollback) {
+		t.Fatalf("ExecTx: err = %v, want %v", err, errRollback)
+	}
+	before := next.count("FindTenantBySlug")
+	if got, _ := store.AuthRepository().FindTenantBySlug(ctx, "acme"); got == nil || got.Name != "Acme" {
+		t.Errorf("FindTenantBySlug after rollback = %+v, want name Acme", got)
+	}
+	if n := next.count("FindTenantBySlug") - before; n != 1 {
+		t.Errorf("lookups reaching the store after the tx = %d, want 1", n)
+	}
+
+	if err := store.ExecTx(ctx, func(tx port.Store) error {
+		return tx.AuthRepository().RenameTenant(ctx, acme.ID, "Committed")
+	}); err != nil {
+		t.Fatalf("ExecTx: %v", err)
+	}
+	if got, _ := store.AuthRepository().FindTenantBySlug(ctx, "acme"); got == nil || got.Name != "Committed" {
+		t.Errorf("FindTenantBySlug after commit = %+v, want name Committed", got)
+	}
+}
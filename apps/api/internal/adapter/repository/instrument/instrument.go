@@ -0,0 +1,123 @@
+// Package instrument wraps database handles used by the SQL repository adapters with tracing
+// and slow-query logging.
+package instrument
+
+import (
+	"context"
+	"database/sql"
+	"log/slog"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/example/something-like-sns/apps/api/internal/domain"
+)
+
+var tracer = otel.Tracer("github.com/example/something-like-sns/apps/api/internal/adapter/repository")
+
+// DBTX is an interface that is satisfied by both *sql.DB and *sql.Tx
+type DBTX interface {
+	ExecContext(context.Context, string, ...interface{}) (sql.Result, error)
+	QueryContext(context.Context, string, ...interface{}) (*sql.Rows, error)
+	QueryRowContext(context.Context, string, ...interface{}) *sql.Row
+}
+
+// Wrap returns a DBTX that records a span for every statement executed through q.
+// system is the database system name reported in span attributes, e.g. "mysql".
+// Statements taking at least slowQuery are logged as warnings; zero disables slow-query logging.
+func Wrap(q DBTX, system string, slowQuery time.Duration) DBTX {
+	return &tracedDBTX{q: q, system: system, slowQuery: slowQuery}
+}
+
+type tracedDBTX struct {
+	q         DBTX
+	system    string
+	slowQuery time.Duration
+}
+
+func (t *tracedDBTX) ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	ctx, span := t.start(ctx, query)
+	defer span.End()
+	defer t.logSlow(ctx, query, time.Now())
+	res, err := t.q.ExecContext(ctx, query, args...)
+	recordError(span, err)
+	return res, err
+}
+
+func (t *tracedDBTX) QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error) {
+	ctx, span := t.start(ctx, query)
+	defer span.End()
+	defer t.logSlow(ctx, query, time.Now())
+	rows, err := t.q.QueryContext(ctx, query, args...)
+	recordError(span, err)
+	return rows, err
+}
+
+func (t *tracedDBTX) QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row {
+	ctx, span := t.start(ctx, query)
+	defer span.End()
+	defer t.logSlow(ctx, query, time.Now())
+	row := t.q.QueryRowContext(ctx, query, args...)
+	if err := row.Err(); err != sql.ErrNoRows {
+		recordError(span, err)
+	}
+	return row
+}
+
+// logSlow logs query if it has been running since start for longer than the threshold.
+// For QueryContext this covers the time to the first row, not the iteration over the result.
+func (t *tracedDBTX) logSlow(ctx context.Context, query string, start time.Time) {
+	if t.slowQuery <= 0 {
+		return
+	}
+	if elapsed := time.Since(start); elapsed >= t.slowQuery {
+		slog.WarnContext(ctx, "slow query",
+			slog.String("db.system", t.system),
+			slog.String("db.query.text", normalize(query)),
+			slog.Duration("duration", elapsed),
+		)
+	}
+}
+
+func (t *tracedDBTX) start(ctx context.Context, query string) (context.Context, trace.Span) {
+	query = normalize(query)
+	op := query
+	if i := strings.IndexByte(query, ' '); i > 0 {
+		op = query[:i]
+	}
+	attrs := []attribute.KeyValue{
+		semconv.DBSystemKey.String(t.system),
+		semconv.DBOperationName(strings.ToUpper(op)),
+		semconv.DBQueryText(query),
+	}
+	if scope, ok := domain.ScopeFromContext(ctx); ok {
+		attrs = append(attrs, attribute.Int64("sns.tenant_id", int64(scope.TenantID)))
+	}
+	return tracer.Start(ctx, "db."+strings.ToLower(op), trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(attrs...))
+}
+
+// StartTx starts a span covering a whole transaction. The returned function ends it, recording err.
+func StartTx(ctx context.Context, system string) (context.Context, func(err error)) {
+	ctx, span := tracer.Start(ctx, "Store.ExecTx", trace.WithAttributes(semconv.DBSystemKey.String(system)))
+	return ctx, func(err error) {
+		recordError(span, err)
+		span.End()
+	}
+}
+
+func recordError(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+}
+
+// normalize collapses the indentation of multi-line statements into single spaces.
+func normalize(query string) string {
+	return strings.Join(strings.Fields(query), " ")
+}
@@ -0,0 +1,381 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/example/something-like-sns/apps/api/internal/domain"
+)
+
+type authRepository struct {
+	s *memStore
+}
+
+func (r *authRepository) FindTenantByHost(ctx context.Context, host string) (*domain.Tenant, error) {
+	db := r.s.lock()
+	defer r.s.unlock()
+
+	if key, ok := findVerifiedDomain(db, host); ok {
+		return db.tenants[key.TenantID].domain(), nil
+	}
+	if idx := strings.IndexByte(host, '.'); idx > 0 {
+		if t, ok := findTenantBySlug(db, host[:idx]); ok && t.AllowSubdomainFallback {
+			return t.domain(), nil
+		}
+	}
+	return nil, domain.NewNotFoundError("tenant", nil)
+}
+
+func (r *authRepository) FindTenantBySlug(ctx context.Context, slug string) (*domain.Tenant, error) {
+	db := r.s.lock()
+	defer r.s.unlock()
+
+	t, ok := findTenantBySlug(db, slug)
+	if !ok {
+		return nil, domain.NewNotFoundError("tenant", nil)
+	}
+	return t.domain(), nil
+}
+
+func (r *authRepository) FindTenantByID(ctx context.Context, tenantID uint64) (*domain.Tenant, error) {
+	db := r.s.lock()
+	defer r.s.unlock()
+
+	t, ok := db.tenants[tenantID]
+	if !ok {
+		return nil, domain.NewNotFoundError("tenant", tenantID)
+	}
+	return t.domain(), nil
+}
+
+func (r *authRepository) CreateTenant(ctx context.Context, slug, name string) (*domain.Tenant, error) {
+	db := r.s.lock()
+	defer r.s.unlock()
+
+	if _, ok := findTenantBySlug(db, slug); ok {
+		return nil, domain.NewConflictError("tenant", "already exists")
+	}
+	t := tenantRow{ID: db.nextID("tenants"), Slug: slug, Name: name, Plan: "free", JoinPolicy: domain.JoinPolicyInvite, AllowSubdomainFallback: true}
+	db.tenants[t.ID] = t
+	return t.domain(), nil
+}
+
+func (r *authRepository) RenameTenant(ctx context.Context, tenantID uint64, name string) error {
+	db := r.s.lock()
+	defer r.s.unlock()
+
+	t, ok := db.tenants[tenantID]
+	if !ok {
+		return domain.NewNotFoundError("tenant", nil)
+	}
+	t.Name = name
+	db.tenants[tenantID] = t
+	return nil
+}
+
+func (r *authRepository) SetTenantDisabled(ctx context.Context, tenantID uint64, disabled bool) error {
+	db := r.s.lock()
+	defer r.s.unlock()
+
+	t, ok := db.tenants[tenantID]
+	if !ok {
+		return domain.NewNotFoundError("tenant", nil)
+	}
+	t.Disabled = disabled
+	db.tenants[tenantID] = t
+	return nil
+}
+
+func (r *authRepository) SetTenantJoinPolicy(ctx context.Context, tenantID uint64, policy string) error {
+	db := r.s.lock()
+	defer r.s.unlock()
+
+	t, ok := db.tenants[tenantID]
+	if !ok {
+		return domain.NewNotFoundError("tenant", nil)
+	}
+	t.JoinPolicy = policy
+	db.tenants[tenantID] = t
+	return nil
+}
+
+func (r *authRepository) SetTenantSubdomainFallback(ctx context.Context, tenantID uint64, allowed bool) error {
+	db := r.s.lock()
+	defer r.s.unlock()
+
+	t, ok := db.tenants[tenantID]
+	if !ok {
+		return domain.NewNotFoundError("tenant", nil)
+	}
+	t.AllowSubdomainFallback = allowed
+	db.tenants[tenantID] = t
+	return nil
+}
+
+func (r *authRepository) AddTenantDomain(ctx context.Context, tenantID uint64, d *domain.TenantDomain) error {
+	db := r.s.lock()
+	defer r.s.unlock()
+
+	if _, ok := db.tenants[tenantID]; !ok {
+		return referenced("tenant domain")
+	}
+	key := tenantDomainKey{tenantID, d.Host}
+	if _, ok := db.tenantDomains[key]; ok {
+		return domain.NewConflictError("tenant domain", "already exists")
+	}
+	if _, ok := findVerifiedDomain(db, d.Host); ok && d.Verified {
+		return domain.NewConflictError("tenant domain", "already exists")
+	}
+	db.tenantDomains[key] = tenantDomainRow{CreatedAt: r.s.timestamp(), Verified: d.Verified, Token: d.VerificationToken}
+	return nil
+}
+
+func (r *authRepository) VerifyTenantDomain(ctx context.Context, tenantID uint64, host string) error {
+	db := r.s.lock()
+	defer r.s.unlock()
+
+	key := tenantDomainKey{tenantID, host}
+	d, ok := db.tenantDomains[key]
+	if !ok {
+		return domain.NewNotFoundError("tenant domain", nil)
+	}
+	if other, ok := findVerifiedDomain(db, host); ok && other != key {
+		return domain.NewConflictError("tenant domain", "already exists")
+	}
+	d.Verified, d.Token = true, ""
+	db.tenantDomains[key] = d
+	return nil
+}
+
+func (r *authRepository) RemoveTenantDomain(ctx context.Context, tenantID uint64, host string) error {
+	db := r.s.lock()
+	defer r.s.unlock()
+
+	key := tenantDomainKey{tenantID, host}
+	if _, ok := db.tenantDomains[key]; !ok {
+		return domain.NewNotFoundError("tenant domain", nil)
+	}
+	delete(db.tenantDomains, key)
+	return nil
+}
+
+func (r *authRepository) FindTenantDomains(ctx context.Context, tenantID uint64) ([]*domain.TenantDomain, error) {
+	db := r.s.lock()
+	defer r.s.unlock()
+
+	var domains []*domain.TenantDomain
+	for key, d := range db.tenantDomains {
+		if key.TenantID == tenantID {
+			domains = append(domains, &domain.TenantDomain{Host: key.Host, Verified: d.Verified, VerificationToken: d.Token, CreatedAt: d.CreatedAt})
+		}
+	}
+	sort.Slice(domains, func(i, j int) bool { return domains[i].Host < domains[j].Host })
+	return domains, nil
+}
+
+// findVerifiedDomain returns the key of the verified domain host. Any number of tenants may claim
+// a host, but only one can verify it.
+func findVerifiedDomain(db *tables, host string) (tenantDomainKey, bool) {
+	for key, d := range db.tenantDomains {
+		if key.Host == host && d.Verified {
+			return key, true
+		}
+	}
+	return tenantDomainKey{}, false
+}
+
+func (r *authRepository) FindOrCreateUser(ctx context.Context, authSub, displayName string) (uint64, error) {
+	db := r.s.lock()
+	defer r.s.unlock()
+
+	for id, u := range db.users {
+		if u.AuthSub == authSub {
+			return id, nil
+		}
+	}
+	u := userRow{ID: db.nextID("users"), AuthSub: authSub, DisplayName: displayName}
+	db.users[u.ID] = u
+	return u.ID, nil
+}
+
+func (r *authRepository) FindUserByID(ctx context.Context, userID uint64) (*domain.User, error) {
+	db := r.s.lock()
+	defer r.s.unlock()
+
+	u, ok := db.users[userID]
+	if !ok {
+		return nil, domain.NewNotFoundError("user", nil)
+	}
+	return u.domain(), nil
+}
+
+func (r *authRepository) FindUserByAuthSub(ctx context.Context, authSub string) (*domain.User, error) {
+	db := r.s.lock()
+	defer r.s.unlock()
+
+	for _, u := range db.users {
+		if u.AuthSub == authSub {
+			return u.domain(), nil
+		}
+	}
+	return nil, domain.NewNotFoundError("user", nil)
+}
+
+func (r *authRepository) SetUserSuspended(ctx context.Context, userID uint64, suspended bool) error {
+	db := r.s.lock()
+	defer r.s.unlock()
+
+	u, ok := db.users[userID]
+	if !ok {
+		return domain.NewNotFoundError("user", nil)
+	}
+	u.Suspended = suspended
+	db.users[userID] = u
+	return nil
+}
+
+func (r *authRepository) FindMembershipRole(ctx context.Context, tenantID, userID uint64) (string, error) {
+	db := r.s.lock()
+	defer r.s.unlock()
+
+	return db.memberships[membershipKey{tenantID, userID}].Role, nil
+}
+
+func (r *authRepository) EnsureMembership(ctx context.Context, tenantID, userID uint64, role string) error {
+	db := r.s.lock()
+	defer r.s.unlock()
+
+	if _, ok := db.tenants[tenantID]; !ok {
+		return referenced("membership")
+	}
+	if _, ok := db.users[userID]; !ok {
+		return referenced("membership")
+	}
+	key := membershipKey{tenantID, userID}
+	if _, ok := db.memberships[key]; !ok {
+		db.memberships[key] = membershipRow{Role: role, CreatedAt: r.s.timestamp()}
+		r.s.addUsage(db, tenantID, 0, 0, 1)
+	}
+	delete(db.removals, key)
+	return nil
+}
+
+func (r *authRepository) UpdateMembershipRole(ctx context.Context, tenantID, userID uint64, role string) error {
+	db := r.s.lock()
+	defer r.s.unlock()
+
+	key := membershipKey{tenantID, userID}
+	m, ok := db.memberships[key]
+	if !ok {
+		return domain.NewNotFoundError("membership", nil)
+	}
+	m.Role = role
+	db.memberships[key] = m
+	return nil
+}
+
+func (r *authRepository) RemoveMembership(ctx context.Context, tenantID, userID uint64) error {
+	db := r.s.lock()
+	defer r.s.unlock()
+
+	key := membershipKey{tenantID, userID}
+	if _, ok := db.memberships[key]; !ok {
+		return domain.NewNotFoundError("membership", nil)
+	}
+	delete(db.memberships, key)
+	db.removals[key] = struct{}{}
+	r.s.addUsage(db, tenantID, 0, 0, -1)
+	return nil
+}
+
+func (r *authRepository) IsMemberRemoved(ctx context.Context, tenantID, userID uint64) (bool, error) {
+	db := r.s.lock()
+	defer r.s.unlock()
+
+	_, ok := db.removals[membershipKey{tenantID, userID}]
+	return ok, nil
+}
+
+func (r *authRepository) SetMembershipSuspended(ctx context.Context, tenantID, userID uint64, suspended bool) error {
+	db := r.s.lock()
+	defer r.s.unlock()
+
+	key := membershipKey{tenantID, userID}
+	m, ok := db.memberships[key]
+	if !ok {
+		return domain.NewNotFoundError("membership", nil)
+	}
+	m.Suspended = suspended
+	db.memberships[key] = m
+	return nil
+}
+
+func (r *authRepository) FindMembership(ctx context.Context, tenantID, userID uint64) (string, bool, error) {
+	db := r.s.lock()
+	defer r.s.unlock()
+
+	m := db.memberships[membershipKey{tenantID, userID}]
+	return m.Role, m.Suspended, nil
+}
+
+func (r *authRepository) FindMembers(ctx context.Context, tenantID uint64) ([]*domain.Member, error) {
+	db := r.s.lock()
+	defer r.s.unlock()
+
+	var members []*domain.Member
+	for key, m := range db.memberships {
+		if key.TenantID == tenantID {
+			u := db.users[key.UserID]
+			members = append(members, &domain.Member{UserID: u.ID, AuthSub: u.AuthSub, DisplayName: m.profile(u).DisplayName, Role: m.Role, Suspended: u.Suspended || m.Suspended, JoinedAt: m.CreatedAt})
+		}
+	}
+	sort.Slice(members, func(i, j int) bool { return members[i].UserID < members[j].UserID })
+	return members, nil
+}
+
+func (r *authRepository) LockOwners(ctx context.Context, tenantID uint64) ([]uint64, error) {
+	// ExecTx holds the store's lock for the whole transaction, so there is nothing more to lock.
+	db := r.s.lock()
+	defer r.s.unlock()
+
+	var owners []uint64
+	for key, m := range db.memberships {
+		if key.TenantID == tenantID && m.Role == domain.RoleOwner {
+			owners = append(owners, key.UserID)
+		}
+	}
+	sort.Slice(owners, func(i, j int) bool { return owners[i] < owners[j] })
+	return owners, nil
+}
+
+func (r *authRepository) FindUserMemberships(ctx context.Context, userID uint64) ([]*domain.TenantMembership, error) {
+	db := r.s.lock()
+	defer r.s.unlock()
+
+	memberships := make([]*domain.TenantMembership, 0, 4)
+	for key, m := range db.memberships {
+		if key.UserID == userID {
+			memberships = append(memberships, &domain.TenantMembership{TenantID: key.TenantID, TenantSlug: db.tenants[key.TenantID].Slug, Role: m.Role})
+		}
+	}
+	sort.Slice(memberships, func(i, j int) bool { return memberships[i].TenantID < memberships[j].TenantID })
+	return memberships, nil
+}
+
+func findTenantBySlug(db *tables, slug string) (tenantRow, bool) {
+	for _, t := range db.tenants {
+		if t.Slug == slug {
+			return t, true
+		}
+	}
+	return tenantRow{}, false
+}
+
+func (t tenantRow) domain() *domain.Tenant {
+	return &domain.Tenant{ID: t.ID, Slug: t.Slug, Name: t.Name, Plan: t.Plan, Disabled: t.Disabled, JoinPolicy: t.JoinPolicy, AllowSubdomainFallback: t.AllowSubdomainFallback}
+}
+
+func (u userRow) domain() *domain.User {
+	return &domain.User{ID: u.ID, AuthSub: u.AuthSub, DisplayName: u.DisplayName, Suspended: u.Suspended}
+}
@@ -0,0 +1,177 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/example/something-like-sns/apps/api/internal/domain"
+)
+
+type bulkRepository struct {
+	s *memStore
+}
+
+func (r *bulkRepository) NextID(ctx context.Context, table string) (uint64, error) {
+	db := r.s.lock()
+	defer r.s.unlock()
+
+	switch table {
+	case "users", "posts", "comments", "conversations", "messages":
+		return db.lastID[table] + 1, nil
+	}
+	return 0, fmt.Errorf("bulk: unknown table %q", table)
+}
+
+// reserve records id as used in table, like an explicit value in an auto-increment column.
+func (db *tables) reserve(table string, id uint64) {
+	db.lastID[table] = max(db.lastID[table], id)
+}
+
+func (r *bulkRepository) InsertUsers(ctx context.Context, users []*domain.User) error {
+	db := r.s.lock()
+	defer r.s.unlock()
+
+	subs := make(map[string]bool, len(db.users))
+	for _, u := range db.users {
+		subs[u.AuthSub] = true
+	}
+	for _, u := range users {
+		if _, ok := db.users[u.ID]; ok || subs[u.AuthSub] {
+			return domain.NewConflictError("user", "already exists")
+		}
+		subs[u.AuthSub] = true
+		db.users[u.ID] = userRow{ID: u.ID, AuthSub: u.AuthSub, DisplayName: u.DisplayName, Suspended: u.Suspended}
+		db.reserve("users", u.ID)
+	}
+	return nil
+}
+
+func (r *bulkRepository) InsertMemberships(ctx context.Context, tenantID uint64, members []*domain.Member) error {
+	db := r.s.lock()
+	defer r.s.unlock()
+
+	if _, ok := db.tenants[tenantID]; !ok {
+		return referenced("membership")
+	}
+	for _, m := range members {
+		if _, ok := db.users[m.UserID]; !ok {
+			return referenced("membership")
+		}
+		key := membershipKey{tenantID, m.UserID}
+		if _, ok := db.memberships[key]; ok {
+			return domain.NewConflictError("membership", "already exists")
+		}
+		db.memberships[key] = membershipRow{Role: m.Role, CreatedAt: r.s.timestamp()}
+		r.s.addUsage(db, tenantID, 0, 0, 1)
+	}
+	return nil
+}
+
+func (r *bulkRepository) InsertPosts(ctx context.Context, tenantID uint64, posts []*domain.Post) error {
+	db := r.s.lock()
+	defer r.s.unlock()
+
+	if _, ok := db.tenants[tenantID]; !ok {
+		return referenced("post")
+	}
+	for _, p := range posts {
+		if _, ok := db.users[p.AuthorUserID]; !ok {
+			return referenced("post")
+		}
+		if _, ok := db.posts[p.ID]; ok {
+			return domain.NewConflictError("post", "already exists")
+		}
+		db.posts[p.ID] = postRow{ID: p.ID, TenantID: tenantID, AuthorID: p.AuthorUserID, Body: p.Body, CreatedAt: p.CreatedAt}
+		db.reserve("posts", p.ID)
+		// Bulk-loaded posts are history and do not count toward today's.
+		r.s.addUsage(db, tenantID, 0, len(p.Body), 0)
+	}
+	return nil
+}
+
+func (r *bulkRepository) InsertComments(ctx context.Context, tenantID uint64, comments []*domain.Comment) error {
+	db := r.s.lock()
+	defer r.s.unlock()
+
+	for _, c := range comments {
+		if _, ok := db.posts[c.PostID]; !ok {
+			return referenced("comment")
+		}
+		if _, ok := db.users[c.AuthorUserID]; !ok {
+			return referenced("comment")
+		}
+		if _, ok := db.comments[c.ID]; ok {
+			return domain.NewConflictError("comment", "already exists")
+		}
+		db.comments[c.ID] = commentRow{ID: c.ID, TenantID: tenantID, PostID: c.PostID, AuthorID: c.AuthorUserID, Body: c.Body, CreatedAt: c.CreatedAt}
+		db.reserve("comments", c.ID)
+		r.s.addUsage(db, tenantID, 0, len(c.Body), 0)
+	}
+	return nil
+}
+
+func (r *bulkRepository) InsertReactions(ctx context.Context, tenantID uint64, reactions []*domain.ReactionRecord) error {
+	db := r.s.lock()
+	defer r.s.unlock()
+
+	if _, ok := db.tenants[tenantID]; !ok {
+		return referenced("reaction")
+	}
+	for _, rec := range reactions {
+		if _, ok := db.users[rec.UserID]; !ok {
+			return referenced("reaction")
+		}
+		key := reactionKey{TenantID: tenantID, TargetType: rec.TargetType, TargetID: rec.TargetID, UserID: rec.UserID, Type: rec.Type}
+		if _, ok := db.reactions[key]; ok {
+			return domain.NewConflictError("reaction", "already exists")
+		}
+		db.reactions[key] = struct{}{}
+	}
+	return nil
+}
+
+func (r *bulkRepository) InsertConversations(ctx context.Context, tenantID uint64, conversations []*domain.Conversation) error {
+	db := r.s.lock()
+	defer r.s.unlock()
+
+	if _, ok := db.tenants[tenantID]; !ok {
+		return referenced("conversation")
+	}
+	for _, c := range conversations {
+		if _, ok := db.conversations[c.ID]; ok {
+			return domain.NewConflictError("conversation", "already exists")
+		}
+		for _, userID := range c.MemberUserIDs {
+			if _, ok := db.users[userID]; !ok {
+				return referenced("conversation member")
+			}
+		}
+		members := slices.Clone(c.MemberUserIDs)
+		slices.Sort(members)
+		db.conversations[c.ID] = conversationRow{ID: c.ID, TenantID: tenantID, CreatedAt: c.CreatedAt, MemberIDs: members}
+		db.reserve("conversations", c.ID)
+	}
+	return nil
+}
+
+func (r *bulkRepository) InsertMessages(ctx context.Context, tenantID uint64, messages []*domain.Message) error {
+	db := r.s.lock()
+	defer r.s.unlock()
+
+	for _, m := range messages {
+		if c, ok := db.conversations[m.ConversationID]; !ok || c.TenantID != tenantID {
+			return referenced("message")
+		}
+		if _, ok := db.users[m.SenderUserID]; !ok {
+			return referenced("message")
+		}
+		if _, ok := db.messages[m.ID]; ok {
+			return domain.NewConflictError("message", "already exists")
+		}
+		db.messages[m.ID] = messageRow{ID: m.ID, TenantID: tenantID, ConversationID: m.ConversationID, SenderID: m.SenderUserID, Body: m.Body, CreatedAt: m.CreatedAt}
+		db.reserve("messages", m.ID)
+		r.s.addUsage(db, tenantID, 0, len(m.Body), 0)
+	}
+	return nil
+}
@@ -0,0 +1,133 @@
+package memory
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/example/something-like-sns/apps/api/internal/domain"
+)
+
+type dmRepository struct {
+	s *memStore
+}
+
+func (r *dmRepository) FindDMConversation(ctx context.Context, tenantID, userID1, userID2 uint64) (uint64, error) {
+	db := r.s.lock()
+	defer r.s.unlock()
+
+	var found uint64
+	for _, c := range db.conversations {
+		if c.TenantID == tenantID && slices.Contains(c.MemberIDs, userID1) && slices.Contains(c.MemberIDs, userID2) {
+			if found == 0 || c.ID < found {
+				found = c.ID
+			}
+		}
+	}
+	return found, nil
+}
+
+func (r *dmRepository) IsMember(ctx context.Context, tenantID, conversationID, userID uint64) (bool, error) {
+	db := r.s.lock()
+	defer r.s.unlock()
+
+	c, ok := db.conversations[conversationID]
+	return ok && c.TenantID == tenantID && slices.Contains(c.MemberIDs, userID), nil
+}
+
+func (r *dmRepository) CreateDMConversation(ctx context.Context, tenantID uint64, userIDs ...uint64) (uint64, error) {
+	db := r.s.lock()
+	defer r.s.unlock()
+
+	if !exists(db.tenants, tenantID) {
+		return 0, referenced("conversation")
+	}
+	members := slices.Clone(userIDs)
+	slices.Sort(members)
+	for i, id := range members {
+		if !exists(db.users, id) {
+			return 0, referenced("conversation member")
+		}
+		if i > 0 && members[i-1] == id {
+			return 0, domain.NewConflictError("conversation member", "already exists")
+		}
+	}
+	c := conversationRow{ID: db.nextID("conversations"), TenantID: tenantID, CreatedAt: r.s.timestamp(), MemberIDs: members}
+	db.conversations[c.ID] = c
+	return c.ID, nil
+}
+
+func (r *dmRepository) FindConversations(ctx context.Context, tenantID, userID uint64, limit int, cursor domain.Cursor) ([]*domain.Conversation, bool, error) {
+	db := r.s.lock()
+	defer r.s.unlock()
+
+	var rows []conversationRow
+	for _, c := range db.conversations {
+		if c.TenantID == tenantID && slices.Contains(c.MemberIDs, userID) {
+			rows = append(rows, c)
+		}
+	}
+	rows, hasMore := page(rows, limit, cursor, true, func(c conversationRow) (time.Time, uint64) { return c.CreatedAt, c.ID })
+
+	items := make([]*domain.Conversation, len(rows))
+	for i, c := range rows {
+		items[i] = &domain.Conversation{ID: c.ID, CreatedAt: c.CreatedAt, MemberUserIDs: slices.Clone(c.MemberIDs)}
+	}
+	return items, hasMore, nil
+}
+
+func (r *dmRepository) FindMessageByID(ctx context.Context, tenantID, messageID uint64) (*domain.Message, error) {
+	db := r.s.lock()
+	defer r.s.unlock()
+
+	m, ok := db.messages[messageID]
+	if !ok || m.TenantID != tenantID || m.Deleted {
+		return nil, domain.NewNotFoundError("message", nil)
+	}
+	return &domain.Message{ID: m.ID, ConversationID: m.ConversationID, SenderUserID: m.SenderID, Body: m.Body, CreatedAt: m.CreatedAt}, nil
+}
+
+func (r *dmRepository) FindMessages(ctx context.Context, tenantID, conversationID uint64, limit int, cursor domain.Cursor) ([]*domain.Message, bool, error) {
+	db := r.s.lock()
+	defer r.s.unlock()
+
+	var rows []messageRow
+	for _, m := range db.messages {
+		if m.TenantID == tenantID && m.ConversationID == conversationID && !m.Deleted {
+			rows = append(rows, m)
+		}
+	}
+	rows, hasMore := page(rows, limit, cursor, true, func(m messageRow) (time.Time, uint64) { return m.CreatedAt, m.ID })
+
+	items := make([]*domain.Message, len(rows))
+	for i, m := range rows {
+		items[i] = &domain.Message{ID: m.ID, ConversationID: m.ConversationID, SenderUserID: m.SenderID, Body: m.Body, CreatedAt: m.CreatedAt}
+	}
+	return items, hasMore, nil
+}
+
+func (r *dmRepository) CountMessages(ctx context.Context, tenantID, conversationID uint64) (uint64, error) {
+	db := r.s.lock()
+	defer r.s.unlock()
+
+	var n uint64
+	for _, m := range db.messages {
+		if m.TenantID == tenantID && m.ConversationID == conversationID && !m.Deleted {
+			n++
+		}
+	}
+	return n, nil
+}
+
+func (r *dmRepository) CreateMessage(ctx context.Context, tenantID, conversationID, senderID uint64, body string) (*domain.Message, error) {
+	db := r.s.lock()
+	defer r.s.unlock()
+
+	if !exists(db.tenants, tenantID) || !exists(db.conversations, conversationID) || !exists(db.users, senderID) {
+		return nil, referenced("message")
+	}
+	m := messageRow{ID: db.nextID("messages"), TenantID: tenantID, ConversationID: conversationID, SenderID: senderID, Body: body, CreatedAt: r.s.timestamp()}
+	db.messages[m.ID] = m
+	r.s.addUsage(db, tenantID, 0, len(body), 0)
+	return &domain.Message{ID: m.ID, ConversationID: conversationID, SenderUserID: senderID, Body: body, CreatedAt: m.CreatedAt}, nil
+}
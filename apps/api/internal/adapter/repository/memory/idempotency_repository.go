@@ -0,0 +1,74 @@
+package memory
+
+import (
+	"context"
+	"time"
+
+	"github.com/example/something-like-sns/apps/api/internal/domain"
+)
+
+type idempotencyRepository struct {
+	s *memStore
+}
+
+func (r *idempotencyRepository) Find(ctx context.Context, tenantID, userID uint64, procedure, key string) (*domain.IdempotencyRecord, error) {
+	db := r.s.lock()
+	defer r.s.unlock()
+
+	rec, ok := db.idempotency[idempotencyKey{tenantID, userID, procedure, key}]
+	if !ok {
+		return nil, domain.NewNotFoundError("idempotency key", nil)
+	}
+	return &rec, nil
+}
+
+func (r *idempotencyRepository) Reserve(ctx context.Context, rec *domain.IdempotencyRecord) error {
+	db := r.s.lock()
+	defer r.s.unlock()
+
+	if !exists(db.tenants, rec.TenantID) || !exists(db.users, rec.UserID) {
+		return referenced("idempotency key")
+	}
+	k := idempotencyKey{rec.TenantID, rec.UserID, rec.Procedure, rec.Key}
+	if _, ok := db.idempotency[k]; ok {
+		return domain.NewConflictError("idempotency key", "already exists")
+	}
+	stored := *rec
+	stored.Response = nil
+	db.idempotency[k] = stored
+	return nil
+}
+
+func (r *idempotencyRepository) Complete(ctx context.Context, tenantID, userID uint64, procedure, key string, response []byte) error {
+	db := r.s.lock()
+	defer r.s.unlock()
+
+	k := idempotencyKey{tenantID, userID, procedure, key}
+	if rec, ok := db.idempotency[k]; ok {
+		rec.Response = response
+		db.idempotency[k] = rec
+	}
+	return nil
+}
+
+func (r *idempotencyRepository) Delete(ctx context.Context, tenantID, userID uint64, procedure, key string) error {
+	db := r.s.lock()
+	defer r.s.unlock()
+
+	delete(db.idempotency, idempotencyKey{tenantID, userID, procedure, key})
+	return nil
+}
+
+func (r *idempotencyRepository) DeleteExpired(ctx context.Context, now time.Time) (int64, error) {
+	db := r.s.lock()
+	defer r.s.unlock()
+
+	var n int64
+	for k, rec := range db.idempotency {
+		if !rec.ExpiresAt.After(now) {
+			delete(db.idempotency, k)
+			n++
+		}
+	}
+	return n, nil
+}
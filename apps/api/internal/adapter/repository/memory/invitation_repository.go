@@ -0,0 +1,203 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/example/something-like-sns/apps/api/internal/domain"
+)
+
+type invitationRepository struct {
+	s *memStore
+}
+
+func (r *invitationRepository) CreateInvitation(ctx context.Context, tenantID uint64, inv *domain.Invitation, tokenHash []byte) (*domain.Invitation, error) {
+	db := r.s.lock()
+	defer r.s.unlock()
+
+	if _, ok := db.tenants[tenantID]; !ok {
+		return nil, referenced("invitation")
+	}
+	if _, ok := db.users[inv.CreatedByUserID]; !ok {
+		return nil, referenced("invitation")
+	}
+	for _, row := range db.invitations {
+		if row.TokenHash == string(tokenHash) {
+			return nil, domain.NewConflictError("invitation", "already exists")
+		}
+	}
+	row := invitationRow{
+		ID:        db.nextID("invitations"),
+		TenantID:  tenantID,
+		TokenHash: string(tokenHash),
+		Role:      inv.Role,
+		CreatedBy: inv.CreatedByUserID,
+		CreatedAt: r.s.timestamp(),
+		ExpiresAt: inv.ExpiresAt.UTC().Truncate(time.Second),
+	}
+	db.invitations[row.ID] = row
+	return row.domain(), nil
+}
+
+func (r *invitationRepository) FindInvitationByTokenHash(ctx context.Context, tenantID uint64, tokenHash []byte) (*domain.Invitation, error) {
+	db := r.s.lock()
+	defer r.s.unlock()
+
+	for _, row := range db.invitations {
+		if row.TenantID == tenantID && row.TokenHash == string(tokenHash) {
+			return row.domain(), nil
+		}
+	}
+	return nil, domain.NewNotFoundError("invitation", nil)
+}
+
+func (r *invitationRepository) FindPendingInvitations(ctx context.Context, tenantID uint64, now time.Time) ([]*domain.Invitation, error) {
+	db := r.s.lock()
+	defer r.s.unlock()
+
+	var invs []*domain.Invitation
+	for _, row := range db.invitations {
+		if row.TenantID == tenantID && row.AcceptedBy == 0 && row.ExpiresAt.After(now) {
+			invs = append(invs, row.domain())
+		}
+	}
+	sort.Slice(invs, func(i, j int) bool { return invs[i].ID < invs[j].ID })
+	return invs, nil
+}
+
+func (r *invitationRepository) ClaimInvitation(ctx context.Context, tenantID, invitationID, userID uint64, now time.Time) error {
+	db := r.s.lock()
+	defer r.s.unlock()
+
+	row, ok := db.invitations[invitationID]
+	if !ok || row.TenantID != tenantID || row.AcceptedBy != 0 || !row.ExpiresAt.After(now) {
+		return domain.NewConflictError("invitation", "is used or expired")
+	}
+	if _, ok := db.users[userID]; !ok {
+		return referenced("invitation")
+	}
+	row.AcceptedBy, row.AcceptedAt = userID, r.s.timestamp()
+	db.invitations[invitationID] = row
+	return nil
+}
+
+func (r *invitationRepository) DeleteInvitation(ctx context.Context, tenantID, invitationID uint64) error {
+	db := r.s.lock()
+	defer r.s.unlock()
+
+	if row, ok := db.invitations[invitationID]; !ok || row.TenantID != tenantID {
+		return domain.NewNotFoundError("invitation", invitationID)
+	}
+	delete(db.invitations, invitationID)
+	return nil
+}
+
+func (r *invitationRepository) CreateJoinRequest(ctx context.Context, tenantID, userID uint64, message string) (*domain.JoinRequest, error) {
+	db := r.s.lock()
+	defer r.s.unlock()
+
+	if _, ok := db.tenants[tenantID]; !ok {
+		return nil, referenced("join request")
+	}
+	if _, ok := db.users[userID]; !ok {
+		return nil, referenced("join request")
+	}
+	for _, row := range db.joinRequests {
+		if row.TenantID == tenantID && row.UserID == userID {
+			return nil, domain.NewConflictError("join request", "already exists")
+		}
+	}
+	row := joinRequestRow{ID: db.nextID("join_requests"), TenantID: tenantID, UserID: userID, Message: message, CreatedAt: r.s.timestamp()}
+	db.joinRequests[row.ID] = row
+	return row.domain(db), nil
+}
+
+func (r *invitationRepository) FindJoinRequest(ctx context.Context, tenantID, requestID uint64) (*domain.JoinRequest, error) {
+	db := r.s.lock()
+	defer r.s.unlock()
+
+	row, ok := db.joinRequests[requestID]
+	if !ok || row.TenantID != tenantID {
+		return nil, domain.NewNotFoundError("join request", requestID)
+	}
+	return row.domain(db), nil
+}
+
+func (r *invitationRepository) FindJoinRequests(ctx context.Context, tenantID uint64) ([]*domain.JoinRequest, error) {
+	db := r.s.lock()
+	defer r.s.unlock()
+
+	var reqs []*domain.JoinRequest
+	for _, row := range db.joinRequests {
+		if row.TenantID == tenantID {
+			reqs = append(reqs, row.domain(db))
+		}
+	}
+	sort.Slice(reqs, func(i, j int) bool { return reqs[i].ID < reqs[j].ID })
+	return reqs, nil
+}
+
+func (r *invitationRepository) DeleteJoinRequest(ctx context.Context, tenantID, requestID uint64) error {
+	db := r.s.lock()
+	defer r.s.unlock()
+
+	if row, ok := db.joinRequests[requestID]; !ok || row.TenantID != tenantID {
+		return domain.NewNotFoundError("join request", requestID)
+	}
+	delete(db.joinRequests, requestID)
+	return nil
+}
+
+func (r *invitationRepository) FindEmailDomains(ctx context.Context, tenantID uint64) ([]string, error) {
+	db := r.s.lock()
+	defer r.s.unlock()
+
+	var domains []string
+	for key := range db.emailDomains {
+		if key.TenantID == tenantID {
+			domains = append(domains, key.Domain)
+		}
+	}
+	sort.Strings(domains)
+	return domains, nil
+}
+
+func (r *invitationRepository) SetEmailDomains(ctx context.Context, tenantID uint64, domains []string) error {
+	db := r.s.lock()
+	defer r.s.unlock()
+
+	if _, ok := db.tenants[tenantID]; !ok {
+		return referenced("email domain")
+	}
+	for key := range db.emailDomains {
+		if key.TenantID == tenantID {
+			delete(db.emailDomains, key)
+		}
+	}
+	for _, d := range domains {
+		db.emailDomains[emailDomainKey{tenantID, d}] = struct{}{}
+	}
+	return nil
+}
+
+func (row invitationRow) domain() *domain.Invitation {
+	return &domain.Invitation{
+		ID:               row.ID,
+		Role:             row.Role,
+		CreatedByUserID:  row.CreatedBy,
+		CreatedAt:        row.CreatedAt,
+		ExpiresAt:        row.ExpiresAt,
+		AcceptedByUserID: row.AcceptedBy,
+	}
+}
+
+func (row joinRequestRow) domain(db *tables) *domain.JoinRequest {
+	return &domain.JoinRequest{
+		ID:          row.ID,
+		UserID:      row.UserID,
+		DisplayName: db.users[row.UserID].DisplayName,
+		Message:     row.Message,
+		CreatedAt:   row.CreatedAt,
+	}
+}
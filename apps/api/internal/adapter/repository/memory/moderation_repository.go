@@ -0,0 +1,184 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/example/something-like-sns/apps/api/internal/domain"
+)
+
+type moderationRepository struct {
+	s *memStore
+}
+
+func (r *moderationRepository) CreateReport(ctx context.Context, tenantID uint64, rep *domain.Report) (*domain.Report, error) {
+	db := r.s.lock()
+	defer r.s.unlock()
+
+	if !exists(db.tenants, tenantID) || !exists(db.users, rep.AuthorUserID) || !exists(db.users, rep.ReporterUserID) {
+		return nil, referenced("report")
+	}
+	for _, row := range db.reports {
+		if row.TenantID == tenantID && row.Report.TargetType == rep.TargetType && row.Report.TargetID == rep.TargetID && row.Report.ReporterUserID == rep.ReporterUserID {
+			return nil, domain.NewConflictError("report", "already exists")
+		}
+	}
+	created := domain.Report{
+		ID:             db.nextID("content_reports"),
+		TargetType:     rep.TargetType,
+		TargetID:       rep.TargetID,
+		AuthorUserID:   rep.AuthorUserID,
+		ReporterUserID: rep.ReporterUserID,
+		Reason:         rep.Reason,
+		Content:        rep.Content,
+		Status:         domain.ReportOpen,
+		CreatedAt:      r.s.timestamp(),
+	}
+	db.reports[created.ID] = reportRow{TenantID: tenantID, Report: created}
+	return &created, nil
+}
+
+func (r *moderationRepository) FindReport(ctx context.Context, tenantID, reportID uint64) (*domain.Report, error) {
+	db := r.s.lock()
+	defer r.s.unlock()
+
+	row, ok := db.reports[reportID]
+	if !ok || row.TenantID != tenantID {
+		return nil, domain.NewNotFoundError("report", nil)
+	}
+	rep := row.Report
+	return &rep, nil
+}
+
+func (r *moderationRepository) FindReports(ctx context.Context, tenantID uint64, status string, limit int, cursor domain.Cursor) ([]*domain.Report, bool, error) {
+	db := r.s.lock()
+	defer r.s.unlock()
+
+	var rows []domain.Report
+	for _, row := range db.reports {
+		if row.TenantID == tenantID && row.Report.Status == status {
+			rows = append(rows, row.Report)
+		}
+	}
+	rows, hasMore := page(rows, limit, cursor, false, func(rep domain.Report) (time.Time, uint64) { return rep.CreatedAt, rep.ID })
+
+	items := make([]*domain.Report, len(rows))
+	for i := range rows {
+		items[i] = &rows[i]
+	}
+	return items, hasMore, nil
+}
+
+func (r *moderationRepository) ResolveReports(ctx context.Context, tenantID uint64, targetType domain.ReportTargetType, targetID, userID uint64, action domain.ModerationAction, now time.Time) error {
+	db := r.s.lock()
+	defer r.s.unlock()
+
+	if !exists(db.users, userID) {
+		return referenced("report")
+	}
+	for id, row := range db.reports {
+		rep := &row.Report
+		if row.TenantID != tenantID || rep.TargetType != targetType || rep.TargetID != targetID || rep.Status != domain.ReportOpen {
+			continue
+		}
+		rep.Status = domain.ReportResolved
+		rep.Action = action
+		rep.ResolvedByUserID = userID
+		rep.ResolvedAt = now.UTC().Truncate(time.Second)
+		db.reports[id] = row
+	}
+	return nil
+}
+
+func (r *moderationRepository) RemoveContent(ctx context.Context, tenantID uint64, targetType domain.ReportTargetType, targetID uint64, erase bool) error {
+	db := r.s.lock()
+	defer r.s.unlock()
+
+	switch targetType {
+	case domain.ReportTargetPost:
+		p, ok := db.posts[targetID]
+		if !ok || p.TenantID != tenantID {
+			return domain.NewNotFoundError(string(targetType), nil)
+		}
+		if !p.Deleted {
+			r.s.addUsage(db, tenantID, 0, -len(p.Body), 0)
+		}
+		p.Deleted = true
+		if erase {
+			p.Body = ""
+		}
+		db.posts[targetID] = p
+	case domain.ReportTargetComment:
+		c, ok := db.comments[targetID]
+		if !ok || c.TenantID != tenantID {
+			return domain.NewNotFoundError(string(targetType), nil)
+		}
+		if !c.Deleted {
+			r.s.addUsage(db, tenantID, 0, -len(c.Body), 0)
+		}
+		c.Deleted = true
+		if erase {
+			c.Body = ""
+		}
+		db.comments[targetID] = c
+	case domain.ReportTargetMessage:
+		m, ok := db.messages[targetID]
+		if !ok || m.TenantID != tenantID {
+			return domain.NewNotFoundError(string(targetType), nil)
+		}
+		if !m.Deleted {
+			r.s.addUsage(db, tenantID, 0, -len(m.Body), 0)
+		}
+		m.Deleted = true
+		if erase {
+			m.Body = ""
+		}
+		db.messages[targetID] = m
+	default:
+		return fmt.Errorf("unknown report target type %q", targetType)
+	}
+	if erase {
+		// Reports keep a copy of the content, which goes with it.
+		for id, rr := range db.reports {
+			if rr.TenantID == tenantID && rr.Report.TargetType == targetType && rr.Report.TargetID == targetID {
+				rr.Report.Content = ""
+				db.reports[id] = rr
+			}
+		}
+	}
+	return nil
+}
+
+func (r *moderationRepository) AppendAuditEvent(ctx context.Context, tenantID uint64, e *domain.AuditEvent) error {
+	db := r.s.lock()
+	defer r.s.unlock()
+
+	if !exists(db.tenants, tenantID) || !exists(db.users, e.ActorUserID) || e.ReportID != 0 && !exists(db.reports, e.ReportID) {
+		return referenced("audit event")
+	}
+	event := *e
+	event.ID = db.nextID("audit_events")
+	event.CreatedAt = r.s.timestamp()
+	db.auditEvents[event.ID] = auditEventRow{TenantID: tenantID, Event: event}
+	return nil
+}
+
+func (r *moderationRepository) FindAuditEvents(ctx context.Context, tenantID uint64, limit int, cursor domain.Cursor) ([]*domain.AuditEvent, bool, error) {
+	db := r.s.lock()
+	defer r.s.unlock()
+
+	var rows []domain.AuditEvent
+	for _, row := range db.auditEvents {
+		if row.TenantID == tenantID {
+			rows = append(rows, row.Event)
+		}
+	}
+	rows, hasMore := page(rows, limit, cursor, true, func(e domain.AuditEvent) (time.Time, uint64) { return e.CreatedAt, e.ID })
+
+	items := make([]*domain.AuditEvent, len(rows))
+	for i := range rows {
+		items[i] = &rows[i]
+	}
+	return items, hasMore, nil
+}
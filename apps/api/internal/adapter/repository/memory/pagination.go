@@ -0,0 +1,56 @@
+package memory
+
+import (
+	"cmp"
+	"slices"
+	"time"
+
+	"github.com/example/something-like-sns/apps/api/internal/domain"
+)
+
+// page returns up to limit items following cursor in a list ordered by key, newest first when desc is set,
+// like a keyset query of the SQL adapters. It reports whether more items follow in the cursor's direction.
+func page[T any](items []T, limit int, cursor domain.Cursor, desc bool, key func(T) (time.Time, uint64)) ([]T, bool) {
+	if cursor.Direction == domain.PageBackward {
+		desc = !desc
+	}
+	compare := func(a, b T) int {
+		at, aid := key(a)
+		bt, bid := key(b)
+		c := at.Compare(bt)
+		if c == 0 {
+			c = cmp.Compare(aid, bid)
+		}
+		if desc {
+			return -c
+		}
+		return c
+	}
+	slices.SortFunc(items, compare)
+
+	out := make([]T, 0, limit+1)
+	for _, item := range items {
+		if !cursor.IsZero() {
+			t, id := key(item)
+			c := t.Compare(cursor.Time)
+			if c == 0 {
+				c = cmp.Compare(id, cursor.ID)
+			}
+			if desc && c >= 0 || !desc && c <= 0 {
+				continue
+			}
+		}
+		out = append(out, item)
+		if len(out) > limit {
+			break
+		}
+	}
+	hasMore := len(out) > limit
+	if hasMore {
+		out = out[:limit]
+	}
+	if cursor.Direction == domain.PageBackward {
+		slices.Reverse(out)
+	}
+	return out, hasMore
+}
@@ -0,0 +1,53 @@
+package memory
+
+import (
+	"context"
+	"time"
+
+	"github.com/example/something-like-sns/apps/api/internal/domain"
+)
+
+type planRepository struct {
+	s *memStore
+}
+
+func (r *planRepository) FindTenantPlan(ctx context.Context, tenantID uint64) (*domain.Plan, error) {
+	db := r.s.lock()
+	defer r.s.unlock()
+
+	t, ok := db.tenants[tenantID]
+	if !ok {
+		return nil, domain.NewNotFoundError("plan", nil)
+	}
+	p := db.plans[t.Plan]
+	return &p, nil
+}
+
+func (r *planRepository) GetUsage(ctx context.Context, tenantID uint64) (*domain.TenantUsage, error) {
+	db := r.s.lock()
+	defer r.s.unlock()
+
+	u := db.usage[tenantID]
+	if !u.Day.Equal(r.s.today()) {
+		u.PostsToday = 0
+	}
+	return &domain.TenantUsage{PostsToday: uint32(u.PostsToday), StorageBytes: uint64(u.StorageBytes), Members: uint32(u.Members)}, nil
+}
+
+// addUsage adds to the tenant's usage counters, starting today's count of posts over if they were
+// last counted on an earlier day.
+func (s *memStore) addUsage(db *tables, tenantID uint64, posts, bytes, members int) {
+	u := db.usage[tenantID]
+	if today := s.today(); !u.Day.Equal(today) {
+		u.Day, u.PostsToday = today, 0
+	}
+	u.PostsToday += int64(posts)
+	u.StorageBytes += int64(bytes)
+	u.Members += int64(members)
+	db.usage[tenantID] = u
+}
+
+// today returns midnight UTC of the current day, when the daily post quota resets.
+func (s *memStore) today() time.Time {
+	return s.now().UTC().Truncate(24 * time.Hour)
+}
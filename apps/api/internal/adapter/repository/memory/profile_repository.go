@@ -0,0 +1,123 @@
+package memory
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/example/something-like-sns/apps/api/internal/domain"
+)
+
+type profileRepository struct {
+	s *memStore
+}
+
+func (r *profileRepository) FindProfiles(ctx context.Context, tenantID uint64, userIDs []uint64) ([]*domain.Profile, error) {
+	db := r.s.lock()
+	defer r.s.unlock()
+
+	seen := make(map[uint64]bool, len(userIDs))
+	profiles := make([]*domain.Profile, 0, len(userIDs))
+	for _, id := range userIDs {
+		u, ok := db.users[id]
+		if !ok || seen[id] {
+			continue
+		}
+		seen[id] = true
+		p := db.memberships[membershipKey{tenantID, id}].profile(u)
+		profiles = append(profiles, &p)
+	}
+	return profiles, nil
+}
+
+func (r *profileRepository) FindProfileSettings(ctx context.Context, tenantID, userID uint64) (*domain.ProfileSettings, error) {
+	db := r.s.lock()
+	defer r.s.unlock()
+
+	m, ok := db.memberships[membershipKey{tenantID, userID}]
+	if !ok {
+		return nil, domain.NewNotFoundError("membership", nil)
+	}
+	u := db.users[userID]
+	return &domain.ProfileSettings{
+		Profile:  domain.Profile{UserID: u.ID, DisplayName: u.DisplayName, Handle: m.Handle, Bio: u.Bio, AvatarURL: u.AvatarURL},
+		Override: domain.ProfileOverride{DisplayName: m.DisplayName, AvatarURL: m.AvatarURL},
+	}, nil
+}
+
+func (r *profileRepository) UpdateProfile(ctx context.Context, tenantID uint64, p *domain.Profile) error {
+	db := r.s.lock()
+	defer r.s.unlock()
+
+	key := membershipKey{tenantID, p.UserID}
+	m, ok := db.memberships[key]
+	if !ok {
+		return domain.NewNotFoundError("membership", nil)
+	}
+	if p.Handle != "" {
+		for k, other := range db.memberships {
+			if k.TenantID == tenantID && k != key && other.Handle == p.Handle {
+				return domain.NewConflictError("handle", "already exists")
+			}
+		}
+	}
+	m.Handle = p.Handle
+	db.memberships[key] = m
+
+	u := db.users[p.UserID]
+	u.DisplayName, u.Bio, u.AvatarURL = p.DisplayName, p.Bio, p.AvatarURL
+	db.users[p.UserID] = u
+	return nil
+}
+
+func (r *profileRepository) UpdateProfileOverride(ctx context.Context, tenantID, userID uint64, o domain.ProfileOverride) error {
+	db := r.s.lock()
+	defer r.s.unlock()
+
+	key := membershipKey{tenantID, userID}
+	m, ok := db.memberships[key]
+	if !ok {
+		return domain.NewNotFoundError("membership", nil)
+	}
+	m.DisplayName, m.AvatarURL = o.DisplayName, o.AvatarURL
+	db.memberships[key] = m
+	return nil
+}
+
+func (r *profileRepository) FindDirectoryMembers(ctx context.Context, tenantID, viewerID uint64, filter domain.MemberFilter, limit int, cursor domain.Cursor) ([]*domain.DirectoryMember, bool, error) {
+	db := r.s.lock()
+	defer r.s.unlock()
+
+	prefix := strings.ToLower(filter.Prefix)
+	var members []*domain.DirectoryMember
+	for key, m := range db.memberships {
+		u := db.users[key.UserID]
+		if key.TenantID != tenantID || u.Suspended || m.Suspended || filter.Role != "" && m.Role != filter.Role || db.blocked(tenantID, viewerID, u.ID) {
+			continue
+		}
+		p := m.profile(u)
+		if prefix != "" && !strings.HasPrefix(strings.ToLower(p.DisplayName), prefix) && !strings.HasPrefix(m.Handle, prefix) {
+			continue
+		}
+		members = append(members, &domain.DirectoryMember{
+			Profile:  p,
+			Role:     m.Role,
+			JoinedAt: m.CreatedAt,
+		})
+	}
+	members, hasMore := page(members, limit, cursor, false, func(m *domain.DirectoryMember) (time.Time, uint64) { return m.JoinedAt, m.UserID })
+	return members, hasMore, nil
+}
+
+// profile returns the profile of user u in the tenant of the membership, which may be the zero
+// membershipRow if u is not a member.
+func (m membershipRow) profile(u userRow) domain.Profile {
+	p := domain.Profile{UserID: u.ID, DisplayName: u.DisplayName, Handle: m.Handle, Bio: u.Bio, AvatarURL: u.AvatarURL}
+	if m.DisplayName != "" {
+		p.DisplayName = m.DisplayName
+	}
+	if m.AvatarURL != "" {
+		p.AvatarURL = m.AvatarURL
+	}
+	return p
+}
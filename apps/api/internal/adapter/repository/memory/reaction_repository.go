@@ -0,0 +1,40 @@
+package memory
+
+import (
+	"context"
+
+	"github.com/example/something-like-sns/apps/api/internal/domain"
+)
+
+type reactionRepository struct {
+	s *memStore
+}
+
+func (r *reactionRepository) Toggle(ctx context.Context, tenantID, userID uint64, targetType domain.ReactionTargetType, targetID uint64, reactionType string) (bool, error) {
+	db := r.s.lock()
+	defer r.s.unlock()
+
+	key := reactionKey{TenantID: tenantID, TargetType: targetType, TargetID: targetID, UserID: userID, Type: reactionType}
+	if _, ok := db.reactions[key]; ok {
+		delete(db.reactions, key)
+		return false, nil
+	}
+	if !exists(db.tenants, tenantID) || !exists(db.users, userID) {
+		return false, referenced("reaction")
+	}
+	db.reactions[key] = struct{}{}
+	return true, nil
+}
+
+func (r *reactionRepository) Count(ctx context.Context, tenantID uint64, targetType domain.ReactionTargetType, targetID uint64, reactionType string) (uint32, error) {
+	db := r.s.lock()
+	defer r.s.unlock()
+
+	var total uint32
+	for key := range db.reactions {
+		if key.TenantID == tenantID && key.TargetType == targetType && key.TargetID == targetID && key.Type == reactionType {
+			total++
+		}
+	}
+	return total, nil
+}
@@ -0,0 +1,68 @@
+package memory
+
+import (
+	"context"
+
+	"github.com/example/something-like-sns/apps/api/internal/domain"
+)
+
+type relationRepository struct {
+	s *memStore
+}
+
+func (r *relationRepository) AddRelation(ctx context.Context, tenantID, userID, targetID uint64, kind domain.RelationKind) error {
+	db := r.s.lock()
+	defer r.s.unlock()
+
+	if !exists(db.tenants, tenantID) || !exists(db.users, userID) || !exists(db.users, targetID) {
+		return referenced("relation")
+	}
+	db.relations[relationKey{tenantID, userID, targetID, kind}] = struct{}{}
+	return nil
+}
+
+func (r *relationRepository) RemoveRelation(ctx context.Context, tenantID, userID, targetID uint64, kind domain.RelationKind) error {
+	db := r.s.lock()
+	defer r.s.unlock()
+
+	delete(db.relations, relationKey{tenantID, userID, targetID, kind})
+	return nil
+}
+
+func (r *relationRepository) IsBlocked(ctx context.Context, tenantID, userID1, userID2 uint64) (bool, error) {
+	db := r.s.lock()
+	defer r.s.unlock()
+
+	return db.blocked(tenantID, userID1, userID2), nil
+}
+
+func (r *relationRepository) IsBlockedInConversation(ctx context.Context, tenantID, conversationID, userID uint64) (bool, error) {
+	db := r.s.lock()
+	defer r.s.unlock()
+
+	c, ok := db.conversations[conversationID]
+	if !ok || c.TenantID != tenantID {
+		return false, nil
+	}
+	for _, id := range c.MemberIDs {
+		if id != userID && db.blocked(tenantID, userID, id) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// blocked reports whether either of the two users blocks the other in the tenant.
+func (t *tables) blocked(tenantID, userID1, userID2 uint64) bool {
+	_, ok1 := t.relations[relationKey{tenantID, userID1, userID2, domain.RelationBlock}]
+	_, ok2 := t.relations[relationKey{tenantID, userID2, userID1, domain.RelationBlock}]
+	return ok1 || ok2
+}
+
+// hides reports whether userID blocks or mutes authorID in the tenant, which hides the author's
+// posts from the user's feed.
+func (t *tables) hides(tenantID, userID, authorID uint64) bool {
+	_, blocked := t.relations[relationKey{tenantID, userID, authorID, domain.RelationBlock}]
+	_, muted := t.relations[relationKey{tenantID, userID, authorID, domain.RelationMute}]
+	return blocked || muted
+}
@@ -0,0 +1,351 @@
+// Package memory implements port.Store in process memory. It is meant for tests and
+// follows the semantics of the SQL adapters, including foreign keys and unique constraints.
+package memory
+
+import (
+	"context"
+	"maps"
+	"sync"
+	"time"
+
+	"github.com/example/something-like-sns/apps/api/internal/domain"
+	"github.com/example/something-like-sns/apps/api/internal/port"
+)
+
+// Rows are stored by value so that a shallow copy of every table is a snapshot.
+type (
+	tenantRow struct {
+		ID         uint64
+		Slug       string
+		Name       string
+		Plan       string
+		Disabled   bool
+		JoinPolicy string
+		// AllowSubdomainFallback defaults to true, as the column default does.
+		AllowSubdomainFallback bool
+	}
+	userRow struct {
+		ID          uint64
+		AuthSub     string
+		DisplayName string
+		Bio         string
+		AvatarURL   string
+		Suspended   bool
+	}
+	tenantDomainKey struct {
+		TenantID uint64
+		Host     string
+	}
+	tenantDomainRow struct {
+		CreatedAt time.Time
+		Verified  bool
+		Token     string
+	}
+	membershipKey struct{ TenantID, UserID uint64 }
+	membershipRow struct {
+		Role      string
+		Handle    string
+		CreatedAt time.Time
+		// DisplayName and AvatarURL override the user's own within the tenant when set.
+		DisplayName string
+		AvatarURL   string
+		Suspended   bool
+	}
+	postRow struct {
+		ID        uint64
+		TenantID  uint64
+		AuthorID  uint64
+		Body      string
+		CreatedAt time.Time
+		Deleted   bool
+	}
+	commentRow struct {
+		ID        uint64
+		TenantID  uint64
+		PostID    uint64
+		AuthorID  uint64
+		Body      string
+		CreatedAt time.Time
+		Deleted   bool
+	}
+	reactionKey struct {
+		TenantID   uint64
+		TargetType domain.ReactionTargetType
+		TargetID   uint64
+		UserID     uint64
+		Type       string
+	}
+	conversationRow struct {
+		ID        uint64
+		TenantID  uint64
+		CreatedAt time.Time
+		// MemberIDs is sorted and never modified after creation.
+		MemberIDs []uint64
+	}
+	messageRow struct {
+		ID             uint64
+		TenantID       uint64
+		ConversationID uint64
+		SenderID       uint64
+		Body           string
+		CreatedAt      time.Time
+		Deleted        bool
+	}
+	idempotencyKey struct {
+		TenantID, UserID uint64
+		Procedure, Key   string
+	}
+	relationKey struct {
+		TenantID, UserID, TargetID uint64
+		Kind                       domain.RelationKind
+	}
+	emailDomainKey struct {
+		TenantID uint64
+		Domain   string
+	}
+	invitationRow struct {
+		ID         uint64
+		TenantID   uint64
+		TokenHash  string
+		Role       string
+		CreatedBy  uint64
+		CreatedAt  time.Time
+		ExpiresAt  time.Time
+		AcceptedBy uint64
+		AcceptedAt time.Time
+	}
+	joinRequestRow struct {
+		ID        uint64
+		TenantID  uint64
+		UserID    uint64
+		Message   string
+		CreatedAt time.Time
+	}
+	reportRow struct {
+		TenantID uint64
+		Report   domain.Report
+	}
+	auditEventRow struct {
+		TenantID uint64
+		Event    domain.AuditEvent
+	}
+	usageRow struct {
+		// Day is midnight UTC of the day PostsToday counts the posts of.
+		Day          time.Time
+		PostsToday   int64
+		StorageBytes int64
+		Members      int64
+	}
+)
+
+// tables holds the whole database.
+type tables struct {
+	lastID        map[string]uint64
+	plans         map[string]domain.Plan
+	tenants       map[uint64]tenantRow
+	tenantDomains map[tenantDomainKey]tenantDomainRow
+	users         map[uint64]userRow
+	memberships   map[membershipKey]membershipRow
+	removals      map[membershipKey]struct{}
+	posts         map[uint64]postRow
+	comments      map[uint64]commentRow
+	reactions     map[reactionKey]struct{}
+	conversations map[uint64]conversationRow
+	messages      map[uint64]messageRow
+	idempotency   map[idempotencyKey]domain.IdempotencyRecord
+	emailDomains  map[emailDomainKey]struct{}
+	invitations   map[uint64]invitationRow
+	joinRequests  map[uint64]joinRequestRow
+	relations     map[relationKey]struct{}
+	reports       map[uint64]reportRow
+	auditEvents   map[uint64]auditEventRow
+	usage         map[uint64]usageRow
+}
+
+func newTables() *tables {
+	return &tables{
+		lastID:        map[string]uint64{},
+		plans:         defaultPlans(),
+		tenants:       map[uint64]tenantRow{},
+		tenantDomains: map[tenantDomainKey]tenantDomainRow{},
+		users:         map[uint64]userRow{},
+		memberships:   map[membershipKey]membershipRow{},
+		removals:      map[membershipKey]struct{}{},
+		posts:         map[uint64]postRow{},
+		comments:      map[uint64]commentRow{},
+		reactions:     map[reactionKey]struct{}{},
+		conversations: map[uint64]conversationRow{},
+		messages:      map[uint64]messageRow{},
+		idempotency:   map[idempotencyKey]domain.IdempotencyRecord{},
+		emailDomains:  map[emailDomainKey]struct{}{},
+		invitations:   map[uint64]invitationRow{},
+		joinRequests:  map[uint64]joinRequestRow{},
+		relations:     map[relationKey]struct{}{},
+		reports:       map[uint64]reportRow{},
+		auditEvents:   map[uint64]auditEventRow{},
+		usage:         map[uint64]usageRow{},
+	}
+}
+
+func (t *tables) clone() *tables {
+	return &tables{
+		lastID:        maps.Clone(t.lastID),
+		plans:         maps.Clone(t.plans),
+		tenants:       maps.Clone(t.tenants),
+		tenantDomains: maps.Clone(t.tenantDomains),
+		users:         maps.Clone(t.users),
+		memberships:   maps.Clone(t.memberships),
+		removals:      maps.Clone(t.removals),
+		posts:         maps.Clone(t.posts),
+		comments:      maps.Clone(t.comments),
+		reactions:     maps.Clone(t.reactions),
+		conversations: maps.Clone(t.conversations),
+		messages:      maps.Clone(t.messages),
+		idempotency:   maps.Clone(t.idempotency),
+		emailDomains:  maps.Clone(t.emailDomains),
+		invitations:   maps.Clone(t.invitations),
+		joinRequests:  maps.Clone(t.joinRequests),
+		relations:     maps.Clone(t.relations),
+		reports:       maps.Clone(t.reports),
+		auditEvents:   maps.Clone(t.auditEvents),
+		usage:         maps.Clone(t.usage),
+	}
+}
+
+// nextID returns the next auto-increment value of table.
+func (t *tables) nextID(table string) uint64 {
+	t.lastID[table]++
+	return t.lastID[table]
+}
+
+// defaultPlans mirrors the plans seeded by the 0002_tenant_plans migration.
+func defaultPlans() map[string]domain.Plan {
+	return map[string]domain.Plan{
+		"free":       {Name: "free", PostsPerMinute: 10, CommentsPerMinute: 20, MessagesPerMinute: 20, DailyPosts: 200, StorageBytes: 50 << 20, MaxMembers: 50},
+		"pro":        {Name: "pro", PostsPerMinute: 30, CommentsPerMinute: 60, MessagesPerMinute: 60, DailyPosts: 2000, StorageBytes: 5 << 30, MaxMembers: 500},
+		"enterprise": {Name: "enterprise", PostsPerMinute: 120, CommentsPerMinute: 240, MessagesPerMinute: 240},
+	}
+}
+
+// memStore provides all repositories over one set of tables.
+type memStore struct {
+	mu  *sync.Mutex
+	db  *tables
+	now func() time.Time
+	// inTx is set on the store passed to ExecTx callbacks, which already holds mu.
+	inTx bool
+}
+
+// StoreOption configures a Store created by NewStore.
+type StoreOption func(*memStore)
+
+// WithClock sets the function used for created_at timestamps. It defaults to time.Now.
+func WithClock(now func() time.Time) StoreOption {
+	return func(s *memStore) {
+		s.now = now
+	}
+}
+
+// WithPlans replaces the definitions of the plans with the same names as plans.
+func WithPlans(plans ...domain.Plan) StoreOption {
+	return func(s *memStore) {
+		for _, p := range plans {
+			s.db.plans[p.Name] = p
+		}
+	}
+}
+
+// NewStore creates a new, empty Store with the default plans.
+func NewStore(opts ...StoreOption) port.Store {
+	s := &memStore{mu: &sync.Mutex{}, db: newTables(), now: time.Now}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// ExecTx runs fn against a snapshot of the tables and keeps its changes only if fn succeeds.
+// Transactions are serialized with all other operations on the store.
+func (s *memStore) ExecTx(ctx context.Context, fn func(port.Store) error) error {
+	if s.inTx {
+		return fn(s)
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	tx := &memStore{mu: s.mu, db: s.db.clone(), now: s.now, inTx: true}
+	if err := fn(tx); err != nil {
+		return err
+	}
+	s.db = tx.db
+	return nil
+}
+
+// lock acquires the store for one operation and returns the tables to use.
+// The caller must call unlock when done.
+func (s *memStore) lock() *tables {
+	if !s.inTx {
+		s.mu.Lock()
+	}
+	return s.db
+}
+
+func (s *memStore) unlock() {
+	if !s.inTx {
+		s.mu.Unlock()
+	}
+}
+
+// timestamp returns the current time at the precision of a MySQL TIMESTAMP column.
+func (s *memStore) timestamp() time.Time {
+	return s.now().UTC().Truncate(time.Second)
+}
+
+func (s *memStore) AuthRepository() port.AuthRepository {
+	return &authRepository{s: s}
+}
+
+func (s *memStore) ProfileRepository() port.ProfileRepository {
+	return &profileRepository{s: s}
+}
+
+func (s *memStore) RelationRepository() port.RelationRepository {
+	return &relationRepository{s: s}
+}
+
+func (s *memStore) ModerationRepository() port.ModerationRepository {
+	return &moderationRepository{s: s}
+}
+
+func (s *memStore) TimelineRepository() port.TimelineRepository {
+	return &timelineRepository{s: s}
+}
+
+func (s *memStore) ReactionRepository() port.ReactionRepository {
+	return &reactionRepository{s: s}
+}
+
+func (s *memStore) DMRepository() port.DMRepository {
+	return &dmRepository{s: s}
+}
+
+func (s *memStore) PlanRepository() port.PlanRepository {
+	return &planRepository{s: s}
+}
+
+func (s *memStore) IdempotencyRepository() port.IdempotencyRepository {
+	return &idempotencyRepository{s: s}
+}
+
+func (s *memStore) InvitationRepository() port.InvitationRepository {
+	return &invitationRepository{s: s}
+}
+
+func (s *memStore) BulkRepository() port.BulkRepository {
+	return &bulkRepository{s: s}
+}
+
+// referenced returns the NotFound error the SQL adapters return for a foreign key violation.
+func referenced(resource string) error {
+	return domain.NewNotFoundError("referenced "+resource, nil)
+}
@@ -0,0 +1,15 @@
+package memory_test
+
+import (
+	"testing"
+
+	"github.com/example/something-like-sns/apps/api/internal/adapter/repository/memory"
+	"github.com/example/something-like-sns/apps/api/internal/adapter/repository/repotest"
+	"github.com/example/something-like-sns/apps/api/internal/port"
+)
+
+func TestStoreContract(t *testing.T) {
+	repotest.Run(t, func(t *testing.T) port.Store {
+		return memory.NewStore()
+	})
+}
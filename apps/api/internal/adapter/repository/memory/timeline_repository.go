@@ -0,0 +1,129 @@
+package memory
+
+import (
+	"context"
+	"time"
+
+	"github.com/example/something-like-sns/apps/api/internal/domain"
+)
+
+type timelineRepository struct {
+	s *memStore
+}
+
+func (r *timelineRepository) CreatePost(ctx context.Context, tenantID, authorID uint64, body string) (*domain.Post, error) {
+	db := r.s.lock()
+	defer r.s.unlock()
+
+	if !exists(db.tenants, tenantID) || !exists(db.users, authorID) {
+		return nil, referenced("post")
+	}
+	p := postRow{ID: db.nextID("posts"), TenantID: tenantID, AuthorID: authorID, Body: body, CreatedAt: r.s.timestamp()}
+	db.posts[p.ID] = p
+	r.s.addUsage(db, tenantID, 1, len(body), 0)
+	return &domain.Post{ID: p.ID, AuthorUserID: authorID, Body: body, CreatedAt: p.CreatedAt}, nil
+}
+
+func (r *timelineRepository) FindPostByID(ctx context.Context, tenantID, postID uint64) (*domain.Post, error) {
+	db := r.s.lock()
+	defer r.s.unlock()
+
+	p, ok := db.posts[postID]
+	if !ok || p.TenantID != tenantID || p.Deleted {
+		return nil, domain.NewNotFoundError("post", nil)
+	}
+	return &domain.Post{ID: p.ID, AuthorUserID: p.AuthorID, Body: p.Body, CreatedAt: p.CreatedAt}, nil
+}
+
+func (r *timelineRepository) FindFeed(ctx context.Context, tenantID, userID uint64, limit int, cursor domain.Cursor) ([]*domain.Post, bool, error) {
+	db := r.s.lock()
+	defer r.s.unlock()
+
+	var rows []postRow
+	for _, p := range db.posts {
+		if p.TenantID == tenantID && !p.Deleted && !db.hides(tenantID, userID, p.AuthorID) {
+			rows = append(rows, p)
+		}
+	}
+	rows, hasMore := page(rows, limit, cursor, true, func(p postRow) (time.Time, uint64) { return p.CreatedAt, p.ID })
+
+	items := make([]*domain.Post, len(rows))
+	for i, p := range rows {
+		post := &domain.Post{ID: p.ID, AuthorUserID: p.AuthorID, Body: p.Body, CreatedAt: p.CreatedAt}
+		for key := range db.reactions {
+			if key.TenantID == tenantID && key.TargetType == domain.ReactionTargetPost && key.TargetID == p.ID {
+				post.LikeCount++
+				post.LikedByMe = post.LikedByMe || key.UserID == userID
+			}
+		}
+		for _, c := range db.comments {
+			if c.TenantID == tenantID && c.PostID == p.ID && !c.Deleted {
+				post.CommentCount++
+			}
+		}
+		items[i] = post
+	}
+	return items, hasMore, nil
+}
+
+func (r *timelineRepository) CreateComment(ctx context.Context, tenantID, postID, authorID uint64, body string) (*domain.Comment, error) {
+	db := r.s.lock()
+	defer r.s.unlock()
+
+	if !exists(db.tenants, tenantID) || !exists(db.posts, postID) || !exists(db.users, authorID) {
+		return nil, referenced("comment")
+	}
+	c := commentRow{ID: db.nextID("comments"), TenantID: tenantID, PostID: postID, AuthorID: authorID, Body: body, CreatedAt: r.s.timestamp()}
+	db.comments[c.ID] = c
+	r.s.addUsage(db, tenantID, 0, len(body), 0)
+	return &domain.Comment{ID: c.ID, PostID: postID, AuthorUserID: authorID, Body: body, CreatedAt: c.CreatedAt}, nil
+}
+
+func (r *timelineRepository) FindCommentByID(ctx context.Context, tenantID, commentID uint64) (*domain.Comment, error) {
+	db := r.s.lock()
+	defer r.s.unlock()
+
+	c, ok := db.comments[commentID]
+	if !ok || c.TenantID != tenantID || c.Deleted {
+		return nil, domain.NewNotFoundError("comment", nil)
+	}
+	return &domain.Comment{ID: c.ID, PostID: c.PostID, AuthorUserID: c.AuthorID, Body: c.Body, CreatedAt: c.CreatedAt}, nil
+}
+
+func (r *timelineRepository) FindCommentsByPostID(ctx context.Context, tenantID, postID uint64, limit int, cursor domain.Cursor) ([]*domain.Comment, bool, error) {
+	db := r.s.lock()
+	defer r.s.unlock()
+
+	var rows []commentRow
+	for _, c := range db.comments {
+		if c.TenantID == tenantID && c.PostID == postID && !c.Deleted {
+			rows = append(rows, c)
+		}
+	}
+	rows, hasMore := page(rows, limit, cursor, false, func(c commentRow) (time.Time, uint64) { return c.CreatedAt, c.ID })
+
+	items := make([]*domain.Comment, len(rows))
+	for i, c := range rows {
+		items[i] = &domain.Comment{ID: c.ID, PostID: c.PostID, AuthorUserID: c.AuthorID, Body: c.Body, CreatedAt: c.CreatedAt}
+	}
+	return items, hasMore, nil
+}
+
+func (r *timelineRepository) CountComments(ctx context.Context, tenantID, postID uint64) (uint64, error) {
+	db := r.s.lock()
+	defer r.s.unlock()
+
+	var n uint64
+	for _, c := range db.comments {
+		if c.TenantID == tenantID && c.PostID == postID && !c.Deleted {
+			n++
+		}
+	}
+	return n, nil
+}
+
+// exists reports whether table has a row with the given ID.
+func exists[T any](table map[uint64]T, id uint64) bool {
+	_, ok := table[id]
+	return ok
+}
@@ -0,0 +1,63 @@
+package migrate
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// lockName names the advisory lock held while migrating.
+const lockName = "sns_schema_migrations"
+
+// dialect holds what differs between databases. Locks are session-level and wait until ctx is
+// done; they are released with the session if the process dies.
+type dialect struct {
+	insertVersion    string
+	lock             func(ctx context.Context, conn *sql.Conn) error
+	unlock           func(ctx context.Context, conn *sql.Conn) error
+	isUndefinedTable func(err error) bool
+}
+
+var dialects = map[string]dialect{
+	"mysql": {
+		insertVersion: "INSERT INTO schema_migrations (version, dirty) VALUES (?, ?)",
+		lock: func(ctx context.Context, conn *sql.Conn) error {
+			var ok sql.NullInt64
+			// A negative timeout waits indefinitely.
+			if err := conn.QueryRowContext(ctx, "SELECT GET_LOCK(?, -1)", lockName).Scan(&ok); err != nil {
+				return err
+			}
+			if ok.Int64 != 1 {
+				return fmt.Errorf("GET_LOCK(%q) returned %v", lockName, ok)
+			}
+			return nil
+		},
+		unlock: func(ctx context.Context, conn *sql.Conn) error {
+			_, err := conn.ExecContext(ctx, "DO RELEASE_LOCK(?)", lockName)
+			return err
+		},
+		isUndefinedTable: func(err error) bool {
+			var myErr *mysql.MySQLError
+			return errors.As(err, &myErr) && myErr.Number == 1146 // ER_NO_SUCH_TABLE
+		},
+	},
+	"postgres": {
+		insertVersion: "INSERT INTO schema_migrations (version, dirty) VALUES ($1, $2)",
+		lock: func(ctx context.Context, conn *sql.Conn) error {
+			_, err := conn.ExecContext(ctx, "SELECT pg_advisory_lock(hashtext($1))", lockName)
+			return err
+		},
+		unlock: func(ctx context.Context, conn *sql.Conn) error {
+			_, err := conn.ExecContext(ctx, "SELECT pg_advisory_unlock(hashtext($1))", lockName)
+			return err
+		},
+		isUndefinedTable: func(err error) bool {
+			var pgErr *pgconn.PgError
+			return errors.As(err, &pgErr) && pgErr.Code == "42P01" // undefined_table
+		},
+	},
+}
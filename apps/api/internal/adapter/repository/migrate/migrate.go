@@ -0,0 +1,328 @@
+// Package migrate applies the schema migrations embedded in the SQL repository adapters. It reads
+// and writes the schema_migrations table in golang-migrate's format, so databases migrated with
+// the migrate CLI carry over, and holds a database advisory lock while migrating so that
+// concurrently started tasks apply each migration once.
+package migrate
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io/fs"
+	"log/slog"
+	"regexp"
+	"sort"
+	"strconv"
+)
+
+// ErrDirty is returned when a previous migration failed halfway. The schema must be repaired by
+// hand and the version set with Force.
+var ErrDirty = errors.New("schema is dirty")
+
+// ErrBehind is returned by Check when migrations are pending.
+var ErrBehind = errors.New("schema is behind")
+
+// Migration is one version of the schema.
+type Migration struct {
+	Version uint64
+	Name    string
+	up      string
+	down    string
+}
+
+// String returns the file name stem, e.g. "0001_init_schema".
+func (m Migration) String() string {
+	return fmt.Sprintf("%04d_%s", m.Version, m.Name)
+}
+
+// Status is the state of the schema.
+type Status struct {
+	// Version is the applied version, 0 if none.
+	Version uint64
+	// Dirty is set when the migration to Version failed.
+	Dirty bool
+	// Migrations are all known migrations, in order.
+	Migrations []Migration
+}
+
+// Latest returns the version of the last known migration, 0 if none.
+func (s Status) Latest() uint64 {
+	if len(s.Migrations) == 0 {
+		return 0
+	}
+	return s.Migrations[len(s.Migrations)-1].Version
+}
+
+// Pending returns the migrations above the applied version.
+func (s Status) Pending() []Migration {
+	var pending []Migration
+	for _, m := range s.Migrations {
+		if m.Version > s.Version {
+			pending = append(pending, m)
+		}
+	}
+	return pending
+}
+
+// Migrator applies the migrations of one database.
+type Migrator struct {
+	db         *sql.DB
+	dialect    dialect
+	fsys       fs.FS
+	migrations []Migration
+}
+
+// New returns a Migrator for db, opened with driver "mysql" or "postgres", that applies the
+// migrations in the root of fsys. Files are named like golang-migrate's:
+// "0001_init_schema.up.sql" and the optional "0001_init_schema.down.sql". A file may hold several
+// statements, so MySQL connections need multiStatements=true.
+func New(db *sql.DB, driver string, fsys fs.FS) (*Migrator, error) {
+	d, ok := dialects[driver]
+	if !ok {
+		return nil, fmt.Errorf("migrate: unsupported driver %q", driver)
+	}
+	return newMigrator(db, d, fsys)
+}
+
+func newMigrator(db *sql.DB, d dialect, fsys fs.FS) (*Migrator, error) {
+	migrations, err := parse(fsys)
+	if err != nil {
+		return nil, err
+	}
+	return &Migrator{db: db, dialect: d, fsys: fsys, migrations: migrations}, nil
+}
+
+var fileName = regexp.MustCompile(`^(\d+)_(.+)\.(up|down)\.sql$`)
+
+func parse(fsys fs.FS) ([]Migration, error) {
+	entries, err := fs.ReadDir(fsys, ".")
+	if err != nil {
+		return nil, fmt.Errorf("migrate: %w", err)
+	}
+	byVersion := map[uint64]*Migration{}
+	for _, e := range entries {
+		m := fileName.FindStringSubmatch(e.Name())
+		if e.IsDir() || m == nil {
+			continue
+		}
+		version, err := strconv.ParseUint(m[1], 10, 64)
+		if err != nil || version == 0 {
+			return nil, fmt.Errorf("migrate: invalid version in %s", e.Name())
+		}
+		mig, ok := byVersion[version]
+		if !ok {
+			mig = &Migration{Version: version, Name: m[2]}
+			byVersion[version] = mig
+		}
+		if mig.Name != m[2] {
+			return nil, fmt.Errorf("migrate: version %d has two names, %s and %s", version, mig.Name, m[2])
+		}
+		if m[3] == "up" {
+			mig.up = e.Name()
+		} else {
+			mig.down = e.Name()
+		}
+	}
+
+	migrations := make([]Migration, 0, len(byVersion))
+	for _, mig := range byVersion {
+		if mig.up == "" {
+			return nil, fmt.Errorf("migrate: %s has no up migration", mig)
+		}
+		migrations = append(migrations, *mig)
+	}
+	sort.Slice(migrations, func(i, j int) bool { return migrations[i].Version < migrations[j].Version })
+	return migrations, nil
+}
+
+// Status returns the applied version and the known migrations.
+func (m *Migrator) Status(ctx context.Context) (Status, error) {
+	conn, err := m.db.Conn(ctx)
+	if err != nil {
+		return Status{}, err
+	}
+	defer conn.Close()
+	version, dirty, err := m.version(ctx, conn)
+	if err != nil {
+		return Status{}, err
+	}
+	return Status{Version: version, Dirty: dirty, Migrations: m.migrations}, nil
+}
+
+// Check returns ErrBehind if migrations are pending and ErrDirty if the last one failed. A
+// schema ahead of the known migrations passes, so an older release keeps serving while a newer
+// one is rolled out.
+func (m *Migrator) Check(ctx context.Context) error {
+	s, err := m.Status(ctx)
+	if err != nil {
+		return err
+	}
+	if s.Dirty {
+		return fmt.Errorf("%w at version %d", ErrDirty, s.Version)
+	}
+	if s.Version < s.Latest() {
+		return fmt.Errorf("%w: version %d, want %d", ErrBehind, s.Version, s.Latest())
+	}
+	return nil
+}
+
+// Up applies all pending migrations.
+func (m *Migrator) Up(ctx context.Context) error {
+	return m.locked(ctx, func(conn *sql.Conn, version uint64) error {
+		for _, mig := range m.migrations {
+			if mig.Version <= version {
+				continue
+			}
+			if err := m.run(ctx, conn, mig.up, mig.Version); err != nil {
+				return fmt.Errorf("migrate up %s: %w", mig, err)
+			}
+			slog.InfoContext(ctx, "applied migration", slog.String("migration", mig.String()))
+		}
+		return nil
+	})
+}
+
+// Down rolls back the last n applied migrations.
+func (m *Migrator) Down(ctx context.Context, n int) error {
+	return m.locked(ctx, func(conn *sql.Conn, version uint64) error {
+		for ; n > 0 && version > 0; n-- {
+			i := m.index(version)
+			if i < 0 {
+				return fmt.Errorf("migrate down: applied version %d is unknown to this release", version)
+			}
+			mig := m.migrations[i]
+			if mig.down == "" {
+				return fmt.Errorf("migrate down %s: no down migration", mig)
+			}
+			version = 0
+			if i > 0 {
+				version = m.migrations[i-1].Version
+			}
+			if err := m.run(ctx, conn, mig.down, version); err != nil {
+				return fmt.Errorf("migrate down %s: %w", mig, err)
+			}
+			slog.InfoContext(ctx, "rolled back migration", slog.String("migration", mig.String()))
+		}
+		return nil
+	})
+}
+
+// Force records version as applied and clean without running any migration, after a dirty
+// schema was repaired by hand. Version 0 records that no migration is applied.
+func (m *Migrator) Force(ctx context.Context, version uint64) error {
+	if version != 0 && m.index(version) < 0 {
+		return fmt.Errorf("migrate force: unknown version %d", version)
+	}
+	conn, err := m.db.Conn(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	if err := m.lock(ctx, conn); err != nil {
+		return err
+	}
+	defer m.unlock(ctx, conn)
+	return m.setVersion(ctx, conn, version, false)
+}
+
+func (m *Migrator) index(version uint64) int {
+	for i, mig := range m.migrations {
+		if mig.Version == version {
+			return i
+		}
+	}
+	return -1
+}
+
+// locked runs fn on a connection holding the migration lock, with the applied version.
+func (m *Migrator) locked(ctx context.Context, fn func(conn *sql.Conn, version uint64) error) error {
+	conn, err := m.db.Conn(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	if err := m.lock(ctx, conn); err != nil {
+		return err
+	}
+	defer m.unlock(ctx, conn)
+
+	version, dirty, err := m.version(ctx, conn)
+	if err != nil {
+		return err
+	}
+	if dirty {
+		return fmt.Errorf("%w at version %d; repair it and run force", ErrDirty, version)
+	}
+	return fn(conn, version)
+}
+
+func (m *Migrator) lock(ctx context.Context, conn *sql.Conn) error {
+	if err := m.dialect.lock(ctx, conn); err != nil {
+		return fmt.Errorf("migrate: acquire lock: %w", err)
+	}
+	if _, err := conn.ExecContext(ctx, createTable); err != nil {
+		m.unlock(ctx, conn)
+		return err
+	}
+	return nil
+}
+
+func (m *Migrator) unlock(ctx context.Context, conn *sql.Conn) {
+	if err := m.dialect.unlock(context.WithoutCancel(ctx), conn); err != nil {
+		slog.ErrorContext(ctx, "migrate: release lock failed", slog.Any("error", err))
+	}
+}
+
+// run executes the migration file name and records version. The version is marked dirty until
+// the file has run, as DDL cannot be rolled back on every database.
+func (m *Migrator) run(ctx context.Context, conn *sql.Conn, name string, version uint64) error {
+	stmts, err := fs.ReadFile(m.fsys, name)
+	if err != nil {
+		return err
+	}
+	if err := m.setVersion(ctx, conn, version, true); err != nil {
+		return err
+	}
+	if _, err := conn.ExecContext(ctx, string(stmts)); err != nil {
+		return err
+	}
+	return m.setVersion(ctx, conn, version, false)
+}
+
+// createTable is golang-migrate's schema_migrations table, which holds at most one row.
+const createTable = "CREATE TABLE IF NOT EXISTS schema_migrations (version BIGINT NOT NULL PRIMARY KEY, dirty BOOLEAN NOT NULL)"
+
+func (m *Migrator) version(ctx context.Context, conn *sql.Conn) (uint64, bool, error) {
+	var version int64
+	var dirty bool
+	err := conn.QueryRowContext(ctx, "SELECT version, dirty FROM schema_migrations LIMIT 1").Scan(&version, &dirty)
+	if errors.Is(err, sql.ErrNoRows) || m.dialect.isUndefinedTable(err) {
+		return 0, false, nil
+	}
+	if err != nil {
+		return 0, false, err
+	}
+	// golang-migrate records -1 for no version.
+	if version < 0 {
+		return 0, dirty, nil
+	}
+	return uint64(version), dirty, nil
+}
+
+func (m *Migrator) setVersion(ctx context.Context, conn *sql.Conn, version uint64, dirty bool) error {
+	tx, err := conn.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	if _, err := tx.ExecContext(ctx, "DELETE FROM schema_migrations"); err != nil {
+		return err
+	}
+	if version > 0 || dirty {
+		if _, err := tx.ExecContext(ctx, m.dialect.insertVersion, int64(version), dirty); err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
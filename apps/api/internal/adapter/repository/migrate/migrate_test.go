@@ -0,0 +1,171 @@
+package migrate
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"io/fs"
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	"github.com/example/something-like-sns/apps/api/internal/adapter/repository/mysql"
+	"github.com/example/something-like-sns/apps/api/internal/adapter/repository/postgres"
+	"github.com/example/something-like-sns/apps/api/internal/adapter/repository/sqlite"
+)
+
+// sqliteDialect runs the migrator on SQLite, which needs no lock as it has a single writer.
+var sqliteDialect = dialect{
+	insertVersion: "INSERT INTO schema_migrations (version, dirty) VALUES (?, ?)",
+	lock:          func(context.Context, *sql.Conn) error { return nil },
+	unlock:        func(context.Context, *sql.Conn) error { return nil },
+	isUndefinedTable: func(err error) bool {
+		return err != nil && strings.Contains(err.Error(), "no such table")
+	},
+}
+
+func newTestMigrator(t *testing.T, fsys fs.FS) (*Migrator, *sql.DB) {
+	t.Helper()
+	db, err := sqlite.Open(":memory:")
+	if err != nil {
+		t.Fatalf("open: %v", err)
+	}
+	t.Cleanup(func() { db.Close() })
+	m, err := newMigrator(db, sqliteDialect, fsys)
+	if err != nil {
+		t.Fatalf("new migrator: %v", err)
+	}
+	return m, db
+}
+
+func file(s string) *fstest.MapFile { return &fstest.MapFile{Data: []byte(s)} }
+
+func hasTable(t *testing.T, db *sql.DB, name string) bool {
+	t.Helper()
+	var n int
+	if err := db.QueryRow("SELECT COUNT(*) FROM sqlite_master WHERE type='table' AND name=?", name).Scan(&n); err != nil {
+		t.Fatalf("query: %v", err)
+	}
+	return n == 1
+}
+
+func TestUpDown(t *testing.T) {
+	ctx := context.Background()
+	m, db := newTestMigrator(t, fstest.MapFS{
+		"0001_a.up.sql":   file("CREATE TABLE a (id INTEGER)"),
+		"0001_a.down.sql": file("DROP TABLE a"),
+		"0002_b.up.sql":   file("CREATE TABLE b (id INTEGER); CREATE TABLE c (id INTEGER)"),
+		"0002_b.down.sql": file("DROP TABLE c; DROP TABLE b"),
+		"README.md":       file("ignored"),
+	})
+
+	s, err := m.Status(ctx)
+	if err != nil {
+		t.Fatalf("status: %v", err)
+	}
+	if s.Version != 0 || s.Latest() != 2 || len(s.Pending()) != 2 {
+		t.Fatalf("status before up = version %d, latest %d, %d pending", s.Version, s.Latest(), len(s.Pending()))
+	}
+	if err := m.Check(ctx); !errors.Is(err, ErrBehind) {
+		t.Fatalf("check before up = %v, want ErrBehind", err)
+	}
+
+	if err := m.Up(ctx); err != nil {
+		t.Fatalf("up: %v", err)
+	}
+	if err := m.Check(ctx); err != nil {
+		t.Fatalf("check after up: %v", err)
+	}
+	if !hasTable(t, db, "a") || !hasTable(t, db, "c") {
+		t.Fatal("tables missing after up")
+	}
+	if err := m.Up(ctx); err != nil {
+		t.Fatalf("second up: %v", err)
+	}
+
+	if err := m.Down(ctx, 1); err != nil {
+		t.Fatalf("down: %v", err)
+	}
+	if s, _ := m.Status(ctx); s.Version != 1 {
+		t.Errorf("version after down 1 = %d, want 1", s.Version)
+	}
+	if hasTable(t, db, "b") || !hasTable(t, db, "a") {
+		t.Error("down 1 did not roll back only the last migration")
+	}
+	if err := m.Down(ctx, 5); err != nil {
+		t.Fatalf("down all: %v", err)
+	}
+	if s, _ := m.Status(ctx); s.Version != 0 || hasTable(t, db, "a") {
+		t.Errorf("version after down all = %d, want 0 and no tables", s.Version)
+	}
+}
+
+func TestDirtyAndForce(t *testing.T) {
+	ctx := context.Background()
+	m, _ := newTestMigrator(t, fstest.MapFS{
+		"0001_a.up.sql":      file("CREATE TABLE a (id INTEGER)"),
+		"0002_broken.up.sql": file("CREATE TABLE"),
+	})
+
+	if err := m.Up(ctx); err == nil {
+		t.Fatal("up with a broken migration succeeded")
+	}
+	s, err := m.Status(ctx)
+	if err != nil {
+		t.Fatalf("status: %v", err)
+	}
+	if s.Version != 2 || !s.Dirty {
+		t.Fatalf("status after failure = version %d, dirty %v; want 2, dirty", s.Version, s.Dirty)
+	}
+	if err := m.Check(ctx); !errors.Is(err, ErrDirty) {
+		t.Errorf("check = %v, want ErrDirty", err)
+	}
+	if err := m.Up(ctx); !errors.Is(err, ErrDirty) {
+		t.Errorf("up on a dirty schema = %v, want ErrDirty", err)
+	}
+
+	if err := m.Force(ctx, 9); err == nil {
+		t.Error("force to an unknown version succeeded")
+	}
+	if err := m.Force(ctx, 1); err != nil {
+		t.Fatalf("force: %v", err)
+	}
+	if s, _ := m.Status(ctx); s.Version != 1 || s.Dirty {
+		t.Errorf("status after force = version %d, dirty %v; want 1, clean", s.Version, s.Dirty)
+	}
+}
+
+func TestParseRejectsInvalidSets(t *testing.T) {
+	for name, fsys := range map[string]fstest.MapFS{
+		"down without up": {"0001_a.down.sql": file("")},
+		"two names":       {"0001_a.up.sql": file(""), "0001_b.up.sql": file("")},
+		"version zero":    {"0000_a.up.sql": file("")},
+	} {
+		if _, err := parse(fsys); err == nil {
+			t.Errorf("%s: parse succeeded", name)
+		}
+	}
+}
+
+// TestEmbeddedMigrations checks that the adapters' migrations are numbered without gaps and can
+// be rolled back.
+func TestEmbeddedMigrations(t *testing.T) {
+	for driver, fsys := range map[string]fs.FS{"mysql": mysql.Migrations(), "postgres": postgres.Migrations()} {
+		migrations, err := parse(fsys)
+		if err != nil {
+			t.Errorf("%s: %v", driver, err)
+			continue
+		}
+		if len(migrations) == 0 {
+			t.Errorf("%s: no migrations embedded", driver)
+		}
+		for i, m := range migrations {
+			if m.Version != uint64(i+1) {
+				t.Errorf("%s: %s is not numbered %d", driver, m, i+1)
+			}
+			if m.down == "" {
+				t.Errorf("%s: %s has no down migration", driver, m)
+			}
+		}
+	}
+}
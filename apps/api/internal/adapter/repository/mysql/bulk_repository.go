@@ -0,0 +1,129 @@
+package mysql
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/example/something-like-sns/apps/api/internal/adapter/repository/tenantguard"
+	"github.com/example/something-like-sns/apps/api/internal/domain"
+)
+
+// maxPlaceholders is the number of placeholders a prepared statement may have.
+const maxPlaceholders = 65535
+
+type bulkRepository struct {
+	q DBTX
+}
+
+func (r *bulkRepository) NextID(ctx context.Context, table string) (uint64, error) {
+	switch table {
+	case "users", "posts", "comments", "conversations", "messages":
+	default:
+		return 0, fmt.Errorf("bulk: unknown table %q", table)
+	}
+	// IDs are allocated across tenants.
+	ctx = tenantguard.AllowCrossTenant(ctx)
+	var next uint64
+	err := r.q.QueryRowContext(ctx, "SELECT COALESCE(MAX(id), 0) + 1 FROM "+table).Scan(&next)
+	return next, err
+}
+
+func (r *bulkRepository) InsertUsers(ctx context.Context, users []*domain.User) error {
+	args := make([]any, 0, 3*len(users))
+	for _, u := range users {
+		args = append(args, u.ID, u.AuthSub, u.DisplayName)
+	}
+	return r.insert(ctx, "user", "INSERT INTO users (id, auth_sub, display_name) VALUES", 3, args)
+}
+
+func (r *bulkRepository) InsertMemberships(ctx context.Context, tenantID uint64, members []*domain.Member) error {
+	args := make([]any, 0, 3*len(members))
+	for _, m := range members {
+		args = append(args, tenantID, m.UserID, m.Role)
+	}
+	if err := r.insert(ctx, "membership", "INSERT INTO tenant_memberships (tenant_id, user_id, role) VALUES", 3, args); err != nil {
+		return err
+	}
+	return addUsage(ctx, r.q, tenantID, 0, 0, len(members))
+}
+
+func (r *bulkRepository) InsertPosts(ctx context.Context, tenantID uint64, posts []*domain.Post) error {
+	args := make([]any, 0, 5*len(posts))
+	bytes := 0
+	for _, p := range posts {
+		bytes += len(p.Body)
+		args = append(args, p.ID, tenantID, p.AuthorUserID, p.Body, p.CreatedAt)
+	}
+	if err := r.insert(ctx, "post", "INSERT INTO posts (id, tenant_id, author_user_id, body, created_at) VALUES", 5, args); err != nil {
+		return err
+	}
+	// Bulk-loaded posts are history and do not count toward today's.
+	return addUsage(ctx, r.q, tenantID, 0, bytes, 0)
+}
+
+func (r *bulkRepository) InsertComments(ctx context.Context, tenantID uint64, comments []*domain.Comment) error {
+	args := make([]any, 0, 6*len(comments))
+	bytes := 0
+	for _, c := range comments {
+		bytes += len(c.Body)
+		args = append(args, c.ID, tenantID, c.PostID, c.AuthorUserID, c.Body, c.CreatedAt)
+	}
+	if err := r.insert(ctx, "comment", "INSERT INTO comments (id, tenant_id, post_id, author_user_id, body, created_at) VALUES", 6, args); err != nil {
+		return err
+	}
+	return addUsage(ctx, r.q, tenantID, 0, bytes, 0)
+}
+
+func (r *bulkRepository) InsertReactions(ctx context.Context, tenantID uint64, reactions []*domain.ReactionRecord) error {
+	args := make([]any, 0, 6*len(reactions))
+	for _, rec := range reactions {
+		args = append(args, tenantID, string(rec.TargetType), rec.TargetID, rec.UserID, rec.Type, rec.CreatedAt)
+	}
+	return r.insert(ctx, "reaction", "INSERT INTO reactions (tenant_id, target_type, target_id, user_id, type, created_at) VALUES", 6, args)
+}
+
+func (r *bulkRepository) InsertConversations(ctx context.Context, tenantID uint64, conversations []*domain.Conversation) error {
+	args := make([]any, 0, 3*len(conversations))
+	var members []any
+	for _, c := range conversations {
+		args = append(args, c.ID, tenantID, c.CreatedAt)
+		for _, userID := range c.MemberUserIDs {
+			members = append(members, c.ID, userID, c.CreatedAt)
+		}
+	}
+	if err := r.insert(ctx, "conversation", "INSERT INTO conversations (id, tenant_id, created_at) VALUES", 3, args); err != nil {
+		return err
+	}
+	// conversation_members has no tenant_id; the conversations were created for tenantID just above.
+	return r.insert(tenantguard.AllowCrossTenant(ctx), "conversation member", "INSERT INTO conversation_members (conversation_id, user_id, joined_at) VALUES", 3, members)
+}
+
+func (r *bulkRepository) InsertMessages(ctx context.Context, tenantID uint64, messages []*domain.Message) error {
+	args := make([]any, 0, 6*len(messages))
+	bytes := 0
+	for _, m := range messages {
+		bytes += len(m.Body)
+		args = append(args, m.ID, tenantID, m.ConversationID, m.SenderUserID, m.Body, m.CreatedAt)
+	}
+	if err := r.insert(ctx, "message", "INSERT INTO messages (id, tenant_id, conversation_id, sender_user_id, body, created_at) VALUES", 6, args); err != nil {
+		return err
+	}
+	return addUsage(ctx, r.q, tenantID, 0, bytes, 0)
+}
+
+// insert runs the INSERT ... VALUES statement prefix for args, holding width values per row, in
+// as few multi-row statements as the placeholder limit allows.
+func (r *bulkRepository) insert(ctx context.Context, resource, prefix string, width int, args []any) error {
+	rowsPerStmt := maxPlaceholders / width
+	for len(args) > 0 {
+		n := min(len(args)/width, rowsPerStmt)
+		row := "(?" + strings.Repeat(",?", width-1) + ")"
+		stmt := prefix + " " + row + strings.Repeat(","+row, n-1)
+		if _, err := r.q.ExecContext(ctx, stmt, args[:n*width]...); err != nil {
+			return translateError(err, resource)
+		}
+		args = args[n*width:]
+	}
+	return nil
+}
@@ -3,10 +3,13 @@ package mysql
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 	"strings"
 	"time"
 
+	"github.com/example/something-like-sns/apps/api/internal/adapter/repository/sqlutil"
+	"github.com/example/something-like-sns/apps/api/internal/adapter/repository/tenantguard"
 	"github.com/example/something-like-sns/apps/api/internal/domain"
 )
 
@@ -21,18 +24,27 @@ func (r *dmRepository) FindDMConversation(ctx context.Context, tenantID, userID1
           JOIN conversation_members m2 ON m2.conversation_id=c.id AND m2.user_id=?
           WHERE c.tenant_id=? AND c.kind='dm' LIMIT 1`
 	err := r.q.QueryRowContext(ctx, q, userID1, userID2, tenantID).Scan(&convID)
-	if err != nil && err != sql.ErrNoRows {
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
 		return 0, err
 	}
 	return convID, nil
 }
 
+func (r *dmRepository) IsMember(ctx context.Context, tenantID, conversationID, userID uint64) (bool, error) {
+	var ok bool
+	err := r.q.QueryRowContext(ctx, `SELECT EXISTS(
+          SELECT 1 FROM conversations c
+          JOIN conversation_members m ON m.conversation_id=c.id AND m.user_id=?
+          WHERE c.tenant_id=? AND c.id=?)`, userID, tenantID, conversationID).Scan(&ok)
+	return ok, err
+}
+
 func (r *dmRepository) CreateDMConversation(ctx context.Context, tenantID uint64, userIDs ...uint64) (uint64, error) {
 	// This method will be called within a transaction from the usecase layer.
 	// The transaction is handled by the sqlStore.
 	res, err := r.q.ExecContext(ctx, "INSERT INTO conversations (tenant_id, kind) VALUES (?, 'dm')", tenantID)
 	if err != nil {
-		return 0, err
+		return 0, translateError(err, "conversation")
 	}
 	id, _ := res.LastInsertId()
 	convID := uint64(id)
@@ -44,56 +56,46 @@ func (r *dmRepository) CreateDMConversation(ctx context.Context, tenantID uint64
 		valueArgs = append(valueArgs, convID, userID)
 	}
 	stmt := fmt.Sprintf("INSERT INTO conversation_members (conversation_id, user_id) VALUES %s", strings.Join(valueStrings, ","))
-	
-	if _, err := r.q.ExecContext(ctx, stmt, valueArgs...); err != nil {
-		return 0, err
+
+	// conversation_members has no tenant_id; the conversation was created for tenantID just above.
+	if _, err := r.q.ExecContext(tenantguard.AllowCrossTenant(ctx), stmt, valueArgs...); err != nil {
+		return 0, translateError(err, "conversation member")
 	}
 
 	return convID, nil
 }
 
-func (r *dmRepository) FindConversations(ctx context.Context, tenantID, userID uint64, limit int, cursorTime time.Time, cursorID uint64) ([]*domain.Conversation, error) {
-    var rows *sql.Rows
-    var err error
-    if cursorID == 0 {
-        rows, err = r.q.QueryContext(ctx, `
+func (r *dmRepository) FindConversations(ctx context.Context, tenantID, userID uint64, limit int, cursor domain.Cursor) ([]*domain.Conversation, bool, error) {
+	where, order, args := keyset("c.", cursor, true)
+	rows, err := r.q.QueryContext(ctx, `
             SELECT c.id, c.created_at
             FROM conversations c
             JOIN conversation_members m ON m.conversation_id=c.id AND m.user_id=?
-            WHERE c.tenant_id=?
-            ORDER BY c.created_at DESC, c.id DESC
-            LIMIT ?`, userID, tenantID, limit)
-    } else {
-        rows, err = r.q.QueryContext(ctx, `
-            SELECT c.id, c.created_at
-            FROM conversations c
-            JOIN conversation_members m ON m.conversation_id=c.id AND m.user_id=?
-            WHERE c.tenant_id=? AND (c.created_at < ? OR (c.created_at = ? AND c.id < ?))
-            ORDER BY c.created_at DESC, c.id DESC
-            LIMIT ?`, userID, tenantID, cursorTime, cursorTime, cursorID, limit)
-    }
+            WHERE c.tenant_id=?`+where+`
+            `+order+`
+            LIMIT ?`, append(append([]interface{}{userID, tenantID}, args...), limit+1)...)
 	if err != nil {
-		return nil, err
+		return nil, false, err
 	}
 	defer rows.Close()
 
-	items := make([]*domain.Conversation, 0, limit)
+	items := make([]*domain.Conversation, 0, limit+1)
 	for rows.Next() {
 		var conv domain.Conversation
 		if err := rows.Scan(&conv.ID, &conv.CreatedAt); err != nil {
-			return nil, err
+			return nil, false, err
 		}
 		// Fetch members
-		mrows, err := r.q.QueryContext(ctx, "SELECT user_id FROM conversation_members WHERE conversation_id=? ORDER BY user_id", conv.ID)
+		mrows, err := r.q.QueryContext(ctx, "SELECT m.user_id FROM conversation_members m JOIN conversations c ON c.id=m.conversation_id WHERE c.tenant_id=? AND m.conversation_id=? ORDER BY m.user_id", tenantID, conv.ID)
 		if err != nil {
-			return nil, err
+			return nil, false, err
 		}
 		var members []uint64
 		for mrows.Next() {
 			var uid uint64
 			if err := mrows.Scan(&uid); err != nil {
 				_ = mrows.Close()
-				return nil, err
+				return nil, false, err
 			}
 			members = append(members, uid)
 		}
@@ -101,52 +103,62 @@ func (r *dmRepository) FindConversations(ctx context.Context, tenantID, userID u
 		conv.MemberUserIDs = members
 		items = append(items, &conv)
 	}
-	return items, rows.Err()
+	if err := rows.Err(); err != nil {
+		return nil, false, err
+	}
+	items, hasMore := sqlutil.TrimPage(items, limit, cursor)
+	return items, hasMore, nil
 }
 
-func (r *dmRepository) FindMessages(ctx context.Context, tenantID, conversationID uint64, limit int, cursorTime time.Time, cursorID uint64) ([]*domain.Message, error) {
-	var rows *sql.Rows
-	var err error
-	if cursorID == 0 {
-		rows, err = r.q.QueryContext(ctx, `
-            SELECT id, sender_user_id, body, created_at
-            FROM messages
-            WHERE tenant_id=? AND conversation_id=?
-            ORDER BY created_at DESC, id DESC
-            LIMIT ?`, tenantID, conversationID, limit)
-	} else {
-		rows, err = r.q.QueryContext(ctx, `
+func (r *dmRepository) FindMessageByID(ctx context.Context, tenantID, messageID uint64) (*domain.Message, error) {
+	var m domain.Message
+	err := r.q.QueryRowContext(ctx, "SELECT id, conversation_id, sender_user_id, body, created_at FROM messages WHERE tenant_id=? AND id=? AND deleted_at IS NULL", tenantID, messageID).Scan(&m.ID, &m.ConversationID, &m.SenderUserID, &m.Body, &m.CreatedAt)
+	if err != nil {
+		return nil, translateError(err, "message")
+	}
+	return &m, nil
+}
+
+func (r *dmRepository) FindMessages(ctx context.Context, tenantID, conversationID uint64, limit int, cursor domain.Cursor) ([]*domain.Message, bool, error) {
+	where, order, args := keyset("", cursor, true)
+	rows, err := r.q.QueryContext(ctx, `
             SELECT id, sender_user_id, body, created_at
             FROM messages
-            WHERE tenant_id=? AND conversation_id=? AND (created_at < ? OR (created_at = ? AND id < ?))
-            ORDER BY created_at DESC, id DESC
-            LIMIT ?`, tenantID, conversationID, cursorTime, cursorTime, cursorID, limit)
-	}
+            WHERE tenant_id=? AND conversation_id=? AND deleted_at IS NULL`+where+`
+            `+order+`
+            LIMIT ?`, append(append([]interface{}{tenantID, conversationID}, args...), limit+1)...)
 	if err != nil {
-		return nil, err
+		return nil, false, err
 	}
 	defer rows.Close()
 
-	items := make([]*domain.Message, 0, limit)
+	items := make([]*domain.Message, 0, limit+1)
 	for rows.Next() {
 		var msg domain.Message
 		if err := rows.Scan(&msg.ID, &msg.SenderUserID, &msg.Body, &msg.CreatedAt); err != nil {
-			return nil, err
+			return nil, false, err
 		}
 		msg.ConversationID = conversationID
 		items = append(items, &msg)
 	}
-	return items, rows.Err()
+	if err := rows.Err(); err != nil {
+		return nil, false, err
+	}
+	items, hasMore := sqlutil.TrimPage(items, limit, cursor)
+	return items, hasMore, nil
 }
 
 func (r *dmRepository) CreateMessage(ctx context.Context, tenantID, conversationID, senderID uint64, body string) (*domain.Message, error) {
 	resExec, err := r.q.ExecContext(ctx, "INSERT INTO messages (tenant_id, conversation_id, sender_user_id, body) VALUES (?,?,?,?)", tenantID, conversationID, senderID, body)
 	if err != nil {
+		return nil, translateError(err, "message")
+	}
+	if err := addUsage(ctx, r.q, tenantID, 0, len(body), 0); err != nil {
 		return nil, err
 	}
 	id, _ := resExec.LastInsertId()
 	var created time.Time
-	_ = r.q.QueryRowContext(ctx, "SELECT created_at FROM messages WHERE id=?", id).Scan(&created)
+	_ = r.q.QueryRowContext(ctx, "SELECT created_at FROM messages WHERE tenant_id=? AND id=?", tenantID, id).Scan(&created)
 	return &domain.Message{
 		ID:             uint64(id),
 		ConversationID: conversationID,
@@ -155,3 +167,12 @@ func (r *dmRepository) CreateMessage(ctx context.Context, tenantID, conversation
 		CreatedAt:      created,
 	}, nil
 }
+
+func (r *dmRepository) CountMessages(ctx context.Context, tenantID, conversationID uint64) (uint64, error) {
+	var n uint64
+	err := r.q.QueryRowContext(ctx, `
+            SELECT COUNT(*) FROM (
+                SELECT 1 FROM messages WHERE tenant_id=? AND conversation_id=? AND deleted_at IS NULL LIMIT ?
+            ) AS t`, tenantID, conversationID, sqlutil.CountCap).Scan(&n)
+	return n, err
+}
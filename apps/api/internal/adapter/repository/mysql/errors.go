@@ -0,0 +1,34 @@
+package mysql
+
+import (
+	"database/sql"
+	"errors"
+
+	driver "github.com/go-sql-driver/mysql"
+
+	"github.com/example/something-like-sns/apps/api/internal/domain"
+)
+
+// MySQL server error numbers translated into domain errors.
+const (
+	errDuplicateEntry  = 1062
+	errNoReferencedRow = 1452
+)
+
+// translateError maps sql.ErrNoRows and MySQL constraint violations on resource to domain errors.
+// Other errors are returned unchanged.
+func translateError(err error, resource string) error {
+	if errors.Is(err, sql.ErrNoRows) {
+		return domain.NewNotFoundError(resource, nil)
+	}
+	var me *driver.MySQLError
+	if errors.As(err, &me) {
+		switch me.Number {
+		case errDuplicateEntry:
+			return domain.NewConflictError(resource, "already exists")
+		case errNoReferencedRow:
+			return domain.NewNotFoundError("referenced "+resource, nil)
+		}
+	}
+	return err
+}
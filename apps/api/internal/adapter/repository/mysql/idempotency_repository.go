@@ -0,0 +1,60 @@
+package mysql
+
+import (
+	"context"
+	"time"
+
+	"github.com/example/something-like-sns/apps/api/internal/adapter/repository/tenantguard"
+	"github.com/example/something-like-sns/apps/api/internal/domain"
+)
+
+type idempotencyRepository struct {
+	q DBTX
+}
+
+func (r *idempotencyRepository) Find(ctx context.Context, tenantID, userID uint64, procedure, key string) (*domain.IdempotencyRecord, error) {
+	rec := domain.IdempotencyRecord{TenantID: tenantID, UserID: userID, Procedure: procedure, Key: key}
+	err := r.q.QueryRowContext(ctx, `
+        SELECT request_hash, response, expires_at
+        FROM idempotency_keys
+        WHERE tenant_id=? AND user_id=? AND procedure_name=? AND idem_key=?`,
+		tenantID, userID, procedure, key).Scan(&rec.RequestHash, &rec.Response, &rec.ExpiresAt)
+	if err != nil {
+		return nil, translateError(err, "idempotency key")
+	}
+	return &rec, nil
+}
+
+func (r *idempotencyRepository) Reserve(ctx context.Context, rec *domain.IdempotencyRecord) error {
+	_, err := r.q.ExecContext(ctx, `
+        INSERT INTO idempotency_keys (tenant_id, user_id, procedure_name, idem_key, request_hash, expires_at)
+        VALUES (?, ?, ?, ?, ?, ?)`,
+		rec.TenantID, rec.UserID, rec.Procedure, rec.Key, rec.RequestHash, rec.ExpiresAt)
+	return translateError(err, "idempotency key")
+}
+
+func (r *idempotencyRepository) Complete(ctx context.Context, tenantID, userID uint64, procedure, key string, response []byte) error {
+	_, err := r.q.ExecContext(ctx, `
+        UPDATE idempotency_keys SET response=?
+        WHERE tenant_id=? AND user_id=? AND procedure_name=? AND idem_key=?`,
+		response, tenantID, userID, procedure, key)
+	return err
+}
+
+func (r *idempotencyRepository) Delete(ctx context.Context, tenantID, userID uint64, procedure, key string) error {
+	_, err := r.q.ExecContext(ctx, `
+        DELETE FROM idempotency_keys
+        WHERE tenant_id=? AND user_id=? AND procedure_name=? AND idem_key=?`,
+		tenantID, userID, procedure, key)
+	return err
+}
+
+func (r *idempotencyRepository) DeleteExpired(ctx context.Context, now time.Time) (int64, error) {
+	// Expired keys are purged for every tenant at once.
+	ctx = tenantguard.AllowCrossTenant(ctx)
+	res, err := r.q.ExecContext(ctx, `DELETE FROM idempotency_keys WHERE expires_at <= ?`, now)
+	if err != nil {
+		return 0, err
+	}
+	return res.RowsAffected()
+}
@@ -0,0 +1,23 @@
+-- Per-tenant plans: rate limits and quotas
+
+-- plans
+CREATE TABLE IF NOT EXISTS plans (
+  name                VARCHAR(32) PRIMARY KEY,
+  posts_per_minute    INT UNSIGNED NOT NULL,
+  comments_per_minute INT UNSIGNED NOT NULL,
+  messages_per_minute INT UNSIGNED NOT NULL,
+  daily_posts         INT UNSIGNED NOT NULL,
+  storage_bytes       BIGINT UNSIGNED NOT NULL,
+  max_members         INT UNSIGNED NOT NULL,
+  created_at          TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- 0 means unlimited
+INSERT INTO plans (name, posts_per_minute, comments_per_minute, messages_per_minute, daily_posts, storage_bytes, max_members) VALUES
+  ('free',        10,  20,  20,  200,   52428800,   50),
+  ('pro',         30,  60,  60,  2000,  5368709120, 500),
+  ('enterprise',  120, 240, 240, 0,     0,          0);
+
+ALTER TABLE tenants
+  ADD COLUMN plan VARCHAR(32) NOT NULL DEFAULT 'free' AFTER name,
+  ADD CONSTRAINT fk_tenants_plan FOREIGN KEY (plan) REFERENCES plans(name);
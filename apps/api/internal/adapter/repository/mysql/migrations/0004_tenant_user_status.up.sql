@@ -0,0 +1,4 @@
+-- Disabled tenants and suspended users, set by snsctl
+
+ALTER TABLE tenants ADD COLUMN disabled_at TIMESTAMP NULL;
+ALTER TABLE users ADD COLUMN suspended_at TIMESTAMP NULL;
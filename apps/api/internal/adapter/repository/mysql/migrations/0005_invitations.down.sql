@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS join_requests;
+DROP TABLE IF EXISTS invitations;
+DROP TABLE IF EXISTS tenant_email_domains;
+ALTER TABLE tenants DROP COLUMN join_policy;
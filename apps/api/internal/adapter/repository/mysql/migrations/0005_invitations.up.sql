@@ -0,0 +1,39 @@
+-- Join policies, email domain allowlists, invitations and join requests
+
+ALTER TABLE tenants ADD COLUMN join_policy ENUM('open','invite','domain') NOT NULL DEFAULT 'invite';
+-- Existing tenants keep admitting anyone until an admin chooses a policy.
+UPDATE tenants SET join_policy = 'open';
+
+CREATE TABLE IF NOT EXISTS tenant_email_domains (
+  tenant_id    BIGINT NOT NULL,
+  domain       VARCHAR(255) NOT NULL,
+  PRIMARY KEY (tenant_id, domain),
+  CONSTRAINT fk_email_domains_tenant FOREIGN KEY (tenant_id) REFERENCES tenants(id)
+);
+
+CREATE TABLE IF NOT EXISTS invitations (
+  id           BIGINT PRIMARY KEY AUTO_INCREMENT,
+  tenant_id    BIGINT NOT NULL,
+  token_hash   BINARY(32) NOT NULL UNIQUE,
+  role         ENUM('owner','admin','member') NOT NULL,
+  created_by   BIGINT NOT NULL,
+  created_at   TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  expires_at   TIMESTAMP NOT NULL,
+  accepted_by  BIGINT NULL,
+  accepted_at  TIMESTAMP NULL,
+  INDEX idx_invitations_tenant (tenant_id, id),
+  CONSTRAINT fk_invitations_tenant FOREIGN KEY (tenant_id) REFERENCES tenants(id),
+  CONSTRAINT fk_invitations_creator FOREIGN KEY (created_by) REFERENCES users(id),
+  CONSTRAINT fk_invitations_acceptor FOREIGN KEY (accepted_by) REFERENCES users(id)
+);
+
+CREATE TABLE IF NOT EXISTS join_requests (
+  id           BIGINT PRIMARY KEY AUTO_INCREMENT,
+  tenant_id    BIGINT NOT NULL,
+  user_id      BIGINT NOT NULL,
+  message      VARCHAR(500) NOT NULL DEFAULT '',
+  created_at   TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  UNIQUE KEY uniq_join_request (tenant_id, user_id),
+  CONSTRAINT fk_join_requests_tenant FOREIGN KEY (tenant_id) REFERENCES tenants(id),
+  CONSTRAINT fk_join_requests_user FOREIGN KEY (user_id) REFERENCES users(id)
+);
@@ -0,0 +1 @@
+ALTER TABLE tenant_domains DROP COLUMN verified_at;
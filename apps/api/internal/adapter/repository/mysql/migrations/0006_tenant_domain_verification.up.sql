@@ -0,0 +1,5 @@
+-- Custom domains added by tenant admins only route requests once verified
+
+ALTER TABLE tenant_domains ADD COLUMN verified_at TIMESTAMP NULL;
+-- Existing domains were added by operators and are trusted.
+UPDATE tenant_domains SET verified_at = created_at;
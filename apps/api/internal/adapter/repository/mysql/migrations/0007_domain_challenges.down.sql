@@ -0,0 +1,2 @@
+ALTER TABLE tenants DROP COLUMN allow_subdomain_fallback;
+ALTER TABLE tenant_domains DROP COLUMN verification_token;
@@ -0,0 +1,4 @@
+-- DNS TXT challenges for custom domains, and whether tenants also resolve from <slug>.<any host>
+
+ALTER TABLE tenant_domains ADD COLUMN verification_token VARCHAR(64) NULL;
+ALTER TABLE tenants ADD COLUMN allow_subdomain_fallback BOOLEAN NOT NULL DEFAULT TRUE;
@@ -0,0 +1,3 @@
+DROP INDEX uniq_membership_handle ON tenant_memberships;
+ALTER TABLE tenant_memberships DROP COLUMN handle;
+ALTER TABLE users DROP COLUMN bio;
@@ -0,0 +1,5 @@
+-- User profiles: a bio next to the existing avatar_url, and a handle unique within each tenant
+
+ALTER TABLE users ADD COLUMN bio VARCHAR(280) NOT NULL DEFAULT '';
+ALTER TABLE tenant_memberships ADD COLUMN handle VARCHAR(30) NULL;
+CREATE UNIQUE INDEX uniq_membership_handle ON tenant_memberships (tenant_id, handle);
@@ -0,0 +1,2 @@
+ALTER TABLE tenant_memberships DROP COLUMN avatar_url;
+ALTER TABLE tenant_memberships DROP COLUMN display_name;
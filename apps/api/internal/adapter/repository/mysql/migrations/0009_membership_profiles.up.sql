@@ -0,0 +1,4 @@
+-- Per-tenant profiles: a display name and avatar that replace the user's own within one tenant
+
+ALTER TABLE tenant_memberships ADD COLUMN display_name VARCHAR(64) NULL;
+ALTER TABLE tenant_memberships ADD COLUMN avatar_url VARCHAR(512) NULL;
@@ -0,0 +1,14 @@
+-- Blocks and mutes, which members set on each other within a tenant
+
+CREATE TABLE IF NOT EXISTS user_relations (
+  tenant_id      BIGINT NOT NULL,
+  user_id        BIGINT NOT NULL,
+  target_user_id BIGINT NOT NULL,
+  kind           ENUM('block','mute') NOT NULL,
+  created_at     TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (tenant_id, user_id, target_user_id, kind),
+  INDEX idx_user_relations_target (tenant_id, target_user_id),
+  CONSTRAINT fk_user_relations_tenant FOREIGN KEY (tenant_id) REFERENCES tenants(id),
+  CONSTRAINT fk_user_relations_user FOREIGN KEY (user_id) REFERENCES users(id),
+  CONSTRAINT fk_user_relations_target FOREIGN KEY (target_user_id) REFERENCES users(id)
+);
@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS audit_events;
+DROP TABLE IF EXISTS content_reports;
+ALTER TABLE tenant_memberships DROP COLUMN suspended_at;
+ALTER TABLE messages DROP COLUMN deleted_at;
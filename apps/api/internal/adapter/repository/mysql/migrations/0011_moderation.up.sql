@@ -0,0 +1,42 @@
+-- Content reports, the moderation actions taken on them, and the audit trail of admin actions
+
+ALTER TABLE messages ADD COLUMN deleted_at TIMESTAMP NULL;
+ALTER TABLE tenant_memberships ADD COLUMN suspended_at TIMESTAMP NULL;
+
+CREATE TABLE IF NOT EXISTS content_reports (
+  id                  BIGINT PRIMARY KEY AUTO_INCREMENT,
+  tenant_id           BIGINT NOT NULL,
+  target_type         ENUM('post','comment','message') NOT NULL,
+  target_id           BIGINT NOT NULL,
+  author_user_id      BIGINT NOT NULL,
+  reporter_user_id    BIGINT NOT NULL,
+  reason              VARCHAR(500) NOT NULL DEFAULT '',
+  content             TEXT NOT NULL,
+  status              ENUM('open','resolved') NOT NULL DEFAULT 'open',
+  action              ENUM('dismiss','hide','delete','warn','suspend_author') NULL,
+  resolved_by_user_id BIGINT NULL,
+  resolved_at         TIMESTAMP NULL,
+  created_at          TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  UNIQUE KEY uniq_content_reports_reporter (tenant_id, target_type, target_id, reporter_user_id),
+  INDEX idx_content_reports_status_created (tenant_id, status, created_at),
+  CONSTRAINT fk_content_reports_tenant FOREIGN KEY (tenant_id) REFERENCES tenants(id),
+  CONSTRAINT fk_content_reports_author FOREIGN KEY (author_user_id) REFERENCES users(id),
+  CONSTRAINT fk_content_reports_reporter FOREIGN KEY (reporter_user_id) REFERENCES users(id),
+  CONSTRAINT fk_content_reports_resolved_by FOREIGN KEY (resolved_by_user_id) REFERENCES users(id)
+);
+
+CREATE TABLE IF NOT EXISTS audit_events (
+  id            BIGINT PRIMARY KEY AUTO_INCREMENT,
+  tenant_id     BIGINT NOT NULL,
+  actor_user_id BIGINT NOT NULL,
+  action        VARCHAR(32) NOT NULL,
+  target_type   VARCHAR(16) NOT NULL,
+  target_id     BIGINT NOT NULL,
+  report_id     BIGINT NULL,
+  note          VARCHAR(500) NOT NULL DEFAULT '',
+  created_at    TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  INDEX idx_audit_events_tenant_created (tenant_id, created_at),
+  CONSTRAINT fk_audit_events_tenant FOREIGN KEY (tenant_id) REFERENCES tenants(id),
+  CONSTRAINT fk_audit_events_actor FOREIGN KEY (actor_user_id) REFERENCES users(id),
+  CONSTRAINT fk_audit_events_report FOREIGN KEY (report_id) REFERENCES content_reports(id)
+);
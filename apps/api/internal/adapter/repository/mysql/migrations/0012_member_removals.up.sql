@@ -0,0 +1,10 @@
+-- Members an admin removed, whom the join policy no longer admits by signing in
+
+CREATE TABLE IF NOT EXISTS tenant_member_removals (
+  tenant_id  BIGINT NOT NULL,
+  user_id    BIGINT NOT NULL,
+  removed_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (tenant_id, user_id),
+  CONSTRAINT fk_tenant_member_removals_tenant FOREIGN KEY (tenant_id) REFERENCES tenants(id),
+  CONSTRAINT fk_tenant_member_removals_user FOREIGN KEY (user_id) REFERENCES users(id)
+);
@@ -0,0 +1,6 @@
+ALTER TABLE tenant_domains ADD UNIQUE KEY domain (domain), ADD INDEX idx_tenant_domains_tenant (tenant_id);
+ALTER TABLE tenant_domains
+  DROP INDEX idx_tenant_domains_domain,
+  DROP INDEX uniq_tenant_domains_verified,
+  DROP INDEX uniq_tenant_domains_tenant,
+  DROP COLUMN verified_domain;
@@ -0,0 +1,10 @@
+-- Only verified domains hold their host, so a pending claim cannot keep other tenants from it
+
+ALTER TABLE tenant_domains
+  ADD COLUMN verified_domain VARCHAR(255) AS (IF(verified_at IS NULL, NULL, domain)) STORED,
+  ADD UNIQUE KEY uniq_tenant_domains_tenant (tenant_id, domain),
+  ADD UNIQUE KEY uniq_tenant_domains_verified (verified_domain),
+  ADD INDEX idx_tenant_domains_domain (domain);
+ALTER TABLE tenant_domains DROP INDEX domain;
+-- The challenge is of no use once a domain is verified.
+UPDATE tenant_domains SET verification_token = NULL WHERE verified_at IS NOT NULL;
@@ -0,0 +1,21 @@
+-- Usage counters that the writes keep up to date, so that quota checks need not scan the content
+
+CREATE TABLE IF NOT EXISTS tenant_usage (
+  tenant_id     BIGINT PRIMARY KEY,
+  -- posts_today counts the posts created on usage_day (UTC)
+  usage_day     DATE NOT NULL,
+  posts_today   INT NOT NULL DEFAULT 0,
+  storage_bytes BIGINT NOT NULL DEFAULT 0,
+  members       INT NOT NULL DEFAULT 0,
+  CONSTRAINT fk_tenant_usage_tenant FOREIGN KEY (tenant_id) REFERENCES tenants(id)
+);
+
+-- created_at is in the session time zone; NOW() - UTC_TIMESTAMP() is its offset from UTC.
+INSERT INTO tenant_usage (tenant_id, usage_day, posts_today, storage_bytes, members)
+SELECT t.id, UTC_DATE(),
+  (SELECT COUNT(*) FROM posts WHERE tenant_id=t.id AND created_at >= UTC_DATE() + INTERVAL TIMESTAMPDIFF(SECOND, UTC_TIMESTAMP(), NOW()) SECOND),
+  (SELECT COALESCE(SUM(LENGTH(body)), 0) FROM posts WHERE tenant_id=t.id AND deleted_at IS NULL)
+    + (SELECT COALESCE(SUM(LENGTH(body)), 0) FROM comments WHERE tenant_id=t.id AND deleted_at IS NULL)
+    + (SELECT COALESCE(SUM(LENGTH(body)), 0) FROM messages WHERE tenant_id=t.id AND deleted_at IS NULL),
+  (SELECT COUNT(*) FROM tenant_memberships WHERE tenant_id=t.id)
+FROM tenants t;
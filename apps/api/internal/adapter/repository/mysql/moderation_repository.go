@@ -0,0 +1,151 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/example/something-like-sns/apps/api/internal/adapter/repository/sqlutil"
+	"github.com/example/something-like-sns/apps/api/internal/domain"
+)
+
+type moderationRepository struct {
+	q DBTX
+}
+
+const reportColumns = "id, target_type, target_id, author_user_id, reporter_user_id, reason, content, status, created_at, COALESCE(action, ''), COALESCE(resolved_by_user_id, 0), resolved_at"
+
+func (r *moderationRepository) CreateReport(ctx context.Context, tenantID uint64, rep *domain.Report) (*domain.Report, error) {
+	res, err := r.q.ExecContext(ctx, "INSERT INTO content_reports (tenant_id, target_type, target_id, author_user_id, reporter_user_id, reason, content) VALUES (?,?,?,?,?,?,?)",
+		tenantID, string(rep.TargetType), rep.TargetID, rep.AuthorUserID, rep.ReporterUserID, rep.Reason, rep.Content)
+	if err != nil {
+		return nil, translateError(err, "report")
+	}
+	id, _ := res.LastInsertId()
+	return r.FindReport(ctx, tenantID, uint64(id))
+}
+
+func (r *moderationRepository) FindReport(ctx context.Context, tenantID, reportID uint64) (*domain.Report, error) {
+	return scanReport(r.q.QueryRowContext(ctx, "SELECT "+reportColumns+" FROM content_reports WHERE tenant_id=? AND id=?", tenantID, reportID))
+}
+
+func (r *moderationRepository) FindReports(ctx context.Context, tenantID uint64, status string, limit int, cursor domain.Cursor) ([]*domain.Report, bool, error) {
+	where, order, args := keyset("", cursor, false)
+	rows, err := r.q.QueryContext(ctx, "SELECT "+reportColumns+" FROM content_reports WHERE tenant_id=? AND status=?"+where+" "+order+" LIMIT ?",
+		append(append([]any{tenantID, status}, args...), limit+1)...)
+	if err != nil {
+		return nil, false, err
+	}
+	defer rows.Close()
+
+	items := make([]*domain.Report, 0, limit+1)
+	for rows.Next() {
+		rep, err := scanReport(rows)
+		if err != nil {
+			return nil, false, err
+		}
+		items = append(items, rep)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, false, err
+	}
+	items, hasMore := sqlutil.TrimPage(items, limit, cursor)
+	return items, hasMore, nil
+}
+
+func (r *moderationRepository) ResolveReports(ctx context.Context, tenantID uint64, targetType domain.ReportTargetType, targetID, userID uint64, action domain.ModerationAction, now time.Time) error {
+	_, err := r.q.ExecContext(ctx, "UPDATE content_reports SET status='resolved', action=?, resolved_by_user_id=?, resolved_at=? WHERE tenant_id=? AND target_type=? AND target_id=? AND status='open'",
+		string(action), userID, now.UTC(), tenantID, string(targetType), targetID)
+	return err
+}
+
+func (r *moderationRepository) RemoveContent(ctx context.Context, tenantID uint64, targetType domain.ReportTargetType, targetID uint64, erase bool) error {
+	table, err := contentTable(targetType)
+	if err != nil {
+		return err
+	}
+	res, err := r.q.ExecContext(ctx, "UPDATE "+table+" SET deleted_at=CURRENT_TIMESTAMP WHERE tenant_id=? AND id=? AND deleted_at IS NULL", tenantID, targetID)
+	if err != nil {
+		return err
+	}
+	if n, err := res.RowsAffected(); err != nil {
+		return err
+	} else if n > 0 {
+		// The content stops counting toward the storage quota when it is first removed.
+		var size int
+		if err := r.q.QueryRowContext(ctx, "SELECT LENGTH(body) FROM "+table+" WHERE tenant_id=? AND id=?", tenantID, targetID).Scan(&size); err != nil {
+			return err
+		}
+		if err := addUsage(ctx, r.q, tenantID, 0, -size, 0); err != nil {
+			return err
+		}
+	} else {
+		var found int
+		if err := r.q.QueryRowContext(ctx, "SELECT 1 FROM "+table+" WHERE tenant_id=? AND id=?", tenantID, targetID).Scan(&found); err != nil {
+			return translateError(err, string(targetType))
+		}
+	}
+	if !erase {
+		return nil
+	}
+	if _, err := r.q.ExecContext(ctx, "UPDATE "+table+" SET body='' WHERE tenant_id=? AND id=?", tenantID, targetID); err != nil {
+		return err
+	}
+	// Reports keep a copy of the content, which goes with it.
+	_, err = r.q.ExecContext(ctx, "UPDATE content_reports SET content='' WHERE tenant_id=? AND target_type=? AND target_id=?", tenantID, targetType, targetID)
+	return err
+}
+
+func (r *moderationRepository) AppendAuditEvent(ctx context.Context, tenantID uint64, e *domain.AuditEvent) error {
+	_, err := r.q.ExecContext(ctx, "INSERT INTO audit_events (tenant_id, actor_user_id, action, target_type, target_id, report_id, note) VALUES (?,?,?,?,?,NULLIF(?, 0),?)",
+		tenantID, e.ActorUserID, e.Action, e.TargetType, e.TargetID, e.ReportID, e.Note)
+	return translateError(err, "audit event")
+}
+
+func (r *moderationRepository) FindAuditEvents(ctx context.Context, tenantID uint64, limit int, cursor domain.Cursor) ([]*domain.AuditEvent, bool, error) {
+	where, order, args := keyset("", cursor, true)
+	rows, err := r.q.QueryContext(ctx, "SELECT id, actor_user_id, action, target_type, target_id, COALESCE(report_id, 0), note, created_at FROM audit_events WHERE tenant_id=?"+where+" "+order+" LIMIT ?",
+		append(append([]any{tenantID}, args...), limit+1)...)
+	if err != nil {
+		return nil, false, err
+	}
+	defer rows.Close()
+
+	items := make([]*domain.AuditEvent, 0, limit+1)
+	for rows.Next() {
+		var e domain.AuditEvent
+		if err := rows.Scan(&e.ID, &e.ActorUserID, &e.Action, &e.TargetType, &e.TargetID, &e.ReportID, &e.Note, &e.CreatedAt); err != nil {
+			return nil, false, err
+		}
+		items = append(items, &e)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, false, err
+	}
+	items, hasMore := sqlutil.TrimPage(items, limit, cursor)
+	return items, hasMore, nil
+}
+
+// contentTable returns the table holding reportable content of targetType.
+func contentTable(targetType domain.ReportTargetType) (string, error) {
+	switch targetType {
+	case domain.ReportTargetPost:
+		return "posts", nil
+	case domain.ReportTargetComment:
+		return "comments", nil
+	case domain.ReportTargetMessage:
+		return "messages", nil
+	}
+	return "", fmt.Errorf("unknown report target type %q", targetType)
+}
+
+func scanReport(row interface{ Scan(...any) error }) (*domain.Report, error) {
+	var rep domain.Report
+	var resolvedAt sql.NullTime
+	if err := row.Scan(&rep.ID, &rep.TargetType, &rep.TargetID, &rep.AuthorUserID, &rep.ReporterUserID, &rep.Reason, &rep.Content, &rep.Status, &rep.CreatedAt, &rep.Action, &rep.ResolvedByUserID, &resolvedAt); err != nil {
+		return nil, translateError(err, "report")
+	}
+	rep.ResolvedAt = resolvedAt.Time
+	return &rep, nil
+}
@@ -0,0 +1,32 @@
+package mysql
+
+import (
+	"fmt"
+
+	"github.com/example/something-like-sns/apps/api/internal/domain"
+)
+
+// keyset returns the predicate (with a leading AND, empty at the start of the list) and the ORDER BY clause
+// that select the rows following c in a list ordered by (created_at, id), newest first when desc is set.
+// Backward cursors select the preceding rows in reverse order; callers reverse the scanned rows back.
+// prefix qualifies the column names, e.g. "p.".
+func keyset(prefix string, c domain.Cursor, desc bool) (string, string, []interface{}) {
+	return keysetOn(prefix+"created_at", prefix+"id", c, desc)
+}
+
+// keysetOn is keyset for a list ordered by the columns timeCol and idCol.
+func keysetOn(timeCol, idCol string, c domain.Cursor, desc bool) (string, string, []interface{}) {
+	if c.Direction == domain.PageBackward {
+		desc = !desc
+	}
+	cmp, dir := ">", "ASC"
+	if desc {
+		cmp, dir = "<", "DESC"
+	}
+	order := fmt.Sprintf("ORDER BY %[1]s %[3]s, %[2]s %[3]s", timeCol, idCol, dir)
+	if c.IsZero() {
+		return "", order, nil
+	}
+	where := fmt.Sprintf(" AND (%[1]s %[3]s ? OR (%[1]s = ? AND %[2]s %[3]s ?))", timeCol, idCol, cmp)
+	return where, order, []interface{}{c.Time, c.Time, c.ID}
+}
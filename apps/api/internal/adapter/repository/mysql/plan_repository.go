@@ -0,0 +1,51 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/example/something-like-sns/apps/api/internal/domain"
+)
+
+type planRepository struct {
+	q DBTX
+}
+
+func (r *planRepository) FindTenantPlan(ctx context.Context, tenantID uint64) (*domain.Plan, error) {
+	var p domain.Plan
+	err := r.q.QueryRowContext(ctx, `
+        SELECT p.name, p.posts_per_minute, p.comments_per_minute, p.messages_per_minute, p.daily_posts, p.storage_bytes, p.max_members
+        FROM tenants t
+        JOIN plans p ON p.name=t.plan
+        WHERE t.id=?`, tenantID).Scan(&p.Name, &p.PostsPerMinute, &p.CommentsPerMinute, &p.MessagesPerMinute, &p.DailyPosts, &p.StorageBytes, &p.MaxMembers)
+	if err != nil {
+		return nil, translateError(err, "plan")
+	}
+	return &p, nil
+}
+
+func (r *planRepository) GetUsage(ctx context.Context, tenantID uint64) (*domain.TenantUsage, error) {
+	var u domain.TenantUsage
+	err := r.q.QueryRowContext(ctx, "SELECT IF(usage_day=UTC_DATE(), posts_today, 0), storage_bytes, members FROM tenant_usage WHERE tenant_id=?", tenantID).
+		Scan(&u.PostsToday, &u.StorageBytes, &u.Members)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, err
+	}
+	return &u, nil
+}
+
+// addUsage adds to the tenant's usage counters, starting today's count of posts over if they were
+// last counted on an earlier day. MySQL assigns from left to right, so posts_today is computed
+// before usage_day changes.
+func addUsage(ctx context.Context, q DBTX, tenantID uint64, posts, bytes, members int) error {
+	_, err := q.ExecContext(ctx, `
+        INSERT INTO tenant_usage (tenant_id, usage_day, posts_today, storage_bytes, members) VALUES (?, UTC_DATE(), ?, ?, ?)
+        ON DUPLICATE KEY UPDATE
+            posts_today=IF(usage_day=UTC_DATE(), posts_today, 0) + ?,
+            usage_day=UTC_DATE(),
+            storage_bytes=storage_bytes + ?,
+            members=members + ?`,
+		tenantID, posts, bytes, members, posts, bytes, members)
+	return err
+}
@@ -0,0 +1,105 @@
+package mysql
+
+import (
+	"context"
+	"strings"
+
+	"github.com/example/something-like-sns/apps/api/internal/adapter/repository/sqlutil"
+	"github.com/example/something-like-sns/apps/api/internal/domain"
+)
+
+type profileRepository struct {
+	q DBTX
+}
+
+func (r *profileRepository) FindProfiles(ctx context.Context, tenantID uint64, userIDs []uint64) ([]*domain.Profile, error) {
+	if len(userIDs) == 0 {
+		return nil, nil
+	}
+	args := make([]any, 0, len(userIDs)+1)
+	args = append(args, tenantID)
+	for _, id := range userIDs {
+		args = append(args, id)
+	}
+	rows, err := r.q.QueryContext(ctx, "SELECT u.id, COALESCE(m.display_name, u.display_name), COALESCE(m.handle, ''), u.bio, COALESCE(m.avatar_url, u.avatar_url, '') FROM users u LEFT JOIN tenant_memberships m ON m.user_id=u.id AND m.tenant_id=? WHERE u.id IN (?"+strings.Repeat(",?", len(userIDs)-1)+")", args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	profiles := make([]*domain.Profile, 0, len(userIDs))
+	for rows.Next() {
+		var p domain.Profile
+		if err := rows.Scan(&p.UserID, &p.DisplayName, &p.Handle, &p.Bio, &p.AvatarURL); err != nil {
+			return nil, err
+		}
+		profiles = append(profiles, &p)
+	}
+	return profiles, rows.Err()
+}
+
+func (r *profileRepository) UpdateProfile(ctx context.Context, tenantID uint64, p *domain.Profile) error {
+	var found int
+	if err := r.q.QueryRowContext(ctx, "SELECT 1 FROM tenant_memberships WHERE tenant_id=? AND user_id=?", tenantID, p.UserID).Scan(&found); err != nil {
+		return translateError(err, "membership")
+	}
+	if _, err := r.q.ExecContext(ctx, "UPDATE tenant_memberships SET handle=NULLIF(?, '') WHERE tenant_id=? AND user_id=?", p.Handle, tenantID, p.UserID); err != nil {
+		return translateError(err, "handle")
+	}
+	_, err := r.q.ExecContext(ctx, "UPDATE users SET display_name=?, bio=?, avatar_url=NULLIF(?, '') WHERE id=?", p.DisplayName, p.Bio, p.AvatarURL, p.UserID)
+	return err
+}
+
+func (r *profileRepository) FindProfileSettings(ctx context.Context, tenantID, userID uint64) (*domain.ProfileSettings, error) {
+	var ps domain.ProfileSettings
+	err := r.q.QueryRowContext(ctx, "SELECT u.id, u.display_name, COALESCE(m.handle, ''), u.bio, COALESCE(u.avatar_url, ''), COALESCE(m.display_name, ''), COALESCE(m.avatar_url, '') FROM tenant_memberships m JOIN users u ON u.id=m.user_id WHERE m.tenant_id=? AND m.user_id=?", tenantID, userID).
+		Scan(&ps.UserID, &ps.DisplayName, &ps.Handle, &ps.Bio, &ps.AvatarURL, &ps.Override.DisplayName, &ps.Override.AvatarURL)
+	if err != nil {
+		return nil, translateError(err, "membership")
+	}
+	return &ps, nil
+}
+
+func (r *profileRepository) UpdateProfileOverride(ctx context.Context, tenantID, userID uint64, o domain.ProfileOverride) error {
+	var found int
+	if err := r.q.QueryRowContext(ctx, "SELECT 1 FROM tenant_memberships WHERE tenant_id=? AND user_id=?", tenantID, userID).Scan(&found); err != nil {
+		return translateError(err, "membership")
+	}
+	_, err := r.q.ExecContext(ctx, "UPDATE tenant_memberships SET display_name=NULLIF(?, ''), avatar_url=NULLIF(?, '') WHERE tenant_id=? AND user_id=?", o.DisplayName, o.AvatarURL, tenantID, userID)
+	return err
+}
+
+func (r *profileRepository) FindDirectoryMembers(ctx context.Context, tenantID, viewerID uint64, filter domain.MemberFilter, limit int, cursor domain.Cursor) ([]*domain.DirectoryMember, bool, error) {
+	query := "SELECT u.id, COALESCE(m.display_name, u.display_name), COALESCE(m.handle, ''), u.bio, COALESCE(m.avatar_url, u.avatar_url, ''), m.role, m.created_at FROM tenant_memberships m JOIN users u ON u.id=m.user_id WHERE m.tenant_id=? AND u.suspended_at IS NULL AND m.suspended_at IS NULL" +
+		" AND NOT EXISTS(SELECT 1 FROM user_relations ur WHERE ur.tenant_id=m.tenant_id AND ur.kind='block' AND ((ur.user_id=? AND ur.target_user_id=m.user_id) OR (ur.user_id=m.user_id AND ur.target_user_id=?)))"
+	args := []any{tenantID, viewerID, viewerID}
+	if filter.Role != "" {
+		query += " AND m.role=?"
+		args = append(args, filter.Role)
+	}
+	if filter.Prefix != "" {
+		query += " AND (COALESCE(m.display_name, u.display_name) LIKE ? ESCAPE '!' OR m.handle LIKE ? ESCAPE '!')"
+		pattern := sqlutil.EscapeLike(filter.Prefix) + "%"
+		args = append(args, pattern, pattern)
+	}
+	where, order, keyArgs := keysetOn("m.created_at", "m.user_id", cursor, false)
+	rows, err := r.q.QueryContext(ctx, query+where+" "+order+" LIMIT ?", append(append(args, keyArgs...), limit+1)...)
+	if err != nil {
+		return nil, false, err
+	}
+	defer rows.Close()
+
+	members := make([]*domain.DirectoryMember, 0, limit+1)
+	for rows.Next() {
+		var m domain.DirectoryMember
+		if err := rows.Scan(&m.UserID, &m.DisplayName, &m.Handle, &m.Bio, &m.AvatarURL, &m.Role, &m.JoinedAt); err != nil {
+			return nil, false, err
+		}
+		members = append(members, &m)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, false, err
+	}
+	members, hasMore := sqlutil.TrimPage(members, limit, cursor)
+	return members, hasMore, nil
+}
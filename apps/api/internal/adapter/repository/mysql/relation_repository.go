@@ -0,0 +1,41 @@
+package mysql
+
+import (
+	"context"
+
+	"github.com/example/something-like-sns/apps/api/internal/domain"
+)
+
+type relationRepository struct {
+	q DBTX
+}
+
+func (r *relationRepository) AddRelation(ctx context.Context, tenantID, userID, targetID uint64, kind domain.RelationKind) error {
+	_, err := r.q.ExecContext(ctx, "INSERT INTO user_relations (tenant_id, user_id, target_user_id, kind) VALUES (?, ?, ?, ?) ON DUPLICATE KEY UPDATE kind=kind", tenantID, userID, targetID, string(kind))
+	return translateError(err, "relation")
+}
+
+func (r *relationRepository) RemoveRelation(ctx context.Context, tenantID, userID, targetID uint64, kind domain.RelationKind) error {
+	_, err := r.q.ExecContext(ctx, "DELETE FROM user_relations WHERE tenant_id=? AND user_id=? AND target_user_id=? AND kind=?", tenantID, userID, targetID, string(kind))
+	return err
+}
+
+func (r *relationRepository) IsBlocked(ctx context.Context, tenantID, userID1, userID2 uint64) (bool, error) {
+	var ok bool
+	err := r.q.QueryRowContext(ctx, `SELECT EXISTS(
+          SELECT 1 FROM user_relations
+          WHERE tenant_id=? AND kind='block' AND ((user_id=? AND target_user_id=?) OR (user_id=? AND target_user_id=?)))`,
+		tenantID, userID1, userID2, userID2, userID1).Scan(&ok)
+	return ok, err
+}
+
+func (r *relationRepository) IsBlockedInConversation(ctx context.Context, tenantID, conversationID, userID uint64) (bool, error) {
+	var ok bool
+	err := r.q.QueryRowContext(ctx, `SELECT EXISTS(
+          SELECT 1 FROM conversations c
+          JOIN conversation_members m ON m.conversation_id=c.id AND m.user_id<>?
+          JOIN user_relations ur ON ur.tenant_id=c.tenant_id AND ur.kind='block'
+            AND ((ur.user_id=? AND ur.target_user_id=m.user_id) OR (ur.user_id=m.user_id AND ur.target_user_id=?))
+          WHERE c.tenant_id=? AND c.id=?)`, userID, userID, userID, tenantID, conversationID).Scan(&ok)
+	return ok, err
+}
@@ -4,41 +4,70 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"time"
 
+	"github.com/example/something-like-sns/apps/api/internal/adapter/repository/instrument"
+	"github.com/example/something-like-sns/apps/api/internal/adapter/repository/tenantguard"
 	"github.com/example/something-like-sns/apps/api/internal/port"
 )
 
 // DBTX is an interface that is satisfied by both *sql.DB and *sql.Tx
-type DBTX interface {
-	ExecContext(context.Context, string, ...interface{}) (sql.Result, error)
-	QueryContext(context.Context, string, ...interface{}) (*sql.Rows, error)
-	QueryRowContext(context.Context, string, ...interface{}) *sql.Row
-}
+type DBTX = instrument.DBTX
+
+// dbSystem is the database system name reported in traces.
+const dbSystem = "mysql"
 
 // sqlStore provides all functions to execute db queries and transactions
 type sqlStore struct {
-	db *sql.DB
-	q  DBTX
+	db        *sql.DB
+	q         DBTX
+	slowQuery time.Duration
+	guard     tenantguard.Mode
+}
+
+// StoreOption configures a Store created by NewStore.
+type StoreOption func(*sqlStore)
+
+// WithSlowQueryThreshold logs statements that take at least d. Zero, the default, disables it.
+func WithSlowQueryThreshold(d time.Duration) StoreOption {
+	return func(s *sqlStore) {
+		s.slowQuery = d
+	}
+}
+
+// WithTenantGuard sets how statements missing a tenant_id predicate are handled.
+// It defaults to tenantguard.Enforce.
+func WithTenantGuard(mode tenantguard.Mode) StoreOption {
+	return func(s *sqlStore) {
+		s.guard = mode
+	}
 }
 
 // NewStore creates a new Store
-func NewStore(db *sql.DB) port.Store {
-	return &sqlStore{
-		db: db,
-		q:  db,
+func NewStore(db *sql.DB, opts ...StoreOption) port.Store {
+	s := &sqlStore{db: db}
+	for _, opt := range opts {
+		opt(s)
 	}
+	s.q = instrument.Wrap(tenantguard.Wrap(db, s.guard), dbSystem, s.slowQuery)
+	return s
 }
 
 // ExecTx executes a function within a database transaction
-func (s *sqlStore) ExecTx(ctx context.Context, fn func(port.Store) error) error {
+func (s *sqlStore) ExecTx(ctx context.Context, fn func(port.Store) error) (err error) {
+	ctx, end := instrument.StartTx(ctx, dbSystem)
+	defer func() { end(err) }()
+
 	tx, err := s.db.BeginTx(ctx, nil)
 	if err != nil {
 		return err
 	}
 
 	txStore := &sqlStore{
-		db: s.db,
-		q:  tx,
+		db:        s.db,
+		q:         instrument.Wrap(tenantguard.Wrap(tx, s.guard), dbSystem, s.slowQuery),
+		slowQuery: s.slowQuery,
+		guard:     s.guard,
 	}
 
 	err = fn(txStore)
@@ -56,6 +85,18 @@ func (s *sqlStore) AuthRepository() port.AuthRepository {
 	return &authRepository{q: s.q}
 }
 
+func (s *sqlStore) ProfileRepository() port.ProfileRepository {
+	return &profileRepository{q: s.q}
+}
+
+func (s *sqlStore) RelationRepository() port.RelationRepository {
+	return &relationRepository{q: s.q}
+}
+
+func (s *sqlStore) ModerationRepository() port.ModerationRepository {
+	return &moderationRepository{q: s.q}
+}
+
 func (s *sqlStore) TimelineRepository() port.TimelineRepository {
 	return &timelineRepository{q: s.q}
 }
@@ -67,3 +108,19 @@ func (s *sqlStore) ReactionRepository() port.ReactionRepository {
 func (s *sqlStore) DMRepository() port.DMRepository {
 	return &dmRepository{q: s.q}
 }
+
+func (s *sqlStore) PlanRepository() port.PlanRepository {
+	return &planRepository{q: s.q}
+}
+
+func (s *sqlStore) IdempotencyRepository() port.IdempotencyRepository {
+	return &idempotencyRepository{q: s.q}
+}
+
+func (s *sqlStore) InvitationRepository() port.InvitationRepository {
+	return &invitationRepository{q: s.q}
+}
+
+func (s *sqlStore) BulkRepository() port.BulkRepository {
+	return &bulkRepository{q: s.q}
+}
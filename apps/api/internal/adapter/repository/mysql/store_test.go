@@ -0,0 +1,34 @@
+package mysql_test
+
+import (
+	"database/sql"
+	"os"
+	"testing"
+
+	_ "github.com/go-sql-driver/mysql"
+
+	"github.com/example/something-like-sns/apps/api/internal/adapter/repository/mysql"
+	"github.com/example/something-like-sns/apps/api/internal/adapter/repository/repotest"
+	"github.com/example/something-like-sns/apps/api/internal/port"
+)
+
+// TestStoreContract runs against the migrated database named by TEST_MYSQL_DSN, e.g.
+// "app:pass@tcp(127.0.0.1:3306)/sns?parseTime=true", and is skipped when it is not set.
+func TestStoreContract(t *testing.T) {
+	dsn := os.Getenv("TEST_MYSQL_DSN")
+	if dsn == "" {
+		t.Skip("TEST_MYSQL_DSN is not set")
+	}
+	db, err := sql.Open("mysql", dsn)
+	if err != nil {
+		t.Fatalf("open: %v", err)
+	}
+	t.Cleanup(func() { db.Close() })
+	if err := db.Ping(); err != nil {
+		t.Fatalf("ping: %v", err)
+	}
+
+	repotest.Run(t, func(t *testing.T) port.Store {
+		return mysql.NewStore(db)
+	})
+}
@@ -0,0 +1,340 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+
+	"github.com/example/something-like-sns/apps/api/internal/adapter/repository/tenantguard"
+	"github.com/example/something-like-sns/apps/api/internal/domain"
+)
+
+type authRepository struct {
+	s *sqlStore
+}
+
+// tenantColumns are the columns of tenants t that scanTenant reads. Every tenant lookup selects
+// all of them, as the cache decorator keeps whichever lookup it sees first.
+const tenantColumns = "t.id, t.slug, t.name, t.plan, t.disabled_at IS NOT NULL, t.join_policy, t.allow_subdomain_fallback"
+
+func scanTenant(row *sql.Row) (*domain.Tenant, error) {
+	var t domain.Tenant
+	if err := row.Scan(&t.ID, &t.Slug, &t.Name, &t.Plan, &t.Disabled, &t.JoinPolicy, &t.AllowSubdomainFallback); err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
+
+func (r *authRepository) FindTenantByHost(ctx context.Context, host string) (*domain.Tenant, error) {
+	// The tenant is what is being resolved, so no tenant predicate applies.
+	ctx = tenantguard.AllowCrossTenant(ctx)
+	t, err := scanTenant(r.s.q.QueryRowContext(ctx, "SELECT "+tenantColumns+" FROM tenant_domains d JOIN tenants t ON t.id=d.tenant_id WHERE d.domain=$1 AND d.verified_at IS NOT NULL", host))
+	if errors.Is(err, sql.ErrNoRows) {
+		if idx := strings.IndexByte(host, '.'); idx > 0 {
+			guess := host[:idx]
+			t, err = scanTenant(r.s.q.QueryRowContext(ctx, "SELECT "+tenantColumns+" FROM tenants t WHERE t.slug=$1 AND t.allow_subdomain_fallback", guess))
+		}
+	}
+	if err != nil {
+		return nil, translateError(err, "tenant")
+	}
+	return t, nil
+}
+
+func (r *authRepository) FindTenantBySlug(ctx context.Context, slug string) (*domain.Tenant, error) {
+	t, err := scanTenant(r.s.q.QueryRowContext(ctx, "SELECT "+tenantColumns+" FROM tenants t WHERE t.slug=$1", slug))
+	if err != nil {
+		return nil, translateError(err, "tenant")
+	}
+	return t, nil
+}
+
+func (r *authRepository) FindTenantByID(ctx context.Context, tenantID uint64) (*domain.Tenant, error) {
+	t, err := scanTenant(r.s.q.QueryRowContext(ctx, "SELECT "+tenantColumns+" FROM tenants t WHERE t.id=$1", tenantID))
+	if err != nil {
+		return nil, translateError(err, "tenant")
+	}
+	return t, nil
+}
+
+func (r *authRepository) CreateTenant(ctx context.Context, slug, name string) (*domain.Tenant, error) {
+	t := domain.Tenant{Slug: slug, Name: name}
+	err := r.s.q.QueryRowContext(ctx, "INSERT INTO tenants (slug, name) VALUES ($1, $2) RETURNING id, plan, join_policy, allow_subdomain_fallback", slug, name).Scan(&t.ID, &t.Plan, &t.JoinPolicy, &t.AllowSubdomainFallback)
+	if err != nil {
+		return nil, translateError(err, "tenant")
+	}
+	return &t, nil
+}
+
+func (r *authRepository) RenameTenant(ctx context.Context, tenantID uint64, name string) error {
+	res, err := r.s.q.ExecContext(ctx, "UPDATE tenants SET name=$1 WHERE id=$2", name, tenantID)
+	return checkFound(res, err, "tenant")
+}
+
+func (r *authRepository) SetTenantDisabled(ctx context.Context, tenantID uint64, disabled bool) error {
+	query := "UPDATE tenants SET disabled_at=NULL WHERE id=$1"
+	if disabled {
+		query = "UPDATE tenants SET disabled_at=COALESCE(disabled_at, now()) WHERE id=$1"
+	}
+	res, err := r.s.q.ExecContext(ctx, query, tenantID)
+	return checkFound(res, err, "tenant")
+}
+
+func (r *authRepository) SetTenantJoinPolicy(ctx context.Context, tenantID uint64, policy string) error {
+	res, err := r.s.q.ExecContext(ctx, "UPDATE tenants SET join_policy=$1 WHERE id=$2", policy, tenantID)
+	return checkFound(res, err, "tenant")
+}
+
+func (r *authRepository) SetTenantSubdomainFallback(ctx context.Context, tenantID uint64, allowed bool) error {
+	res, err := r.s.q.ExecContext(ctx, "UPDATE tenants SET allow_subdomain_fallback=$1 WHERE id=$2", allowed, tenantID)
+	return checkFound(res, err, "tenant")
+}
+
+func (r *authRepository) AddTenantDomain(ctx context.Context, tenantID uint64, d *domain.TenantDomain) error {
+	err := r.s.withTenant(ctx, tenantID, func(q DBTX) error {
+		_, err := q.ExecContext(ctx, "INSERT INTO tenant_domains (tenant_id, domain, verified_at, verification_token) VALUES ($1, $2, CASE WHEN $3::boolean THEN now() END, NULLIF($4, ''))", tenantID, d.Host, d.Verified, d.VerificationToken)
+		return err
+	})
+	return translateError(err, "tenant domain")
+}
+
+func (r *authRepository) VerifyTenantDomain(ctx context.Context, tenantID uint64, host string) error {
+	return r.s.withTenant(ctx, tenantID, func(q DBTX) error {
+		res, err := q.ExecContext(ctx, "UPDATE tenant_domains SET verified_at=COALESCE(verified_at, now()), verification_token=NULL WHERE tenant_id=$1 AND domain=$2", tenantID, host)
+		return checkFound(res, translateError(err, "tenant domain"), "tenant domain")
+	})
+}
+
+func (r *authRepository) RemoveTenantDomain(ctx context.Context, tenantID uint64, host string) error {
+	return r.s.withTenant(ctx, tenantID, func(q DBTX) error {
+		res, err := q.ExecContext(ctx, "DELETE FROM tenant_domains WHERE tenant_id=$1 AND domain=$2", tenantID, host)
+		return checkFound(res, err, "tenant domain")
+	})
+}
+
+func (r *authRepository) FindTenantDomains(ctx context.Context, tenantID uint64) ([]*domain.TenantDomain, error) {
+	var domains []*domain.TenantDomain
+	err := r.s.withTenant(ctx, tenantID, func(q DBTX) error {
+		rows, err := q.QueryContext(ctx, "SELECT domain, verified_at IS NOT NULL, COALESCE(verification_token, ''), created_at FROM tenant_domains WHERE tenant_id=$1 ORDER BY domain", tenantID)
+		if err != nil {
+			return err
+		}
+		defer rows.Close()
+		for rows.Next() {
+			var d domain.TenantDomain
+			if err := rows.Scan(&d.Host, &d.Verified, &d.VerificationToken, &d.CreatedAt); err != nil {
+				return err
+			}
+			domains = append(domains, &d)
+		}
+		return rows.Err()
+	})
+	if err != nil {
+		return nil, err
+	}
+	return domains, nil
+}
+
+func (r *authRepository) FindOrCreateUser(ctx context.Context, authSub, displayName string) (uint64, error) {
+	var userID uint64
+	err := r.s.q.QueryRowContext(ctx, "SELECT id FROM users WHERE auth_sub=$1", authSub).Scan(&userID)
+	if !errors.Is(err, sql.ErrNoRows) {
+		return userID, err
+	}
+	// Another request may create the user first; both then read the same row.
+	if _, err := r.s.q.ExecContext(ctx, "INSERT INTO users (auth_sub, display_name) VALUES ($1, $2) ON CONFLICT (auth_sub) DO NOTHING", authSub, displayName); err != nil {
+		return 0, err
+	}
+	if err := r.s.q.QueryRowContext(ctx, "SELECT id FROM users WHERE auth_sub=$1", authSub).Scan(&userID); err != nil {
+		return 0, err
+	}
+	return userID, nil
+}
+
+func (r *authRepository) FindUserByID(ctx context.Context, userID uint64) (*domain.User, error) {
+	var u domain.User
+	err := r.s.q.QueryRowContext(ctx, "SELECT id, auth_sub, display_name, suspended_at IS NOT NULL FROM users WHERE id=$1", userID).Scan(&u.ID, &u.AuthSub, &u.DisplayName, &u.Suspended)
+	if err != nil {
+		return nil, translateError(err, "user")
+	}
+	return &u, nil
+}
+
+func (r *authRepository) FindUserByAuthSub(ctx context.Context, authSub string) (*domain.User, error) {
+	var u domain.User
+	err := r.s.q.QueryRowContext(ctx, "SELECT id, auth_sub, display_name, suspended_at IS NOT NULL FROM users WHERE auth_sub=$1", authSub).Scan(&u.ID, &u.AuthSub, &u.DisplayName, &u.Suspended)
+	if err != nil {
+		return nil, translateError(err, "user")
+	}
+	return &u, nil
+}
+
+func (r *authRepository) SetUserSuspended(ctx context.Context, userID uint64, suspended bool) error {
+	query := "UPDATE users SET suspended_at=NULL WHERE id=$1"
+	if suspended {
+		query = "UPDATE users SET suspended_at=COALESCE(suspended_at, now()) WHERE id=$1"
+	}
+	res, err := r.s.q.ExecContext(ctx, query, userID)
+	return checkFound(res, err, "user")
+}
+
+func (r *authRepository) FindMembershipRole(ctx context.Context, tenantID, userID uint64) (string, error) {
+	var role string
+	err := r.s.withTenant(ctx, tenantID, func(q DBTX) error {
+		return q.QueryRowContext(ctx, "SELECT role FROM tenant_memberships WHERE tenant_id=$1 AND user_id=$2", tenantID, userID).Scan(&role)
+	})
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return "", err
+	}
+	return role, nil
+}
+
+func (r *authRepository) EnsureMembership(ctx context.Context, tenantID, userID uint64, role string) error {
+	return r.s.withTenant(ctx, tenantID, func(q DBTX) error {
+		res, err := q.ExecContext(ctx, "INSERT INTO tenant_memberships (tenant_id, user_id, role) VALUES ($1, $2, $3) ON CONFLICT (tenant_id, user_id) DO NOTHING", tenantID, userID, role)
+		if err != nil {
+			return translateError(err, "membership")
+		}
+		if n, err := res.RowsAffected(); err != nil {
+			return err
+		} else if n > 0 {
+			if err := addUsage(ctx, q, tenantID, 0, 0, 1); err != nil {
+				return err
+			}
+		}
+		_, err = q.ExecContext(ctx, "DELETE FROM tenant_member_removals WHERE tenant_id=$1 AND user_id=$2", tenantID, userID)
+		return err
+	})
+}
+
+func (r *authRepository) UpdateMembershipRole(ctx context.Context, tenantID, userID uint64, role string) error {
+	return r.s.withTenant(ctx, tenantID, func(q DBTX) error {
+		res, err := q.ExecContext(ctx, "UPDATE tenant_memberships SET role=$1 WHERE tenant_id=$2 AND user_id=$3", role, tenantID, userID)
+		return checkFound(res, err, "membership")
+	})
+}
+
+func (r *authRepository) RemoveMembership(ctx context.Context, tenantID, userID uint64) error {
+	return r.s.withTenant(ctx, tenantID, func(q DBTX) error {
+		res, err := q.ExecContext(ctx, "DELETE FROM tenant_memberships WHERE tenant_id=$1 AND user_id=$2", tenantID, userID)
+		if err := checkFound(res, err, "membership"); err != nil {
+			return err
+		}
+		if err := addUsage(ctx, q, tenantID, 0, 0, -1); err != nil {
+			return err
+		}
+		_, err = q.ExecContext(ctx, "INSERT INTO tenant_member_removals (tenant_id, user_id) VALUES ($1, $2) ON CONFLICT (tenant_id, user_id) DO UPDATE SET removed_at=now()", tenantID, userID)
+		return err
+	})
+}
+
+func (r *authRepository) IsMemberRemoved(ctx context.Context, tenantID, userID uint64) (bool, error) {
+	var ok bool
+	err := r.s.withTenant(ctx, tenantID, func(q DBTX) error {
+		return q.QueryRowContext(ctx, "SELECT EXISTS(SELECT 1 FROM tenant_member_removals WHERE tenant_id=$1 AND user_id=$2)", tenantID, userID).Scan(&ok)
+	})
+	return ok, err
+}
+
+func (r *authRepository) SetMembershipSuspended(ctx context.Context, tenantID, userID uint64, suspended bool) error {
+	query := "UPDATE tenant_memberships SET suspended_at=NULL WHERE tenant_id=$1 AND user_id=$2"
+	if suspended {
+		query = "UPDATE tenant_memberships SET suspended_at=COALESCE(suspended_at, now()) WHERE tenant_id=$1 AND user_id=$2"
+	}
+	return r.s.withTenant(ctx, tenantID, func(q DBTX) error {
+		res, err := q.ExecContext(ctx, query, tenantID, userID)
+		return checkFound(res, err, "membership")
+	})
+}
+
+func (r *authRepository) FindMembership(ctx context.Context, tenantID, userID uint64) (string, bool, error) {
+	var role string
+	var suspended bool
+	err := r.s.withTenant(ctx, tenantID, func(q DBTX) error {
+		return q.QueryRowContext(ctx, "SELECT role, suspended_at IS NOT NULL FROM tenant_memberships WHERE tenant_id=$1 AND user_id=$2", tenantID, userID).Scan(&role, &suspended)
+	})
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return "", false, err
+	}
+	return role, suspended, nil
+}
+
+func (r *authRepository) FindMembers(ctx context.Context, tenantID uint64) ([]*domain.Member, error) {
+	var members []*domain.Member
+	err := r.s.withTenant(ctx, tenantID, func(q DBTX) error {
+		rows, err := q.QueryContext(ctx, "SELECT u.id, u.auth_sub, COALESCE(m.display_name, u.display_name), m.role, u.suspended_at IS NOT NULL OR m.suspended_at IS NOT NULL, m.created_at FROM tenant_memberships m JOIN users u ON u.id=m.user_id WHERE m.tenant_id=$1 ORDER BY u.id", tenantID)
+		if err != nil {
+			return err
+		}
+		defer rows.Close()
+		for rows.Next() {
+			var m domain.Member
+			if err := rows.Scan(&m.UserID, &m.AuthSub, &m.DisplayName, &m.Role, &m.Suspended, &m.JoinedAt); err != nil {
+				return err
+			}
+			members = append(members, &m)
+		}
+		return rows.Err()
+	})
+	if err != nil {
+		return nil, err
+	}
+	return members, nil
+}
+
+func (r *authRepository) LockOwners(ctx context.Context, tenantID uint64) ([]uint64, error) {
+	var owners []uint64
+	err := r.s.withTenant(ctx, tenantID, func(q DBTX) error {
+		rows, err := q.QueryContext(ctx, "SELECT user_id FROM tenant_memberships WHERE tenant_id=$1 AND role='owner' ORDER BY user_id FOR UPDATE", tenantID)
+		if err != nil {
+			return err
+		}
+		defer rows.Close()
+		for rows.Next() {
+			var id uint64
+			if err := rows.Scan(&id); err != nil {
+				return err
+			}
+			owners = append(owners, id)
+		}
+		return rows.Err()
+	})
+	return owners, err
+}
+
+func (r *authRepository) FindUserMemberships(ctx context.Context, userID uint64) ([]*domain.TenantMembership, error) {
+	// A user's memberships span tenants by definition.
+	ctx = tenantguard.AllowCrossTenant(ctx)
+	memberships := make([]*domain.TenantMembership, 0, 4)
+	err := r.s.withUser(ctx, userID, func(q DBTX) error {
+		rows, err := q.QueryContext(ctx, "SELECT m.tenant_id, m.role, t.slug FROM tenant_memberships m JOIN tenants t ON t.id=m.tenant_id WHERE m.user_id=$1 ORDER BY m.tenant_id", userID)
+		if err != nil {
+			return err
+		}
+		defer rows.Close()
+		for rows.Next() {
+			var m domain.TenantMembership
+			if err := rows.Scan(&m.TenantID, &m.Role, &m.TenantSlug); err != nil {
+				return err
+			}
+			memberships = append(memberships, &m)
+		}
+		return rows.Err()
+	})
+	if err != nil {
+		return nil, err
+	}
+	return memberships, nil
+}
+
+// checkFound returns a NotFoundError for resource if the statement that returned res matched no row.
+func checkFound(res sql.Result, err error, resource string) error {
+	if err != nil {
+		return err
+	}
+	if n, err := res.RowsAffected(); err != nil || n > 0 {
+		return err
+	}
+	return domain.NewNotFoundError(resource, nil)
+}
@@ -0,0 +1,190 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/example/something-like-sns/apps/api/internal/adapter/repository/tenantguard"
+	"github.com/example/something-like-sns/apps/api/internal/domain"
+)
+
+// maxPlaceholders is the number of parameters a statement may have.
+const maxPlaceholders = 65535
+
+type bulkRepository struct {
+	s *sqlStore
+}
+
+// NextID reads the table's identity sequence rather than MAX(id), which the row-level security
+// policies would limit to one tenant. Inserts advance the sequence past the IDs they write.
+func (r *bulkRepository) NextID(ctx context.Context, table string) (uint64, error) {
+	switch table {
+	case "users", "posts", "comments", "conversations", "messages":
+	default:
+		return 0, fmt.Errorf("bulk: unknown table %q", table)
+	}
+	var next uint64
+	err := r.s.q.QueryRowContext(ctx, "SELECT COALESCE(pg_sequence_last_value(pg_get_serial_sequence($1, 'id')::regclass), 0) + 1", table).Scan(&next)
+	return next, err
+}
+
+func (r *bulkRepository) InsertUsers(ctx context.Context, users []*domain.User) error {
+	args := make([]any, 0, 3*len(users))
+	var maxID uint64
+	for _, u := range users {
+		args = append(args, u.ID, u.AuthSub, u.DisplayName)
+		maxID = max(maxID, u.ID)
+	}
+	if err := insert(ctx, r.s.q, "user", "INSERT INTO users (id, auth_sub, display_name) VALUES", 3, args); err != nil {
+		return err
+	}
+	return advanceSequence(ctx, r.s.q, "users", maxID)
+}
+
+func (r *bulkRepository) InsertMemberships(ctx context.Context, tenantID uint64, members []*domain.Member) error {
+	args := make([]any, 0, 3*len(members))
+	for _, m := range members {
+		args = append(args, tenantID, m.UserID, m.Role)
+	}
+	return r.s.withTenant(ctx, tenantID, func(q DBTX) error {
+		if err := insert(ctx, q, "membership", "INSERT INTO tenant_memberships (tenant_id, user_id, role) VALUES", 3, args); err != nil {
+			return err
+		}
+		return addUsage(ctx, q, tenantID, 0, 0, len(members))
+	})
+}
+
+func (r *bulkRepository) InsertPosts(ctx context.Context, tenantID uint64, posts []*domain.Post) error {
+	args := make([]any, 0, 5*len(posts))
+	var maxID uint64
+	bytes := 0
+	for _, p := range posts {
+		bytes += len(p.Body)
+		args = append(args, p.ID, tenantID, p.AuthorUserID, p.Body, p.CreatedAt)
+		maxID = max(maxID, p.ID)
+	}
+	return r.s.withTenant(ctx, tenantID, func(q DBTX) error {
+		if err := insert(ctx, q, "post", "INSERT INTO posts (id, tenant_id, author_user_id, body, created_at) VALUES", 5, args); err != nil {
+			return err
+		}
+		if err := advanceSequence(ctx, q, "posts", maxID); err != nil {
+			return err
+		}
+		// Bulk-loaded posts are history and do not count toward today's.
+		return addUsage(ctx, q, tenantID, 0, bytes, 0)
+	})
+}
+
+func (r *bulkRepository) InsertComments(ctx context.Context, tenantID uint64, comments []*domain.Comment) error {
+	args := make([]any, 0, 6*len(comments))
+	var maxID uint64
+	bytes := 0
+	for _, c := range comments {
+		bytes += len(c.Body)
+		args = append(args, c.ID, tenantID, c.PostID, c.AuthorUserID, c.Body, c.CreatedAt)
+		maxID = max(maxID, c.ID)
+	}
+	return r.s.withTenant(ctx, tenantID, func(q DBTX) error {
+		if err := insert(ctx, q, "comment", "INSERT INTO comments (id, tenant_id, post_id, author_user_id, body, created_at) VALUES", 6, args); err != nil {
+			return err
+		}
+		if err := advanceSequence(ctx, q, "comments", maxID); err != nil {
+			return err
+		}
+		return addUsage(ctx, q, tenantID, 0, bytes, 0)
+	})
+}
+
+func (r *bulkRepository) InsertReactions(ctx context.Context, tenantID uint64, reactions []*domain.ReactionRecord) error {
+	args := make([]any, 0, 6*len(reactions))
+	for _, rec := range reactions {
+		args = append(args, tenantID, string(rec.TargetType), rec.TargetID, rec.UserID, rec.Type, rec.CreatedAt)
+	}
+	return r.s.withTenant(ctx, tenantID, func(q DBTX) error {
+		return insert(ctx, q, "reaction", "INSERT INTO reactions (tenant_id, target_type, target_id, user_id, type, created_at) VALUES", 6, args)
+	})
+}
+
+func (r *bulkRepository) InsertConversations(ctx context.Context, tenantID uint64, conversations []*domain.Conversation) error {
+	args := make([]any, 0, 3*len(conversations))
+	var members []any
+	var maxID uint64
+	for _, c := range conversations {
+		args = append(args, c.ID, tenantID, c.CreatedAt)
+		for _, userID := range c.MemberUserIDs {
+			members = append(members, c.ID, userID, c.CreatedAt)
+		}
+		maxID = max(maxID, c.ID)
+	}
+	return r.s.withTenant(ctx, tenantID, func(q DBTX) error {
+		if err := insert(ctx, q, "conversation", "INSERT INTO conversations (id, tenant_id, created_at) VALUES", 3, args); err != nil {
+			return err
+		}
+		if err := advanceSequence(ctx, q, "conversations", maxID); err != nil {
+			return err
+		}
+		// conversation_members has no tenant_id; the conversations were created for tenantID just above.
+		return insert(tenantguard.AllowCrossTenant(ctx), q, "conversation member", "INSERT INTO conversation_members (conversation_id, user_id, joined_at) VALUES", 3, members)
+	})
+}
+
+func (r *bulkRepository) InsertMessages(ctx context.Context, tenantID uint64, messages []*domain.Message) error {
+	args := make([]any, 0, 6*len(messages))
+	var maxID uint64
+	bytes := 0
+	for _, m := range messages {
+		bytes += len(m.Body)
+		args = append(args, m.ID, tenantID, m.ConversationID, m.SenderUserID, m.Body, m.CreatedAt)
+		maxID = max(maxID, m.ID)
+	}
+	return r.s.withTenant(ctx, tenantID, func(q DBTX) error {
+		if err := insert(ctx, q, "message", "INSERT INTO messages (id, tenant_id, conversation_id, sender_user_id, body, created_at) VALUES", 6, args); err != nil {
+			return err
+		}
+		if err := advanceSequence(ctx, q, "messages", maxID); err != nil {
+			return err
+		}
+		return addUsage(ctx, q, tenantID, 0, bytes, 0)
+	})
+}
+
+// insert runs the INSERT ... VALUES statement prefix for args, holding width values per row, in
+// as few multi-row statements as the parameter limit allows.
+func insert(ctx context.Context, q DBTX, resource, prefix string, width int, args []any) error {
+	rowsPerStmt := maxPlaceholders / width
+	for len(args) > 0 {
+		n := min(len(args)/width, rowsPerStmt)
+		var b strings.Builder
+		b.WriteString(prefix)
+		for i := 0; i < n*width; i++ {
+			switch {
+			case i == 0:
+				b.WriteString(" (")
+			case i%width == 0:
+				b.WriteString("),(")
+			default:
+				b.WriteString(",")
+			}
+			b.WriteString("$" + strconv.Itoa(i+1))
+		}
+		b.WriteString(")")
+		if _, err := q.ExecContext(ctx, b.String(), args[:n*width]...); err != nil {
+			return translateError(err, resource)
+		}
+		args = args[n*width:]
+	}
+	return nil
+}
+
+// advanceSequence moves the identity sequence of table past id, as rows inserted with explicit
+// IDs do not advance it, so that later inserts do not reuse their IDs.
+func advanceSequence(ctx context.Context, q DBTX, table string, id uint64) error {
+	if id == 0 {
+		return nil
+	}
+	_, err := q.ExecContext(ctx, `SELECT setval(seq, GREATEST($2, COALESCE(pg_sequence_last_value(seq), 0)))
+          FROM (SELECT pg_get_serial_sequence($1, 'id')::regclass AS seq) s`, table, id)
+	return err
+}
@@ -0,0 +1,179 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/example/something-like-sns/apps/api/internal/adapter/repository/sqlutil"
+	"github.com/example/something-like-sns/apps/api/internal/adapter/repository/tenantguard"
+	"github.com/example/something-like-sns/apps/api/internal/domain"
+)
+
+// typeMap scans PostgreSQL arrays, which database/sql does not support.
+var typeMap = pgtype.NewMap()
+
+type dmRepository struct {
+	s *sqlStore
+}
+
+func (r *dmRepository) FindDMConversation(ctx context.Context, tenantID, userID1, userID2 uint64) (uint64, error) {
+	var convID uint64
+	err := r.s.withTenant(ctx, tenantID, func(q DBTX) error {
+		return q.QueryRowContext(ctx, `SELECT c.id FROM conversations c
+          JOIN conversation_members m1 ON m1.conversation_id=c.id AND m1.user_id=$1
+          JOIN conversation_members m2 ON m2.conversation_id=c.id AND m2.user_id=$2
+          WHERE c.tenant_id=$3 AND c.kind='dm' LIMIT 1`, userID1, userID2, tenantID).Scan(&convID)
+	})
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return 0, err
+	}
+	return convID, nil
+}
+
+func (r *dmRepository) IsMember(ctx context.Context, tenantID, conversationID, userID uint64) (bool, error) {
+	var ok bool
+	err := r.s.withTenant(ctx, tenantID, func(q DBTX) error {
+		return q.QueryRowContext(ctx, `SELECT EXISTS(
+          SELECT 1 FROM conversations c
+          JOIN conversation_members m ON m.conversation_id=c.id AND m.user_id=$1
+          WHERE c.tenant_id=$2 AND c.id=$3)`, userID, tenantID, conversationID).Scan(&ok)
+	})
+	return ok, err
+}
+
+func (r *dmRepository) CreateDMConversation(ctx context.Context, tenantID uint64, userIDs ...uint64) (uint64, error) {
+	// This method will be called within a transaction from the usecase layer.
+	// The transaction is handled by the sqlStore.
+	var convID uint64
+	err := r.s.withTenant(ctx, tenantID, func(q DBTX) error {
+		if err := q.QueryRowContext(ctx, "INSERT INTO conversations (tenant_id, kind) VALUES ($1, 'dm') RETURNING id", tenantID).Scan(&convID); err != nil {
+			return translateError(err, "conversation")
+		}
+
+		valueStrings := make([]string, 0, len(userIDs))
+		valueArgs := make([]interface{}, 0, len(userIDs)*2)
+		for i, userID := range userIDs {
+			valueStrings = append(valueStrings, fmt.Sprintf("($%d, $%d)", 2*i+1, 2*i+2))
+			valueArgs = append(valueArgs, convID, userID)
+		}
+		stmt := fmt.Sprintf("INSERT INTO conversation_members (conversation_id, user_id) VALUES %s", strings.Join(valueStrings, ","))
+		// conversation_members has no tenant_id; the conversation was created for tenantID just above.
+		if _, err := q.ExecContext(tenantguard.AllowCrossTenant(ctx), stmt, valueArgs...); err != nil {
+			return translateError(err, "conversation member")
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return convID, nil
+}
+
+func (r *dmRepository) FindConversations(ctx context.Context, tenantID, userID uint64, limit int, cursor domain.Cursor) ([]*domain.Conversation, bool, error) {
+	where, order, args := keyset("c.", cursor, true, 2)
+	items := make([]*domain.Conversation, 0, limit+1)
+	err := r.s.withTenant(ctx, tenantID, func(q DBTX) error {
+		rows, err := q.QueryContext(ctx, `
+            SELECT c.id, c.created_at,
+                   ARRAY(SELECT cm.user_id FROM conversation_members cm WHERE cm.conversation_id=c.id ORDER BY cm.user_id) AS members
+            FROM conversations c
+            JOIN conversation_members m ON m.conversation_id=c.id AND m.user_id=$1
+            WHERE c.tenant_id=$2`+where+`
+            `+order+`
+            LIMIT `+limitArg(args, 2), append(append([]interface{}{userID, tenantID}, args...), limit+1)...)
+		if err != nil {
+			return err
+		}
+		defer rows.Close()
+		for rows.Next() {
+			var conv domain.Conversation
+			var members []int64
+			if err := rows.Scan(&conv.ID, &conv.CreatedAt, typeMap.SQLScanner(&members)); err != nil {
+				return err
+			}
+			conv.MemberUserIDs = make([]uint64, len(members))
+			for i, m := range members {
+				conv.MemberUserIDs[i] = uint64(m)
+			}
+			items = append(items, &conv)
+		}
+		return rows.Err()
+	})
+	if err != nil {
+		return nil, false, err
+	}
+	items, hasMore := sqlutil.TrimPage(items, limit, cursor)
+	return items, hasMore, nil
+}
+
+func (r *dmRepository) FindMessageByID(ctx context.Context, tenantID, messageID uint64) (*domain.Message, error) {
+	var m domain.Message
+	err := r.s.withTenant(ctx, tenantID, func(q DBTX) error {
+		return q.QueryRowContext(ctx, "SELECT id, conversation_id, sender_user_id, body, created_at FROM messages WHERE tenant_id=$1 AND id=$2 AND deleted_at IS NULL", tenantID, messageID).Scan(&m.ID, &m.ConversationID, &m.SenderUserID, &m.Body, &m.CreatedAt)
+	})
+	if err != nil {
+		return nil, translateError(err, "message")
+	}
+	return &m, nil
+}
+
+func (r *dmRepository) FindMessages(ctx context.Context, tenantID, conversationID uint64, limit int, cursor domain.Cursor) ([]*domain.Message, bool, error) {
+	where, order, args := keyset("", cursor, true, 2)
+	items := make([]*domain.Message, 0, limit+1)
+	err := r.s.withTenant(ctx, tenantID, func(q DBTX) error {
+		rows, err := q.QueryContext(ctx, `
+            SELECT id, sender_user_id, body, created_at
+            FROM messages
+            WHERE tenant_id=$1 AND conversation_id=$2 AND deleted_at IS NULL`+where+`
+            `+order+`
+            LIMIT `+limitArg(args, 2), append(append([]interface{}{tenantID, conversationID}, args...), limit+1)...)
+		if err != nil {
+			return err
+		}
+		defer rows.Close()
+		for rows.Next() {
+			var msg domain.Message
+			if err := rows.Scan(&msg.ID, &msg.SenderUserID, &msg.Body, &msg.CreatedAt); err != nil {
+				return err
+			}
+			msg.ConversationID = conversationID
+			items = append(items, &msg)
+		}
+		return rows.Err()
+	})
+	if err != nil {
+		return nil, false, err
+	}
+	items, hasMore := sqlutil.TrimPage(items, limit, cursor)
+	return items, hasMore, nil
+}
+
+func (r *dmRepository) CreateMessage(ctx context.Context, tenantID, conversationID, senderID uint64, body string) (*domain.Message, error) {
+	msg := domain.Message{ConversationID: conversationID, SenderUserID: senderID, Body: body}
+	err := r.s.withTenant(ctx, tenantID, func(q DBTX) error {
+		if err := q.QueryRowContext(ctx, "INSERT INTO messages (tenant_id, conversation_id, sender_user_id, body) VALUES ($1,$2,$3,$4) RETURNING id, created_at", tenantID, conversationID, senderID, body).Scan(&msg.ID, &msg.CreatedAt); err != nil {
+			return err
+		}
+		return addUsage(ctx, q, tenantID, 0, len(body), 0)
+	})
+	if err != nil {
+		return nil, translateError(err, "message")
+	}
+	return &msg, nil
+}
+
+func (r *dmRepository) CountMessages(ctx context.Context, tenantID, conversationID uint64) (uint64, error) {
+	var n uint64
+	err := r.s.withTenant(ctx, tenantID, func(q DBTX) error {
+		return q.QueryRowContext(ctx, `
+            SELECT COUNT(*) FROM (
+                SELECT 1 FROM messages WHERE tenant_id=$1 AND conversation_id=$2 AND deleted_at IS NULL LIMIT $3
+            ) AS t`, tenantID, conversationID, sqlutil.CountCap).Scan(&n)
+	})
+	return n, err
+}
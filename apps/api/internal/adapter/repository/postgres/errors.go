@@ -0,0 +1,34 @@
+package postgres
+
+import (
+	"database/sql"
+	"errors"
+
+	"github.com/jackc/pgx/v5/pgconn"
+
+	"github.com/example/something-like-sns/apps/api/internal/domain"
+)
+
+// PostgreSQL SQLSTATE codes translated into domain errors.
+const (
+	uniqueViolation     = "23505"
+	foreignKeyViolation = "23503"
+)
+
+// translateError maps sql.ErrNoRows and PostgreSQL constraint violations on resource to domain errors.
+// Other errors are returned unchanged.
+func translateError(err error, resource string) error {
+	if errors.Is(err, sql.ErrNoRows) {
+		return domain.NewNotFoundError(resource, nil)
+	}
+	var pe *pgconn.PgError
+	if errors.As(err, &pe) {
+		switch pe.Code {
+		case uniqueViolation:
+			return domain.NewConflictError(resource, "already exists")
+		case foreignKeyViolation:
+			return domain.NewNotFoundError("referenced "+resource, nil)
+		}
+	}
+	return err
+}
@@ -0,0 +1,71 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/example/something-like-sns/apps/api/internal/adapter/repository/tenantguard"
+	"github.com/example/something-like-sns/apps/api/internal/domain"
+)
+
+type idempotencyRepository struct {
+	s *sqlStore
+}
+
+func (r *idempotencyRepository) Find(ctx context.Context, tenantID, userID uint64, procedure, key string) (*domain.IdempotencyRecord, error) {
+	rec := domain.IdempotencyRecord{TenantID: tenantID, UserID: userID, Procedure: procedure, Key: key}
+	err := r.s.withTenant(ctx, tenantID, func(q DBTX) error {
+		return q.QueryRowContext(ctx, `
+        SELECT request_hash, response, expires_at
+        FROM idempotency_keys
+        WHERE tenant_id=$1 AND user_id=$2 AND procedure_name=$3 AND idem_key=$4`,
+			tenantID, userID, procedure, key).Scan(&rec.RequestHash, &rec.Response, &rec.ExpiresAt)
+	})
+	if err != nil {
+		return nil, translateError(err, "idempotency key")
+	}
+	return &rec, nil
+}
+
+func (r *idempotencyRepository) Reserve(ctx context.Context, rec *domain.IdempotencyRecord) error {
+	err := r.s.withTenant(ctx, rec.TenantID, func(q DBTX) error {
+		_, err := q.ExecContext(ctx, `
+        INSERT INTO idempotency_keys (tenant_id, user_id, procedure_name, idem_key, request_hash, expires_at)
+        VALUES ($1, $2, $3, $4, $5, $6)`,
+			rec.TenantID, rec.UserID, rec.Procedure, rec.Key, rec.RequestHash, rec.ExpiresAt)
+		return err
+	})
+	return translateError(err, "idempotency key")
+}
+
+func (r *idempotencyRepository) Complete(ctx context.Context, tenantID, userID uint64, procedure, key string, response []byte) error {
+	return r.s.withTenant(ctx, tenantID, func(q DBTX) error {
+		_, err := q.ExecContext(ctx, `
+        UPDATE idempotency_keys SET response=$1
+        WHERE tenant_id=$2 AND user_id=$3 AND procedure_name=$4 AND idem_key=$5`,
+			response, tenantID, userID, procedure, key)
+		return err
+	})
+}
+
+func (r *idempotencyRepository) Delete(ctx context.Context, tenantID, userID uint64, procedure, key string) error {
+	return r.s.withTenant(ctx, tenantID, func(q DBTX) error {
+		_, err := q.ExecContext(ctx, `
+        DELETE FROM idempotency_keys
+        WHERE tenant_id=$1 AND user_id=$2 AND procedure_name=$3 AND idem_key=$4`,
+			tenantID, userID, procedure, key)
+		return err
+	})
+}
+
+// DeleteExpired runs without a tenant: the purge_expired policy lets it delete expired keys of
+// every tenant, as judged by the database clock.
+func (r *idempotencyRepository) DeleteExpired(ctx context.Context, now time.Time) (int64, error) {
+	// Expired keys are purged for every tenant at once.
+	ctx = tenantguard.AllowCrossTenant(ctx)
+	res, err := r.s.q.ExecContext(ctx, `DELETE FROM idempotency_keys WHERE expires_at <= $1`, now)
+	if err != nil {
+		return 0, err
+	}
+	return res.RowsAffected()
+}
@@ -0,0 +1,191 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/example/something-like-sns/apps/api/internal/domain"
+)
+
+type invitationRepository struct {
+	s *sqlStore
+}
+
+const invitationColumns = "id, role, created_by, created_at, expires_at, COALESCE(accepted_by, 0)"
+
+func (r *invitationRepository) CreateInvitation(ctx context.Context, tenantID uint64, inv *domain.Invitation, tokenHash []byte) (*domain.Invitation, error) {
+	var created *domain.Invitation
+	err := r.s.withTenant(ctx, tenantID, func(q DBTX) error {
+		var err error
+		created, err = scanInvitation(q.QueryRowContext(ctx, "INSERT INTO invitations (tenant_id, token_hash, role, created_by, expires_at) VALUES ($1,$2,$3,$4,$5) RETURNING "+invitationColumns,
+			tenantID, tokenHash, inv.Role, inv.CreatedByUserID, inv.ExpiresAt.UTC()))
+		return err
+	})
+	if err != nil {
+		return nil, translateError(err, "invitation")
+	}
+	return created, nil
+}
+
+func (r *invitationRepository) FindInvitationByTokenHash(ctx context.Context, tenantID uint64, tokenHash []byte) (*domain.Invitation, error) {
+	var inv *domain.Invitation
+	err := r.s.withTenant(ctx, tenantID, func(q DBTX) error {
+		var err error
+		inv, err = scanInvitation(q.QueryRowContext(ctx, "SELECT "+invitationColumns+" FROM invitations WHERE tenant_id=$1 AND token_hash=$2", tenantID, tokenHash))
+		return err
+	})
+	if err != nil {
+		return nil, translateError(err, "invitation")
+	}
+	return inv, nil
+}
+
+func (r *invitationRepository) FindPendingInvitations(ctx context.Context, tenantID uint64, now time.Time) ([]*domain.Invitation, error) {
+	var invs []*domain.Invitation
+	err := r.s.withTenant(ctx, tenantID, func(q DBTX) error {
+		rows, err := q.QueryContext(ctx, "SELECT "+invitationColumns+" FROM invitations WHERE tenant_id=$1 AND accepted_by IS NULL AND expires_at > $2 ORDER BY id", tenantID, now.UTC())
+		if err != nil {
+			return err
+		}
+		defer rows.Close()
+		for rows.Next() {
+			inv, err := scanInvitation(rows)
+			if err != nil {
+				return err
+			}
+			invs = append(invs, inv)
+		}
+		return rows.Err()
+	})
+	if err != nil {
+		return nil, err
+	}
+	return invs, nil
+}
+
+func (r *invitationRepository) ClaimInvitation(ctx context.Context, tenantID, invitationID, userID uint64, now time.Time) error {
+	var n int64
+	err := r.s.withTenant(ctx, tenantID, func(q DBTX) error {
+		res, err := q.ExecContext(ctx, "UPDATE invitations SET accepted_by=$1, accepted_at=now() WHERE tenant_id=$2 AND id=$3 AND accepted_by IS NULL AND expires_at > $4",
+			userID, tenantID, invitationID, now.UTC())
+		if err != nil {
+			return err
+		}
+		n, err = res.RowsAffected()
+		return err
+	})
+	if err != nil {
+		return translateError(err, "invitation")
+	}
+	if n == 0 {
+		return domain.NewConflictError("invitation", "is used or expired")
+	}
+	return nil
+}
+
+func (r *invitationRepository) DeleteInvitation(ctx context.Context, tenantID, invitationID uint64) error {
+	return r.s.withTenant(ctx, tenantID, func(q DBTX) error {
+		res, err := q.ExecContext(ctx, "DELETE FROM invitations WHERE tenant_id=$1 AND id=$2", tenantID, invitationID)
+		return checkFound(res, err, "invitation")
+	})
+}
+
+func (r *invitationRepository) CreateJoinRequest(ctx context.Context, tenantID, userID uint64, message string) (*domain.JoinRequest, error) {
+	var id uint64
+	err := r.s.withTenant(ctx, tenantID, func(q DBTX) error {
+		return q.QueryRowContext(ctx, "INSERT INTO join_requests (tenant_id, user_id, message) VALUES ($1,$2,$3) RETURNING id", tenantID, userID, message).Scan(&id)
+	})
+	if err != nil {
+		return nil, translateError(err, "join request")
+	}
+	return r.FindJoinRequest(ctx, tenantID, id)
+}
+
+const joinRequestQuery = "SELECT j.id, j.user_id, u.display_name, j.message, j.created_at FROM join_requests j JOIN users u ON u.id=j.user_id WHERE j.tenant_id=$1"
+
+func (r *invitationRepository) FindJoinRequest(ctx context.Context, tenantID, requestID uint64) (*domain.JoinRequest, error) {
+	var j domain.JoinRequest
+	err := r.s.withTenant(ctx, tenantID, func(q DBTX) error {
+		return q.QueryRowContext(ctx, joinRequestQuery+" AND j.id=$2", tenantID, requestID).Scan(&j.ID, &j.UserID, &j.DisplayName, &j.Message, &j.CreatedAt)
+	})
+	if err != nil {
+		return nil, translateError(err, "join request")
+	}
+	return &j, nil
+}
+
+func (r *invitationRepository) FindJoinRequests(ctx context.Context, tenantID uint64) ([]*domain.JoinRequest, error) {
+	var reqs []*domain.JoinRequest
+	err := r.s.withTenant(ctx, tenantID, func(q DBTX) error {
+		rows, err := q.QueryContext(ctx, joinRequestQuery+" ORDER BY j.id", tenantID)
+		if err != nil {
+			return err
+		}
+		defer rows.Close()
+		for rows.Next() {
+			var j domain.JoinRequest
+			if err := rows.Scan(&j.ID, &j.UserID, &j.DisplayName, &j.Message, &j.CreatedAt); err != nil {
+				return err
+			}
+			reqs = append(reqs, &j)
+		}
+		return rows.Err()
+	})
+	if err != nil {
+		return nil, err
+	}
+	return reqs, nil
+}
+
+func (r *invitationRepository) DeleteJoinRequest(ctx context.Context, tenantID, requestID uint64) error {
+	return r.s.withTenant(ctx, tenantID, func(q DBTX) error {
+		res, err := q.ExecContext(ctx, "DELETE FROM join_requests WHERE tenant_id=$1 AND id=$2", tenantID, requestID)
+		return checkFound(res, err, "join request")
+	})
+}
+
+func (r *invitationRepository) FindEmailDomains(ctx context.Context, tenantID uint64) ([]string, error) {
+	var domains []string
+	err := r.s.withTenant(ctx, tenantID, func(q DBTX) error {
+		rows, err := q.QueryContext(ctx, "SELECT domain FROM tenant_email_domains WHERE tenant_id=$1 ORDER BY domain", tenantID)
+		if err != nil {
+			return err
+		}
+		defer rows.Close()
+		for rows.Next() {
+			var d string
+			if err := rows.Scan(&d); err != nil {
+				return err
+			}
+			domains = append(domains, d)
+		}
+		return rows.Err()
+	})
+	if err != nil {
+		return nil, err
+	}
+	return domains, nil
+}
+
+func (r *invitationRepository) SetEmailDomains(ctx context.Context, tenantID uint64, domains []string) error {
+	err := r.s.withTenant(ctx, tenantID, func(q DBTX) error {
+		if _, err := q.ExecContext(ctx, "DELETE FROM tenant_email_domains WHERE tenant_id=$1", tenantID); err != nil {
+			return err
+		}
+		for _, d := range domains {
+			if _, err := q.ExecContext(ctx, "INSERT INTO tenant_email_domains (tenant_id, domain) VALUES ($1,$2)", tenantID, d); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	return translateError(err, "email domain")
+}
+
+func scanInvitation(row interface{ Scan(...any) error }) (*domain.Invitation, error) {
+	var inv domain.Invitation
+	if err := row.Scan(&inv.ID, &inv.Role, &inv.CreatedByUserID, &inv.CreatedAt, &inv.ExpiresAt, &inv.AcceptedByUserID); err != nil {
+		return nil, err
+	}
+	return &inv, nil
+}
@@ -0,0 +1,10 @@
+DROP TABLE IF EXISTS messages;
+DROP TABLE IF EXISTS conversation_members;
+DROP TABLE IF EXISTS conversations;
+DROP TABLE IF EXISTS reactions;
+DROP TABLE IF EXISTS comments;
+DROP TABLE IF EXISTS posts;
+DROP TABLE IF EXISTS tenant_memberships;
+DROP TABLE IF EXISTS users;
+DROP TABLE IF EXISTS tenant_domains;
+DROP TABLE IF EXISTS tenants;
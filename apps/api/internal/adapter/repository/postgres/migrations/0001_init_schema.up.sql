@@ -0,0 +1,116 @@
+-- Initial schema, PostgreSQL translation of migrations/0001_init_schema.up.sql
+
+-- tenants
+CREATE TABLE IF NOT EXISTS tenants (
+  id           BIGINT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+  slug         VARCHAR(64) NOT NULL UNIQUE,
+  name         VARCHAR(128) NOT NULL,
+  created_at   TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+-- tenant_domains
+CREATE TABLE IF NOT EXISTS tenant_domains (
+  id           BIGINT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+  tenant_id    BIGINT NOT NULL,
+  domain       VARCHAR(255) NOT NULL UNIQUE,
+  created_at   TIMESTAMPTZ NOT NULL DEFAULT now(),
+  CONSTRAINT fk_tenant_domains_tenant FOREIGN KEY (tenant_id) REFERENCES tenants(id)
+);
+
+-- users
+CREATE TABLE IF NOT EXISTS users (
+  id           BIGINT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+  auth_sub     VARCHAR(255) NOT NULL UNIQUE,
+  display_name VARCHAR(64) NOT NULL,
+  avatar_url   VARCHAR(512),
+  created_at   TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+-- tenant_memberships
+CREATE TABLE IF NOT EXISTS tenant_memberships (
+  id           BIGINT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+  tenant_id    BIGINT NOT NULL,
+  user_id      BIGINT NOT NULL,
+  role         VARCHAR(16) NOT NULL DEFAULT 'member' CHECK (role IN ('owner','admin','member')),
+  created_at   TIMESTAMPTZ NOT NULL DEFAULT now(),
+  CONSTRAINT uniq_membership UNIQUE (tenant_id, user_id),
+  CONSTRAINT fk_memberships_tenant FOREIGN KEY (tenant_id) REFERENCES tenants(id),
+  CONSTRAINT fk_memberships_user FOREIGN KEY (user_id) REFERENCES users(id)
+);
+
+-- posts
+CREATE TABLE IF NOT EXISTS posts (
+  id             BIGINT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+  tenant_id      BIGINT NOT NULL,
+  author_user_id BIGINT NOT NULL,
+  body           TEXT NOT NULL,
+  created_at     TIMESTAMPTZ NOT NULL DEFAULT now(),
+  updated_at     TIMESTAMPTZ NULL,
+  deleted_at     TIMESTAMPTZ NULL,
+  CONSTRAINT fk_posts_tenant FOREIGN KEY (tenant_id) REFERENCES tenants(id),
+  CONSTRAINT fk_posts_author FOREIGN KEY (author_user_id) REFERENCES users(id)
+);
+CREATE INDEX IF NOT EXISTS idx_posts_tenant_created ON posts (tenant_id, created_at DESC);
+
+-- comments
+CREATE TABLE IF NOT EXISTS comments (
+  id             BIGINT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+  tenant_id      BIGINT NOT NULL,
+  post_id        BIGINT NOT NULL,
+  author_user_id BIGINT NOT NULL,
+  body           TEXT NOT NULL,
+  created_at     TIMESTAMPTZ NOT NULL DEFAULT now(),
+  deleted_at     TIMESTAMPTZ NULL,
+  CONSTRAINT fk_comments_tenant FOREIGN KEY (tenant_id) REFERENCES tenants(id),
+  CONSTRAINT fk_comments_post FOREIGN KEY (post_id) REFERENCES posts(id),
+  CONSTRAINT fk_comments_author FOREIGN KEY (author_user_id) REFERENCES users(id)
+);
+CREATE INDEX IF NOT EXISTS idx_comments_tenant_post_created ON comments (tenant_id, post_id, created_at);
+
+-- reactions
+CREATE TABLE IF NOT EXISTS reactions (
+  id           BIGINT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+  tenant_id    BIGINT NOT NULL,
+  target_type  VARCHAR(16) NOT NULL CHECK (target_type IN ('post','comment')),
+  target_id    BIGINT NOT NULL,
+  user_id      BIGINT NOT NULL,
+  type         VARCHAR(16) NOT NULL DEFAULT 'like' CHECK (type IN ('like')),
+  created_at   TIMESTAMPTZ NOT NULL DEFAULT now(),
+  CONSTRAINT uniq_reaction UNIQUE (tenant_id, target_type, target_id, user_id, type),
+  CONSTRAINT fk_reactions_tenant FOREIGN KEY (tenant_id) REFERENCES tenants(id),
+  CONSTRAINT fk_reactions_user FOREIGN KEY (user_id) REFERENCES users(id)
+);
+CREATE INDEX IF NOT EXISTS idx_reactions_tenant_target ON reactions (tenant_id, target_type, target_id);
+
+-- conversations
+CREATE TABLE IF NOT EXISTS conversations (
+  id         BIGINT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+  tenant_id  BIGINT NOT NULL,
+  kind       VARCHAR(16) NOT NULL DEFAULT 'dm' CHECK (kind IN ('dm')),
+  created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  CONSTRAINT fk_conversations_tenant FOREIGN KEY (tenant_id) REFERENCES tenants(id)
+);
+
+CREATE TABLE IF NOT EXISTS conversation_members (
+  id               BIGINT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+  conversation_id  BIGINT NOT NULL,
+  user_id          BIGINT NOT NULL,
+  joined_at        TIMESTAMPTZ NOT NULL DEFAULT now(),
+  CONSTRAINT uniq_member UNIQUE (conversation_id, user_id),
+  CONSTRAINT fk_conv_members_conversation FOREIGN KEY (conversation_id) REFERENCES conversations(id),
+  CONSTRAINT fk_conv_members_user FOREIGN KEY (user_id) REFERENCES users(id)
+);
+
+-- messages
+CREATE TABLE IF NOT EXISTS messages (
+  id               BIGINT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+  tenant_id        BIGINT NOT NULL,
+  conversation_id  BIGINT NOT NULL,
+  sender_user_id   BIGINT NOT NULL,
+  body             TEXT NOT NULL,
+  created_at       TIMESTAMPTZ NOT NULL DEFAULT now(),
+  CONSTRAINT fk_messages_tenant FOREIGN KEY (tenant_id) REFERENCES tenants(id),
+  CONSTRAINT fk_messages_conversation FOREIGN KEY (conversation_id) REFERENCES conversations(id),
+  CONSTRAINT fk_messages_sender FOREIGN KEY (sender_user_id) REFERENCES users(id)
+);
+CREATE INDEX IF NOT EXISTS idx_messages_cnv_created ON messages (conversation_id, created_at);
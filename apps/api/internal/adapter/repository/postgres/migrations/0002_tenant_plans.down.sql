@@ -0,0 +1,5 @@
+ALTER TABLE tenants
+  DROP CONSTRAINT fk_tenants_plan,
+  DROP COLUMN plan;
+
+DROP TABLE IF EXISTS plans;
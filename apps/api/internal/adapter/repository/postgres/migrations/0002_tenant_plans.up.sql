@@ -0,0 +1,23 @@
+-- Per-tenant plans: rate limits and quotas
+
+-- plans
+CREATE TABLE IF NOT EXISTS plans (
+  name                VARCHAR(32) PRIMARY KEY,
+  posts_per_minute    INTEGER NOT NULL CHECK (posts_per_minute >= 0),
+  comments_per_minute INTEGER NOT NULL CHECK (comments_per_minute >= 0),
+  messages_per_minute INTEGER NOT NULL CHECK (messages_per_minute >= 0),
+  daily_posts         INTEGER NOT NULL CHECK (daily_posts >= 0),
+  storage_bytes       BIGINT NOT NULL CHECK (storage_bytes >= 0),
+  max_members         INTEGER NOT NULL CHECK (max_members >= 0),
+  created_at          TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+-- 0 means unlimited
+INSERT INTO plans (name, posts_per_minute, comments_per_minute, messages_per_minute, daily_posts, storage_bytes, max_members) VALUES
+  ('free',        10,  20,  20,  200,   52428800,   50),
+  ('pro',         30,  60,  60,  2000,  5368709120, 500),
+  ('enterprise',  120, 240, 240, 0,     0,          0);
+
+ALTER TABLE tenants
+  ADD COLUMN plan VARCHAR(32) NOT NULL DEFAULT 'free',
+  ADD CONSTRAINT fk_tenants_plan FOREIGN KEY (plan) REFERENCES plans(name);
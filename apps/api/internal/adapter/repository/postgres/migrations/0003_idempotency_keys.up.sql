@@ -0,0 +1,16 @@
+-- Idempotency keys for retried write RPCs
+
+CREATE TABLE IF NOT EXISTS idempotency_keys (
+  tenant_id      BIGINT NOT NULL,
+  user_id        BIGINT NOT NULL,
+  procedure_name VARCHAR(255) NOT NULL,
+  idem_key       VARCHAR(255) NOT NULL,
+  request_hash   BYTEA NOT NULL,
+  response       BYTEA NULL,
+  created_at     TIMESTAMPTZ NOT NULL DEFAULT now(),
+  expires_at     TIMESTAMPTZ NOT NULL,
+  PRIMARY KEY (tenant_id, user_id, procedure_name, idem_key),
+  CONSTRAINT fk_idempotency_tenant FOREIGN KEY (tenant_id) REFERENCES tenants(id),
+  CONSTRAINT fk_idempotency_user FOREIGN KEY (user_id) REFERENCES users(id)
+);
+CREATE INDEX IF NOT EXISTS idx_idempotency_expires ON idempotency_keys (expires_at);
@@ -0,0 +1,42 @@
+DROP POLICY IF EXISTS purge_expired ON idempotency_keys;
+DROP POLICY IF EXISTS own_memberships ON tenant_memberships;
+DROP POLICY IF EXISTS domain_lookup ON tenant_domains;
+
+DROP POLICY IF EXISTS tenant_isolation ON conversation_members;
+ALTER TABLE conversation_members NO FORCE ROW LEVEL SECURITY;
+ALTER TABLE conversation_members DISABLE ROW LEVEL SECURITY;
+
+DROP POLICY IF EXISTS tenant_isolation ON idempotency_keys;
+ALTER TABLE idempotency_keys NO FORCE ROW LEVEL SECURITY;
+ALTER TABLE idempotency_keys DISABLE ROW LEVEL SECURITY;
+
+DROP POLICY IF EXISTS tenant_isolation ON messages;
+ALTER TABLE messages NO FORCE ROW LEVEL SECURITY;
+ALTER TABLE messages DISABLE ROW LEVEL SECURITY;
+
+DROP POLICY IF EXISTS tenant_isolation ON conversations;
+ALTER TABLE conversations NO FORCE ROW LEVEL SECURITY;
+ALTER TABLE conversations DISABLE ROW LEVEL SECURITY;
+
+DROP POLICY IF EXISTS tenant_isolation ON reactions;
+ALTER TABLE reactions NO FORCE ROW LEVEL SECURITY;
+ALTER TABLE reactions DISABLE ROW LEVEL SECURITY;
+
+DROP POLICY IF EXISTS tenant_isolation ON comments;
+ALTER TABLE comments NO FORCE ROW LEVEL SECURITY;
+ALTER TABLE comments DISABLE ROW LEVEL SECURITY;
+
+DROP POLICY IF EXISTS tenant_isolation ON posts;
+ALTER TABLE posts NO FORCE ROW LEVEL SECURITY;
+ALTER TABLE posts DISABLE ROW LEVEL SECURITY;
+
+DROP POLICY IF EXISTS tenant_isolation ON tenant_memberships;
+ALTER TABLE tenant_memberships NO FORCE ROW LEVEL SECURITY;
+ALTER TABLE tenant_memberships DISABLE ROW LEVEL SECURITY;
+
+DROP POLICY IF EXISTS tenant_isolation ON tenant_domains;
+ALTER TABLE tenant_domains NO FORCE ROW LEVEL SECURITY;
+ALTER TABLE tenant_domains DISABLE ROW LEVEL SECURITY;
+
+DROP FUNCTION IF EXISTS app_user_id();
+DROP FUNCTION IF EXISTS app_tenant_id();
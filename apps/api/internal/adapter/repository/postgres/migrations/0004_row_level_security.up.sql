@@ -0,0 +1,81 @@
+-- Row-level security for tenant isolation.
+-- Tenant-scoped rows are only visible to, and writable by, transactions that set the tenant with
+--   SELECT set_config('app.tenant_id', '<id>', true)
+-- in addition to the application's own WHERE tenant_id clauses. FORCE makes the policies apply
+-- to the table owner too; superusers and BYPASSRLS roles are still exempt, so the API must not
+-- connect as one.
+
+CREATE OR REPLACE FUNCTION app_tenant_id() RETURNS BIGINT
+  LANGUAGE sql STABLE
+  AS $$ SELECT NULLIF(current_setting('app.tenant_id', true), '')::BIGINT $$;
+
+CREATE OR REPLACE FUNCTION app_user_id() RETURNS BIGINT
+  LANGUAGE sql STABLE
+  AS $$ SELECT NULLIF(current_setting('app.user_id', true), '')::BIGINT $$;
+
+ALTER TABLE tenant_domains ENABLE ROW LEVEL SECURITY;
+ALTER TABLE tenant_domains FORCE ROW LEVEL SECURITY;
+CREATE POLICY tenant_isolation ON tenant_domains
+  USING (tenant_id = app_tenant_id())
+  WITH CHECK (tenant_id = app_tenant_id());
+
+ALTER TABLE tenant_memberships ENABLE ROW LEVEL SECURITY;
+ALTER TABLE tenant_memberships FORCE ROW LEVEL SECURITY;
+CREATE POLICY tenant_isolation ON tenant_memberships
+  USING (tenant_id = app_tenant_id())
+  WITH CHECK (tenant_id = app_tenant_id());
+
+ALTER TABLE posts ENABLE ROW LEVEL SECURITY;
+ALTER TABLE posts FORCE ROW LEVEL SECURITY;
+CREATE POLICY tenant_isolation ON posts
+  USING (tenant_id = app_tenant_id())
+  WITH CHECK (tenant_id = app_tenant_id());
+
+ALTER TABLE comments ENABLE ROW LEVEL SECURITY;
+ALTER TABLE comments FORCE ROW LEVEL SECURITY;
+CREATE POLICY tenant_isolation ON comments
+  USING (tenant_id = app_tenant_id())
+  WITH CHECK (tenant_id = app_tenant_id());
+
+ALTER TABLE reactions ENABLE ROW LEVEL SECURITY;
+ALTER TABLE reactions FORCE ROW LEVEL SECURITY;
+CREATE POLICY tenant_isolation ON reactions
+  USING (tenant_id = app_tenant_id())
+  WITH CHECK (tenant_id = app_tenant_id());
+
+ALTER TABLE conversations ENABLE ROW LEVEL SECURITY;
+ALTER TABLE conversations FORCE ROW LEVEL SECURITY;
+CREATE POLICY tenant_isolation ON conversations
+  USING (tenant_id = app_tenant_id())
+  WITH CHECK (tenant_id = app_tenant_id());
+
+ALTER TABLE messages ENABLE ROW LEVEL SECURITY;
+ALTER TABLE messages FORCE ROW LEVEL SECURITY;
+CREATE POLICY tenant_isolation ON messages
+  USING (tenant_id = app_tenant_id())
+  WITH CHECK (tenant_id = app_tenant_id());
+
+ALTER TABLE idempotency_keys ENABLE ROW LEVEL SECURITY;
+ALTER TABLE idempotency_keys FORCE ROW LEVEL SECURITY;
+CREATE POLICY tenant_isolation ON idempotency_keys
+  USING (tenant_id = app_tenant_id())
+  WITH CHECK (tenant_id = app_tenant_id());
+
+-- conversation_members has no tenant_id; it follows the visibility of its conversation.
+ALTER TABLE conversation_members ENABLE ROW LEVEL SECURITY;
+ALTER TABLE conversation_members FORCE ROW LEVEL SECURITY;
+CREATE POLICY tenant_isolation ON conversation_members
+  USING (EXISTS (SELECT 1 FROM conversations c WHERE c.id = conversation_members.conversation_id))
+  WITH CHECK (EXISTS (SELECT 1 FROM conversations c WHERE c.id = conversation_members.conversation_id));
+
+-- Host lookups resolve the tenant, so they run before app.tenant_id is known.
+CREATE POLICY domain_lookup ON tenant_domains FOR SELECT
+  USING (true);
+
+-- A user lists their memberships across tenants with app.user_id set instead.
+CREATE POLICY own_memberships ON tenant_memberships FOR SELECT
+  USING (user_id = app_user_id());
+
+-- Expired keys are purged for all tenants at once.
+CREATE POLICY purge_expired ON idempotency_keys FOR DELETE
+  USING (expires_at <= now());
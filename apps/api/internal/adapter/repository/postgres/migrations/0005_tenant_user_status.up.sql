@@ -0,0 +1,4 @@
+-- Disabled tenants and suspended users, set by snsctl
+
+ALTER TABLE tenants ADD COLUMN IF NOT EXISTS disabled_at TIMESTAMPTZ NULL;
+ALTER TABLE users ADD COLUMN IF NOT EXISTS suspended_at TIMESTAMPTZ NULL;
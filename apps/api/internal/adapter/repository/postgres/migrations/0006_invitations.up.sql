@@ -0,0 +1,58 @@
+-- Join policies, email domain allowlists, invitations and join requests
+
+ALTER TABLE tenants ADD COLUMN IF NOT EXISTS join_policy VARCHAR(16) NOT NULL DEFAULT 'invite'
+  CHECK (join_policy IN ('open','invite','domain'));
+-- Existing tenants keep admitting anyone until an admin chooses a policy.
+UPDATE tenants SET join_policy = 'open';
+
+CREATE TABLE IF NOT EXISTS tenant_email_domains (
+  tenant_id    BIGINT NOT NULL,
+  domain       VARCHAR(255) NOT NULL,
+  PRIMARY KEY (tenant_id, domain),
+  CONSTRAINT fk_email_domains_tenant FOREIGN KEY (tenant_id) REFERENCES tenants(id)
+);
+
+CREATE TABLE IF NOT EXISTS invitations (
+  id           BIGINT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+  tenant_id    BIGINT NOT NULL,
+  token_hash   BYTEA NOT NULL UNIQUE,
+  role         VARCHAR(16) NOT NULL CHECK (role IN ('owner','admin','member')),
+  created_by   BIGINT NOT NULL,
+  created_at   TIMESTAMPTZ NOT NULL DEFAULT now(),
+  expires_at   TIMESTAMPTZ NOT NULL,
+  accepted_by  BIGINT NULL,
+  accepted_at  TIMESTAMPTZ NULL,
+  CONSTRAINT fk_invitations_tenant FOREIGN KEY (tenant_id) REFERENCES tenants(id),
+  CONSTRAINT fk_invitations_creator FOREIGN KEY (created_by) REFERENCES users(id),
+  CONSTRAINT fk_invitations_acceptor FOREIGN KEY (accepted_by) REFERENCES users(id)
+);
+CREATE INDEX IF NOT EXISTS idx_invitations_tenant ON invitations (tenant_id, id);
+
+CREATE TABLE IF NOT EXISTS join_requests (
+  id           BIGINT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+  tenant_id    BIGINT NOT NULL,
+  user_id      BIGINT NOT NULL,
+  message      VARCHAR(500) NOT NULL DEFAULT '',
+  created_at   TIMESTAMPTZ NOT NULL DEFAULT now(),
+  CONSTRAINT uniq_join_request UNIQUE (tenant_id, user_id),
+  CONSTRAINT fk_join_requests_tenant FOREIGN KEY (tenant_id) REFERENCES tenants(id),
+  CONSTRAINT fk_join_requests_user FOREIGN KEY (user_id) REFERENCES users(id)
+);
+
+ALTER TABLE tenant_email_domains ENABLE ROW LEVEL SECURITY;
+ALTER TABLE tenant_email_domains FORCE ROW LEVEL SECURITY;
+CREATE POLICY tenant_isolation ON tenant_email_domains
+  USING (tenant_id = app_tenant_id())
+  WITH CHECK (tenant_id = app_tenant_id());
+
+ALTER TABLE invitations ENABLE ROW LEVEL SECURITY;
+ALTER TABLE invitations FORCE ROW LEVEL SECURITY;
+CREATE POLICY tenant_isolation ON invitations
+  USING (tenant_id = app_tenant_id())
+  WITH CHECK (tenant_id = app_tenant_id());
+
+ALTER TABLE join_requests ENABLE ROW LEVEL SECURITY;
+ALTER TABLE join_requests FORCE ROW LEVEL SECURITY;
+CREATE POLICY tenant_isolation ON join_requests
+  USING (tenant_id = app_tenant_id())
+  WITH CHECK (tenant_id = app_tenant_id());
@@ -0,0 +1 @@
+ALTER TABLE tenant_domains DROP COLUMN IF EXISTS verified_at;
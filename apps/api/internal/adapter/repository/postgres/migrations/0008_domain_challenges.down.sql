@@ -0,0 +1,2 @@
+ALTER TABLE tenants DROP COLUMN IF EXISTS allow_subdomain_fallback;
+ALTER TABLE tenant_domains DROP COLUMN IF EXISTS verification_token;
@@ -0,0 +1,4 @@
+-- DNS TXT challenges for custom domains, and whether tenants also resolve from <slug>.<any host>
+
+ALTER TABLE tenant_domains ADD COLUMN IF NOT EXISTS verification_token VARCHAR(64) NULL;
+ALTER TABLE tenants ADD COLUMN IF NOT EXISTS allow_subdomain_fallback BOOLEAN NOT NULL DEFAULT TRUE;
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS uniq_membership_handle;
+ALTER TABLE tenant_memberships DROP COLUMN IF EXISTS handle;
+ALTER TABLE users DROP COLUMN IF EXISTS bio;
@@ -0,0 +1,5 @@
+-- User profiles: a bio next to the existing avatar_url, and a handle unique within each tenant
+
+ALTER TABLE users ADD COLUMN IF NOT EXISTS bio VARCHAR(280) NOT NULL DEFAULT '';
+ALTER TABLE tenant_memberships ADD COLUMN IF NOT EXISTS handle VARCHAR(30) NULL;
+CREATE UNIQUE INDEX IF NOT EXISTS uniq_membership_handle ON tenant_memberships (tenant_id, handle);
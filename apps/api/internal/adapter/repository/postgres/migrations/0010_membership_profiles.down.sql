@@ -0,0 +1,2 @@
+ALTER TABLE tenant_memberships DROP COLUMN IF EXISTS avatar_url;
+ALTER TABLE tenant_memberships DROP COLUMN IF EXISTS display_name;
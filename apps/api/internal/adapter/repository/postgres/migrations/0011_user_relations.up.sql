@@ -0,0 +1,20 @@
+-- Blocks and mutes, which members set on each other within a tenant
+
+CREATE TABLE IF NOT EXISTS user_relations (
+  tenant_id      BIGINT NOT NULL,
+  user_id        BIGINT NOT NULL,
+  target_user_id BIGINT NOT NULL,
+  kind           VARCHAR(16) NOT NULL CHECK (kind IN ('block','mute')),
+  created_at     TIMESTAMPTZ NOT NULL DEFAULT now(),
+  PRIMARY KEY (tenant_id, user_id, target_user_id, kind),
+  CONSTRAINT fk_user_relations_tenant FOREIGN KEY (tenant_id) REFERENCES tenants(id),
+  CONSTRAINT fk_user_relations_user FOREIGN KEY (user_id) REFERENCES users(id),
+  CONSTRAINT fk_user_relations_target FOREIGN KEY (target_user_id) REFERENCES users(id)
+);
+CREATE INDEX IF NOT EXISTS idx_user_relations_target ON user_relations (tenant_id, target_user_id);
+
+ALTER TABLE user_relations ENABLE ROW LEVEL SECURITY;
+ALTER TABLE user_relations FORCE ROW LEVEL SECURITY;
+CREATE POLICY tenant_isolation ON user_relations
+  USING (tenant_id = app_tenant_id())
+  WITH CHECK (tenant_id = app_tenant_id());
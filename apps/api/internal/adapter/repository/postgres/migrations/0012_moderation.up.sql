@@ -0,0 +1,54 @@
+-- Content reports, the moderation actions taken on them, and the audit trail of admin actions
+
+ALTER TABLE messages ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMPTZ NULL;
+ALTER TABLE tenant_memberships ADD COLUMN IF NOT EXISTS suspended_at TIMESTAMPTZ NULL;
+
+CREATE TABLE IF NOT EXISTS content_reports (
+  id                  BIGSERIAL PRIMARY KEY,
+  tenant_id           BIGINT NOT NULL,
+  target_type         VARCHAR(16) NOT NULL CHECK (target_type IN ('post','comment','message')),
+  target_id           BIGINT NOT NULL,
+  author_user_id      BIGINT NOT NULL,
+  reporter_user_id    BIGINT NOT NULL,
+  reason              VARCHAR(500) NOT NULL DEFAULT '',
+  content             TEXT NOT NULL,
+  status              VARCHAR(16) NOT NULL DEFAULT 'open' CHECK (status IN ('open','resolved')),
+  action              VARCHAR(16) NULL CHECK (action IN ('dismiss','hide','delete','warn','suspend_author')),
+  resolved_by_user_id BIGINT NULL,
+  resolved_at         TIMESTAMPTZ NULL,
+  created_at          TIMESTAMPTZ NOT NULL DEFAULT now(),
+  CONSTRAINT uniq_content_reports_reporter UNIQUE (tenant_id, target_type, target_id, reporter_user_id),
+  CONSTRAINT fk_content_reports_tenant FOREIGN KEY (tenant_id) REFERENCES tenants(id),
+  CONSTRAINT fk_content_reports_author FOREIGN KEY (author_user_id) REFERENCES users(id),
+  CONSTRAINT fk_content_reports_reporter FOREIGN KEY (reporter_user_id) REFERENCES users(id),
+  CONSTRAINT fk_content_reports_resolved_by FOREIGN KEY (resolved_by_user_id) REFERENCES users(id)
+);
+CREATE INDEX IF NOT EXISTS idx_content_reports_status_created ON content_reports (tenant_id, status, created_at);
+
+CREATE TABLE IF NOT EXISTS audit_events (
+  id            BIGSERIAL PRIMARY KEY,
+  tenant_id     BIGINT NOT NULL,
+  actor_user_id BIGINT NOT NULL,
+  action        VARCHAR(32) NOT NULL,
+  target_type   VARCHAR(16) NOT NULL,
+  target_id     BIGINT NOT NULL,
+  report_id     BIGINT NULL,
+  note          VARCHAR(500) NOT NULL DEFAULT '',
+  created_at    TIMESTAMPTZ NOT NULL DEFAULT now(),
+  CONSTRAINT fk_audit_events_tenant FOREIGN KEY (tenant_id) REFERENCES tenants(id),
+  CONSTRAINT fk_audit_events_actor FOREIGN KEY (actor_user_id) REFERENCES users(id),
+  CONSTRAINT fk_audit_events_report FOREIGN KEY (report_id) REFERENCES content_reports(id)
+);
+CREATE INDEX IF NOT EXISTS idx_audit_events_tenant_created ON audit_events (tenant_id, created_at);
+
+ALTER TABLE content_reports ENABLE ROW LEVEL SECURITY;
+ALTER TABLE content_reports FORCE ROW LEVEL SECURITY;
+CREATE POLICY tenant_isolation ON content_reports
+  USING (tenant_id = app_tenant_id())
+  WITH CHECK (tenant_id = app_tenant_id());
+
+ALTER TABLE audit_events ENABLE ROW LEVEL SECURITY;
+ALTER TABLE audit_events FORCE ROW LEVEL SECURITY;
+CREATE POLICY tenant_isolation ON audit_events
+  USING (tenant_id = app_tenant_id())
+  WITH CHECK (tenant_id = app_tenant_id());
@@ -0,0 +1,16 @@
+-- Members an admin removed, whom the join policy no longer admits by signing in
+
+CREATE TABLE IF NOT EXISTS tenant_member_removals (
+  tenant_id  BIGINT NOT NULL,
+  user_id    BIGINT NOT NULL,
+  removed_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+  PRIMARY KEY (tenant_id, user_id),
+  CONSTRAINT fk_tenant_member_removals_tenant FOREIGN KEY (tenant_id) REFERENCES tenants(id),
+  CONSTRAINT fk_tenant_member_removals_user FOREIGN KEY (user_id) REFERENCES users(id)
+);
+
+ALTER TABLE tenant_member_removals ENABLE ROW LEVEL SECURITY;
+ALTER TABLE tenant_member_removals FORCE ROW LEVEL SECURITY;
+CREATE POLICY tenant_isolation ON tenant_member_removals
+  USING (tenant_id = app_tenant_id())
+  WITH CHECK (tenant_id = app_tenant_id());
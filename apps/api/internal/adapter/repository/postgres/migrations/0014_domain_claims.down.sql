@@ -0,0 +1,7 @@
+DROP POLICY IF EXISTS domain_lookup ON tenant_domains;
+CREATE POLICY domain_lookup ON tenant_domains FOR SELECT
+  USING (true);
+
+DROP INDEX IF EXISTS uniq_tenant_domains_verified;
+ALTER TABLE tenant_domains DROP CONSTRAINT IF EXISTS uniq_tenant_domains_tenant;
+ALTER TABLE tenant_domains ADD CONSTRAINT tenant_domains_domain_key UNIQUE (domain);
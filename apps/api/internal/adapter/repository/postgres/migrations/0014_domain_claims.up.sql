@@ -0,0 +1,12 @@
+-- Only verified domains hold their host, so a pending claim cannot keep other tenants from it
+
+ALTER TABLE tenant_domains DROP CONSTRAINT IF EXISTS tenant_domains_domain_key;
+ALTER TABLE tenant_domains ADD CONSTRAINT uniq_tenant_domains_tenant UNIQUE (tenant_id, domain);
+CREATE UNIQUE INDEX IF NOT EXISTS uniq_tenant_domains_verified ON tenant_domains (domain) WHERE verified_at IS NOT NULL;
+-- The challenge is of no use once a domain is verified.
+UPDATE tenant_domains SET verification_token = NULL WHERE verified_at IS NOT NULL;
+
+-- Host lookups only need verified domains; pending claims and their challenges stay with their tenant.
+DROP POLICY IF EXISTS domain_lookup ON tenant_domains;
+CREATE POLICY domain_lookup ON tenant_domains FOR SELECT
+  USING (verified_at IS NOT NULL);
@@ -0,0 +1,26 @@
+-- Usage counters that the writes keep up to date, so that quota checks need not scan the content
+
+CREATE TABLE IF NOT EXISTS tenant_usage (
+  tenant_id     BIGINT PRIMARY KEY,
+  -- posts_today counts the posts created on usage_day (UTC)
+  usage_day     DATE NOT NULL,
+  posts_today   INT NOT NULL DEFAULT 0,
+  storage_bytes BIGINT NOT NULL DEFAULT 0,
+  members       INT NOT NULL DEFAULT 0,
+  CONSTRAINT fk_tenant_usage_tenant FOREIGN KEY (tenant_id) REFERENCES tenants(id)
+);
+
+INSERT INTO tenant_usage (tenant_id, usage_day, posts_today, storage_bytes, members)
+SELECT t.id, (now() AT TIME ZONE 'UTC')::date,
+  (SELECT COUNT(*) FROM posts WHERE tenant_id=t.id AND created_at AT TIME ZONE 'UTC' >= (now() AT TIME ZONE 'UTC')::date),
+  (SELECT COALESCE(SUM(octet_length(body)), 0) FROM posts WHERE tenant_id=t.id AND deleted_at IS NULL)
+    + (SELECT COALESCE(SUM(octet_length(body)), 0) FROM comments WHERE tenant_id=t.id AND deleted_at IS NULL)
+    + (SELECT COALESCE(SUM(octet_length(body)), 0) FROM messages WHERE tenant_id=t.id AND deleted_at IS NULL),
+  (SELECT COUNT(*) FROM tenant_memberships WHERE tenant_id=t.id)
+FROM tenants t;
+
+ALTER TABLE tenant_usage ENABLE ROW LEVEL SECURITY;
+ALTER TABLE tenant_usage FORCE ROW LEVEL SECURITY;
+CREATE POLICY tenant_isolation ON tenant_usage
+  USING (tenant_id = app_tenant_id())
+  WITH CHECK (tenant_id = app_tenant_id());
@@ -0,0 +1,171 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/example/something-like-sns/apps/api/internal/adapter/repository/sqlutil"
+	"github.com/example/something-like-sns/apps/api/internal/domain"
+)
+
+type moderationRepository struct {
+	s *sqlStore
+}
+
+const reportColumns = "id, target_type, target_id, author_user_id, reporter_user_id, reason, content, status, created_at, COALESCE(action, ''), COALESCE(resolved_by_user_id, 0), resolved_at"
+
+func (r *moderationRepository) CreateReport(ctx context.Context, tenantID uint64, rep *domain.Report) (*domain.Report, error) {
+	var created *domain.Report
+	err := r.s.withTenant(ctx, tenantID, func(q DBTX) error {
+		var err error
+		created, err = scanReport(q.QueryRowContext(ctx, "INSERT INTO content_reports (tenant_id, target_type, target_id, author_user_id, reporter_user_id, reason, content) VALUES ($1,$2,$3,$4,$5,$6,$7) RETURNING "+reportColumns,
+			tenantID, string(rep.TargetType), rep.TargetID, rep.AuthorUserID, rep.ReporterUserID, rep.Reason, rep.Content))
+		return err
+	})
+	if err != nil {
+		return nil, translateError(err, "report")
+	}
+	return created, nil
+}
+
+func (r *moderationRepository) FindReport(ctx context.Context, tenantID, reportID uint64) (*domain.Report, error) {
+	var rep *domain.Report
+	err := r.s.withTenant(ctx, tenantID, func(q DBTX) error {
+		var err error
+		rep, err = scanReport(q.QueryRowContext(ctx, "SELECT "+reportColumns+" FROM content_reports WHERE tenant_id=$1 AND id=$2", tenantID, reportID))
+		return err
+	})
+	if err != nil {
+		return nil, translateError(err, "report")
+	}
+	return rep, nil
+}
+
+func (r *moderationRepository) FindReports(ctx context.Context, tenantID uint64, status string, limit int, cursor domain.Cursor) ([]*domain.Report, bool, error) {
+	where, order, args := keyset("", cursor, false, 2)
+	items := make([]*domain.Report, 0, limit+1)
+	err := r.s.withTenant(ctx, tenantID, func(q DBTX) error {
+		rows, err := q.QueryContext(ctx, "SELECT "+reportColumns+" FROM content_reports WHERE tenant_id=$1 AND status=$2"+where+" "+order+" LIMIT "+limitArg(args, 2),
+			append(append([]interface{}{tenantID, status}, args...), limit+1)...)
+		if err != nil {
+			return err
+		}
+		defer rows.Close()
+		for rows.Next() {
+			rep, err := scanReport(rows)
+			if err != nil {
+				return err
+			}
+			items = append(items, rep)
+		}
+		return rows.Err()
+	})
+	if err != nil {
+		return nil, false, err
+	}
+	items, hasMore := sqlutil.TrimPage(items, limit, cursor)
+	return items, hasMore, nil
+}
+
+func (r *moderationRepository) ResolveReports(ctx context.Context, tenantID uint64, targetType domain.ReportTargetType, targetID, userID uint64, action domain.ModerationAction, now time.Time) error {
+	return r.s.withTenant(ctx, tenantID, func(q DBTX) error {
+		_, err := q.ExecContext(ctx, "UPDATE content_reports SET status='resolved', action=$1, resolved_by_user_id=$2, resolved_at=$3 WHERE tenant_id=$4 AND target_type=$5 AND target_id=$6 AND status='open'",
+			string(action), userID, now.UTC(), tenantID, string(targetType), targetID)
+		return err
+	})
+}
+
+func (r *moderationRepository) RemoveContent(ctx context.Context, tenantID uint64, targetType domain.ReportTargetType, targetID uint64, erase bool) error {
+	table, err := contentTable(targetType)
+	if err != nil {
+		return err
+	}
+	return r.s.withTenant(ctx, tenantID, func(q DBTX) error {
+		// The content stops counting toward the storage quota when it is first removed.
+		var size int
+		err := q.QueryRowContext(ctx, "UPDATE "+table+" SET deleted_at=now() WHERE tenant_id=$1 AND id=$2 AND deleted_at IS NULL RETURNING octet_length(body)", tenantID, targetID).Scan(&size)
+		switch {
+		case err == nil:
+			if err := addUsage(ctx, q, tenantID, 0, -size, 0); err != nil {
+				return err
+			}
+		case errors.Is(err, sql.ErrNoRows):
+			var found int
+			if err := q.QueryRowContext(ctx, "SELECT 1 FROM "+table+" WHERE tenant_id=$1 AND id=$2", tenantID, targetID).Scan(&found); err != nil {
+				return translateError(err, string(targetType))
+			}
+		default:
+			return err
+		}
+		if !erase {
+			return nil
+		}
+		if _, err := q.ExecContext(ctx, "UPDATE "+table+" SET body='' WHERE tenant_id=$1 AND id=$2", tenantID, targetID); err != nil {
+			return err
+		}
+		// Reports keep a copy of the content, which goes with it.
+		_, err = q.ExecContext(ctx, "UPDATE content_reports SET content='' WHERE tenant_id=$1 AND target_type=$2 AND target_id=$3", tenantID, targetType, targetID)
+		return err
+	})
+}
+
+func (r *moderationRepository) AppendAuditEvent(ctx context.Context, tenantID uint64, e *domain.AuditEvent) error {
+	err := r.s.withTenant(ctx, tenantID, func(q DBTX) error {
+		_, err := q.ExecContext(ctx, "INSERT INTO audit_events (tenant_id, actor_user_id, action, target_type, target_id, report_id, note) VALUES ($1,$2,$3,$4,$5,NULLIF($6::bigint, 0),$7)",
+			tenantID, e.ActorUserID, e.Action, e.TargetType, e.TargetID, e.ReportID, e.Note)
+		return err
+	})
+	return translateError(err, "audit event")
+}
+
+func (r *moderationRepository) FindAuditEvents(ctx context.Context, tenantID uint64, limit int, cursor domain.Cursor) ([]*domain.AuditEvent, bool, error) {
+	where, order, args := keyset("", cursor, true, 1)
+	items := make([]*domain.AuditEvent, 0, limit+1)
+	err := r.s.withTenant(ctx, tenantID, func(q DBTX) error {
+		rows, err := q.QueryContext(ctx, "SELECT id, actor_user_id, action, target_type, target_id, COALESCE(report_id, 0), note, created_at FROM audit_events WHERE tenant_id=$1"+where+" "+order+" LIMIT "+limitArg(args, 1),
+			append(append([]interface{}{tenantID}, args...), limit+1)...)
+		if err != nil {
+			return err
+		}
+		defer rows.Close()
+		for rows.Next() {
+			var e domain.AuditEvent
+			if err := rows.Scan(&e.ID, &e.ActorUserID, &e.Action, &e.TargetType, &e.TargetID, &e.ReportID, &e.Note, &e.CreatedAt); err != nil {
+				return err
+			}
+			items = append(items, &e)
+		}
+		return rows.Err()
+	})
+	if err != nil {
+		return nil, false, err
+	}
+	items, hasMore := sqlutil.TrimPage(items, limit, cursor)
+	return items, hasMore, nil
+}
+
+// contentTable returns the table holding reportable content of targetType.
+func contentTable(targetType domain.ReportTargetType) (string, error) {
+	switch targetType {
+	case domain.ReportTargetPost:
+		return "posts", nil
+	case domain.ReportTargetComment:
+		return "comments", nil
+	case domain.ReportTargetMessage:
+		return "messages", nil
+	}
+	return "", fmt.Errorf("unknown report target type %q", targetType)
+}
+
+func scanReport(row interface{ Scan(...any) error }) (*domain.Report, error) {
+	var rep domain.Report
+	var resolvedAt sql.NullTime
+	if err := row.Scan(&rep.ID, &rep.TargetType, &rep.TargetID, &rep.AuthorUserID, &rep.ReporterUserID, &rep.Reason, &rep.Content, &rep.Status, &rep.CreatedAt, &rep.Action, &rep.ResolvedByUserID, &resolvedAt); err != nil {
+		return nil, err
+	}
+	rep.ResolvedAt = resolvedAt.Time
+	return &rep, nil
+}
@@ -0,0 +1,39 @@
+package postgres
+
+import (
+	"fmt"
+
+	"github.com/example/something-like-sns/apps/api/internal/domain"
+)
+
+// keyset returns the predicate (with a leading AND, empty at the start of the list) and the ORDER BY clause
+// that select the rows following c in a list ordered by (created_at, id), newest first when desc is set.
+// Backward cursors select the preceding rows in reverse order; callers reverse the scanned rows back.
+// prefix qualifies the column names, e.g. "p.", and n is the number of query arguments before the
+// returned ones, which are numbered from $n+1.
+func keyset(prefix string, c domain.Cursor, desc bool, n int) (string, string, []interface{}) {
+	return keysetOn(prefix+"created_at", prefix+"id", c, desc, n)
+}
+
+// keysetOn is keyset for a list ordered by the columns timeCol and idCol.
+func keysetOn(timeCol, idCol string, c domain.Cursor, desc bool, n int) (string, string, []interface{}) {
+	if c.Direction == domain.PageBackward {
+		desc = !desc
+	}
+	cmp, dir := ">", "ASC"
+	if desc {
+		cmp, dir = "<", "DESC"
+	}
+	order := fmt.Sprintf("ORDER BY %[1]s %[3]s, %[2]s %[3]s", timeCol, idCol, dir)
+	if c.IsZero() {
+		return "", order, nil
+	}
+	where := fmt.Sprintf(" AND (%[1]s, %[2]s) %[3]s ($%[4]d, $%[5]d)", timeCol, idCol, cmp, n+1, n+2)
+	return where, order, []interface{}{c.Time, c.ID}
+}
+
+// limitArg returns the placeholder of the LIMIT argument that follows n fixed arguments and
+// the keyset arguments.
+func limitArg(keysetArgs []interface{}, n int) string {
+	return fmt.Sprintf("$%d", n+len(keysetArgs)+1)
+}
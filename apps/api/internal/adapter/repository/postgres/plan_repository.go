@@ -0,0 +1,52 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/example/something-like-sns/apps/api/internal/domain"
+)
+
+type planRepository struct {
+	s *sqlStore
+}
+
+func (r *planRepository) FindTenantPlan(ctx context.Context, tenantID uint64) (*domain.Plan, error) {
+	var p domain.Plan
+	err := r.s.q.QueryRowContext(ctx, `
+        SELECT p.name, p.posts_per_minute, p.comments_per_minute, p.messages_per_minute, p.daily_posts, p.storage_bytes, p.max_members
+        FROM tenants t
+        JOIN plans p ON p.name=t.plan
+        WHERE t.id=$1`, tenantID).Scan(&p.Name, &p.PostsPerMinute, &p.CommentsPerMinute, &p.MessagesPerMinute, &p.DailyPosts, &p.StorageBytes, &p.MaxMembers)
+	if err != nil {
+		return nil, translateError(err, "plan")
+	}
+	return &p, nil
+}
+
+func (r *planRepository) GetUsage(ctx context.Context, tenantID uint64) (*domain.TenantUsage, error) {
+	var u domain.TenantUsage
+	err := r.s.withTenant(ctx, tenantID, func(q DBTX) error {
+		return q.QueryRowContext(ctx, "SELECT CASE WHEN usage_day=(now() AT TIME ZONE 'UTC')::date THEN posts_today ELSE 0 END, storage_bytes, members FROM tenant_usage WHERE tenant_id=$1", tenantID).
+			Scan(&u.PostsToday, &u.StorageBytes, &u.Members)
+	})
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, err
+	}
+	return &u, nil
+}
+
+// addUsage adds to the tenant's usage counters, starting today's count of posts over if they were
+// last counted on an earlier day. It must run in the tenant's transaction.
+func addUsage(ctx context.Context, q DBTX, tenantID uint64, posts, bytes, members int) error {
+	_, err := q.ExecContext(ctx, `
+        INSERT INTO tenant_usage (tenant_id, usage_day, posts_today, storage_bytes, members) VALUES ($1, (now() AT TIME ZONE 'UTC')::date, $2, $3, $4)
+        ON CONFLICT (tenant_id) DO UPDATE SET
+            posts_today=CASE WHEN tenant_usage.usage_day=excluded.usage_day THEN tenant_usage.posts_today ELSE 0 END + excluded.posts_today,
+            usage_day=excluded.usage_day,
+            storage_bytes=tenant_usage.storage_bytes + excluded.storage_bytes,
+            members=tenant_usage.members + excluded.members`,
+		tenantID, posts, bytes, members)
+	return err
+}
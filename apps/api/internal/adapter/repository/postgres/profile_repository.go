@@ -0,0 +1,127 @@
+package postgres
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/example/something-like-sns/apps/api/internal/adapter/repository/sqlutil"
+	"github.com/example/something-like-sns/apps/api/internal/domain"
+)
+
+type profileRepository struct {
+	s *sqlStore
+}
+
+func (r *profileRepository) FindProfiles(ctx context.Context, tenantID uint64, userIDs []uint64) ([]*domain.Profile, error) {
+	if len(userIDs) == 0 {
+		return nil, nil
+	}
+	var b strings.Builder
+	b.WriteString("SELECT u.id, COALESCE(m.display_name, u.display_name), COALESCE(m.handle, ''), u.bio, COALESCE(m.avatar_url, u.avatar_url, '') FROM users u LEFT JOIN tenant_memberships m ON m.user_id=u.id AND m.tenant_id=$1 WHERE u.id IN (")
+	args := make([]any, 0, len(userIDs)+1)
+	args = append(args, tenantID)
+	for i, id := range userIDs {
+		if i > 0 {
+			b.WriteString(",")
+		}
+		b.WriteString("$" + strconv.Itoa(i+2))
+		args = append(args, id)
+	}
+	b.WriteString(")")
+
+	profiles := make([]*domain.Profile, 0, len(userIDs))
+	err := r.s.withTenant(ctx, tenantID, func(q DBTX) error {
+		rows, err := q.QueryContext(ctx, b.String(), args...)
+		if err != nil {
+			return err
+		}
+		defer rows.Close()
+		for rows.Next() {
+			var p domain.Profile
+			if err := rows.Scan(&p.UserID, &p.DisplayName, &p.Handle, &p.Bio, &p.AvatarURL); err != nil {
+				return err
+			}
+			profiles = append(profiles, &p)
+		}
+		return rows.Err()
+	})
+	if err != nil {
+		return nil, err
+	}
+	return profiles, nil
+}
+
+func (r *profileRepository) UpdateProfile(ctx context.Context, tenantID uint64, p *domain.Profile) error {
+	return r.s.withTenant(ctx, tenantID, func(q DBTX) error {
+		res, err := q.ExecContext(ctx, "UPDATE tenant_memberships SET handle=NULLIF($1, '') WHERE tenant_id=$2 AND user_id=$3", p.Handle, tenantID, p.UserID)
+		if err != nil {
+			return translateError(err, "handle")
+		}
+		if err := checkFound(res, nil, "membership"); err != nil {
+			return err
+		}
+		_, err = q.ExecContext(ctx, "UPDATE users SET display_name=$1, bio=$2, avatar_url=NULLIF($3, '') WHERE id=$4", p.DisplayName, p.Bio, p.AvatarURL, p.UserID)
+		return err
+	})
+}
+
+func (r *profileRepository) FindProfileSettings(ctx context.Context, tenantID, userID uint64) (*domain.ProfileSettings, error) {
+	var ps domain.ProfileSettings
+	err := r.s.withTenant(ctx, tenantID, func(q DBTX) error {
+		return q.QueryRowContext(ctx, "SELECT u.id, u.display_name, COALESCE(m.handle, ''), u.bio, COALESCE(u.avatar_url, ''), COALESCE(m.display_name, ''), COALESCE(m.avatar_url, '') FROM tenant_memberships m JOIN users u ON u.id=m.user_id WHERE m.tenant_id=$1 AND m.user_id=$2", tenantID, userID).
+			Scan(&ps.UserID, &ps.DisplayName, &ps.Handle, &ps.Bio, &ps.AvatarURL, &ps.Override.DisplayName, &ps.Override.AvatarURL)
+	})
+	if err != nil {
+		return nil, translateError(err, "membership")
+	}
+	return &ps, nil
+}
+
+func (r *profileRepository) UpdateProfileOverride(ctx context.Context, tenantID, userID uint64, o domain.ProfileOverride) error {
+	return r.s.withTenant(ctx, tenantID, func(q DBTX) error {
+		res, err := q.ExecContext(ctx, "UPDATE tenant_memberships SET display_name=NULLIF($1, ''), avatar_url=NULLIF($2, '') WHERE tenant_id=$3 AND user_id=$4", o.DisplayName, o.AvatarURL, tenantID, userID)
+		return checkFound(res, err, "membership")
+	})
+}
+
+func (r *profileRepository) FindDirectoryMembers(ctx context.Context, tenantID, viewerID uint64, filter domain.MemberFilter, limit int, cursor domain.Cursor) ([]*domain.DirectoryMember, bool, error) {
+	query := "SELECT u.id, COALESCE(m.display_name, u.display_name), COALESCE(m.handle, ''), u.bio, COALESCE(m.avatar_url, u.avatar_url, ''), m.role, m.created_at FROM tenant_memberships m JOIN users u ON u.id=m.user_id WHERE m.tenant_id=$1 AND u.suspended_at IS NULL AND m.suspended_at IS NULL" +
+		" AND NOT EXISTS(SELECT 1 FROM user_relations ur WHERE ur.tenant_id=m.tenant_id AND ur.kind='block' AND ((ur.user_id=$2 AND ur.target_user_id=m.user_id) OR (ur.user_id=m.user_id AND ur.target_user_id=$2)))"
+	args := []any{tenantID, viewerID}
+	if filter.Role != "" {
+		args = append(args, filter.Role)
+		query += " AND m.role=$" + strconv.Itoa(len(args))
+	}
+	if filter.Prefix != "" {
+		args = append(args, sqlutil.EscapeLike(filter.Prefix)+"%")
+		n := strconv.Itoa(len(args))
+		query += " AND (COALESCE(m.display_name, u.display_name) ILIKE $" + n + " ESCAPE '!' OR m.handle ILIKE $" + n + " ESCAPE '!')"
+	}
+	where, order, keyArgs := keysetOn("m.created_at", "m.user_id", cursor, false, len(args))
+	args = append(args, keyArgs...)
+	args = append(args, limit+1)
+	query += where + " " + order + " LIMIT $" + strconv.Itoa(len(args))
+
+	members := make([]*domain.DirectoryMember, 0, limit+1)
+	err := r.s.withTenant(ctx, tenantID, func(q DBTX) error {
+		rows, err := q.QueryContext(ctx, query, args...)
+		if err != nil {
+			return err
+		}
+		defer rows.Close()
+		for rows.Next() {
+			var m domain.DirectoryMember
+			if err := rows.Scan(&m.UserID, &m.DisplayName, &m.Handle, &m.Bio, &m.AvatarURL, &m.Role, &m.JoinedAt); err != nil {
+				return err
+			}
+			members = append(members, &m)
+		}
+		return rows.Err()
+	})
+	if err != nil {
+		return nil, false, err
+	}
+	members, hasMore := sqlutil.TrimPage(members, limit, cursor)
+	return members, hasMore, nil
+}
@@ -0,0 +1,41 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/example/something-like-sns/apps/api/internal/domain"
+)
+
+type reactionRepository struct {
+	s *sqlStore
+}
+
+func (r *reactionRepository) Toggle(ctx context.Context, tenantID, userID uint64, targetType domain.ReactionTargetType, targetID uint64, reactionType string) (bool, error) {
+	active := false
+	err := r.s.withTenant(ctx, tenantID, func(q DBTX) error {
+		res, err := q.ExecContext(ctx, "DELETE FROM reactions WHERE tenant_id=$1 AND target_type=$2 AND target_id=$3 AND user_id=$4 AND type=$5", tenantID, string(targetType), targetID, userID, reactionType)
+		if err != nil {
+			return err
+		}
+		affected, _ := res.RowsAffected()
+		if affected == 0 {
+			if _, err := q.ExecContext(ctx, "INSERT INTO reactions (tenant_id, target_type, target_id, user_id, type) VALUES ($1,$2,$3,$4,$5)", tenantID, string(targetType), targetID, userID, reactionType); err != nil {
+				return err
+			}
+			active = true
+		}
+		return nil
+	})
+	return active, err
+}
+
+func (r *reactionRepository) Count(ctx context.Context, tenantID uint64, targetType domain.ReactionTargetType, targetID uint64, reactionType string) (uint32, error) {
+	var total uint32
+	err := r.s.withTenant(ctx, tenantID, func(q DBTX) error {
+		return q.QueryRowContext(ctx, "SELECT COUNT(*) FROM reactions WHERE tenant_id=$1 AND target_type=$2 AND target_id=$3 AND type=$4", tenantID, string(targetType), targetID, reactionType).Scan(&total)
+	})
+	if err != nil {
+		return 0, err
+	}
+	return total, nil
+}
@@ -0,0 +1,49 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/example/something-like-sns/apps/api/internal/domain"
+)
+
+type relationRepository struct {
+	s *sqlStore
+}
+
+func (r *relationRepository) AddRelation(ctx context.Context, tenantID, userID, targetID uint64, kind domain.RelationKind) error {
+	return r.s.withTenant(ctx, tenantID, func(q DBTX) error {
+		_, err := q.ExecContext(ctx, "INSERT INTO user_relations (tenant_id, user_id, target_user_id, kind) VALUES ($1, $2, $3, $4) ON CONFLICT (tenant_id, user_id, target_user_id, kind) DO NOTHING", tenantID, userID, targetID, string(kind))
+		return translateError(err, "relation")
+	})
+}
+
+func (r *relationRepository) RemoveRelation(ctx context.Context, tenantID, userID, targetID uint64, kind domain.RelationKind) error {
+	return r.s.withTenant(ctx, tenantID, func(q DBTX) error {
+		_, err := q.ExecContext(ctx, "DELETE FROM user_relations WHERE tenant_id=$1 AND user_id=$2 AND target_user_id=$3 AND kind=$4", tenantID, userID, targetID, string(kind))
+		return err
+	})
+}
+
+func (r *relationRepository) IsBlocked(ctx context.Context, tenantID, userID1, userID2 uint64) (bool, error) {
+	var ok bool
+	err := r.s.withTenant(ctx, tenantID, func(q DBTX) error {
+		return q.QueryRowContext(ctx, `SELECT EXISTS(
+          SELECT 1 FROM user_relations
+          WHERE tenant_id=$1 AND kind='block' AND ((user_id=$2 AND target_user_id=$3) OR (user_id=$3 AND target_user_id=$2)))`,
+			tenantID, userID1, userID2).Scan(&ok)
+	})
+	return ok, err
+}
+
+func (r *relationRepository) IsBlockedInConversation(ctx context.Context, tenantID, conversationID, userID uint64) (bool, error) {
+	var ok bool
+	err := r.s.withTenant(ctx, tenantID, func(q DBTX) error {
+		return q.QueryRowContext(ctx, `SELECT EXISTS(
+          SELECT 1 FROM conversations c
+          JOIN conversation_members m ON m.conversation_id=c.id AND m.user_id<>$1
+          JOIN user_relations ur ON ur.tenant_id=c.tenant_id AND ur.kind='block'
+            AND ((ur.user_id=$1 AND ur.target_user_id=m.user_id) OR (ur.user_id=m.user_id AND ur.target_user_id=$1))
+          WHERE c.tenant_id=$2 AND c.id=$3)`, userID, tenantID, conversationID).Scan(&ok)
+	})
+	return ok, err
+}
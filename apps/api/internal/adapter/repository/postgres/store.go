@@ -0,0 +1,165 @@
+// Package postgres implements port.Store on PostgreSQL. Besides the WHERE tenant_id clauses
+// of every query, tenant isolation is enforced by the row-level security policies of
+// migrations/0004_row_level_security: each statement on a tenant-scoped table runs in a transaction
+// whose app.tenant_id setting names the tenant.
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/example/something-like-sns/apps/api/internal/adapter/repository/instrument"
+	"github.com/example/something-like-sns/apps/api/internal/adapter/repository/tenantguard"
+	"github.com/example/something-like-sns/apps/api/internal/port"
+)
+
+// DBTX is an interface that is satisfied by both *sql.DB and *sql.Tx
+type DBTX = instrument.DBTX
+
+// dbSystem is the database system name reported in traces.
+const dbSystem = "postgresql"
+
+// Settings read by the row-level security policies.
+const (
+	settingTenantID = "app.tenant_id"
+	settingUserID   = "app.user_id"
+)
+
+// sqlStore provides all functions to execute db queries and transactions
+type sqlStore struct {
+	db        *sql.DB
+	q         DBTX
+	inTx      bool
+	slowQuery time.Duration
+	guard     tenantguard.Mode
+}
+
+// StoreOption configures a Store created by NewStore.
+type StoreOption func(*sqlStore)
+
+// WithSlowQueryThreshold logs statements that take at least d. Zero, the default, disables it.
+func WithSlowQueryThreshold(d time.Duration) StoreOption {
+	return func(s *sqlStore) {
+		s.slowQuery = d
+	}
+}
+
+// WithTenantGuard sets how statements missing a tenant_id predicate are handled.
+// It defaults to tenantguard.Enforce.
+func WithTenantGuard(mode tenantguard.Mode) StoreOption {
+	return func(s *sqlStore) {
+		s.guard = mode
+	}
+}
+
+// NewStore creates a new Store. db must be opened with the pgx driver as a role that is
+// subject to row-level security, i.e. neither a superuser nor a BYPASSRLS role.
+func NewStore(db *sql.DB, opts ...StoreOption) port.Store {
+	s := &sqlStore{db: db}
+	for _, opt := range opts {
+		opt(s)
+	}
+	s.q = instrument.Wrap(tenantguard.Wrap(db, s.guard), dbSystem, s.slowQuery)
+	return s
+}
+
+// ExecTx executes a function within a database transaction
+func (s *sqlStore) ExecTx(ctx context.Context, fn func(port.Store) error) (err error) {
+	ctx, end := instrument.StartTx(ctx, dbSystem)
+	defer func() { end(err) }()
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	txStore := &sqlStore{
+		db:        s.db,
+		q:         instrument.Wrap(tenantguard.Wrap(tx, s.guard), dbSystem, s.slowQuery),
+		inTx:      true,
+		slowQuery: s.slowQuery,
+		guard:     s.guard,
+	}
+
+	err = fn(txStore)
+	if err != nil {
+		if rbErr := tx.Rollback(); rbErr != nil {
+			return fmt.Errorf("tx err: %v, rb err: %v", err, rbErr)
+		}
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// withTenant runs fn in a transaction scoped to tenantID by the row-level security policies.
+// Outside ExecTx, the transaction is started and committed around fn.
+func (s *sqlStore) withTenant(ctx context.Context, tenantID uint64, fn func(q DBTX) error) error {
+	return s.withSetting(ctx, settingTenantID, tenantID, fn)
+}
+
+// withUser runs fn in a transaction that may read userID's own rows across tenants.
+func (s *sqlStore) withUser(ctx context.Context, userID uint64, fn func(q DBTX) error) error {
+	return s.withSetting(ctx, settingUserID, userID, fn)
+}
+
+func (s *sqlStore) withSetting(ctx context.Context, name string, id uint64, fn func(q DBTX) error) error {
+	if !s.inTx {
+		return s.ExecTx(ctx, func(tx port.Store) error {
+			return tx.(*sqlStore).withSetting(ctx, name, id, fn)
+		})
+	}
+	// is_local=true limits the setting to the current transaction, so pooled connections never
+	// carry it over to another request.
+	if _, err := s.q.ExecContext(ctx, "SELECT set_config($1, $2, true)", name, strconv.FormatUint(id, 10)); err != nil {
+		return err
+	}
+	return fn(s.q)
+}
+
+func (s *sqlStore) AuthRepository() port.AuthRepository {
+	return &authRepository{s: s}
+}
+
+func (s *sqlStore) ProfileRepository() port.ProfileRepository {
+	return &profileRepository{s: s}
+}
+
+func (s *sqlStore) RelationRepository() port.RelationRepository {
+	return &relationRepository{s: s}
+}
+
+func (s *sqlStore) ModerationRepository() port.ModerationRepository {
+	return &moderationRepository{s: s}
+}
+
+func (s *sqlStore) TimelineRepository() port.TimelineRepository {
+	return &timelineRepository{s: s}
+}
+
+func (s *sqlStore) ReactionRepository() port.ReactionRepository {
+	return &reactionRepository{s: s}
+}
+
+func (s *sqlStore) DMRepository() port.DMRepository {
+	return &dmRepository{s: s}
+}
+
+func (s *sqlStore) PlanRepository() port.PlanRepository {
+	return &planRepository{s: s}
+}
+
+func (s *sqlStore) IdempotencyRepository() port.IdempotencyRepository {
+	return &idempotencyRepository{s: s}
+}
+
+func (s *sqlStore) InvitationRepository() port.InvitationRepository {
+	return &invitationRepository{s: s}
+}
+
+func (s *sqlStore) BulkRepository() port.BulkRepository {
+	return &bulkRepository{s: s}
+}
@@ -0,0 +1,138 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/example/something-like-sns/apps/api/internal/adapter/repository/sqlutil"
+	"github.com/example/something-like-sns/apps/api/internal/domain"
+)
+
+type timelineRepository struct {
+	s *sqlStore
+}
+
+func (r *timelineRepository) CreatePost(ctx context.Context, tenantID, authorID uint64, body string) (*domain.Post, error) {
+	p := domain.Post{AuthorUserID: authorID, Body: body}
+	err := r.s.withTenant(ctx, tenantID, func(q DBTX) error {
+		if err := q.QueryRowContext(ctx, "INSERT INTO posts (tenant_id, author_user_id, body) VALUES ($1,$2,$3) RETURNING id, created_at", tenantID, authorID, body).Scan(&p.ID, &p.CreatedAt); err != nil {
+			return err
+		}
+		return addUsage(ctx, q, tenantID, 1, len(body), 0)
+	})
+	if err != nil {
+		return nil, translateError(err, "post")
+	}
+	return &p, nil
+}
+
+func (r *timelineRepository) FindPostByID(ctx context.Context, tenantID, postID uint64) (*domain.Post, error) {
+	var p domain.Post
+	err := r.s.withTenant(ctx, tenantID, func(q DBTX) error {
+		return q.QueryRowContext(ctx, "SELECT id, author_user_id, body, created_at FROM posts WHERE tenant_id=$1 AND id=$2 AND deleted_at IS NULL", tenantID, postID).Scan(&p.ID, &p.AuthorUserID, &p.Body, &p.CreatedAt)
+	})
+	if err != nil {
+		return nil, translateError(err, "post")
+	}
+	return &p, nil
+}
+
+func (r *timelineRepository) FindFeed(ctx context.Context, tenantID, userID uint64, limit int, cursor domain.Cursor) ([]*domain.Post, bool, error) {
+	where, order, args := keyset("p.", cursor, true, 2)
+	items := make([]*domain.Post, 0, limit+1)
+	err := r.s.withTenant(ctx, tenantID, func(q DBTX) error {
+		rows, err := q.QueryContext(ctx, `
+            SELECT p.id, p.author_user_id, p.body, p.created_at,
+                   (SELECT COUNT(*) FROM reactions r WHERE r.tenant_id=p.tenant_id AND r.target_type='post' AND r.target_id=p.id) AS like_count,
+                   (SELECT COUNT(*) FROM comments c WHERE c.tenant_id=p.tenant_id AND c.post_id=p.id AND c.deleted_at IS NULL) AS comment_count,
+                   EXISTS(SELECT 1 FROM reactions r WHERE r.tenant_id=p.tenant_id AND r.target_type='post' AND r.target_id=p.id AND r.user_id=$1) as liked
+            FROM posts p
+            WHERE p.tenant_id=$2 AND p.deleted_at IS NULL
+              AND NOT EXISTS(SELECT 1 FROM user_relations ur WHERE ur.tenant_id=p.tenant_id AND ur.user_id=$1 AND ur.target_user_id=p.author_user_id)`+where+`
+            `+order+`
+            LIMIT `+limitArg(args, 2), append(append([]interface{}{userID, tenantID}, args...), limit+1)...)
+		if err != nil {
+			return err
+		}
+		defer rows.Close()
+		for rows.Next() {
+			var p domain.Post
+			if err := rows.Scan(&p.ID, &p.AuthorUserID, &p.Body, &p.CreatedAt, &p.LikeCount, &p.CommentCount, &p.LikedByMe); err != nil {
+				return err
+			}
+			items = append(items, &p)
+		}
+		return rows.Err()
+	})
+	if err != nil {
+		return nil, false, err
+	}
+	items, hasMore := sqlutil.TrimPage(items, limit, cursor)
+	return items, hasMore, nil
+}
+
+func (r *timelineRepository) CreateComment(ctx context.Context, tenantID, postID, authorID uint64, body string) (*domain.Comment, error) {
+	c := domain.Comment{PostID: postID, AuthorUserID: authorID, Body: body}
+	err := r.s.withTenant(ctx, tenantID, func(q DBTX) error {
+		if err := q.QueryRowContext(ctx, "INSERT INTO comments (tenant_id, post_id, author_user_id, body) VALUES ($1,$2,$3,$4) RETURNING id, created_at", tenantID, postID, authorID, body).Scan(&c.ID, &c.CreatedAt); err != nil {
+			return err
+		}
+		return addUsage(ctx, q, tenantID, 0, len(body), 0)
+	})
+	if err != nil {
+		return nil, translateError(err, "comment")
+	}
+	return &c, nil
+}
+
+func (r *timelineRepository) FindCommentByID(ctx context.Context, tenantID, commentID uint64) (*domain.Comment, error) {
+	var c domain.Comment
+	err := r.s.withTenant(ctx, tenantID, func(q DBTX) error {
+		return q.QueryRowContext(ctx, "SELECT id, post_id, author_user_id, body, created_at FROM comments WHERE tenant_id=$1 AND id=$2 AND deleted_at IS NULL", tenantID, commentID).Scan(&c.ID, &c.PostID, &c.AuthorUserID, &c.Body, &c.CreatedAt)
+	})
+	if err != nil {
+		return nil, translateError(err, "comment")
+	}
+	return &c, nil
+}
+
+func (r *timelineRepository) FindCommentsByPostID(ctx context.Context, tenantID, postID uint64, limit int, cursor domain.Cursor) ([]*domain.Comment, bool, error) {
+	where, order, args := keyset("", cursor, false, 2)
+	items := make([]*domain.Comment, 0, limit+1)
+	err := r.s.withTenant(ctx, tenantID, func(q DBTX) error {
+		rows, err := q.QueryContext(ctx, `
+            SELECT id, author_user_id, body, created_at
+            FROM comments
+            WHERE tenant_id=$1 AND post_id=$2 AND deleted_at IS NULL`+where+`
+            `+order+`
+            LIMIT `+limitArg(args, 2), append(append([]interface{}{tenantID, postID}, args...), limit+1)...)
+		if err != nil {
+			return err
+		}
+		defer rows.Close()
+		for rows.Next() {
+			var cmt domain.Comment
+			if err := rows.Scan(&cmt.ID, &cmt.AuthorUserID, &cmt.Body, &cmt.CreatedAt); err != nil {
+				return err
+			}
+			cmt.PostID = postID
+			items = append(items, &cmt)
+		}
+		return rows.Err()
+	})
+	if err != nil {
+		return nil, false, err
+	}
+	items, hasMore := sqlutil.TrimPage(items, limit, cursor)
+	return items, hasMore, nil
+}
+
+func (r *timelineRepository) CountComments(ctx context.Context, tenantID, postID uint64) (uint64, error) {
+	var n uint64
+	err := r.s.withTenant(ctx, tenantID, func(q DBTX) error {
+		return q.QueryRowContext(ctx, `
+            SELECT COUNT(*) FROM (
+                SELECT 1 FROM comments WHERE tenant_id=$1 AND post_id=$2 AND deleted_at IS NULL LIMIT $3
+            ) AS t`, tenantID, postID, sqlutil.CountCap).Scan(&n)
+	})
+	return n, err
+}
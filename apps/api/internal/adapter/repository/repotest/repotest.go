@@ -0,0 +1,1592 @@
+// Package repotest is the contract test suite for port.Store implementations.
+// Every repository adapter runs it from its own tests, so they all behave alike.
+package repotest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/example/something-like-sns/apps/api/internal/domain"
+	"github.com/example/something-like-sns/apps/api/internal/port"
+)
+
+// Run runs the contract tests against the store returned by newStore. The store may be shared
+// between tests and contain other data; every test creates its own tenants and users.
+func Run(t *testing.T, newStore func(t *testing.T) port.Store) {
+	tests := []struct {
+		name string
+		fn   func(t *testing.T, f *fixture)
+	}{
+		{"Tenants", testTenants},
+		{"UsersAndMemberships", testUsersAndMemberships},
+		{"Profiles", testProfiles},
+		{"ProfileOverrides", testProfileOverrides},
+		{"Directory", testDirectory},
+		{"Relations", testRelations},
+		{"Moderation", testModeration},
+		{"Posts", testPosts},
+		{"FeedPagination", testFeedPagination},
+		{"Comments", testComments},
+		{"Reactions", testReactions},
+		{"DirectMessages", testDirectMessages},
+		{"Plans", testPlans},
+		{"Idempotency", testIdempotency},
+		{"Invitations", testInvitations},
+		{"ExecTx", testExecTx},
+		{"OwnerLock", testOwnerLock},
+		{"Bulk", testBulk},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.fn(t, newFixture(t, newStore(t)))
+		})
+	}
+}
+
+var seq atomic.Uint64
+
+// unique returns a name that is unique across runs, for slugs and auth subjects.
+func unique(prefix string) string {
+	return fmt.Sprintf("%s-%d-%d", prefix, time.Now().UnixNano(), seq.Add(1))
+}
+
+// fixture is a tenant with three members, alice (owner), bob and carol, and another tenant without members.
+type fixture struct {
+	ctx               context.Context
+	store             port.Store
+	tenant, other     *domain.Tenant
+	alice, bob, carol uint64
+}
+
+func newFixture(t *testing.T, store port.Store) *fixture {
+	t.Helper()
+	f := &fixture{ctx: context.Background(), store: store}
+	auth := store.AuthRepository()
+	var err error
+	if f.tenant, err = auth.CreateTenant(f.ctx, unique("t"), "Test"); err != nil {
+		t.Fatalf("CreateTenant: %v", err)
+	}
+	if f.other, err = auth.CreateTenant(f.ctx, unique("o"), "Other"); err != nil {
+		t.Fatalf("CreateTenant: %v", err)
+	}
+	f.alice = f.user(t, "Alice")
+	f.bob = f.user(t, "Bob")
+	f.carol = f.user(t, "Carol")
+	for user, role := range map[uint64]string{f.alice: domain.RoleOwner, f.bob: domain.RoleMember, f.carol: domain.RoleMember} {
+		if err := auth.EnsureMembership(f.ctx, f.tenant.ID, user, role); err != nil {
+			t.Fatalf("EnsureMembership: %v", err)
+		}
+	}
+	return f
+}
+
+func (f *fixture) user(t *testing.T, name string) uint64 {
+	t.Helper()
+	id, err := f.store.AuthRepository().FindOrCreateUser(f.ctx, unique("u"), name)
+	if err != nil {
+		t.Fatalf("FindOrCreateUser: %v", err)
+	}
+	return id
+}
+
+func (f *fixture) post(t *testing.T, tenantID, authorID uint64, body string) *domain.Post {
+	t.Helper()
+	p, err := f.store.TimelineRepository().CreatePost(f.ctx, tenantID, authorID, body)
+	if err != nil {
+		t.Fatalf("CreatePost: %v", err)
+	}
+	return p
+}
+
+func isNotFound(err error) bool {
+	var nf *domain.NotFoundError
+	return errors.As(err, &nf)
+}
+
+func isConflict(err error) bool {
+	var c *domain.ConflictError
+	return errors.As(err, &c)
+}
+
+func testTenants(t *testing.T, f *fixture) {
+	auth := f.store.AuthRepository()
+
+	got, err := auth.FindTenantBySlug(f.ctx, f.tenant.Slug)
+	if err != nil || got.ID != f.tenant.ID || got.Plan != "free" {
+		t.Fatalf("FindTenantBySlug = %+v, %v; want tenant %d on the free plan", got, err, f.tenant.ID)
+	}
+	if _, err := auth.CreateTenant(f.ctx, f.tenant.Slug, "Again"); !isConflict(err) {
+		t.Errorf("CreateTenant with a taken slug: err = %v, want ConflictError", err)
+	}
+	if _, err := auth.FindTenantBySlug(f.ctx, unique("missing")); !isNotFound(err) {
+		t.Errorf("FindTenantBySlug(missing): err = %v, want NotFoundError", err)
+	}
+	if got, err := auth.FindTenantByID(f.ctx, f.other.ID); err != nil || got.Slug != f.other.Slug || got.JoinPolicy != f.other.JoinPolicy {
+		t.Errorf("FindTenantByID = %+v, %v; want %+v", got, err, f.other)
+	}
+
+	host := unique("host") + ".example.com"
+	if err := auth.AddTenantDomain(f.ctx, f.tenant.ID, &domain.TenantDomain{Host: host, Verified: true}); err != nil {
+		t.Fatalf("AddTenantDomain: %v", err)
+	}
+	if err := auth.AddTenantDomain(f.ctx, f.tenant.ID, &domain.TenantDomain{Host: host}); !isConflict(err) {
+		t.Errorf("AddTenantDomain twice: err = %v, want ConflictError", err)
+	}
+	if err := auth.AddTenantDomain(f.ctx, f.other.ID, &domain.TenantDomain{Host: host, Verified: true}); !isConflict(err) {
+		t.Errorf("AddTenantDomain(verified) with a verified domain of another tenant: err = %v, want ConflictError", err)
+	}
+	// An unverified domain does not resolve until it is verified.
+	pending := unique("pending") + ".example.com"
+	if err := auth.AddTenantDomain(f.ctx, f.tenant.ID, &domain.TenantDomain{Host: pending, VerificationToken: "challenge-token"}); err != nil {
+		t.Fatalf("AddTenantDomain(unverified): %v", err)
+	}
+	if _, err := auth.FindTenantByHost(f.ctx, pending); !isNotFound(err) {
+		t.Errorf("FindTenantByHost(unverified domain): err = %v, want NotFoundError", err)
+	}
+	domains, err := auth.FindTenantDomains(f.ctx, f.tenant.ID)
+	if err != nil || len(domains) != 2 || domains[0].Host != host || !domains[0].Verified || domains[1].Host != pending || domains[1].Verified || domains[1].CreatedAt.IsZero() ||
+		domains[0].VerificationToken != "" || domains[1].VerificationToken != "challenge-token" {
+		t.Errorf("FindTenantDomains = %v, %v; want %s verified and %s pending with its token", domains, err, host, pending)
+	}
+	if err := auth.VerifyTenantDomain(f.ctx, f.other.ID, pending); !isNotFound(err) {
+		t.Errorf("VerifyTenantDomain(other tenant's domain): err = %v, want NotFoundError", err)
+	}
+	// Any tenant may claim a host, but only the first to verify it holds it.
+	if err := auth.AddTenantDomain(f.ctx, f.other.ID, &domain.TenantDomain{Host: pending, VerificationToken: "other-token"}); err != nil {
+		t.Fatalf("AddTenantDomain(another tenant's unverified domain): %v", err)
+	}
+	// Verifying twice keeps the domain verified.
+	for i := 0; i < 2; i++ {
+		if err := auth.VerifyTenantDomain(f.ctx, f.tenant.ID, pending); err != nil {
+			t.Fatalf("VerifyTenantDomain: %v", err)
+		}
+	}
+	if err := auth.VerifyTenantDomain(f.ctx, f.other.ID, pending); !isConflict(err) {
+		t.Errorf("VerifyTenantDomain(domain verified by another tenant): err = %v, want ConflictError", err)
+	}
+	if got, err := auth.FindTenantByHost(f.ctx, pending); err != nil || got.ID != f.tenant.ID {
+		t.Errorf("FindTenantByHost(verified domain) = %+v, %v; want tenant %d", got, err, f.tenant.ID)
+	}
+	if domains, err := auth.FindTenantDomains(f.ctx, f.tenant.ID); err != nil || len(domains) != 2 || !domains[1].Verified || domains[1].VerificationToken != "" {
+		t.Errorf("FindTenantDomains after verifying = %v, %v; want %s verified without its token", domains, err, pending)
+	}
+	if err := auth.RemoveTenantDomain(f.ctx, f.tenant.ID, pending); err != nil {
+		t.Fatalf("RemoveTenantDomain: %v", err)
+	}
+	// Once released, the host can be verified by the other tenant.
+	if err := auth.VerifyTenantDomain(f.ctx, f.other.ID, pending); err != nil {
+		t.Fatalf("VerifyTenantDomain(released domain): %v", err)
+	}
+	if got, err := auth.FindTenantByHost(f.ctx, pending); err != nil || got.ID != f.other.ID {
+		t.Errorf("FindTenantByHost(released domain) = %+v, %v; want tenant %d", got, err, f.other.ID)
+	}
+	if err := auth.RemoveTenantDomain(f.ctx, f.other.ID, pending); err != nil {
+		t.Fatalf("RemoveTenantDomain(other): %v", err)
+	}
+	if domains, err := auth.FindTenantDomains(f.ctx, f.other.ID); err != nil || len(domains) != 0 {
+		t.Errorf("FindTenantDomains(other) = %v, %v; want none", domains, err)
+	}
+	// Every lookup returns the whole tenant, as the cache keeps whichever it sees first.
+	byID, err := auth.FindTenantByID(f.ctx, f.tenant.ID)
+	if err != nil {
+		t.Fatalf("FindTenantByID: %v", err)
+	}
+	if got, err := auth.FindTenantByHost(f.ctx, host); err != nil || *got != *byID {
+		t.Errorf("FindTenantByHost(domain) = %+v, %v; want %+v", got, err, byID)
+	}
+	otherByID, err := auth.FindTenantByID(f.ctx, f.other.ID)
+	if err != nil {
+		t.Fatalf("FindTenantByID: %v", err)
+	}
+	if got, err := auth.FindTenantByHost(f.ctx, f.other.Slug+".localhost"); err != nil || *got != *otherByID {
+		t.Errorf("FindTenantByHost(slug subdomain) = %+v, %v; want %+v", got, err, otherByID)
+	}
+	if got, err := auth.FindTenantBySlug(f.ctx, f.tenant.Slug); err != nil || *got != *byID {
+		t.Errorf("FindTenantBySlug = %+v, %v; want %+v", got, err, byID)
+	}
+	// With the subdomain fallback off, the tenant only resolves from its verified domains.
+	if !got.AllowSubdomainFallback {
+		t.Errorf("new tenant AllowSubdomainFallback = false, want true")
+	}
+	if err := auth.SetTenantSubdomainFallback(f.ctx, f.tenant.ID, false); err != nil {
+		t.Fatalf("SetTenantSubdomainFallback(false): %v", err)
+	}
+	if _, err := auth.FindTenantByHost(f.ctx, f.tenant.Slug+".localhost"); !isNotFound(err) {
+		t.Errorf("FindTenantByHost(slug subdomain without fallback): err = %v, want NotFoundError", err)
+	}
+	if got, err := auth.FindTenantByHost(f.ctx, host); err != nil || got.ID != f.tenant.ID || got.AllowSubdomainFallback {
+		t.Errorf("FindTenantByHost(domain without fallback) = %+v, %v; want tenant %d without fallback", got, err, f.tenant.ID)
+	}
+	if err := auth.SetTenantSubdomainFallback(f.ctx, f.tenant.ID, true); err != nil {
+		t.Fatalf("SetTenantSubdomainFallback(true): %v", err)
+	}
+	if _, err := auth.FindTenantByHost(f.ctx, unique("missing")+".localhost"); !isNotFound(err) {
+		t.Errorf("FindTenantByHost(missing): err = %v, want NotFoundError", err)
+	}
+
+	if err := auth.RemoveTenantDomain(f.ctx, f.other.ID, host); !isNotFound(err) {
+		t.Errorf("RemoveTenantDomain(other tenant's domain): err = %v, want NotFoundError", err)
+	}
+	if err := auth.RemoveTenantDomain(f.ctx, f.tenant.ID, host); err != nil {
+		t.Fatalf("RemoveTenantDomain: %v", err)
+	}
+	if err := auth.RemoveTenantDomain(f.ctx, f.tenant.ID, host); !isNotFound(err) {
+		t.Errorf("RemoveTenantDomain again: err = %v, want NotFoundError", err)
+	}
+	if _, err := auth.FindTenantByHost(f.ctx, host); !isNotFound(err) {
+		t.Errorf("FindTenantByHost(removed domain): err = %v, want NotFoundError", err)
+	}
+
+	if got.Name != "Test" || got.Disabled {
+		t.Errorf("FindTenantBySlug = %+v, want name Test and enabled", got)
+	}
+	// Renaming to the same name or disabling twice still finds the tenant.
+	for i := 0; i < 2; i++ {
+		if err := auth.RenameTenant(f.ctx, f.tenant.ID, "Renamed"); err != nil {
+			t.Fatalf("RenameTenant: %v", err)
+		}
+		if err := auth.SetTenantDisabled(f.ctx, f.tenant.ID, true); err != nil {
+			t.Fatalf("SetTenantDisabled: %v", err)
+		}
+	}
+	if got, err := auth.FindTenantBySlug(f.ctx, f.tenant.Slug); err != nil || got.Name != "Renamed" || !got.Disabled {
+		t.Errorf("FindTenantBySlug after rename and disable = %+v, %v; want name Renamed and disabled", got, err)
+	}
+	if got, err := auth.FindTenantByHost(f.ctx, f.tenant.Slug+".localhost"); err != nil || !got.Disabled {
+		t.Errorf("FindTenantByHost(disabled) = %+v, %v; want disabled", got, err)
+	}
+	if err := auth.SetTenantDisabled(f.ctx, f.tenant.ID, false); err != nil {
+		t.Fatalf("SetTenantDisabled(false): %v", err)
+	}
+	if got, _ := auth.FindTenantBySlug(f.ctx, f.tenant.Slug); got == nil || got.Disabled {
+		t.Errorf("FindTenantBySlug after enable = %+v, want enabled", got)
+	}
+	missing := f.other.ID + 1_000_000
+	if err := auth.RenameTenant(f.ctx, missing, "Nobody"); !isNotFound(err) {
+		t.Errorf("RenameTenant(missing): err = %v, want NotFoundError", err)
+	}
+	if err := auth.SetTenantDisabled(f.ctx, missing, true); !isNotFound(err) {
+		t.Errorf("SetTenantDisabled(missing): err = %v, want NotFoundError", err)
+	}
+
+	if got.JoinPolicy != domain.JoinPolicyInvite {
+		t.Errorf("new tenant join policy = %q, want %q", got.JoinPolicy, domain.JoinPolicyInvite)
+	}
+	for i := 0; i < 2; i++ {
+		if err := auth.SetTenantJoinPolicy(f.ctx, f.tenant.ID, domain.JoinPolicyDomain); err != nil {
+			t.Fatalf("SetTenantJoinPolicy: %v", err)
+		}
+	}
+	if got, err := auth.FindTenantByHost(f.ctx, f.tenant.Slug+".localhost"); err != nil || got.JoinPolicy != domain.JoinPolicyDomain {
+		t.Errorf("FindTenantByHost after SetTenantJoinPolicy = %+v, %v; want policy domain", got, err)
+	}
+	if _, err := auth.FindTenantByID(f.ctx, missing); !isNotFound(err) {
+		t.Errorf("FindTenantByID(missing): err = %v, want NotFoundError", err)
+	}
+	if err := auth.SetTenantJoinPolicy(f.ctx, missing, domain.JoinPolicyOpen); !isNotFound(err) {
+		t.Errorf("SetTenantJoinPolicy(missing): err = %v, want NotFoundError", err)
+	}
+	if err := auth.SetTenantSubdomainFallback(f.ctx, missing, false); !isNotFound(err) {
+		t.Errorf("SetTenantSubdomainFallback(missing): err = %v, want NotFoundError", err)
+	}
+}
+
+func testUsersAndMemberships(t *testing.T, f *fixture) {
+	auth := f.store.AuthRepository()
+
+	sub := unique("sub")
+	id, err := auth.FindOrCreateUser(f.ctx, sub, "Before")
+	if err != nil {
+		t.Fatalf("FindOrCreateUser: %v", err)
+	}
+	again, err := auth.FindOrCreateUser(f.ctx, sub, "After")
+	if err != nil || again != id {
+		t.Fatalf("FindOrCreateUser again = %d, %v; want %d", again, err, id)
+	}
+	if u, err := auth.FindUserByID(f.ctx, id); err != nil || u.DisplayName != "Before" {
+		t.Errorf("FindUserByID = %+v, %v; want display name Before left as it was", u, err)
+	}
+	if _, err := auth.FindUserByID(f.ctx, id+1_000_000); !isNotFound(err) {
+		t.Errorf("FindUserByID(missing): err = %v, want NotFoundError", err)
+	}
+	if u, err := auth.FindUserByAuthSub(f.ctx, sub); err != nil || u.ID != id || u.AuthSub != sub || u.Suspended {
+		t.Errorf("FindUserByAuthSub = %+v, %v; want user %d, not suspended", u, err, id)
+	}
+	if _, err := auth.FindUserByAuthSub(f.ctx, unique("missing")); !isNotFound(err) {
+		t.Errorf("FindUserByAuthSub(missing): err = %v, want NotFoundError", err)
+	}
+	for i := 0; i < 2; i++ {
+		if err := auth.SetUserSuspended(f.ctx, id, true); err != nil {
+			t.Fatalf("SetUserSuspended: %v", err)
+		}
+	}
+	if u, err := auth.FindUserByID(f.ctx, id); err != nil || !u.Suspended {
+		t.Errorf("FindUserByID after suspend = %+v, %v; want suspended", u, err)
+	}
+	if err := auth.SetUserSuspended(f.ctx, id, false); err != nil {
+		t.Fatalf("SetUserSuspended(false): %v", err)
+	}
+	if u, _ := auth.FindUserByAuthSub(f.ctx, sub); u == nil || u.Suspended {
+		t.Errorf("FindUserByAuthSub after unsuspend = %+v, want not suspended", u)
+	}
+	if err := auth.SetUserSuspended(f.ctx, id+1_000_000, true); !isNotFound(err) {
+		t.Errorf("SetUserSuspended(missing): err = %v, want NotFoundError", err)
+	}
+
+	if role, err := auth.FindMembershipRole(f.ctx, f.tenant.ID, f.alice); err != nil || role != domain.RoleOwner {
+		t.Errorf("FindMembershipRole(alice) = %q, %v; want owner", role, err)
+	}
+	if role, err := auth.FindMembershipRole(f.ctx, f.other.ID, f.alice); err != nil || role != "" {
+		t.Errorf("FindMembershipRole(non-member) = %q, %v; want empty", role, err)
+	}
+	// EnsureMembership never changes an existing role.
+	if err := auth.EnsureMembership(f.ctx, f.tenant.ID, f.alice, domain.RoleMember); err != nil {
+		t.Fatalf("EnsureMembership: %v", err)
+	}
+	if role, _ := auth.FindMembershipRole(f.ctx, f.tenant.ID, f.alice); role != domain.RoleOwner {
+		t.Errorf("role after EnsureMembership = %q, want owner", role)
+	}
+	if err := auth.EnsureMembership(f.ctx, f.other.ID, f.alice, domain.RoleMember); err != nil {
+		t.Fatalf("EnsureMembership: %v", err)
+	}
+
+	members, err := auth.FindMembers(f.ctx, f.tenant.ID)
+	if err != nil || len(members) != 3 {
+		t.Fatalf("FindMembers = %v, %v; want 3 members", members, err)
+	}
+	if members[0].UserID != f.alice || members[0].Role != domain.RoleOwner || members[0].DisplayName != "Alice" || members[2].UserID != f.carol {
+		t.Errorf("FindMembers = [%+v %+v %+v], want alice (owner), bob, carol", *members[0], *members[1], *members[2])
+	}
+	if members[0].JoinedAt.IsZero() {
+		t.Errorf("FindMembers: alice has no join time")
+	}
+	for i := 0; i < 2; i++ {
+		if err := auth.UpdateMembershipRole(f.ctx, f.tenant.ID, f.bob, domain.RoleAdmin); err != nil {
+			t.Fatalf("UpdateMembershipRole: %v", err)
+		}
+	}
+	if role, _ := auth.FindMembershipRole(f.ctx, f.tenant.ID, f.bob); role != domain.RoleAdmin {
+		t.Errorf("role after UpdateMembershipRole = %q, want admin", role)
+	}
+	if err := auth.UpdateMembershipRole(f.ctx, f.other.ID, f.bob, domain.RoleAdmin); !isNotFound(err) {
+		t.Errorf("UpdateMembershipRole(non-member): err = %v, want NotFoundError", err)
+	}
+
+	dave := f.user(t, "Dave")
+	if err := auth.EnsureMembership(f.ctx, f.tenant.ID, dave, domain.RoleMember); err != nil {
+		t.Fatalf("EnsureMembership: %v", err)
+	}
+	if err := auth.RemoveMembership(f.ctx, f.other.ID, dave); !isNotFound(err) {
+		t.Errorf("RemoveMembership(non-member): err = %v, want NotFoundError", err)
+	}
+	if err := auth.RemoveMembership(f.ctx, f.tenant.ID, dave); err != nil {
+		t.Fatalf("RemoveMembership: %v", err)
+	}
+	if role, err := auth.FindMembershipRole(f.ctx, f.tenant.ID, dave); err != nil || role != "" {
+		t.Errorf("FindMembershipRole after RemoveMembership = %q, %v; want empty", role, err)
+	}
+	if ok, err := auth.IsMemberRemoved(f.ctx, f.tenant.ID, dave); err != nil || !ok {
+		t.Errorf("IsMemberRemoved after RemoveMembership = %v, %v; want true", ok, err)
+	}
+	if ok, err := auth.IsMemberRemoved(f.ctx, f.other.ID, dave); err != nil || ok {
+		t.Errorf("IsMemberRemoved(another tenant) = %v, %v; want false", ok, err)
+	}
+	if err := auth.EnsureMembership(f.ctx, f.tenant.ID, dave, domain.RoleMember); err != nil {
+		t.Fatalf("EnsureMembership after RemoveMembership: %v", err)
+	}
+	if ok, err := auth.IsMemberRemoved(f.ctx, f.tenant.ID, dave); err != nil || ok {
+		t.Errorf("IsMemberRemoved after EnsureMembership = %v, %v; want false", ok, err)
+	}
+	if err := auth.RemoveMembership(f.ctx, f.tenant.ID, dave); err != nil {
+		t.Fatalf("RemoveMembership again: %v", err)
+	}
+
+	ms, err := auth.FindUserMemberships(f.ctx, f.alice)
+	if err != nil || len(ms) != 2 {
+		t.Fatalf("FindUserMemberships = %v, %v; want 2 memberships", ms, err)
+	}
+	if ms[0].TenantID != f.tenant.ID || ms[0].TenantSlug != f.tenant.Slug || ms[0].Role != domain.RoleOwner || ms[1].TenantID != f.other.ID {
+		t.Errorf("FindUserMemberships = [%+v %+v], want ordered by tenant", *ms[0], *ms[1])
+	}
+}
+
+func testProfiles(t *testing.T, f *fixture) {
+	profiles := f.store.ProfileRepository()
+
+	find := func(tenantID uint64, ids ...uint64) map[uint64]*domain.Profile {
+		t.Helper()
+		ps, err := profiles.FindProfiles(f.ctx, tenantID, ids)
+		if err != nil {
+			t.Fatalf("FindProfiles: %v", err)
+		}
+		byID := make(map[uint64]*domain.Profile, len(ps))
+		for _, p := range ps {
+			byID[p.UserID] = p
+		}
+		return byID
+	}
+
+	got := find(f.tenant.ID, f.alice, f.bob, f.alice, f.carol+1_000_000)
+	if len(got) != 2 || got[f.alice] == nil || got[f.bob] == nil {
+		t.Fatalf("FindProfiles = %v, want alice and bob", got)
+	}
+	if a := got[f.alice]; a.DisplayName != "Alice" || a.Handle != "" || a.Bio != "" || a.AvatarURL != "" {
+		t.Errorf("FindProfiles(alice) = %+v, want display name Alice only", *a)
+	}
+	if got := find(f.tenant.ID); len(got) != 0 {
+		t.Errorf("FindProfiles(no users) = %v, want none", got)
+	}
+
+	alice := &domain.Profile{UserID: f.alice, DisplayName: "Alice A.", Handle: "alice", Bio: "Hi", AvatarURL: "https://example.com/a.png"}
+	for i := 0; i < 2; i++ {
+		if err := profiles.UpdateProfile(f.ctx, f.tenant.ID, alice); err != nil {
+			t.Fatalf("UpdateProfile: %v", err)
+		}
+	}
+	if a := find(f.tenant.ID, f.alice)[f.alice]; a == nil || *a != *alice {
+		t.Errorf("FindProfiles after UpdateProfile = %+v, want %+v", a, *alice)
+	}
+	if u, err := f.store.AuthRepository().FindUserByID(f.ctx, f.alice); err != nil || u.DisplayName != "Alice A." {
+		t.Errorf("FindUserByID after UpdateProfile = %+v, %v; want display name Alice A.", u, err)
+	}
+	// Handles are per tenant; the rest of the profile is the user's.
+	if a := find(f.other.ID, f.alice)[f.alice]; a == nil || a.Handle != "" || a.DisplayName != "Alice A." {
+		t.Errorf("FindProfiles(other tenant) = %+v, want no handle", a)
+	}
+
+	bob := &domain.Profile{UserID: f.bob, DisplayName: "Bob", Handle: "alice"}
+	if err := profiles.UpdateProfile(f.ctx, f.tenant.ID, bob); !isConflict(err) {
+		t.Errorf("UpdateProfile(taken handle): err = %v, want ConflictError", err)
+	}
+	if err := profiles.UpdateProfile(f.ctx, f.other.ID, bob); !isNotFound(err) {
+		t.Errorf("UpdateProfile(non-member): err = %v, want NotFoundError", err)
+	}
+	if err := f.store.AuthRepository().EnsureMembership(f.ctx, f.other.ID, f.bob, domain.RoleMember); err != nil {
+		t.Fatalf("EnsureMembership: %v", err)
+	}
+	if err := profiles.UpdateProfile(f.ctx, f.other.ID, bob); err != nil {
+		t.Errorf("UpdateProfile(handle taken in another tenant): %v", err)
+	}
+
+	// Clearing a handle frees it, and several members may have none.
+	alice.Handle = ""
+	if err := profiles.UpdateProfile(f.ctx, f.tenant.ID, alice); err != nil {
+		t.Fatalf("UpdateProfile(no handle): %v", err)
+	}
+	bob.Handle = "alice"
+	if err := profiles.UpdateProfile(f.ctx, f.tenant.ID, bob); err != nil {
+		t.Errorf("UpdateProfile(freed handle): %v", err)
+	}
+	if got := find(f.tenant.ID, f.alice, f.bob, f.carol); got[f.alice].Handle != "" || got[f.bob].Handle != "alice" || got[f.carol].Handle != "" {
+		t.Errorf("handles = %q, %q, %q; want \"\", alice, \"\"", got[f.alice].Handle, got[f.bob].Handle, got[f.carol].Handle)
+	}
+}
+
+func testProfileOverrides(t *testing.T, f *fixture) {
+	profiles := f.store.ProfileRepository()
+	auth := f.store.AuthRepository()
+	if err := auth.EnsureMembership(f.ctx, f.other.ID, f.alice, domain.RoleMember); err != nil {
+		t.Fatalf("EnsureMembership: %v", err)
+	}
+	global := &domain.Profile{UserID: f.alice, DisplayName: "Alice", Bio: "Hi", AvatarURL: "https://example.com/a.png"}
+	if err := profiles.UpdateProfile(f.ctx, f.tenant.ID, global); err != nil {
+		t.Fatalf("UpdateProfile: %v", err)
+	}
+
+	override := domain.ProfileOverride{DisplayName: "Ally", AvatarURL: "https://example.com/t.png"}
+	for i := 0; i < 2; i++ {
+		if err := profiles.UpdateProfileOverride(f.ctx, f.tenant.ID, f.alice, override); err != nil {
+			t.Fatalf("UpdateProfileOverride: %v", err)
+		}
+	}
+	settings, err := profiles.FindProfileSettings(f.ctx, f.tenant.ID, f.alice)
+	if err != nil {
+		t.Fatalf("FindProfileSettings: %v", err)
+	}
+	if want := (domain.ProfileSettings{Profile: *global, Override: override}); *settings != want {
+		t.Errorf("FindProfileSettings = %+v, want %+v", *settings, want)
+	}
+
+	// Every read in the tenant prefers the override; the other tenant and the user keep the
+	// user's own profile.
+	ps, err := profiles.FindProfiles(f.ctx, f.tenant.ID, []uint64{f.alice})
+	if want := (domain.Profile{UserID: f.alice, DisplayName: "Ally", Bio: "Hi", AvatarURL: "https://example.com/t.png"}); err != nil || len(ps) != 1 || *ps[0] != want {
+		t.Errorf("FindProfiles = %v, %v; want %+v", ps, err, want)
+	}
+	if ps, err := profiles.FindProfiles(f.ctx, f.other.ID, []uint64{f.alice}); err != nil || len(ps) != 1 || *ps[0] != *global {
+		t.Errorf("FindProfiles(other tenant) = %v, %v; want %+v", ps, err, *global)
+	}
+	if u, err := auth.FindUserByID(f.ctx, f.alice); err != nil || u.DisplayName != "Alice" {
+		t.Errorf("FindUserByID = %+v, %v; want display name Alice", u, err)
+	}
+	members, err := auth.FindMembers(f.ctx, f.tenant.ID)
+	if err != nil {
+		t.Fatalf("FindMembers: %v", err)
+	}
+	for _, m := range members {
+		if m.UserID == f.alice && m.DisplayName != "Ally" {
+			t.Errorf("FindMembers: alice's display name = %q, want Ally", m.DisplayName)
+		}
+	}
+	for prefix, want := range map[string]int{"ally": 1, "alice": 0} {
+		if got, _, err := profiles.FindDirectoryMembers(f.ctx, f.tenant.ID, f.bob, domain.MemberFilter{Prefix: prefix}, 10, domain.Cursor{}); err != nil || len(got) != want {
+			t.Errorf("FindDirectoryMembers(%q) = %d members, %v; want %d", prefix, len(got), err, want)
+		}
+	}
+
+	// Clearing the override falls back to the user's own profile.
+	if err := profiles.UpdateProfileOverride(f.ctx, f.tenant.ID, f.alice, domain.ProfileOverride{}); err != nil {
+		t.Fatalf("UpdateProfileOverride(clear): %v", err)
+	}
+	if ps, err := profiles.FindProfiles(f.ctx, f.tenant.ID, []uint64{f.alice}); err != nil || len(ps) != 1 || *ps[0] != *global {
+		t.Errorf("FindProfiles after clearing = %v, %v; want %+v", ps, err, *global)
+	}
+
+	if err := profiles.UpdateProfileOverride(f.ctx, f.other.ID, f.bob, override); !isNotFound(err) {
+		t.Errorf("UpdateProfileOverride(non-member): err = %v, want NotFoundError", err)
+	}
+	if _, err := profiles.FindProfileSettings(f.ctx, f.other.ID, f.bob); !isNotFound(err) {
+		t.Errorf("FindProfileSettings(non-member): err = %v, want NotFoundError", err)
+	}
+}
+
+func testDirectory(t *testing.T, f *fixture) {
+	profiles := f.store.ProfileRepository()
+
+	find := func(filter domain.MemberFilter, limit int, cursor domain.Cursor) ([]*domain.DirectoryMember, bool) {
+		t.Helper()
+		members, hasMore, err := profiles.FindDirectoryMembers(f.ctx, f.tenant.ID, f.alice, filter, limit, cursor)
+		if err != nil {
+			t.Fatalf("FindDirectoryMembers(%+v): %v", filter, err)
+		}
+		return members, hasMore
+	}
+	ids := func(members []*domain.DirectoryMember) []uint64 {
+		out := make([]uint64, len(members))
+		for i, m := range members {
+			out[i] = m.UserID
+		}
+		return out
+	}
+
+	if err := profiles.UpdateProfile(f.ctx, f.tenant.ID, &domain.Profile{UserID: f.bob, DisplayName: "Bob", Handle: "builder_1"}); err != nil {
+		t.Fatalf("UpdateProfile: %v", err)
+	}
+	dave := f.user(t, "Dave")
+	if err := f.store.AuthRepository().EnsureMembership(f.ctx, f.tenant.ID, dave, domain.RoleMember); err != nil {
+		t.Fatalf("EnsureMembership: %v", err)
+	}
+	if err := f.store.AuthRepository().SetUserSuspended(f.ctx, dave, true); err != nil {
+		t.Fatalf("SetUserSuspended: %v", err)
+	}
+
+	// Members are ordered by the time they joined, which the fixture does not fix, so only the
+	// pages are checked against each other.
+	all, hasMore := find(domain.MemberFilter{}, 10, domain.Cursor{})
+	if len(all) != 3 || hasMore {
+		t.Fatalf("FindDirectoryMembers = %v, %v; want alice, bob and carol without suspended dave", ids(all), hasMore)
+	}
+	for _, m := range all {
+		if m.JoinedAt.IsZero() || m.Role == "" {
+			t.Errorf("FindDirectoryMembers: member %+v has no role or join time", *m)
+		}
+		if m.UserID == f.bob && (m.Handle != "builder_1" || m.DisplayName != "Bob" || m.Role != domain.RoleMember) {
+			t.Errorf("FindDirectoryMembers: bob = %+v, want handle builder_1", *m)
+		}
+	}
+	first, hasMore := find(domain.MemberFilter{}, 2, domain.Cursor{})
+	if fmt.Sprint(ids(first)) != fmt.Sprint(ids(all[:2])) || !hasMore {
+		t.Fatalf("first page = %v, %v; want %v with more", ids(first), hasMore, ids(all[:2]))
+	}
+	last := first[1]
+	second, hasMore := find(domain.MemberFilter{}, 2, domain.Cursor{Time: last.JoinedAt, ID: last.UserID})
+	if fmt.Sprint(ids(second)) != fmt.Sprint(ids(all[2:])) || hasMore {
+		t.Errorf("second page = %v, %v; want %v without more", ids(second), hasMore, ids(all[2:]))
+	}
+	back, hasMore := find(domain.MemberFilter{}, 2, domain.Cursor{Time: all[2].JoinedAt, ID: all[2].UserID, Direction: domain.PageBackward})
+	if fmt.Sprint(ids(back)) != fmt.Sprint(ids(all[:2])) || hasMore {
+		t.Errorf("previous page = %v, %v; want %v without more", ids(back), hasMore, ids(all[:2]))
+	}
+
+	for _, tt := range []struct {
+		filter domain.MemberFilter
+		want   []uint64
+	}{
+		{domain.MemberFilter{Role: domain.RoleOwner}, []uint64{f.alice}},
+		{domain.MemberFilter{Prefix: "car"}, []uint64{f.carol}},
+		{domain.MemberFilter{Prefix: "CAR"}, []uint64{f.carol}},
+		{domain.MemberFilter{Prefix: "BUILDER"}, []uint64{f.bob}},
+		{domain.MemberFilter{Prefix: "builder_"}, []uint64{f.bob}},
+		{domain.MemberFilter{Prefix: "b", Role: domain.RoleOwner}, nil},
+		{domain.MemberFilter{Prefix: "dave"}, nil},
+		{domain.MemberFilter{Prefix: "%"}, nil},
+		{domain.MemberFilter{Prefix: "_"}, nil},
+		{domain.MemberFilter{Prefix: "!"}, nil},
+	} {
+		if got, _ := find(tt.filter, 10, domain.Cursor{}); fmt.Sprint(ids(got)) != fmt.Sprint(tt.want) {
+			t.Errorf("FindDirectoryMembers(%+v) = %v, want %v", tt.filter, ids(got), tt.want)
+		}
+	}
+
+	if got, _, err := profiles.FindDirectoryMembers(f.ctx, f.other.ID, f.alice, domain.MemberFilter{}, 10, domain.Cursor{}); err != nil || len(got) != 0 {
+		t.Errorf("FindDirectoryMembers(other tenant) = %v, %v; want none", ids(got), err)
+	}
+}
+
+func testRelations(t *testing.T, f *fixture) {
+	relations := f.store.RelationRepository()
+
+	for i := 0; i < 2; i++ {
+		if err := relations.AddRelation(f.ctx, f.tenant.ID, f.alice, f.bob, domain.RelationBlock); err != nil {
+			t.Fatalf("AddRelation(block): %v", err)
+		}
+	}
+	if err := relations.AddRelation(f.ctx, f.tenant.ID, f.alice, f.carol, domain.RelationMute); err != nil {
+		t.Fatalf("AddRelation(mute): %v", err)
+	}
+	if err := relations.AddRelation(f.ctx, f.tenant.ID, f.alice, f.carol+1_000_000, domain.RelationBlock); !isNotFound(err) {
+		t.Errorf("AddRelation(unknown user): err = %v, want NotFoundError", err)
+	}
+
+	isBlocked := func(tenantID, a, b uint64) bool {
+		t.Helper()
+		ok, err := relations.IsBlocked(f.ctx, tenantID, a, b)
+		if err != nil {
+			t.Fatalf("IsBlocked: %v", err)
+		}
+		return ok
+	}
+	if !isBlocked(f.tenant.ID, f.alice, f.bob) || !isBlocked(f.tenant.ID, f.bob, f.alice) {
+		t.Error("IsBlocked(alice, bob) = false, want true both ways")
+	}
+	if isBlocked(f.tenant.ID, f.alice, f.carol) || isBlocked(f.other.ID, f.alice, f.bob) {
+		t.Error("IsBlocked = true for a mute or another tenant, want false")
+	}
+
+	dm := f.store.DMRepository()
+	blockedConv, err := dm.CreateDMConversation(f.ctx, f.tenant.ID, f.alice, f.bob)
+	if err != nil {
+		t.Fatalf("CreateDMConversation: %v", err)
+	}
+	mutedConv, err := dm.CreateDMConversation(f.ctx, f.tenant.ID, f.alice, f.carol)
+	if err != nil {
+		t.Fatalf("CreateDMConversation: %v", err)
+	}
+	for _, tt := range []struct {
+		tenantID, convID, userID uint64
+		want                     bool
+	}{
+		{f.tenant.ID, blockedConv, f.alice, true},
+		{f.tenant.ID, blockedConv, f.bob, true},
+		{f.tenant.ID, mutedConv, f.carol, false},
+		{f.other.ID, blockedConv, f.bob, false},
+	} {
+		if got, err := relations.IsBlockedInConversation(f.ctx, tt.tenantID, tt.convID, tt.userID); err != nil || got != tt.want {
+			t.Errorf("IsBlockedInConversation(%d, %d, %d) = %v, %v; want %v", tt.tenantID, tt.convID, tt.userID, got, err, tt.want)
+		}
+	}
+
+	// Blocked and muted authors drop out of the blocker's feed only; blocks also hide the two
+	// members from each other in the directory.
+	for _, author := range []uint64{f.alice, f.bob, f.carol} {
+		f.post(t, f.tenant.ID, author, "post")
+	}
+	authors := func(viewer uint64) []uint64 {
+		t.Helper()
+		posts, _, err := f.store.TimelineRepository().FindFeed(f.ctx, f.tenant.ID, viewer, 10, domain.Cursor{})
+		if err != nil {
+			t.Fatalf("FindFeed: %v", err)
+		}
+		var ids []uint64
+		for _, p := range posts {
+			ids = append(ids, p.AuthorUserID)
+		}
+		return sorted(ids...)
+	}
+	members := func(viewer uint64) []uint64 {
+		t.Helper()
+		ms, _, err := f.store.ProfileRepository().FindDirectoryMembers(f.ctx, f.tenant.ID, viewer, domain.MemberFilter{}, 10, domain.Cursor{})
+		if err != nil {
+			t.Fatalf("FindDirectoryMembers: %v", err)
+		}
+		var ids []uint64
+		for _, m := range ms {
+			ids = append(ids, m.UserID)
+		}
+		return sorted(ids...)
+	}
+	for _, tt := range []struct {
+		viewer          uint64
+		feed, directory []uint64
+	}{
+		{f.alice, sorted(f.alice), sorted(f.alice, f.carol)},
+		{f.bob, sorted(f.alice, f.bob, f.carol), sorted(f.bob, f.carol)},
+		{f.carol, sorted(f.alice, f.bob, f.carol), sorted(f.alice, f.bob, f.carol)},
+	} {
+		if got := authors(tt.viewer); fmt.Sprint(got) != fmt.Sprint(tt.feed) {
+			t.Errorf("FindFeed(%d) authors = %v, want %v", tt.viewer, got, tt.feed)
+		}
+		if got := members(tt.viewer); fmt.Sprint(got) != fmt.Sprint(tt.directory) {
+			t.Errorf("FindDirectoryMembers(%d) = %v, want %v", tt.viewer, got, tt.directory)
+		}
+	}
+
+	for i := 0; i < 2; i++ {
+		if err := relations.RemoveRelation(f.ctx, f.tenant.ID, f.alice, f.bob, domain.RelationBlock); err != nil {
+			t.Fatalf("RemoveRelation: %v", err)
+		}
+	}
+	if isBlocked(f.tenant.ID, f.alice, f.bob) {
+		t.Error("IsBlocked after RemoveRelation = true, want false")
+	}
+	if got := authors(f.alice); fmt.Sprint(got) != fmt.Sprint(sorted(f.alice, f.bob)) {
+		t.Errorf("FindFeed after RemoveRelation authors = %v, want alice and bob", got)
+	}
+}
+
+func testModeration(t *testing.T, f *fixture) {
+	mod := f.store.ModerationRepository()
+	timeline := f.store.TimelineRepository()
+	dm := f.store.DMRepository()
+
+	post := f.post(t, f.tenant.ID, f.bob, "spam")
+	comment, err := timeline.CreateComment(f.ctx, f.tenant.ID, post.ID, f.bob, "more spam")
+	if err != nil {
+		t.Fatalf("CreateComment: %v", err)
+	}
+	convID, err := dm.CreateDMConversation(f.ctx, f.tenant.ID, f.alice, f.bob)
+	if err != nil {
+		t.Fatalf("CreateDMConversation: %v", err)
+	}
+	msg, err := dm.CreateMessage(f.ctx, f.tenant.ID, convID, f.bob, "rude")
+	if err != nil {
+		t.Fatalf("CreateMessage: %v", err)
+	}
+	if got, err := dm.FindMessageByID(f.ctx, f.tenant.ID, msg.ID); err != nil || got.ConversationID != convID || got.SenderUserID != f.bob || got.Body != "rude" {
+		t.Errorf("FindMessageByID = %+v, %v", got, err)
+	}
+	if _, err := dm.FindMessageByID(f.ctx, f.other.ID, msg.ID); !isNotFound(err) {
+		t.Errorf("FindMessageByID from another tenant: err = %v, want NotFoundError", err)
+	}
+
+	report := func(targetType domain.ReportTargetType, targetID, reporter uint64, content string) *domain.Report {
+		t.Helper()
+		r, err := mod.CreateReport(f.ctx, f.tenant.ID, &domain.Report{
+			TargetType: targetType, TargetID: targetID, AuthorUserID: f.bob, ReporterUserID: reporter, Reason: "abuse", Content: content,
+		})
+		if err != nil {
+			t.Fatalf("CreateReport: %v", err)
+		}
+		return r
+	}
+	postReport := report(domain.ReportTargetPost, post.ID, f.alice, post.Body)
+	if postReport.ID == 0 || postReport.Status != domain.ReportOpen || postReport.Content != "spam" || postReport.Action != "" || !postReport.ResolvedAt.IsZero() {
+		t.Errorf("CreateReport = %+v", postReport)
+	}
+	report(domain.ReportTargetPost, post.ID, f.carol, post.Body)
+	commentReport := report(domain.ReportTargetComment, comment.ID, f.alice, comment.Body)
+	msgReport := report(domain.ReportTargetMessage, msg.ID, f.alice, msg.Body)
+	if _, err := mod.CreateReport(f.ctx, f.tenant.ID, &domain.Report{TargetType: domain.ReportTargetPost, TargetID: post.ID, AuthorUserID: f.bob, ReporterUserID: f.alice}); !isConflict(err) {
+		t.Errorf("CreateReport twice: err = %v, want ConflictError", err)
+	}
+	if _, err := mod.FindReport(f.ctx, f.other.ID, postReport.ID); !isNotFound(err) {
+		t.Errorf("FindReport from another tenant: err = %v, want NotFoundError", err)
+	}
+
+	// Oldest first.
+	open, hasMore, err := mod.FindReports(f.ctx, f.tenant.ID, domain.ReportOpen, 3, domain.Cursor{})
+	if err != nil || !hasMore || len(open) != 3 || open[0].ID != postReport.ID {
+		t.Fatalf("FindReports = %v, hasMore %v, %v; want 3 of 4 oldest first", open, hasMore, err)
+	}
+	rest, hasMore, err := mod.FindReports(f.ctx, f.tenant.ID, domain.ReportOpen, 3, domain.Cursor{Time: open[2].CreatedAt, ID: open[2].ID})
+	if err != nil || hasMore || len(rest) != 1 || rest[0].ID != msgReport.ID {
+		t.Fatalf("FindReports second page = %v, hasMore %v, %v", rest, hasMore, err)
+	}
+	if reports, _, err := mod.FindReports(f.ctx, f.other.ID, domain.ReportOpen, 10, domain.Cursor{}); err != nil || len(reports) != 0 {
+		t.Errorf("FindReports from another tenant = %v, %v; want none", reports, err)
+	}
+
+	// Resolving resolves every open report of the content.
+	now := time.Now()
+	if err := mod.ResolveReports(f.ctx, f.tenant.ID, domain.ReportTargetPost, post.ID, f.alice, domain.ModerationHide, now); err != nil {
+		t.Fatalf("ResolveReports: %v", err)
+	}
+	resolved, _, err := mod.FindReports(f.ctx, f.tenant.ID, domain.ReportResolved, 10, domain.Cursor{})
+	if err != nil || len(resolved) != 2 {
+		t.Fatalf("FindReports(resolved) = %v, %v; want both post reports", resolved, err)
+	}
+	if r := resolved[0]; r.Action != domain.ModerationHide || r.ResolvedByUserID != f.alice || r.ResolvedAt.Sub(now).Abs() > time.Second {
+		t.Errorf("resolved report = %+v", r)
+	}
+	if open, _, err := mod.FindReports(f.ctx, f.tenant.ID, domain.ReportOpen, 10, domain.Cursor{}); err != nil || len(open) != 2 {
+		t.Errorf("FindReports(open) after ResolveReports = %v, %v; want 2", open, err)
+	}
+
+	// Hidden content drops out of every lookup and list, and deleted content loses its body.
+	if err := mod.RemoveContent(f.ctx, f.tenant.ID, domain.ReportTargetPost, post.ID, false); err != nil {
+		t.Fatalf("RemoveContent(post): %v", err)
+	}
+	if err := mod.RemoveContent(f.ctx, f.tenant.ID, domain.ReportTargetPost, post.ID, false); err != nil {
+		t.Errorf("RemoveContent(post) twice: %v", err)
+	}
+	if _, err := timeline.FindPostByID(f.ctx, f.tenant.ID, post.ID); !isNotFound(err) {
+		t.Errorf("FindPostByID after RemoveContent: err = %v, want NotFoundError", err)
+	}
+	if err := mod.RemoveContent(f.ctx, f.tenant.ID, domain.ReportTargetComment, comment.ID, true); err != nil {
+		t.Fatalf("RemoveContent(comment): %v", err)
+	}
+	if _, err := timeline.FindCommentByID(f.ctx, f.tenant.ID, comment.ID); !isNotFound(err) {
+		t.Errorf("FindCommentByID after RemoveContent: err = %v, want NotFoundError", err)
+	}
+	if n, err := timeline.CountComments(f.ctx, f.tenant.ID, post.ID); err != nil || n != 0 {
+		t.Errorf("CountComments after RemoveContent = %d, %v; want 0", n, err)
+	}
+	if err := mod.RemoveContent(f.ctx, f.tenant.ID, domain.ReportTargetMessage, msg.ID, true); err != nil {
+		t.Fatalf("RemoveContent(message): %v", err)
+	}
+	if msgs, _, err := dm.FindMessages(f.ctx, f.tenant.ID, convID, 10, domain.Cursor{}); err != nil || len(msgs) != 0 {
+		t.Errorf("FindMessages after RemoveContent = %v, %v; want none", msgs, err)
+	}
+	if _, err := dm.FindMessageByID(f.ctx, f.tenant.ID, msg.ID); !isNotFound(err) {
+		t.Errorf("FindMessageByID after RemoveContent: err = %v, want NotFoundError", err)
+	}
+	if err := mod.RemoveContent(f.ctx, f.other.ID, domain.ReportTargetComment, comment.ID, true); !isNotFound(err) {
+		t.Errorf("RemoveContent from another tenant: err = %v, want NotFoundError", err)
+	}
+	// A hidden post's report keeps the content for the queue; erasing a comment erases its reports' copy.
+	if r, err := mod.FindReport(f.ctx, f.tenant.ID, postReport.ID); err != nil || r.Content != "spam" {
+		t.Errorf("FindReport(post) after RemoveContent = %+v, %v; want the content kept", r, err)
+	}
+	if r, err := mod.FindReport(f.ctx, f.tenant.ID, commentReport.ID); err != nil || r.Content != "" {
+		t.Errorf("FindReport(comment) after RemoveContent = %+v, %v; want the content erased", r, err)
+	}
+
+	auth := f.store.AuthRepository()
+	for i := 0; i < 2; i++ {
+		if err := auth.SetMembershipSuspended(f.ctx, f.tenant.ID, f.bob, true); err != nil {
+			t.Fatalf("SetMembershipSuspended: %v", err)
+		}
+	}
+	if role, ok, err := auth.FindMembership(f.ctx, f.tenant.ID, f.bob); err != nil || role != domain.RoleMember || !ok {
+		t.Errorf("FindMembership = %q, %v, %v; want a suspended member", role, ok, err)
+	}
+	if role, ok, err := auth.FindMembership(f.ctx, f.tenant.ID, f.carol); err != nil || role != domain.RoleMember || ok {
+		t.Errorf("FindMembership(carol) = %q, %v, %v; want a member", role, ok, err)
+	}
+	if role, ok, err := auth.FindMembership(f.ctx, f.other.ID, f.bob); err != nil || role != "" || ok {
+		t.Errorf("FindMembership(non-member) = %q, %v, %v; want empty", role, ok, err)
+	}
+	if err := auth.SetMembershipSuspended(f.ctx, f.other.ID, f.bob, true); !isNotFound(err) {
+		t.Errorf("SetMembershipSuspended for a non-member: err = %v, want NotFoundError", err)
+	}
+	members, err := auth.FindMembers(f.ctx, f.tenant.ID)
+	if err != nil {
+		t.Fatalf("FindMembers: %v", err)
+	}
+	for _, m := range members {
+		if m.Suspended != (m.UserID == f.bob) {
+			t.Errorf("FindMembers: member %d Suspended = %v", m.UserID, m.Suspended)
+		}
+	}
+	if err := auth.SetMembershipSuspended(f.ctx, f.tenant.ID, f.bob, false); err != nil {
+		t.Fatalf("SetMembershipSuspended(false): %v", err)
+	}
+	if _, ok, err := auth.FindMembership(f.ctx, f.tenant.ID, f.bob); err != nil || ok {
+		t.Errorf("FindMembership after reinstating = %v, %v; want not suspended", ok, err)
+	}
+
+	// Audit events, newest first.
+	for _, e := range []*domain.AuditEvent{
+		{ActorUserID: f.alice, Action: string(domain.ModerationHide), TargetType: string(domain.ReportTargetPost), TargetID: post.ID, ReportID: postReport.ID, Note: "spam"},
+		{ActorUserID: f.alice, Action: domain.AuditReinstateMember, TargetType: domain.AuditTargetUser, TargetID: f.bob},
+	} {
+		if err := mod.AppendAuditEvent(f.ctx, f.tenant.ID, e); err != nil {
+			t.Fatalf("AppendAuditEvent: %v", err)
+		}
+	}
+	if err := mod.AppendAuditEvent(f.ctx, f.tenant.ID, &domain.AuditEvent{ActorUserID: f.alice, Action: "hide", ReportID: postReport.ID + 1_000_000}); !isNotFound(err) {
+		t.Errorf("AppendAuditEvent with an unknown report: err = %v, want NotFoundError", err)
+	}
+	events, hasMore, err := mod.FindAuditEvents(f.ctx, f.tenant.ID, 1, domain.Cursor{})
+	if err != nil || !hasMore || len(events) != 1 || events[0].Action != domain.AuditReinstateMember || events[0].ReportID != 0 {
+		t.Fatalf("FindAuditEvents = %v, hasMore %v, %v", events, hasMore, err)
+	}
+	events, hasMore, err = mod.FindAuditEvents(f.ctx, f.tenant.ID, 1, domain.Cursor{Time: events[0].CreatedAt, ID: events[0].ID})
+	if err != nil || hasMore || len(events) != 1 {
+		t.Fatalf("FindAuditEvents second page = %v, hasMore %v, %v", events, hasMore, err)
+	}
+	if e := events[0]; e.ActorUserID != f.alice || e.TargetID != post.ID || e.ReportID != postReport.ID || e.Note != "spam" {
+		t.Errorf("audit event = %+v", e)
+	}
+	if events, _, err := mod.FindAuditEvents(f.ctx, f.other.ID, 10, domain.Cursor{}); err != nil || len(events) != 0 {
+		t.Errorf("FindAuditEvents from another tenant = %v, %v; want none", events, err)
+	}
+}
+
+func testPosts(t *testing.T, f *fixture) {
+	timeline := f.store.TimelineRepository()
+
+	p := f.post(t, f.tenant.ID, f.alice, "hello")
+	if p.ID == 0 || p.AuthorUserID != f.alice || p.Body != "hello" || p.CreatedAt.IsZero() {
+		t.Errorf("CreatePost = %+v", p)
+	}
+	got, err := timeline.FindPostByID(f.ctx, f.tenant.ID, p.ID)
+	if err != nil || got.ID != p.ID || got.Body != "hello" {
+		t.Errorf("FindPostByID = %+v, %v", got, err)
+	}
+	if _, err := timeline.FindPostByID(f.ctx, f.other.ID, p.ID); !isNotFound(err) {
+		t.Errorf("FindPostByID from another tenant: err = %v, want NotFoundError", err)
+	}
+
+	if _, err := f.store.ReactionRepository().Toggle(f.ctx, f.tenant.ID, f.bob, domain.ReactionTargetPost, p.ID, "like"); err != nil {
+		t.Fatalf("Toggle: %v", err)
+	}
+	if _, err := timeline.CreateComment(f.ctx, f.tenant.ID, p.ID, f.carol, "nice"); err != nil {
+		t.Fatalf("CreateComment: %v", err)
+	}
+	f.post(t, f.other.ID, f.alice, "elsewhere")
+
+	for _, tc := range []struct {
+		user  uint64
+		liked bool
+	}{{f.bob, true}, {f.carol, false}} {
+		feed, hasMore, err := timeline.FindFeed(f.ctx, f.tenant.ID, tc.user, 10, domain.Cursor{})
+		if err != nil || hasMore || len(feed) != 1 {
+			t.Fatalf("FindFeed = %d posts, hasMore %v, %v; want only the tenant's post", len(feed), hasMore, err)
+		}
+		if got := feed[0]; got.ID != p.ID || got.LikeCount != 1 || got.CommentCount != 1 || got.LikedByMe != tc.liked {
+			t.Errorf("FindFeed for user %d = %+v; want 1 like, 1 comment, liked %v", tc.user, *got, tc.liked)
+		}
+	}
+}
+
+func testFeedPagination(t *testing.T, f *fixture) {
+	timeline := f.store.TimelineRepository()
+
+	var ids []uint64
+	for i := 0; i < 5; i++ {
+		ids = append(ids, f.post(t, f.tenant.ID, f.alice, fmt.Sprint("post ", i)).ID)
+	}
+	// Newest first: ids[4], ids[3], ...
+	page1, hasMore, err := timeline.FindFeed(f.ctx, f.tenant.ID, f.alice, 2, domain.Cursor{})
+	if err != nil || !hasMore || postIDs(page1) != fmt.Sprint([]uint64{ids[4], ids[3]}) {
+		t.Fatalf("first page = %s, hasMore %v, %v", postIDs(page1), hasMore, err)
+	}
+	last := page1[len(page1)-1]
+	page2, hasMore, err := timeline.FindFeed(f.ctx, f.tenant.ID, f.alice, 2, domain.Cursor{Time: last.CreatedAt, ID: last.ID})
+	if err != nil || !hasMore || postIDs(page2) != fmt.Sprint([]uint64{ids[2], ids[1]}) {
+		t.Fatalf("second page = %s, hasMore %v, %v", postIDs(page2), hasMore, err)
+	}
+	last = page2[len(page2)-1]
+	page3, hasMore, err := timeline.FindFeed(f.ctx, f.tenant.ID, f.alice, 2, domain.Cursor{Time: last.CreatedAt, ID: last.ID})
+	if err != nil || hasMore || postIDs(page3) != fmt.Sprint([]uint64{ids[0]}) {
+		t.Fatalf("last page = %s, hasMore %v, %v; want exactly the remaining post", postIDs(page3), hasMore, err)
+	}
+	exact, hasMore, err := timeline.FindFeed(f.ctx, f.tenant.ID, f.alice, 5, domain.Cursor{})
+	if err != nil || hasMore || len(exact) != 5 {
+		t.Fatalf("exactly full page: %d posts, hasMore %v, %v; want 5 and no more", len(exact), hasMore, err)
+	}
+
+	// Backward from the first post of page 3 returns page 2 again, in feed order.
+	first := page3[0]
+	back, hasMore, err := timeline.FindFeed(f.ctx, f.tenant.ID, f.alice, 2, domain.Cursor{Time: first.CreatedAt, ID: first.ID, Direction: domain.PageBackward})
+	if err != nil || !hasMore || postIDs(back) != postIDs(page2) {
+		t.Fatalf("backward page = %s, hasMore %v, %v; want %s", postIDs(back), hasMore, err, postIDs(page2))
+	}
+	first = page2[0]
+	back, hasMore, err = timeline.FindFeed(f.ctx, f.tenant.ID, f.alice, 2, domain.Cursor{Time: first.CreatedAt, ID: first.ID, Direction: domain.PageBackward})
+	if err != nil || hasMore || postIDs(back) != postIDs(page1) {
+		t.Fatalf("backward to start = %s, hasMore %v, %v; want %s", postIDs(back), hasMore, err, postIDs(page1))
+	}
+}
+
+func postIDs(posts []*domain.Post) string {
+	ids := make([]uint64, len(posts))
+	for i, p := range posts {
+		ids[i] = p.ID
+	}
+	return fmt.Sprint(ids)
+}
+
+func testComments(t *testing.T, f *fixture) {
+	timeline := f.store.TimelineRepository()
+	p := f.post(t, f.tenant.ID, f.alice, "post")
+
+	if _, err := timeline.CreateComment(f.ctx, f.tenant.ID, p.ID+1_000_000, f.bob, "orphan"); !isNotFound(err) {
+		t.Errorf("CreateComment on a missing post: err = %v, want NotFoundError", err)
+	}
+	var ids []uint64
+	for i := 0; i < 3; i++ {
+		c, err := timeline.CreateComment(f.ctx, f.tenant.ID, p.ID, f.bob, fmt.Sprint("comment ", i))
+		if err != nil {
+			t.Fatalf("CreateComment: %v", err)
+		}
+		if c.PostID != p.ID || c.AuthorUserID != f.bob {
+			t.Errorf("CreateComment = %+v", c)
+		}
+		ids = append(ids, c.ID)
+	}
+	if c, err := timeline.FindCommentByID(f.ctx, f.tenant.ID, ids[0]); err != nil || c.PostID != p.ID || c.Body != "comment 0" {
+		t.Errorf("FindCommentByID = %+v, %v", c, err)
+	}
+	if _, err := timeline.FindCommentByID(f.ctx, f.other.ID, ids[0]); !isNotFound(err) {
+		t.Errorf("FindCommentByID from another tenant: err = %v, want NotFoundError", err)
+	}
+
+	// Oldest first.
+	page1, hasMore, err := timeline.FindCommentsByPostID(f.ctx, f.tenant.ID, p.ID, 2, domain.Cursor{})
+	if err != nil || !hasMore || len(page1) != 2 || page1[0].ID != ids[0] || page1[1].ID != ids[1] {
+		t.Fatalf("first page = %v, hasMore %v, %v", page1, hasMore, err)
+	}
+	page2, hasMore, err := timeline.FindCommentsByPostID(f.ctx, f.tenant.ID, p.ID, 2, domain.Cursor{Time: page1[1].CreatedAt, ID: page1[1].ID})
+	if err != nil || hasMore || len(page2) != 1 || page2[0].ID != ids[2] {
+		t.Fatalf("second page = %v, hasMore %v, %v", page2, hasMore, err)
+	}
+	back, hasMore, err := timeline.FindCommentsByPostID(f.ctx, f.tenant.ID, p.ID, 1, domain.Cursor{Time: page2[0].CreatedAt, ID: page2[0].ID, Direction: domain.PageBackward})
+	if err != nil || !hasMore || len(back) != 1 || back[0].ID != ids[1] {
+		t.Fatalf("backward page = %v, hasMore %v, %v", back, hasMore, err)
+	}
+	if n, err := timeline.CountComments(f.ctx, f.tenant.ID, p.ID); err != nil || n != 3 {
+		t.Errorf("CountComments = %d, %v; want 3", n, err)
+	}
+	if n, err := timeline.CountComments(f.ctx, f.other.ID, p.ID); err != nil || n != 0 {
+		t.Errorf("CountComments from another tenant = %d, %v; want 0", n, err)
+	}
+}
+
+func testReactions(t *testing.T, f *fixture) {
+	reactions := f.store.ReactionRepository()
+	p := f.post(t, f.tenant.ID, f.alice, "post")
+
+	for i, want := range []bool{true, false, true} {
+		active, err := reactions.Toggle(f.ctx, f.tenant.ID, f.bob, domain.ReactionTargetPost, p.ID, "like")
+		if err != nil || active != want {
+			t.Fatalf("Toggle #%d = %v, %v; want %v", i, active, err, want)
+		}
+	}
+	if _, err := reactions.Toggle(f.ctx, f.tenant.ID, f.carol, domain.ReactionTargetPost, p.ID, "like"); err != nil {
+		t.Fatalf("Toggle: %v", err)
+	}
+	if n, err := reactions.Count(f.ctx, f.tenant.ID, domain.ReactionTargetPost, p.ID, "like"); err != nil || n != 2 {
+		t.Errorf("Count = %d, %v; want 2", n, err)
+	}
+	if n, err := reactions.Count(f.ctx, f.tenant.ID, domain.ReactionTargetComment, p.ID, "like"); err != nil || n != 0 {
+		t.Errorf("Count for comments = %d, %v; want 0", n, err)
+	}
+	if n, err := reactions.Count(f.ctx, f.other.ID, domain.ReactionTargetPost, p.ID, "like"); err != nil || n != 0 {
+		t.Errorf("Count from another tenant = %d, %v; want 0", n, err)
+	}
+}
+
+func testDirectMessages(t *testing.T, f *fixture) {
+	dm := f.store.DMRepository()
+
+	if id, err := dm.FindDMConversation(f.ctx, f.tenant.ID, f.alice, f.bob); err != nil || id != 0 {
+		t.Fatalf("FindDMConversation before creation = %d, %v; want 0", id, err)
+	}
+	convID, err := dm.CreateDMConversation(f.ctx, f.tenant.ID, f.alice, f.bob)
+	if err != nil {
+		t.Fatalf("CreateDMConversation: %v", err)
+	}
+	if id, err := dm.FindDMConversation(f.ctx, f.tenant.ID, f.bob, f.alice); err != nil || id != convID {
+		t.Errorf("FindDMConversation = %d, %v; want %d", id, err, convID)
+	}
+	if id, err := dm.FindDMConversation(f.ctx, f.other.ID, f.alice, f.bob); err != nil || id != 0 {
+		t.Errorf("FindDMConversation in another tenant = %d, %v; want 0", id, err)
+	}
+	for _, tc := range []struct {
+		tenant, user uint64
+		want         bool
+	}{{f.tenant.ID, f.alice, true}, {f.tenant.ID, f.carol, false}, {f.other.ID, f.alice, false}} {
+		if ok, err := dm.IsMember(f.ctx, tc.tenant, convID, tc.user); err != nil || ok != tc.want {
+			t.Errorf("IsMember(tenant %d, user %d) = %v, %v; want %v", tc.tenant, tc.user, ok, err, tc.want)
+		}
+	}
+
+	other, err := dm.CreateDMConversation(f.ctx, f.tenant.ID, f.carol, f.alice)
+	if err != nil {
+		t.Fatalf("CreateDMConversation: %v", err)
+	}
+	convos, hasMore, err := dm.FindConversations(f.ctx, f.tenant.ID, f.alice, 10, domain.Cursor{})
+	if err != nil || hasMore || len(convos) != 2 || convos[0].ID != other || convos[1].ID != convID {
+		t.Fatalf("FindConversations = %v, hasMore %v, %v; want newest first", convos, hasMore, err)
+	}
+	if fmt.Sprint(convos[0].MemberUserIDs) != fmt.Sprint(sorted(f.alice, f.carol)) {
+		t.Errorf("MemberUserIDs = %v, want %v", convos[0].MemberUserIDs, sorted(f.alice, f.carol))
+	}
+	if convos, _, err := dm.FindConversations(f.ctx, f.tenant.ID, f.bob, 10, domain.Cursor{}); err != nil || len(convos) != 1 {
+		t.Errorf("FindConversations(bob) = %v, %v; want only his conversation", convos, err)
+	}
+
+	if _, err := dm.CreateMessage(f.ctx, f.tenant.ID, convID+1_000_000, f.alice, "lost"); !isNotFound(err) {
+		t.Errorf("CreateMessage in a missing conversation: err = %v, want NotFoundError", err)
+	}
+	var ids []uint64
+	for i := 0; i < 3; i++ {
+		m, err := dm.CreateMessage(f.ctx, f.tenant.ID, convID, f.alice, fmt.Sprint("message ", i))
+		if err != nil {
+			t.Fatalf("CreateMessage: %v", err)
+		}
+		if m.ConversationID != convID || m.SenderUserID != f.alice {
+			t.Errorf("CreateMessage = %+v", m)
+		}
+		ids = append(ids, m.ID)
+	}
+	// Newest first.
+	page1, hasMore, err := dm.FindMessages(f.ctx, f.tenant.ID, convID, 2, domain.Cursor{})
+	if err != nil || !hasMore || len(page1) != 2 || page1[0].ID != ids[2] || page1[1].ID != ids[1] {
+		t.Fatalf("first page = %v, hasMore %v, %v", page1, hasMore, err)
+	}
+	page2, hasMore, err := dm.FindMessages(f.ctx, f.tenant.ID, convID, 2, domain.Cursor{Time: page1[1].CreatedAt, ID: page1[1].ID})
+	if err != nil || hasMore || len(page2) != 1 || page2[0].ID != ids[0] {
+		t.Fatalf("second page = %v, hasMore %v, %v", page2, hasMore, err)
+	}
+	back, hasMore, err := dm.FindMessages(f.ctx, f.tenant.ID, convID, 5, domain.Cursor{Time: page2[0].CreatedAt, ID: page2[0].ID, Direction: domain.PageBackward})
+	if err != nil || hasMore || len(back) != 2 || back[0].ID != ids[2] {
+		t.Fatalf("backward page = %v, hasMore %v, %v", back, hasMore, err)
+	}
+	if msgs, _, err := dm.FindMessages(f.ctx, f.other.ID, convID, 10, domain.Cursor{}); err != nil || len(msgs) != 0 {
+		t.Errorf("FindMessages from another tenant = %v, %v; want none", msgs, err)
+	}
+	if n, err := dm.CountMessages(f.ctx, f.tenant.ID, convID); err != nil || n != 3 {
+		t.Errorf("CountMessages = %d, %v; want 3", n, err)
+	}
+}
+
+func sorted(ids ...uint64) []uint64 {
+	ids = append([]uint64(nil), ids...)
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids
+}
+
+func testPlans(t *testing.T, f *fixture) {
+	plans := f.store.PlanRepository()
+
+	plan, err := plans.FindTenantPlan(f.ctx, f.tenant.ID)
+	if err != nil || plan.Name != "free" || plan.DailyPosts == 0 || plan.MaxMembers == 0 {
+		t.Fatalf("FindTenantPlan = %+v, %v; want the limited free plan", plan, err)
+	}
+
+	p := f.post(t, f.tenant.ID, f.alice, "12345")
+	if _, err := f.store.TimelineRepository().CreateComment(f.ctx, f.tenant.ID, p.ID, f.bob, "123"); err != nil {
+		t.Fatalf("CreateComment: %v", err)
+	}
+	convID, err := f.store.DMRepository().CreateDMConversation(f.ctx, f.tenant.ID, f.alice, f.bob)
+	if err != nil {
+		t.Fatalf("CreateDMConversation: %v", err)
+	}
+	if _, err := f.store.DMRepository().CreateMessage(f.ctx, f.tenant.ID, convID, f.bob, "12"); err != nil {
+		t.Fatalf("CreateMessage: %v", err)
+	}
+	f.post(t, f.other.ID, f.alice, "not counted")
+
+	checkUsage := func(what string, want domain.TenantUsage) {
+		t.Helper()
+		usage, err := plans.GetUsage(f.ctx, f.tenant.ID)
+		if err != nil {
+			t.Fatalf("GetUsage %s: %v", what, err)
+		}
+		if *usage != want {
+			t.Errorf("GetUsage %s = %+v, want %+v", what, *usage, want)
+		}
+	}
+	checkUsage("after writing", domain.TenantUsage{PostsToday: 1, StorageBytes: 10, Members: 3})
+
+	// Removed content stops counting toward storage once, however often it is removed or erased.
+	mod := f.store.ModerationRepository()
+	for _, erase := range []bool{false, false, true} {
+		if err := mod.RemoveContent(f.ctx, f.tenant.ID, domain.ReportTargetPost, p.ID, erase); err != nil {
+			t.Fatalf("RemoveContent: %v", err)
+		}
+	}
+	checkUsage("after removing the post", domain.TenantUsage{PostsToday: 1, StorageBytes: 5, Members: 3})
+
+	auth := f.store.AuthRepository()
+	if err := auth.EnsureMembership(f.ctx, f.tenant.ID, f.bob, domain.RoleMember); err != nil {
+		t.Fatalf("EnsureMembership: %v", err)
+	}
+	if err := auth.RemoveMembership(f.ctx, f.tenant.ID, f.carol); err != nil {
+		t.Fatalf("RemoveMembership: %v", err)
+	}
+	checkUsage("after removing a member", domain.TenantUsage{PostsToday: 1, StorageBytes: 5, Members: 2})
+	if err := auth.EnsureMembership(f.ctx, f.tenant.ID, f.carol, domain.RoleMember); err != nil {
+		t.Fatalf("EnsureMembership: %v", err)
+	}
+	checkUsage("after adding the member back", domain.TenantUsage{PostsToday: 1, StorageBytes: 5, Members: 3})
+
+	// A write rolled back with its transaction leaves the counters as they were.
+	_ = f.store.ExecTx(f.ctx, func(s port.Store) error {
+		if _, err := s.TimelineRepository().CreatePost(f.ctx, f.tenant.ID, f.alice, "rolled back"); err != nil {
+			t.Fatalf("CreatePost: %v", err)
+		}
+		return errors.New("rollback")
+	})
+	checkUsage("after a rolled back post", domain.TenantUsage{PostsToday: 1, StorageBytes: 5, Members: 3})
+
+	if usage, err := plans.GetUsage(f.ctx, f.other.ID); err != nil || *usage != (domain.TenantUsage{PostsToday: 1, StorageBytes: 11}) {
+		t.Errorf("GetUsage of the other tenant = %+v, %v; want its own post only", usage, err)
+	}
+}
+
+func testIdempotency(t *testing.T, f *fixture) {
+	repo := f.store.IdempotencyRepository()
+	const procedure = "/sns.v1.TimelineService/CreatePost"
+	hash := make([]byte, 32)
+	hash[0] = 1
+	rec := &domain.IdempotencyRecord{
+		TenantID:    f.tenant.ID,
+		UserID:      f.alice,
+		Procedure:   procedure,
+		Key:         "key-1",
+		RequestHash: hash,
+		ExpiresAt:   time.Now().Add(time.Hour).UTC().Truncate(time.Second),
+	}
+
+	if _, err := repo.Find(f.ctx, f.tenant.ID, f.alice, procedure, "key-1"); !isNotFound(err) {
+		t.Fatalf("Find before Reserve: err = %v, want NotFoundError", err)
+	}
+	if err := repo.Reserve(f.ctx, rec); err != nil {
+		t.Fatalf("Reserve: %v", err)
+	}
+	if err := repo.Reserve(f.ctx, rec); !isConflict(err) {
+		t.Errorf("Reserve twice: err = %v, want ConflictError", err)
+	}
+	got, err := repo.Find(f.ctx, f.tenant.ID, f.alice, procedure, "key-1")
+	if err != nil || got.Response != nil || string(got.RequestHash) != string(hash) || !got.ExpiresAt.Equal(rec.ExpiresAt) {
+		t.Fatalf("Find reserved = %+v, %v", got, err)
+	}
+	if _, err := repo.Find(f.ctx, f.tenant.ID, f.bob, procedure, "key-1"); !isNotFound(err) {
+		t.Errorf("Find for another user: err = %v, want NotFoundError", err)
+	}
+
+	if err := repo.Complete(f.ctx, f.tenant.ID, f.alice, procedure, "key-1", []byte("response")); err != nil {
+		t.Fatalf("Complete: %v", err)
+	}
+	if got, err := repo.Find(f.ctx, f.tenant.ID, f.alice, procedure, "key-1"); err != nil || string(got.Response) != "response" {
+		t.Errorf("Find completed = %+v, %v", got, err)
+	}
+	if err := repo.Delete(f.ctx, f.tenant.ID, f.alice, procedure, "key-1"); err != nil {
+		t.Fatalf("Delete: %v", err)
+	}
+	if _, err := repo.Find(f.ctx, f.tenant.ID, f.alice, procedure, "key-1"); !isNotFound(err) {
+		t.Errorf("Find after Delete: err = %v, want NotFoundError", err)
+	}
+
+	expired := *rec
+	expired.Key = "key-2"
+	expired.ExpiresAt = time.Now().Add(-time.Hour).UTC().Truncate(time.Second)
+	if err := repo.Reserve(f.ctx, &expired); err != nil {
+		t.Fatalf("Reserve: %v", err)
+	}
+	if err := repo.Reserve(f.ctx, rec); err != nil {
+		t.Fatalf("Reserve: %v", err)
+	}
+	if n, err := repo.DeleteExpired(f.ctx, time.Now()); err != nil || n < 1 {
+		t.Errorf("DeleteExpired = %d, %v; want at least 1", n, err)
+	}
+	if _, err := repo.Find(f.ctx, f.tenant.ID, f.alice, procedure, "key-2"); !isNotFound(err) {
+		t.Errorf("Find expired after DeleteExpired: err = %v, want NotFoundError", err)
+	}
+	if _, err := repo.Find(f.ctx, f.tenant.ID, f.alice, procedure, "key-1"); err != nil {
+		t.Errorf("Find unexpired after DeleteExpired: %v", err)
+	}
+}
+
+func testInvitations(t *testing.T, f *fixture) {
+	repo := f.store.InvitationRepository()
+	now := time.Now().UTC().Truncate(time.Second)
+	hash := func(b byte) []byte {
+		h := make([]byte, 32)
+		h[0], h[31] = b, byte(seq.Add(1))
+		return h
+	}
+
+	valid, expired := hash(1), hash(2)
+	inv, err := repo.CreateInvitation(f.ctx, f.tenant.ID, &domain.Invitation{Role: domain.RoleAdmin, CreatedByUserID: f.alice, ExpiresAt: now.Add(time.Hour)}, valid)
+	if err != nil || inv.ID == 0 || inv.Role != domain.RoleAdmin || inv.CreatedByUserID != f.alice || !inv.ExpiresAt.Equal(now.Add(time.Hour)) || inv.CreatedAt.IsZero() {
+		t.Fatalf("CreateInvitation = %+v, %v", inv, err)
+	}
+	if _, err := repo.CreateInvitation(f.ctx, f.tenant.ID, &domain.Invitation{Role: domain.RoleMember, CreatedByUserID: f.alice, ExpiresAt: now.Add(-time.Hour)}, expired); err != nil {
+		t.Fatalf("CreateInvitation(expired): %v", err)
+	}
+	if _, err := repo.CreateInvitation(f.ctx, f.tenant.ID, &domain.Invitation{Role: domain.RoleMember, CreatedByUserID: f.alice, ExpiresAt: now.Add(time.Hour)}, valid); !isConflict(err) {
+		t.Errorf("CreateInvitation with a taken token: err = %v, want ConflictError", err)
+	}
+	if got, err := repo.FindInvitationByTokenHash(f.ctx, f.tenant.ID, valid); err != nil || got.ID != inv.ID {
+		t.Errorf("FindInvitationByTokenHash = %+v, %v; want invitation %d", got, err, inv.ID)
+	}
+	if _, err := repo.FindInvitationByTokenHash(f.ctx, f.other.ID, valid); !isNotFound(err) {
+		t.Errorf("FindInvitationByTokenHash(other tenant): err = %v, want NotFoundError", err)
+	}
+	if got, err := repo.FindPendingInvitations(f.ctx, f.tenant.ID, now); err != nil || len(got) != 1 || got[0].ID != inv.ID {
+		t.Errorf("FindPendingInvitations = %+v, %v; want only invitation %d", got, err, inv.ID)
+	}
+
+	expiredInv, _ := repo.FindInvitationByTokenHash(f.ctx, f.tenant.ID, expired)
+	if expiredInv == nil {
+		t.Fatalf("FindInvitationByTokenHash(expired) found nothing")
+	}
+	if err := repo.ClaimInvitation(f.ctx, f.tenant.ID, expiredInv.ID, f.bob, now); !isConflict(err) {
+		t.Errorf("ClaimInvitation(expired): err = %v, want ConflictError", err)
+	}
+	if err := repo.ClaimInvitation(f.ctx, f.other.ID, inv.ID, f.bob, now); !isConflict(err) {
+		t.Errorf("ClaimInvitation(other tenant): err = %v, want ConflictError", err)
+	}
+	if err := repo.ClaimInvitation(f.ctx, f.tenant.ID, inv.ID, f.bob, now); err != nil {
+		t.Fatalf("ClaimInvitation: %v", err)
+	}
+	if err := repo.ClaimInvitation(f.ctx, f.tenant.ID, inv.ID, f.carol, now); !isConflict(err) {
+		t.Errorf("ClaimInvitation twice: err = %v, want ConflictError", err)
+	}
+	if got, err := repo.FindInvitationByTokenHash(f.ctx, f.tenant.ID, valid); err != nil || got.AcceptedByUserID != f.bob {
+		t.Errorf("FindInvitationByTokenHash(claimed) = %+v, %v; want accepted by %d", got, err, f.bob)
+	}
+	if got, err := repo.FindPendingInvitations(f.ctx, f.tenant.ID, now); err != nil || len(got) != 0 {
+		t.Errorf("FindPendingInvitations after claim = %+v, %v; want none", got, err)
+	}
+	if err := repo.DeleteInvitation(f.ctx, f.other.ID, inv.ID); !isNotFound(err) {
+		t.Errorf("DeleteInvitation(other tenant): err = %v, want NotFoundError", err)
+	}
+	if err := repo.DeleteInvitation(f.ctx, f.tenant.ID, inv.ID); err != nil {
+		t.Fatalf("DeleteInvitation: %v", err)
+	}
+	if err := repo.DeleteInvitation(f.ctx, f.tenant.ID, inv.ID); !isNotFound(err) {
+		t.Errorf("DeleteInvitation again: err = %v, want NotFoundError", err)
+	}
+
+	dave := f.user(t, "Dave")
+	req, err := repo.CreateJoinRequest(f.ctx, f.tenant.ID, dave, "let me in")
+	if err != nil || req.ID == 0 || req.UserID != dave || req.DisplayName != "Dave" || req.Message != "let me in" || req.CreatedAt.IsZero() {
+		t.Fatalf("CreateJoinRequest = %+v, %v", req, err)
+	}
+	if _, err := repo.CreateJoinRequest(f.ctx, f.tenant.ID, dave, "again"); !isConflict(err) {
+		t.Errorf("CreateJoinRequest twice: err = %v, want ConflictError", err)
+	}
+	if _, err := repo.CreateJoinRequest(f.ctx, f.other.ID, dave, ""); err != nil {
+		t.Errorf("CreateJoinRequest(other tenant): %v", err)
+	}
+	if got, err := repo.FindJoinRequest(f.ctx, f.tenant.ID, req.ID); err != nil || got.UserID != dave {
+		t.Errorf("FindJoinRequest = %+v, %v", got, err)
+	}
+	if _, err := repo.FindJoinRequest(f.ctx, f.other.ID, req.ID); !isNotFound(err) {
+		t.Errorf("FindJoinRequest(other tenant): err = %v, want NotFoundError", err)
+	}
+	if got, err := repo.FindJoinRequests(f.ctx, f.tenant.ID); err != nil || len(got) != 1 || got[0].ID != req.ID {
+		t.Errorf("FindJoinRequests = %+v, %v; want only request %d", got, err, req.ID)
+	}
+	if err := repo.DeleteJoinRequest(f.ctx, f.tenant.ID, req.ID); err != nil {
+		t.Fatalf("DeleteJoinRequest: %v", err)
+	}
+	if err := repo.DeleteJoinRequest(f.ctx, f.tenant.ID, req.ID); !isNotFound(err) {
+		t.Errorf("DeleteJoinRequest again: err = %v, want NotFoundError", err)
+	}
+	if got, err := repo.FindJoinRequests(f.ctx, f.tenant.ID); err != nil || len(got) != 0 {
+		t.Errorf("FindJoinRequests after delete = %+v, %v; want none", got, err)
+	}
+
+	if got, err := repo.FindEmailDomains(f.ctx, f.tenant.ID); err != nil || len(got) != 0 {
+		t.Errorf("FindEmailDomains on a new tenant = %v, %v; want none", got, err)
+	}
+	if err := repo.SetEmailDomains(f.ctx, f.tenant.ID, []string{"b.example", "a.example"}); err != nil {
+		t.Fatalf("SetEmailDomains: %v", err)
+	}
+	if err := repo.SetEmailDomains(f.ctx, f.tenant.ID, []string{"c.example", "b.example"}); err != nil {
+		t.Fatalf("SetEmailDomains again: %v", err)
+	}
+	if got, err := repo.FindEmailDomains(f.ctx, f.tenant.ID); err != nil || fmt.Sprint(got) != "[b.example c.example]" {
+		t.Errorf("FindEmailDomains = %v, %v; want [b.example c.example]", got, err)
+	}
+	if got, err := repo.FindEmailDomains(f.ctx, f.other.ID); err != nil || len(got) != 0 {
+		t.Errorf("FindEmailDomains(other tenant) = %v, %v; want none", got, err)
+	}
+}
+
+func testExecTx(t *testing.T, f *fixture) {
+	errAbort := errors.New("abort")
+	var rolledBack, committed *domain.Post
+
+	err := f.store.ExecTx(f.ctx, func(s port.Store) error {
+		var err error
+		rolledBack, err = s.TimelineRepository().CreatePost(f.ctx, f.tenant.ID, f.alice, "rolled back")
+		if err != nil {
+			return err
+		}
+		// Writes are visible inside the transaction.
+		if _, err := s.TimelineRepository().FindPostByID(f.ctx, f.tenant.ID, rolledBack.ID); err != nil {
+			return err
+		}
+		return errAbort
+	})
+	if !errors.Is(err, errAbort) {
+		t.Fatalf("ExecTx = %v, want the callback's error", err)
+	}
+	if _, err := f.store.TimelineRepository().FindPostByID(f.ctx, f.tenant.ID, rolledBack.ID); !isNotFound(err) {
+		t.Errorf("post of a rolled back transaction: err = %v, want NotFoundError", err)
+	}
+
+	err = f.store.ExecTx(f.ctx, func(s port.Store) error {
+		var err error
+		committed, err = s.TimelineRepository().CreatePost(f.ctx, f.tenant.ID, f.alice, "committed")
+		return err
+	})
+	if err != nil {
+		t.Fatalf("ExecTx: %v", err)
+	}
+	if _, err := f.store.TimelineRepository().FindPostByID(f.ctx, f.tenant.ID, committed.ID); err != nil {
+		t.Errorf("post of a committed transaction: %v", err)
+	}
+}
+
+// testOwnerLock races two owners demoting each other. LockOwners must make the second wait for
+// the first, so exactly one demotion goes through and the tenant keeps an owner.
+func testOwnerLock(t *testing.T, f *fixture) {
+	auth := f.store.AuthRepository()
+	if err := auth.UpdateMembershipRole(f.ctx, f.tenant.ID, f.bob, domain.RoleOwner); err != nil {
+		t.Fatalf("UpdateMembershipRole: %v", err)
+	}
+	if owners, err := auth.LockOwners(f.ctx, f.tenant.ID); err != nil || len(owners) != 2 || owners[0] != f.alice || owners[1] != f.bob {
+		t.Fatalf("LockOwners = %v, %v; want alice and bob", owners, err)
+	}
+	if owners, err := auth.LockOwners(f.ctx, f.other.ID); err != nil || len(owners) != 0 {
+		t.Errorf("LockOwners(tenant without members) = %v, %v; want none", owners, err)
+	}
+
+	errLastOwner := errors.New("last owner")
+	demote := func(userID uint64) error {
+		return f.store.ExecTx(f.ctx, func(s port.Store) error {
+			owners, err := s.AuthRepository().LockOwners(f.ctx, f.tenant.ID)
+			if err != nil {
+				return err
+			}
+			if domain.IsLastOwner(owners, userID) {
+				return errLastOwner
+			}
+			return s.AuthRepository().UpdateMembershipRole(f.ctx, f.tenant.ID, userID, domain.RoleMember)
+		})
+	}
+	var wg sync.WaitGroup
+	errs := make([]error, 2)
+	for i, userID := range []uint64{f.alice, f.bob} {
+		wg.Add(1)
+		go func(i int, userID uint64) {
+			defer wg.Done()
+			errs[i] = demote(userID)
+		}(i, userID)
+	}
+	wg.Wait()
+
+	demoted := 0
+	for _, err := range errs {
+		if err == nil {
+			demoted++
+		} else if !errors.Is(err, errLastOwner) {
+			t.Fatalf("demote: %v", err)
+		}
+	}
+	owners, err := auth.LockOwners(f.ctx, f.tenant.ID)
+	if err != nil {
+		t.Fatalf("LockOwners: %v", err)
+	}
+	if demoted != 1 || len(owners) != 1 {
+		t.Errorf("after racing demotions: %d demoted, owners %v; want 1 demoted and 1 owner", demoted, owners)
+	}
+}
+
+func testBulk(t *testing.T, f *fixture) {
+	bulk := f.store.BulkRepository()
+	nextID := func(table string) uint64 {
+		t.Helper()
+		id, err := bulk.NextID(f.ctx, table)
+		if err != nil {
+			t.Fatalf("NextID(%s): %v", table, err)
+		}
+		return id
+	}
+	if _, err := bulk.NextID(f.ctx, "tenants; DROP TABLE users"); err == nil {
+		t.Error("NextID of an unknown table succeeded")
+	}
+
+	userID := nextID("users")
+	sub := unique("bulk")
+	if err := bulk.InsertUsers(f.ctx, []*domain.User{{ID: userID, AuthSub: sub + "-a", DisplayName: "Bulk A"}, {ID: userID + 1, AuthSub: sub + "-b", DisplayName: "Bulk B"}}); err != nil {
+		t.Fatalf("InsertUsers: %v", err)
+	}
+	if got := nextID("users"); got != userID+2 {
+		t.Errorf("NextID(users) after insert = %d, want %d", got, userID+2)
+	}
+	if err := bulk.InsertMemberships(f.ctx, f.tenant.ID, []*domain.Member{{UserID: userID, Role: domain.RoleMember}, {UserID: userID + 1, Role: domain.RoleAdmin}}); err != nil {
+		t.Fatalf("InsertMemberships: %v", err)
+	}
+	if role, _ := f.store.AuthRepository().FindMembershipRole(f.ctx, f.tenant.ID, userID+1); role != domain.RoleAdmin {
+		t.Errorf("role of bulk member = %q, want admin", role)
+	}
+
+	// Older posts get higher IDs, so the feed must order by time rather than ID.
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	postID := nextID("posts")
+	posts := []*domain.Post{
+		{ID: postID, AuthorUserID: userID, Body: "newer", CreatedAt: base.Add(time.Hour)},
+		{ID: postID + 1, AuthorUserID: userID + 1, Body: "older", CreatedAt: base},
+	}
+	if err := bulk.InsertPosts(f.ctx, f.tenant.ID, posts); err != nil {
+		t.Fatalf("InsertPosts: %v", err)
+	}
+	if err := bulk.InsertPosts(f.ctx, f.tenant.ID, posts[:1]); !isConflict(err) {
+		t.Errorf("InsertPosts with a used ID: err = %v, want ConflictError", err)
+	}
+	commentID := nextID("comments")
+	if err := bulk.InsertComments(f.ctx, f.tenant.ID, []*domain.Comment{{ID: commentID, PostID: postID + 1, AuthorUserID: userID, Body: "bulk comment", CreatedAt: base.Add(time.Minute)}}); err != nil {
+		t.Fatalf("InsertComments: %v", err)
+	}
+	if err := bulk.InsertReactions(f.ctx, f.tenant.ID, []*domain.ReactionRecord{
+		{TargetType: domain.ReactionTargetPost, TargetID: postID + 1, UserID: userID, Type: "like", CreatedAt: base},
+		{TargetType: domain.ReactionTargetPost, TargetID: postID + 1, UserID: userID + 1, Type: "like", CreatedAt: base},
+	}); err != nil {
+		t.Fatalf("InsertReactions: %v", err)
+	}
+	// Bulk-loaded posts count toward storage but not toward today's posts.
+	if usage, err := f.store.PlanRepository().GetUsage(f.ctx, f.tenant.ID); err != nil || *usage != (domain.TenantUsage{StorageBytes: 22, Members: 5}) {
+		t.Errorf("GetUsage after bulk inserts = %+v, %v; want 22 bytes and 5 members", usage, err)
+	}
+
+	feed, _, err := f.store.TimelineRepository().FindFeed(f.ctx, f.tenant.ID, userID, 10, domain.Cursor{})
+	if err != nil || len(feed) != 2 {
+		t.Fatalf("FindFeed = %d posts, %v; want the 2 bulk posts", len(feed), err)
+	}
+	if feed[0].ID != postID || feed[1].ID != postID+1 || !feed[1].CreatedAt.Equal(base) || feed[1].LikeCount != 2 || feed[1].CommentCount != 1 || !feed[1].LikedByMe {
+		t.Errorf("FindFeed = [%+v %+v]; want newer first, older with its time, 2 likes and 1 comment", *feed[0], *feed[1])
+	}
+	// Rows written afterwards get IDs above the bulk ones.
+	if p := f.post(t, f.tenant.ID, f.alice, "after bulk"); p.ID <= postID+1 {
+		t.Errorf("CreatePost after bulk insert got ID %d, want above %d", p.ID, postID+1)
+	}
+
+	convID := nextID("conversations")
+	if err := bulk.InsertConversations(f.ctx, f.tenant.ID, []*domain.Conversation{{ID: convID, CreatedAt: base, MemberUserIDs: []uint64{userID, userID + 1}}}); err != nil {
+		t.Fatalf("InsertConversations: %v", err)
+	}
+	msgID := nextID("messages")
+	if err := bulk.InsertMessages(f.ctx, f.tenant.ID, []*domain.Message{
+		{ID: msgID, ConversationID: convID, SenderUserID: userID, Body: "first", CreatedAt: base},
+		{ID: msgID + 1, ConversationID: convID, SenderUserID: userID + 1, Body: "second", CreatedAt: base.Add(time.Second)},
+	}); err != nil {
+		t.Fatalf("InsertMessages: %v", err)
+	}
+	dm := f.store.DMRepository()
+	if got, err := dm.FindDMConversation(f.ctx, f.tenant.ID, userID, userID+1); err != nil || got != convID {
+		t.Errorf("FindDMConversation = %d, %v; want %d", got, err, convID)
+	}
+	msgs, _, err := dm.FindMessages(f.ctx, f.tenant.ID, convID, 10, domain.Cursor{})
+	if err != nil || len(msgs) != 2 {
+		t.Fatalf("FindMessages = %d messages, %v; want 2", len(msgs), err)
+	}
+	if conv, err := dm.CreateDMConversation(f.ctx, f.tenant.ID, f.alice, f.bob); err != nil || conv <= convID {
+		t.Errorf("CreateDMConversation after bulk insert = %d, %v; want an ID above %d", conv, err, convID)
+	}
+}
@@ -0,0 +1,186 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/example/something-like-sns/apps/api/internal/adapter/repository/sqlutil"
+	"github.com/example/something-like-sns/apps/api/internal/adapter/repository/tenantguard"
+	"github.com/example/something-like-sns/apps/api/internal/domain"
+)
+
+type dmRepository struct {
+	q DBTX
+}
+
+func (r *dmRepository) FindDMConversation(ctx context.Context, tenantID, userID1, userID2 uint64) (uint64, error) {
+	var convID uint64
+	q := `SELECT c.id FROM conversations c
+          JOIN conversation_members m1 ON m1.conversation_id=c.id AND m1.user_id=?
+          JOIN conversation_members m2 ON m2.conversation_id=c.id AND m2.user_id=?
+          WHERE c.tenant_id=? AND c.kind='dm' LIMIT 1`
+	err := r.q.QueryRowContext(ctx, q, userID1, userID2, tenantID).Scan(&convID)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return 0, err
+	}
+	return convID, nil
+}
+
+func (r *dmRepository) IsMember(ctx context.Context, tenantID, conversationID, userID uint64) (bool, error) {
+	var ok bool
+	err := r.q.QueryRowContext(ctx, `SELECT EXISTS(
+          SELECT 1 FROM conversations c
+          JOIN conversation_members m ON m.conversation_id=c.id AND m.user_id=?
+          WHERE c.tenant_id=? AND c.id=?)`, userID, tenantID, conversationID).Scan(&ok)
+	return ok, err
+}
+
+func (r *dmRepository) CreateDMConversation(ctx context.Context, tenantID uint64, userIDs ...uint64) (uint64, error) {
+	// This method will be called within a transaction from the usecase layer.
+	// The transaction is handled by the sqlStore.
+	res, err := r.q.ExecContext(ctx, "INSERT INTO conversations (tenant_id, kind) VALUES (?, 'dm')", tenantID)
+	if err != nil {
+		return 0, translateError(err, "conversation")
+	}
+	id, _ := res.LastInsertId()
+	convID := uint64(id)
+
+	valueStrings := make([]string, 0, len(userIDs))
+	valueArgs := make([]interface{}, 0, len(userIDs)*2)
+	for _, userID := range userIDs {
+		valueStrings = append(valueStrings, "(?, ?)")
+		valueArgs = append(valueArgs, convID, userID)
+	}
+	stmt := fmt.Sprintf("INSERT INTO conversation_members (conversation_id, user_id) VALUES %s", strings.Join(valueStrings, ","))
+
+	// conversation_members has no tenant_id; the conversation was created for tenantID just above.
+	if _, err := r.q.ExecContext(tenantguard.AllowCrossTenant(ctx), stmt, valueArgs...); err != nil {
+		return 0, translateError(err, "conversation member")
+	}
+
+	return convID, nil
+}
+
+func (r *dmRepository) FindConversations(ctx context.Context, tenantID, userID uint64, limit int, cursor domain.Cursor) ([]*domain.Conversation, bool, error) {
+	where, order, args := keyset("c.", cursor, true)
+	rows, err := r.q.QueryContext(ctx, `
+            SELECT c.id, c.created_at
+            FROM conversations c
+            JOIN conversation_members m ON m.conversation_id=c.id AND m.user_id=?
+            WHERE c.tenant_id=?`+where+`
+            `+order+`
+            LIMIT ?`, append(append([]interface{}{userID, tenantID}, args...), limit+1)...)
+	if err != nil {
+		return nil, false, err
+	}
+	defer rows.Close()
+
+	items := make([]*domain.Conversation, 0, limit+1)
+	for rows.Next() {
+		var conv domain.Conversation
+		if err := rows.Scan(&conv.ID, &conv.CreatedAt); err != nil {
+			return nil, false, err
+		}
+		items = append(items, &conv)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, false, err
+	}
+	// Members are fetched once the rows are closed: the pool has a single connection.
+	rows.Close()
+	items, hasMore := sqlutil.TrimPage(items, limit, cursor)
+	for _, conv := range items {
+		if conv.MemberUserIDs, err = r.findMembers(ctx, tenantID, conv.ID); err != nil {
+			return nil, false, err
+		}
+	}
+	return items, hasMore, nil
+}
+
+func (r *dmRepository) findMembers(ctx context.Context, tenantID, conversationID uint64) ([]uint64, error) {
+	rows, err := r.q.QueryContext(ctx, "SELECT m.user_id FROM conversation_members m JOIN conversations c ON c.id=m.conversation_id WHERE c.tenant_id=? AND m.conversation_id=? ORDER BY m.user_id", tenantID, conversationID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var members []uint64
+	for rows.Next() {
+		var uid uint64
+		if err := rows.Scan(&uid); err != nil {
+			return nil, err
+		}
+		members = append(members, uid)
+	}
+	return members, rows.Err()
+}
+
+func (r *dmRepository) FindMessageByID(ctx context.Context, tenantID, messageID uint64) (*domain.Message, error) {
+	var m domain.Message
+	err := r.q.QueryRowContext(ctx, "SELECT id, conversation_id, sender_user_id, body, created_at FROM messages WHERE tenant_id=? AND id=? AND deleted_at IS NULL", tenantID, messageID).Scan(&m.ID, &m.ConversationID, &m.SenderUserID, &m.Body, &m.CreatedAt)
+	if err != nil {
+		return nil, translateError(err, "message")
+	}
+	return &m, nil
+}
+
+func (r *dmRepository) FindMessages(ctx context.Context, tenantID, conversationID uint64, limit int, cursor domain.Cursor) ([]*domain.Message, bool, error) {
+	where, order, args := keyset("", cursor, true)
+	rows, err := r.q.QueryContext(ctx, `
+            SELECT id, sender_user_id, body, created_at
+            FROM messages
+            WHERE tenant_id=? AND conversation_id=? AND deleted_at IS NULL`+where+`
+            `+order+`
+            LIMIT ?`, append(append([]interface{}{tenantID, conversationID}, args...), limit+1)...)
+	if err != nil {
+		return nil, false, err
+	}
+	defer rows.Close()
+
+	items := make([]*domain.Message, 0, limit+1)
+	for rows.Next() {
+		var msg domain.Message
+		if err := rows.Scan(&msg.ID, &msg.SenderUserID, &msg.Body, &msg.CreatedAt); err != nil {
+			return nil, false, err
+		}
+		msg.ConversationID = conversationID
+		items = append(items, &msg)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, false, err
+	}
+	items, hasMore := sqlutil.TrimPage(items, limit, cursor)
+	return items, hasMore, nil
+}
+
+func (r *dmRepository) CreateMessage(ctx context.Context, tenantID, conversationID, senderID uint64, body string) (*domain.Message, error) {
+	resExec, err := r.q.ExecContext(ctx, "INSERT INTO messages (tenant_id, conversation_id, sender_user_id, body) VALUES (?,?,?,?)", tenantID, conversationID, senderID, body)
+	if err != nil {
+		return nil, translateError(err, "message")
+	}
+	if err := addUsage(ctx, r.q, tenantID, 0, len(body), 0); err != nil {
+		return nil, err
+	}
+	id, _ := resExec.LastInsertId()
+	var created time.Time
+	_ = r.q.QueryRowContext(ctx, "SELECT created_at FROM messages WHERE tenant_id=? AND id=?", tenantID, id).Scan(&created)
+	return &domain.Message{
+		ID:             uint64(id),
+		ConversationID: conversationID,
+		SenderUserID:   senderID,
+		Body:           body,
+		CreatedAt:      created,
+	}, nil
+}
+
+func (r *dmRepository) CountMessages(ctx context.Context, tenantID, conversationID uint64) (uint64, error) {
+	var n uint64
+	err := r.q.QueryRowContext(ctx, `
+            SELECT COUNT(*) FROM (
+                SELECT 1 FROM messages WHERE tenant_id=? AND conversation_id=? AND deleted_at IS NULL LIMIT ?
+            ) AS t`, tenantID, conversationID, sqlutil.CountCap).Scan(&n)
+	return n, err
+}
@@ -0,0 +1,29 @@
+package sqlite
+
+import (
+	"database/sql"
+	"errors"
+
+	driver "modernc.org/sqlite"
+	sqlite3 "modernc.org/sqlite/lib"
+
+	"github.com/example/something-like-sns/apps/api/internal/domain"
+)
+
+// translateError maps sql.ErrNoRows and SQLite constraint violations on resource to domain errors.
+// Other errors are returned unchanged.
+func translateError(err error, resource string) error {
+	if errors.Is(err, sql.ErrNoRows) {
+		return domain.NewNotFoundError(resource, nil)
+	}
+	var se *driver.Error
+	if errors.As(err, &se) {
+		switch se.Code() {
+		case sqlite3.SQLITE_CONSTRAINT_UNIQUE, sqlite3.SQLITE_CONSTRAINT_PRIMARYKEY:
+			return domain.NewConflictError(resource, "already exists")
+		case sqlite3.SQLITE_CONSTRAINT_FOREIGNKEY:
+			return domain.NewNotFoundError("referenced "+resource, nil)
+		}
+	}
+	return err
+}
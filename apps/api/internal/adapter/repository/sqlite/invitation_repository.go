@@ -0,0 +1,141 @@
+package sqlite
+
+import (
+	"context"
+	"time"
+
+	"github.com/example/something-like-sns/apps/api/internal/domain"
+)
+
+type invitationRepository struct {
+	q DBTX
+}
+
+const invitationColumns = "id, role, created_by, created_at, expires_at, COALESCE(accepted_by, 0)"
+
+func (r *invitationRepository) CreateInvitation(ctx context.Context, tenantID uint64, inv *domain.Invitation, tokenHash []byte) (*domain.Invitation, error) {
+	res, err := r.q.ExecContext(ctx, "INSERT INTO invitations (tenant_id, token_hash, role, created_by, expires_at) VALUES (?,?,?,?,?)",
+		tenantID, tokenHash, inv.Role, inv.CreatedByUserID, ts(inv.ExpiresAt))
+	if err != nil {
+		return nil, translateError(err, "invitation")
+	}
+	id, _ := res.LastInsertId()
+	return scanInvitation(r.q.QueryRowContext(ctx, "SELECT "+invitationColumns+" FROM invitations WHERE tenant_id=? AND id=?", tenantID, id))
+}
+
+func (r *invitationRepository) FindInvitationByTokenHash(ctx context.Context, tenantID uint64, tokenHash []byte) (*domain.Invitation, error) {
+	return scanInvitation(r.q.QueryRowContext(ctx, "SELECT "+invitationColumns+" FROM invitations WHERE tenant_id=? AND token_hash=?", tenantID, tokenHash))
+}
+
+func (r *invitationRepository) FindPendingInvitations(ctx context.Context, tenantID uint64, now time.Time) ([]*domain.Invitation, error) {
+	rows, err := r.q.QueryContext(ctx, "SELECT "+invitationColumns+" FROM invitations WHERE tenant_id=? AND accepted_by IS NULL AND expires_at > ? ORDER BY id", tenantID, ts(now))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var invs []*domain.Invitation
+	for rows.Next() {
+		inv, err := scanInvitation(rows)
+		if err != nil {
+			return nil, err
+		}
+		invs = append(invs, inv)
+	}
+	return invs, rows.Err()
+}
+
+func (r *invitationRepository) ClaimInvitation(ctx context.Context, tenantID, invitationID, userID uint64, now time.Time) error {
+	res, err := r.q.ExecContext(ctx, "UPDATE invitations SET accepted_by=?, accepted_at=CURRENT_TIMESTAMP WHERE tenant_id=? AND id=? AND accepted_by IS NULL AND expires_at > ?",
+		userID, tenantID, invitationID, ts(now))
+	if err != nil {
+		return translateError(err, "invitation")
+	}
+	if n, err := res.RowsAffected(); err != nil || n > 0 {
+		return err
+	}
+	return domain.NewConflictError("invitation", "is used or expired")
+}
+
+func (r *invitationRepository) DeleteInvitation(ctx context.Context, tenantID, invitationID uint64) error {
+	res, err := r.q.ExecContext(ctx, "DELETE FROM invitations WHERE tenant_id=? AND id=?", tenantID, invitationID)
+	return checkFound(res, err, "invitation")
+}
+
+func (r *invitationRepository) CreateJoinRequest(ctx context.Context, tenantID, userID uint64, message string) (*domain.JoinRequest, error) {
+	res, err := r.q.ExecContext(ctx, "INSERT INTO join_requests (tenant_id, user_id, message) VALUES (?,?,?)", tenantID, userID, message)
+	if err != nil {
+		return nil, translateError(err, "join request")
+	}
+	id, _ := res.LastInsertId()
+	return r.FindJoinRequest(ctx, tenantID, uint64(id))
+}
+
+const joinRequestQuery = "SELECT j.id, j.user_id, u.display_name, j.message, j.created_at FROM join_requests j JOIN users u ON u.id=j.user_id WHERE j.tenant_id=?"
+
+func (r *invitationRepository) FindJoinRequest(ctx context.Context, tenantID, requestID uint64) (*domain.JoinRequest, error) {
+	var j domain.JoinRequest
+	err := r.q.QueryRowContext(ctx, joinRequestQuery+" AND j.id=?", tenantID, requestID).Scan(&j.ID, &j.UserID, &j.DisplayName, &j.Message, &j.CreatedAt)
+	if err != nil {
+		return nil, translateError(err, "join request")
+	}
+	return &j, nil
+}
+
+func (r *invitationRepository) FindJoinRequests(ctx context.Context, tenantID uint64) ([]*domain.JoinRequest, error) {
+	rows, err := r.q.QueryContext(ctx, joinRequestQuery+" ORDER BY j.id", tenantID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var reqs []*domain.JoinRequest
+	for rows.Next() {
+		var j domain.JoinRequest
+		if err := rows.Scan(&j.ID, &j.UserID, &j.DisplayName, &j.Message, &j.CreatedAt); err != nil {
+			return nil, err
+		}
+		reqs = append(reqs, &j)
+	}
+	return reqs, rows.Err()
+}
+
+func (r *invitationRepository) DeleteJoinRequest(ctx context.Context, tenantID, requestID uint64) error {
+	res, err := r.q.ExecContext(ctx, "DELETE FROM join_requests WHERE tenant_id=? AND id=?", tenantID, requestID)
+	return checkFound(res, err, "join request")
+}
+
+func (r *invitationRepository) FindEmailDomains(ctx context.Context, tenantID uint64) ([]string, error) {
+	rows, err := r.q.QueryContext(ctx, "SELECT domain FROM tenant_email_domains WHERE tenant_id=? ORDER BY domain", tenantID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var domains []string
+	for rows.Next() {
+		var d string
+		if err := rows.Scan(&d); err != nil {
+			return nil, err
+		}
+		domains = append(domains, d)
+	}
+	return domains, rows.Err()
+}
+
+func (r *invitationRepository) SetEmailDomains(ctx context.Context, tenantID uint64, domains []string) error {
+	if _, err := r.q.ExecContext(ctx, "DELETE FROM tenant_email_domains WHERE tenant_id=?", tenantID); err != nil {
+		return err
+	}
+	for _, d := range domains {
+		if _, err := r.q.ExecContext(ctx, "INSERT INTO tenant_email_domains (tenant_id, domain) VALUES (?,?)", tenantID, d); err != nil {
+			return translateError(err, "email domain")
+		}
+	}
+	return nil
+}
+
+func scanInvitation(row interface{ Scan(...any) error }) (*domain.Invitation, error) {
+	var inv domain.Invitation
+	if err := row.Scan(&inv.ID, &inv.Role, &inv.CreatedByUserID, &inv.CreatedAt, &inv.ExpiresAt, &inv.AcceptedByUserID); err != nil {
+		return nil, translateError(err, "invitation")
+	}
+	return &inv, nil
+}
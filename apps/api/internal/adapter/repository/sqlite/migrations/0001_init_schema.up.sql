@@ -0,0 +1,101 @@
+-- Initial schema, translated from mysql/migrations/0001_init_schema.up.sql.
+-- Timestamps are stored as UTC text in the CURRENT_TIMESTAMP format (YYYY-MM-DD HH:MM:SS).
+
+-- tenants
+CREATE TABLE IF NOT EXISTS tenants (
+  id           INTEGER PRIMARY KEY AUTOINCREMENT,
+  slug         TEXT NOT NULL UNIQUE,
+  name         TEXT NOT NULL,
+  created_at   TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- tenant_domains
+CREATE TABLE IF NOT EXISTS tenant_domains (
+  id           INTEGER PRIMARY KEY AUTOINCREMENT,
+  tenant_id    INTEGER NOT NULL REFERENCES tenants(id),
+  domain       TEXT NOT NULL UNIQUE,
+  created_at   TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- users
+CREATE TABLE IF NOT EXISTS users (
+  id           INTEGER PRIMARY KEY AUTOINCREMENT,
+  auth_sub     TEXT NOT NULL UNIQUE,
+  display_name TEXT NOT NULL,
+  avatar_url   TEXT,
+  created_at   TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- tenant_memberships
+CREATE TABLE IF NOT EXISTS tenant_memberships (
+  id           INTEGER PRIMARY KEY AUTOINCREMENT,
+  tenant_id    INTEGER NOT NULL REFERENCES tenants(id),
+  user_id      INTEGER NOT NULL REFERENCES users(id),
+  role         TEXT NOT NULL DEFAULT 'member' CHECK (role IN ('owner','admin','member')),
+  created_at   TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  UNIQUE (tenant_id, user_id)
+);
+
+-- posts
+CREATE TABLE IF NOT EXISTS posts (
+  id             INTEGER PRIMARY KEY AUTOINCREMENT,
+  tenant_id      INTEGER NOT NULL REFERENCES tenants(id),
+  author_user_id INTEGER NOT NULL REFERENCES users(id),
+  body           TEXT NOT NULL,
+  created_at     TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  updated_at     TIMESTAMP NULL,
+  deleted_at     TIMESTAMP NULL
+);
+CREATE INDEX IF NOT EXISTS idx_posts_tenant_created ON posts (tenant_id, created_at DESC);
+
+-- comments
+CREATE TABLE IF NOT EXISTS comments (
+  id             INTEGER PRIMARY KEY AUTOINCREMENT,
+  tenant_id      INTEGER NOT NULL REFERENCES tenants(id),
+  post_id        INTEGER NOT NULL REFERENCES posts(id),
+  author_user_id INTEGER NOT NULL REFERENCES users(id),
+  body           TEXT NOT NULL,
+  created_at     TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  deleted_at     TIMESTAMP NULL
+);
+CREATE INDEX IF NOT EXISTS idx_comments_tenant_post_created ON comments (tenant_id, post_id, created_at);
+
+-- reactions
+CREATE TABLE IF NOT EXISTS reactions (
+  id           INTEGER PRIMARY KEY AUTOINCREMENT,
+  tenant_id    INTEGER NOT NULL REFERENCES tenants(id),
+  target_type  TEXT NOT NULL CHECK (target_type IN ('post','comment')),
+  target_id    INTEGER NOT NULL,
+  user_id      INTEGER NOT NULL REFERENCES users(id),
+  type         TEXT NOT NULL DEFAULT 'like' CHECK (type IN ('like')),
+  created_at   TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  UNIQUE (tenant_id, target_type, target_id, user_id, type)
+);
+CREATE INDEX IF NOT EXISTS idx_reactions_tenant_target ON reactions (tenant_id, target_type, target_id);
+
+-- conversations
+CREATE TABLE IF NOT EXISTS conversations (
+  id         INTEGER PRIMARY KEY AUTOINCREMENT,
+  tenant_id  INTEGER NOT NULL REFERENCES tenants(id),
+  kind       TEXT NOT NULL DEFAULT 'dm' CHECK (kind IN ('dm')),
+  created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE IF NOT EXISTS conversation_members (
+  id               INTEGER PRIMARY KEY AUTOINCREMENT,
+  conversation_id  INTEGER NOT NULL REFERENCES conversations(id),
+  user_id          INTEGER NOT NULL REFERENCES users(id),
+  joined_at        TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  UNIQUE (conversation_id, user_id)
+);
+
+-- messages
+CREATE TABLE IF NOT EXISTS messages (
+  id               INTEGER PRIMARY KEY AUTOINCREMENT,
+  tenant_id        INTEGER NOT NULL REFERENCES tenants(id),
+  conversation_id  INTEGER NOT NULL REFERENCES conversations(id),
+  sender_user_id   INTEGER NOT NULL REFERENCES users(id),
+  body             TEXT NOT NULL,
+  created_at       TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+CREATE INDEX IF NOT EXISTS idx_messages_cnv_created ON messages (conversation_id, created_at);
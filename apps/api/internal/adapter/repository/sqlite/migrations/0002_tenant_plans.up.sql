@@ -0,0 +1,23 @@
+-- Per-tenant plans: rate limits and quotas, translated from mysql/migrations/0002_tenant_plans.up.sql.
+
+-- plans
+CREATE TABLE IF NOT EXISTS plans (
+  name                TEXT PRIMARY KEY,
+  posts_per_minute    INTEGER NOT NULL,
+  comments_per_minute INTEGER NOT NULL,
+  messages_per_minute INTEGER NOT NULL,
+  daily_posts         INTEGER NOT NULL,
+  storage_bytes       INTEGER NOT NULL,
+  max_members         INTEGER NOT NULL,
+  created_at          TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- 0 means unlimited
+INSERT INTO plans (name, posts_per_minute, comments_per_minute, messages_per_minute, daily_posts, storage_bytes, max_members) VALUES
+  ('free',        10,  20,  20,  200,   52428800,   50),
+  ('pro',         30,  60,  60,  2000,  5368709120, 500),
+  ('enterprise',  120, 240, 240, 0,     0,          0);
+
+-- SQLite only allows adding a column with a foreign key while foreign key enforcement is off,
+-- which Migrate ensures.
+ALTER TABLE tenants ADD COLUMN plan TEXT NOT NULL DEFAULT 'free' REFERENCES plans(name);
@@ -0,0 +1,14 @@
+-- Idempotency keys for retried write RPCs, translated from mysql/migrations/0003_idempotency_keys.up.sql.
+
+CREATE TABLE IF NOT EXISTS idempotency_keys (
+  tenant_id      INTEGER NOT NULL REFERENCES tenants(id),
+  user_id        INTEGER NOT NULL REFERENCES users(id),
+  procedure_name TEXT NOT NULL,
+  idem_key       TEXT NOT NULL,
+  request_hash   BLOB NOT NULL,
+  response       BLOB NULL,
+  created_at     TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  expires_at     TIMESTAMP NOT NULL,
+  PRIMARY KEY (tenant_id, user_id, procedure_name, idem_key)
+);
+CREATE INDEX IF NOT EXISTS idx_idempotency_expires ON idempotency_keys (expires_at);
@@ -0,0 +1,4 @@
+-- Disabled tenants and suspended users, translated from mysql/migrations/0004_tenant_user_status.up.sql.
+
+ALTER TABLE tenants ADD COLUMN disabled_at TIMESTAMP NULL;
+ALTER TABLE users ADD COLUMN suspended_at TIMESTAMP NULL;
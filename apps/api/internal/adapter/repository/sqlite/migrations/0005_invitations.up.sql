@@ -0,0 +1,34 @@
+-- Join policies, email domain allowlists, invitations and join requests, translated from
+-- mysql/migrations/0005_invitations.up.sql.
+
+ALTER TABLE tenants ADD COLUMN join_policy TEXT NOT NULL DEFAULT 'invite' CHECK (join_policy IN ('open','invite','domain'));
+-- Existing tenants keep admitting anyone until an admin chooses a policy.
+UPDATE tenants SET join_policy = 'open';
+
+CREATE TABLE IF NOT EXISTS tenant_email_domains (
+  tenant_id    INTEGER NOT NULL REFERENCES tenants(id),
+  domain       TEXT NOT NULL,
+  PRIMARY KEY (tenant_id, domain)
+);
+
+CREATE TABLE IF NOT EXISTS invitations (
+  id           INTEGER PRIMARY KEY AUTOINCREMENT,
+  tenant_id    INTEGER NOT NULL REFERENCES tenants(id),
+  token_hash   BLOB NOT NULL UNIQUE,
+  role         TEXT NOT NULL CHECK (role IN ('owner','admin','member')),
+  created_by   INTEGER NOT NULL REFERENCES users(id),
+  created_at   TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  expires_at   TIMESTAMP NOT NULL,
+  accepted_by  INTEGER NULL REFERENCES users(id),
+  accepted_at  TIMESTAMP NULL
+);
+CREATE INDEX IF NOT EXISTS idx_invitations_tenant ON invitations (tenant_id, id);
+
+CREATE TABLE IF NOT EXISTS join_requests (
+  id           INTEGER PRIMARY KEY AUTOINCREMENT,
+  tenant_id    INTEGER NOT NULL REFERENCES tenants(id),
+  user_id      INTEGER NOT NULL REFERENCES users(id),
+  message      TEXT NOT NULL DEFAULT '',
+  created_at   TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  UNIQUE (tenant_id, user_id)
+);
@@ -0,0 +1,5 @@
+-- Custom domain verification, translated from mysql/migrations/0006_tenant_domain_verification.up.sql.
+
+ALTER TABLE tenant_domains ADD COLUMN verified_at TIMESTAMP NULL;
+-- Existing domains were added by operators and are trusted.
+UPDATE tenant_domains SET verified_at = created_at;
@@ -0,0 +1,4 @@
+-- DNS TXT challenges for custom domains, translated from mysql/migrations/0007_domain_challenges.up.sql.
+
+ALTER TABLE tenant_domains ADD COLUMN verification_token TEXT NULL;
+ALTER TABLE tenants ADD COLUMN allow_subdomain_fallback BOOLEAN NOT NULL DEFAULT TRUE;
@@ -0,0 +1,5 @@
+-- User profiles, translated from mysql/migrations/0008_profiles.up.sql.
+
+ALTER TABLE users ADD COLUMN bio TEXT NOT NULL DEFAULT '';
+ALTER TABLE tenant_memberships ADD COLUMN handle TEXT NULL;
+CREATE UNIQUE INDEX IF NOT EXISTS uniq_membership_handle ON tenant_memberships (tenant_id, handle);
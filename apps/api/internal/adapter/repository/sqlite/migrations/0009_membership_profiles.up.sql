@@ -0,0 +1,4 @@
+-- Per-tenant profiles, translated from mysql/migrations/0009_membership_profiles.up.sql.
+
+ALTER TABLE tenant_memberships ADD COLUMN display_name TEXT NULL;
+ALTER TABLE tenant_memberships ADD COLUMN avatar_url TEXT NULL;
@@ -0,0 +1,11 @@
+-- Blocks and mutes, translated from mysql/migrations/0010_user_relations.up.sql.
+
+CREATE TABLE IF NOT EXISTS user_relations (
+  tenant_id      INTEGER NOT NULL REFERENCES tenants(id),
+  user_id        INTEGER NOT NULL REFERENCES users(id),
+  target_user_id INTEGER NOT NULL REFERENCES users(id),
+  kind           TEXT NOT NULL CHECK (kind IN ('block','mute')),
+  created_at     TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (tenant_id, user_id, target_user_id, kind)
+);
+CREATE INDEX IF NOT EXISTS idx_user_relations_target ON user_relations (tenant_id, target_user_id);
@@ -0,0 +1,35 @@
+-- Content reports and the audit trail, translated from mysql/migrations/0011_moderation.up.sql.
+
+ALTER TABLE messages ADD COLUMN deleted_at TIMESTAMP NULL;
+ALTER TABLE tenant_memberships ADD COLUMN suspended_at TIMESTAMP NULL;
+
+CREATE TABLE IF NOT EXISTS content_reports (
+  id                  INTEGER PRIMARY KEY AUTOINCREMENT,
+  tenant_id           INTEGER NOT NULL REFERENCES tenants(id),
+  target_type         TEXT NOT NULL CHECK (target_type IN ('post','comment','message')),
+  target_id           INTEGER NOT NULL,
+  author_user_id      INTEGER NOT NULL REFERENCES users(id),
+  reporter_user_id    INTEGER NOT NULL REFERENCES users(id),
+  reason              TEXT NOT NULL DEFAULT '',
+  content             TEXT NOT NULL,
+  status              TEXT NOT NULL DEFAULT 'open' CHECK (status IN ('open','resolved')),
+  action              TEXT NULL CHECK (action IN ('dismiss','hide','delete','warn','suspend_author')),
+  resolved_by_user_id INTEGER NULL REFERENCES users(id),
+  resolved_at         TIMESTAMP NULL,
+  created_at          TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  UNIQUE (tenant_id, target_type, target_id, reporter_user_id)
+);
+CREATE INDEX IF NOT EXISTS idx_content_reports_status_created ON content_reports (tenant_id, status, created_at);
+
+CREATE TABLE IF NOT EXISTS audit_events (
+  id            INTEGER PRIMARY KEY AUTOINCREMENT,
+  tenant_id     INTEGER NOT NULL REFERENCES tenants(id),
+  actor_user_id INTEGER NOT NULL REFERENCES users(id),
+  action        TEXT NOT NULL,
+  target_type   TEXT NOT NULL,
+  target_id     INTEGER NOT NULL,
+  report_id     INTEGER NULL REFERENCES content_reports(id),
+  note          TEXT NOT NULL DEFAULT '',
+  created_at    TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+CREATE INDEX IF NOT EXISTS idx_audit_events_tenant_created ON audit_events (tenant_id, created_at);
@@ -0,0 +1,8 @@
+-- Removed members, translated from mysql/migrations/0012_member_removals.up.sql.
+
+CREATE TABLE IF NOT EXISTS tenant_member_removals (
+  tenant_id  INTEGER NOT NULL REFERENCES tenants(id),
+  user_id    INTEGER NOT NULL REFERENCES users(id),
+  removed_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (tenant_id, user_id)
+);
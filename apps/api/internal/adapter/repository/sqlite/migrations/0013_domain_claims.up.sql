@@ -0,0 +1,17 @@
+-- Only verified domains hold their host, translated from mysql/migrations/0013_domain_claims.up.sql.
+-- SQLite cannot drop the column's UNIQUE constraint, so the table is rebuilt.
+
+CREATE TABLE tenant_domains_new (
+  id                 INTEGER PRIMARY KEY AUTOINCREMENT,
+  tenant_id          INTEGER NOT NULL REFERENCES tenants(id),
+  domain             TEXT NOT NULL,
+  created_at         TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  verified_at        TIMESTAMP NULL,
+  verification_token TEXT NULL,
+  UNIQUE (tenant_id, domain)
+);
+INSERT INTO tenant_domains_new (id, tenant_id, domain, created_at, verified_at, verification_token)
+  SELECT id, tenant_id, domain, created_at, verified_at, CASE WHEN verified_at IS NULL THEN verification_token END FROM tenant_domains;
+DROP TABLE tenant_domains;
+ALTER TABLE tenant_domains_new RENAME TO tenant_domains;
+CREATE UNIQUE INDEX IF NOT EXISTS uniq_tenant_domains_verified ON tenant_domains (domain) WHERE verified_at IS NOT NULL;
@@ -0,0 +1,19 @@
+-- Usage counters, translated from mysql/migrations/0014_tenant_usage.up.sql.
+
+CREATE TABLE IF NOT EXISTS tenant_usage (
+  tenant_id     INTEGER PRIMARY KEY REFERENCES tenants(id),
+  -- posts_today counts the posts created on usage_day (UTC, YYYY-MM-DD)
+  usage_day     TEXT NOT NULL,
+  posts_today   INTEGER NOT NULL DEFAULT 0,
+  storage_bytes INTEGER NOT NULL DEFAULT 0,
+  members       INTEGER NOT NULL DEFAULT 0
+);
+
+INSERT INTO tenant_usage (tenant_id, usage_day, posts_today, storage_bytes, members)
+SELECT t.id, date('now'),
+  (SELECT COUNT(*) FROM posts WHERE tenant_id=t.id AND created_at >= date('now')),
+  (SELECT COALESCE(SUM(LENGTH(CAST(body AS BLOB))), 0) FROM posts WHERE tenant_id=t.id AND deleted_at IS NULL)
+    + (SELECT COALESCE(SUM(LENGTH(CAST(body AS BLOB))), 0) FROM comments WHERE tenant_id=t.id AND deleted_at IS NULL)
+    + (SELECT COALESCE(SUM(LENGTH(CAST(body AS BLOB))), 0) FROM messages WHERE tenant_id=t.id AND deleted_at IS NULL),
+  (SELECT COUNT(*) FROM tenant_memberships WHERE tenant_id=t.id)
+FROM tenants t;
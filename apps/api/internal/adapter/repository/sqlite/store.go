@@ -0,0 +1,155 @@
+// Package sqlite implements port.Store on SQLite with a pure-Go driver, so the API can run
+// from a single process without a database server. It is meant for local development.
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	_ "modernc.org/sqlite"
+
+	"github.com/example/something-like-sns/apps/api/internal/adapter/repository/instrument"
+	"github.com/example/something-like-sns/apps/api/internal/adapter/repository/tenantguard"
+	"github.com/example/something-like-sns/apps/api/internal/port"
+)
+
+// DBTX is an interface that is satisfied by both *sql.DB and *sql.Tx
+type DBTX = instrument.DBTX
+
+// dbSystem is the database system name reported in traces.
+const dbSystem = "sqlite"
+
+// Open opens the SQLite database at path, creating it if needed. Use ":memory:" for a
+// database that lives as long as the returned *sql.DB.
+//
+// The pool is limited to one connection: SQLite allows a single writer anyway, and an
+// in-memory database exists only on the connection that created it.
+func Open(path string) (*sql.DB, error) {
+	db, err := sql.Open("sqlite", "file:"+path+"?_pragma=foreign_keys(1)&_pragma=busy_timeout(5000)")
+	if err != nil {
+		return nil, err
+	}
+	db.SetMaxOpenConns(1)
+	db.SetConnMaxLifetime(0)
+	db.SetConnMaxIdleTime(0)
+	return db, nil
+}
+
+// sqlStore provides all functions to execute db queries and transactions
+type sqlStore struct {
+	db        *sql.DB
+	q         DBTX
+	slowQuery time.Duration
+	guard     tenantguard.Mode
+}
+
+// StoreOption configures a Store created by NewStore.
+type StoreOption func(*sqlStore)
+
+// WithSlowQueryThreshold logs statements that take at least d. Zero, the default, disables it.
+func WithSlowQueryThreshold(d time.Duration) StoreOption {
+	return func(s *sqlStore) {
+		s.slowQuery = d
+	}
+}
+
+// WithTenantGuard sets how statements missing a tenant_id predicate are handled.
+// It defaults to tenantguard.Enforce.
+func WithTenantGuard(mode tenantguard.Mode) StoreOption {
+	return func(s *sqlStore) {
+		s.guard = mode
+	}
+}
+
+// NewStore creates a new Store on a database opened with Open and migrated with Migrate.
+func NewStore(db *sql.DB, opts ...StoreOption) port.Store {
+	s := &sqlStore{db: db}
+	for _, opt := range opts {
+		opt(s)
+	}
+	s.q = instrument.Wrap(tenantguard.Wrap(db, s.guard), dbSystem, s.slowQuery)
+	return s
+}
+
+// ExecTx executes a function within a database transaction
+func (s *sqlStore) ExecTx(ctx context.Context, fn func(port.Store) error) (err error) {
+	ctx, end := instrument.StartTx(ctx, dbSystem)
+	defer func() { end(err) }()
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	txStore := &sqlStore{
+		db:        s.db,
+		q:         instrument.Wrap(tenantguard.Wrap(tx, s.guard), dbSystem, s.slowQuery),
+		slowQuery: s.slowQuery,
+		guard:     s.guard,
+	}
+
+	err = fn(txStore)
+	if err != nil {
+		if rbErr := tx.Rollback(); rbErr != nil {
+			return fmt.Errorf("tx err: %v, rb err: %v", err, rbErr)
+		}
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (s *sqlStore) AuthRepository() port.AuthRepository {
+	return &authRepository{q: s.q}
+}
+
+func (s *sqlStore) ProfileRepository() port.ProfileRepository {
+	return &profileRepository{q: s.q}
+}
+
+func (s *sqlStore) RelationRepository() port.RelationRepository {
+	return &relationRepository{q: s.q}
+}
+
+func (s *sqlStore) ModerationRepository() port.ModerationRepository {
+	return &moderationRepository{q: s.q}
+}
+
+func (s *sqlStore) TimelineRepository() port.TimelineRepository {
+	return &timelineRepository{q: s.q}
+}
+
+func (s *sqlStore) ReactionRepository() port.ReactionRepository {
+	return &reactionRepository{q: s.q}
+}
+
+func (s *sqlStore) DMRepository() port.DMRepository {
+	return &dmRepository{q: s.q}
+}
+
+func (s *sqlStore) PlanRepository() port.PlanRepository {
+	return &planRepository{q: s.q}
+}
+
+func (s *sqlStore) IdempotencyRepository() port.IdempotencyRepository {
+	return &idempotencyRepository{q: s.q}
+}
+
+func (s *sqlStore) InvitationRepository() port.InvitationRepository {
+	return &invitationRepository{q: s.q}
+}
+
+func (s *sqlStore) BulkRepository() port.BulkRepository {
+	return &bulkRepository{q: s.q}
+}
+
+// timeLayout is the text format of TIMESTAMP columns, matching CURRENT_TIMESTAMP.
+const timeLayout = "2006-01-02 15:04:05"
+
+// ts formats t for comparison with TIMESTAMP columns. The driver's own time format would not
+// compare correctly with values written by CURRENT_TIMESTAMP, so every time argument goes through ts.
+func ts(t time.Time) string {
+	return t.UTC().Format(timeLayout)
+}
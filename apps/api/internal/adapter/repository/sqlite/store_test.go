@@ -0,0 +1,42 @@
+package sqlite_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/example/something-like-sns/apps/api/internal/adapter/repository/repotest"
+	"github.com/example/something-like-sns/apps/api/internal/adapter/repository/sqlite"
+	"github.com/example/something-like-sns/apps/api/internal/port"
+)
+
+func TestStoreContract(t *testing.T) {
+	repotest.Run(t, func(t *testing.T) port.Store {
+		db, err := sqlite.Open(":memory:")
+		if err != nil {
+			t.Fatalf("open: %v", err)
+		}
+		t.Cleanup(func() { db.Close() })
+		if err := sqlite.Migrate(context.Background(), db); err != nil {
+			t.Fatalf("migrate: %v", err)
+		}
+		return sqlite.NewStore(db)
+	})
+}
+
+func TestMigrateIsIdempotent(t *testing.T) {
+	db, err := sqlite.Open(":memory:")
+	if err != nil {
+		t.Fatalf("open: %v", err)
+	}
+	defer db.Close()
+	ctx := context.Background()
+	for i := 0; i < 2; i++ {
+		if err := sqlite.Migrate(ctx, db); err != nil {
+			t.Fatalf("migrate #%d: %v", i+1, err)
+		}
+	}
+	var n int
+	if err := db.QueryRowContext(ctx, "SELECT COUNT(*) FROM plans").Scan(&n); err != nil || n != 3 {
+		t.Fatalf("plans = %d, %v; want 3", n, err)
+	}
+}
@@ -0,0 +1,139 @@
+package sqlite
+
+import (
+	"context"
+	"time"
+
+	"github.com/example/something-like-sns/apps/api/internal/adapter/repository/sqlutil"
+	"github.com/example/something-like-sns/apps/api/internal/domain"
+)
+
+type timelineRepository struct {
+	q DBTX
+}
+
+func (r *timelineRepository) CreatePost(ctx context.Context, tenantID, authorID uint64, body string) (*domain.Post, error) {
+	resExec, err := r.q.ExecContext(ctx, "INSERT INTO posts (tenant_id, author_user_id, body) VALUES (?,?,?)", tenantID, authorID, body)
+	if err != nil {
+		return nil, translateError(err, "post")
+	}
+	if err := addUsage(ctx, r.q, tenantID, 1, len(body), 0); err != nil {
+		return nil, err
+	}
+	id, _ := resExec.LastInsertId()
+	var created time.Time
+	_ = r.q.QueryRowContext(ctx, "SELECT created_at FROM posts WHERE tenant_id=? AND id=?", tenantID, id).Scan(&created)
+	return &domain.Post{
+		ID:           uint64(id),
+		AuthorUserID: authorID,
+		Body:         body,
+		CreatedAt:    created,
+	}, nil
+}
+
+func (r *timelineRepository) FindPostByID(ctx context.Context, tenantID, postID uint64) (*domain.Post, error) {
+	var p domain.Post
+	err := r.q.QueryRowContext(ctx, "SELECT id, author_user_id, body, created_at FROM posts WHERE tenant_id=? AND id=? AND deleted_at IS NULL", tenantID, postID).Scan(&p.ID, &p.AuthorUserID, &p.Body, &p.CreatedAt)
+	if err != nil {
+		return nil, translateError(err, "post")
+	}
+	return &p, nil
+}
+
+func (r *timelineRepository) FindFeed(ctx context.Context, tenantID, userID uint64, limit int, cursor domain.Cursor) ([]*domain.Post, bool, error) {
+	where, order, args := keyset("p.", cursor, true)
+	rows, err := r.q.QueryContext(ctx, `
+            SELECT p.id, p.author_user_id, p.body, p.created_at,
+                   (SELECT COUNT(*) FROM reactions r WHERE r.tenant_id=p.tenant_id AND r.target_type='post' AND r.target_id=p.id) AS like_count,
+                   (SELECT COUNT(*) FROM comments c WHERE c.tenant_id=p.tenant_id AND c.post_id=p.id AND c.deleted_at IS NULL) AS comment_count,
+                   EXISTS(SELECT 1 FROM reactions r WHERE r.tenant_id=p.tenant_id AND r.target_type='post' AND r.target_id=p.id AND r.user_id=?) as liked
+            FROM posts p
+            WHERE p.tenant_id=? AND p.deleted_at IS NULL
+              AND NOT EXISTS(SELECT 1 FROM user_relations ur WHERE ur.tenant_id=p.tenant_id AND ur.user_id=? AND ur.target_user_id=p.author_user_id)`+where+`
+            `+order+`
+            LIMIT ?`, append(append([]interface{}{userID, tenantID, userID}, args...), limit+1)...)
+	if err != nil {
+		return nil, false, err
+	}
+	defer rows.Close()
+
+	items := make([]*domain.Post, 0, limit+1)
+	for rows.Next() {
+		var p domain.Post
+		if err := rows.Scan(&p.ID, &p.AuthorUserID, &p.Body, &p.CreatedAt, &p.LikeCount, &p.CommentCount, &p.LikedByMe); err != nil {
+			return nil, false, err
+		}
+		items = append(items, &p)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, false, err
+	}
+	items, hasMore := sqlutil.TrimPage(items, limit, cursor)
+	return items, hasMore, nil
+}
+
+func (r *timelineRepository) CreateComment(ctx context.Context, tenantID, postID, authorID uint64, body string) (*domain.Comment, error) {
+	resExec, err := r.q.ExecContext(ctx, "INSERT INTO comments (tenant_id, post_id, author_user_id, body) VALUES (?,?,?,?)", tenantID, postID, authorID, body)
+	if err != nil {
+		return nil, translateError(err, "comment")
+	}
+	if err := addUsage(ctx, r.q, tenantID, 0, len(body), 0); err != nil {
+		return nil, err
+	}
+	id, _ := resExec.LastInsertId()
+	var created time.Time
+	_ = r.q.QueryRowContext(ctx, "SELECT created_at FROM comments WHERE tenant_id=? AND id=?", tenantID, id).Scan(&created)
+	return &domain.Comment{
+		ID:           uint64(id),
+		PostID:       postID,
+		AuthorUserID: authorID,
+		Body:         body,
+		CreatedAt:    created,
+	}, nil
+}
+
+func (r *timelineRepository) FindCommentByID(ctx context.Context, tenantID, commentID uint64) (*domain.Comment, error) {
+	var c domain.Comment
+	err := r.q.QueryRowContext(ctx, "SELECT id, post_id, author_user_id, body, created_at FROM comments WHERE tenant_id=? AND id=? AND deleted_at IS NULL", tenantID, commentID).Scan(&c.ID, &c.PostID, &c.AuthorUserID, &c.Body, &c.CreatedAt)
+	if err != nil {
+		return nil, translateError(err, "comment")
+	}
+	return &c, nil
+}
+
+func (r *timelineRepository) FindCommentsByPostID(ctx context.Context, tenantID, postID uint64, limit int, cursor domain.Cursor) ([]*domain.Comment, bool, error) {
+	where, order, args := keyset("", cursor, false)
+	rows, err := r.q.QueryContext(ctx, `
+            SELECT id, author_user_id, body, created_at
+            FROM comments
+            WHERE tenant_id=? AND post_id=? AND deleted_at IS NULL`+where+`
+            `+order+`
+            LIMIT ?`, append(append([]interface{}{tenantID, postID}, args...), limit+1)...)
+	if err != nil {
+		return nil, false, err
+	}
+	defer rows.Close()
+	items := make([]*domain.Comment, 0, limit+1)
+	for rows.Next() {
+		var cmt domain.Comment
+		if err := rows.Scan(&cmt.ID, &cmt.AuthorUserID, &cmt.Body, &cmt.CreatedAt); err != nil {
+			return nil, false, err
+		}
+		cmt.PostID = postID
+		items = append(items, &cmt)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, false, err
+	}
+	items, hasMore := sqlutil.TrimPage(items, limit, cursor)
+	return items, hasMore, nil
+}
+
+func (r *timelineRepository) CountComments(ctx context.Context, tenantID, postID uint64) (uint64, error) {
+	var n uint64
+	err := r.q.QueryRowContext(ctx, `
+            SELECT COUNT(*) FROM (
+                SELECT 1 FROM comments WHERE tenant_id=? AND post_id=? AND deleted_at IS NULL LIMIT ?
+            ) AS t`, tenantID, postID, sqlutil.CountCap).Scan(&n)
+	return n, err
+}
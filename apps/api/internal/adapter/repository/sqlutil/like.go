@@ -0,0 +1,11 @@
+package sqlutil
+
+import "strings"
+
+// likeEscaper escapes the wildcards of a LIKE pattern, and its escape character '!'.
+var likeEscaper = strings.NewReplacer("!", "!!", "%", "!%", "_", "!_")
+
+// EscapeLike returns s as a literal LIKE pattern for use with ESCAPE '!'.
+func EscapeLike(s string) string {
+	return likeEscaper.Replace(s)
+}
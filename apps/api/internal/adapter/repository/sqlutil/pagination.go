@@ -0,0 +1,25 @@
+// Package sqlutil holds the helpers the SQL repository adapters share. The SQL itself differs
+// between dialects and stays in each adapter.
+package sqlutil
+
+import (
+	"slices"
+
+	"github.com/example/something-like-sns/apps/api/internal/domain"
+)
+
+// CountCap bounds the rows scanned by approximate counts.
+const CountCap = 10000
+
+// TrimPage drops the extra row of a page queried with limit+1 rows and restores the natural
+// order of backward pages. It reports whether there were more rows in the paging direction.
+func TrimPage[T any](items []T, limit int, c domain.Cursor) ([]T, bool) {
+	hasMore := len(items) > limit
+	if hasMore {
+		items = items[:limit]
+	}
+	if c.Direction == domain.PageBackward {
+		slices.Reverse(items)
+	}
+	return items, hasMore
+}
@@ -0,0 +1,54 @@
+package tenantguard
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"sync"
+)
+
+// failedRow returns a *sql.Row whose Scan returns err. database/sql offers no way to build a Row
+// with an error, so the row is queried from an in-process driver that fails every query with the
+// error passed as its argument.
+func failedRow(ctx context.Context, err error) *sql.Row {
+	return failDB().QueryRowContext(ctx, "", failure{err})
+}
+
+var failDB = sync.OnceValue(func() *sql.DB {
+	return sql.OpenDB(failConnector{})
+})
+
+// failure carries the error through the driver arguments.
+type failure struct{ err error }
+
+type failConnector struct{}
+
+func (failConnector) Connect(context.Context) (driver.Conn, error) { return failConn{}, nil }
+func (failConnector) Driver() driver.Driver                        { return failDriver{} }
+
+type failDriver struct{}
+
+func (failDriver) Open(string) (driver.Conn, error) { return failConn{}, nil }
+
+type failConn struct{}
+
+func (failConn) Prepare(string) (driver.Stmt, error) {
+	return nil, errors.New("tenantguard: prepare not supported")
+}
+func (failConn) Close() error { return nil }
+func (failConn) Begin() (driver.Tx, error) {
+	return nil, errors.New("tenantguard: transactions not supported")
+}
+
+// CheckNamedValue accepts the failure argument as is.
+func (failConn) CheckNamedValue(*driver.NamedValue) error { return nil }
+
+func (failConn) QueryContext(_ context.Context, _ string, args []driver.NamedValue) (driver.Rows, error) {
+	for _, a := range args {
+		if f, ok := a.Value.(failure); ok {
+			return nil, f.err
+		}
+	}
+	return nil, errors.New("tenantguard: query not supported")
+}
@@ -0,0 +1,295 @@
+// Package tenantguard wraps the database handles of the SQL repository adapters with an audit of
+// every statement: tenant-scoped tables may only be touched with a tenant_id predicate, and when
+// the context carries a domain.Scope, the tenant bound to that predicate must be the scope's.
+package tenantguard
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/example/something-like-sns/apps/api/internal/adapter/repository/instrument"
+	"github.com/example/something-like-sns/apps/api/internal/domain"
+)
+
+// Mode selects what the guard does with a statement that breaks the tenant rules.
+type Mode int
+
+const (
+	// Enforce logs the violation and fails the statement without running it.
+	Enforce Mode = iota
+	// Permissive logs the violation and runs the statement anyway.
+	Permissive
+	// Off disables the guard.
+	Off
+)
+
+// ParseMode parses "enforce", "permissive" or "off".
+func ParseMode(s string) (Mode, error) {
+	switch s {
+	case "enforce":
+		return Enforce, nil
+	case "permissive":
+		return Permissive, nil
+	case "off":
+		return Off, nil
+	}
+	return Off, fmt.Errorf("unknown tenant guard mode %q", s)
+}
+
+// ErrViolation is wrapped by the errors of statements rejected in Enforce mode.
+var ErrViolation = errors.New("tenant guard violation")
+
+// globalTables are not tenant-scoped and may be queried freely.
+var globalTables = map[string]bool{
+	"tenants":           true,
+	"users":             true,
+	"plans":             true,
+	"schema_migrations": true,
+}
+
+// tenantTables are tenant-scoped. A table mapped to another table has no tenant_id column
+// and is scoped by joining that parent with a tenant_id predicate. Tables in neither list
+// are rejected, so new tables must be classified here.
+var tenantTables = map[string]string{
+	"tenant_domains":         "",
+	"tenant_memberships":     "",
+	"posts":                  "",
+	"comments":               "",
+	"reactions":              "",
+	"conversations":          "",
+	"conversation_members":   "conversations",
+	"messages":               "",
+	"idempotency_keys":       "",
+	"tenant_email_domains":   "",
+	"invitations":            "",
+	"join_requests":          "",
+	"tenant_member_removals": "",
+	"tenant_usage":           "",
+	"user_relations":         "",
+	"content_reports":        "",
+	"audit_events":           "",
+}
+
+type crossTenantKey struct{}
+
+// AllowCrossTenant returns a context whose statements are not audited. Repositories use it for
+// the few queries that are deliberately not bound to one tenant, such as resolving the tenant
+// of a host or purging expired rows of every tenant.
+func AllowCrossTenant(ctx context.Context) context.Context {
+	return context.WithValue(ctx, crossTenantKey{}, true)
+}
+
+// Wrap returns a DBTX that audits every statement run through q according to mode.
+// Placeholders may be MySQL/SQLite style (?) or PostgreSQL style ($1).
+func Wrap(q instrument.DBTX, mode Mode) instrument.DBTX {
+	if mode == Off {
+		return q
+	}
+	return &guardedDBTX{q: q, mode: mode}
+}
+
+type guardedDBTX struct {
+	q    instrument.DBTX
+	mode Mode
+}
+
+func (g *guardedDBTX) ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	if err := g.check(ctx, query, args); err != nil {
+		return nil, err
+	}
+	return g.q.ExecContext(ctx, query, args...)
+}
+
+func (g *guardedDBTX) QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error) {
+	if err := g.check(ctx, query, args); err != nil {
+		return nil, err
+	}
+	return g.q.QueryContext(ctx, query, args...)
+}
+
+func (g *guardedDBTX) QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row {
+	if err := g.check(ctx, query, args); err != nil {
+		return failedRow(ctx, err)
+	}
+	return g.q.QueryRowContext(ctx, query, args...)
+}
+
+// check audits query and returns the error to fail it with, if any.
+func (g *guardedDBTX) check(ctx context.Context, query string, args []interface{}) error {
+	if allowed, _ := ctx.Value(crossTenantKey{}).(bool); allowed {
+		return nil
+	}
+	problem := analyze(query).problem
+	if problem == "" {
+		if scope, ok := domain.ScopeFromContext(ctx); ok {
+			problem = analyze(query).checkTenant(args, scope.TenantID)
+		}
+	}
+	if problem == "" {
+		return nil
+	}
+	if g.mode == Permissive {
+		slog.WarnContext(ctx, "tenant guard violation", slog.String("problem", problem), slog.String("db.statement", query))
+		return nil
+	}
+	slog.ErrorContext(ctx, "tenant guard violation", slog.String("problem", problem), slog.String("db.statement", query))
+	return fmt.Errorf("%w: %s", ErrViolation, problem)
+}
+
+// analysis is the argument-independent result of auditing a statement.
+type analysis struct {
+	// problem describes why the statement is rejected regardless of its arguments.
+	problem string
+	// tenantArgs are the indexes of the arguments bound to tenant_id predicates or columns.
+	tenantArgs []int
+}
+
+var analyses sync.Map // query string -> *analysis
+
+func analyze(query string) *analysis {
+	if a, ok := analyses.Load(query); ok {
+		return a.(*analysis)
+	}
+	a, _ := analyses.LoadOrStore(query, newAnalysis(query))
+	return a.(*analysis)
+}
+
+var (
+	// tableRef matches the tables a statement reads or writes, with their optional alias.
+	tableRef = regexp.MustCompile(`(?i)(?:\bFROM|\bJOIN|\bINTO|^\s*UPDATE)\s+([a-z_][a-z0-9_]*)(?:\s+(?:AS\s+)?([a-z_][a-z0-9_]*))?`)
+	// insertColumns matches the table, column list and VALUES tuple of an INSERT.
+	insertColumns = regexp.MustCompile(`(?is)\bINTO\s+([a-z_][a-z0-9_]*)\s*\(([^)]*)\)\s*(?:VALUES\s*\(([^)]*)\))?`)
+	// tenantPredicate matches tenant_id comparisons, capturing the qualifier and a bound placeholder.
+	tenantPredicate = regexp.MustCompile(`(?i)(?:\b([a-z_][a-z0-9_]*)\.)?\btenant_id\s*=\s*(?:(\?|\$\d+)|[a-z_])`)
+)
+
+// keywords may follow a table name but are not aliases.
+var keywords = map[string]bool{
+	"WHERE": true, "ON": true, "JOIN": true, "LEFT": true, "RIGHT": true, "INNER": true, "CROSS": true,
+	"SET": true, "VALUES": true, "ORDER": true, "GROUP": true, "HAVING": true, "LIMIT": true, "OFFSET": true,
+	"USING": true, "SELECT": true, "RETURNING": true, "UNION": true, "DEFAULT": true, "AND": true, "OR": true,
+}
+
+func newAnalysis(query string) *analysis {
+	a := &analysis{}
+	type ref struct{ table, qualifier string }
+	var refs []ref
+	for _, m := range tableRef.FindAllStringSubmatch(query, -1) {
+		table := strings.ToLower(m[1])
+		if globalTables[table] {
+			continue
+		}
+		if _, ok := tenantTables[table]; !ok {
+			a.problem = fmt.Sprintf("table %s is not classified as global or tenant-scoped", table)
+			return a
+		}
+		r := ref{table: table}
+		if alias := m[2]; alias != "" && !keywords[strings.ToUpper(alias)] {
+			r.qualifier = strings.ToLower(alias)
+		}
+		refs = append(refs, r)
+	}
+
+	// Qualifiers with a tenant_id predicate; "" stands for an unqualified tenant_id.
+	scoped := map[string]bool{}
+	for _, m := range tenantPredicate.FindAllStringSubmatchIndex(query, -1) {
+		qualifier := ""
+		if m[2] >= 0 {
+			qualifier = strings.ToLower(query[m[2]:m[3]])
+		}
+		scoped[qualifier] = true
+		if m[4] >= 0 {
+			a.tenantArgs = append(a.tenantArgs, placeholderIndex(query, m[4], m[5]))
+		}
+	}
+	if m := insertColumns.FindStringSubmatch(query); m != nil {
+		for _, c := range strings.Split(m[2], ",") {
+			if strings.EqualFold(strings.TrimSpace(c), "tenant_id") {
+				scoped[strings.ToLower(m[1])] = true
+			}
+		}
+		a.tenantArgs = append(a.tenantArgs, insertTenantArgs(query, m[2], m[3])...)
+	}
+
+	isScoped := func(r ref) bool {
+		if r.qualifier != "" {
+			return scoped[r.qualifier]
+		}
+		return scoped[""] || scoped[r.table]
+	}
+	for _, r := range refs {
+		if parent := tenantTables[r.table]; parent != "" {
+			ok := false
+			for _, p := range refs {
+				if p.table == parent && isScoped(p) {
+					ok = true
+				}
+			}
+			if !ok {
+				a.problem = fmt.Sprintf("%s is not joined with %s under a tenant_id predicate", r.table, parent)
+				return a
+			}
+			continue
+		}
+		if !isScoped(r) {
+			a.problem = fmt.Sprintf("statement on %s has no tenant_id predicate", r.table)
+			return a
+		}
+	}
+	return a
+}
+
+// insertTenantArgs returns the index of the argument inserted into a tenant_id column, if any.
+func insertTenantArgs(query, columns, values string) []int {
+	if values == "" {
+		return nil
+	}
+	cols := strings.Split(columns, ",")
+	vals := strings.Split(values, ",")
+	if len(cols) != len(vals) {
+		return nil
+	}
+	offset := strings.Index(query, values)
+	pos := 0
+	for i, c := range cols {
+		if strings.EqualFold(strings.TrimSpace(c), "tenant_id") {
+			v := strings.TrimSpace(vals[i])
+			if v == "?" || strings.HasPrefix(v, "$") {
+				start := offset + pos + strings.Index(vals[i], v)
+				return []int{placeholderIndex(query, start, start+len(v))}
+			}
+		}
+		pos += len(vals[i]) + 1
+	}
+	return nil
+}
+
+// placeholderIndex returns the argument index of the placeholder at query[start:end].
+func placeholderIndex(query string, start, end int) int {
+	if p := query[start:end]; strings.HasPrefix(p, "$") {
+		n, _ := strconv.Atoi(p[1:])
+		return n - 1
+	}
+	return strings.Count(query[:start], "?")
+}
+
+// checkTenant reports a tenant_id bound to another tenant than tenantID.
+func (a *analysis) checkTenant(args []interface{}, tenantID uint64) string {
+	want := strconv.FormatUint(tenantID, 10)
+	for _, i := range a.tenantArgs {
+		if i < 0 || i >= len(args) {
+			continue
+		}
+		if got := fmt.Sprint(args[i]); got != want {
+			return fmt.Sprintf("tenant_id %s does not match the scope's tenant %s", got, want)
+		}
+	}
+	return ""
+}
@@ -0,0 +1,90 @@
+package tenantguard
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/example/something-like-sns/apps/api/internal/domain"
+)
+
+func TestAnalyze(t *testing.T) {
+	tests := []struct {
+		name    string
+		query   string
+		problem string // substring; empty when the statement is allowed
+		args    []int
+	}{
+		{"global table", "SELECT id, slug FROM tenants WHERE slug=?", "", nil},
+		{"unqualified predicate", "SELECT id FROM posts WHERE tenant_id=? AND id=?", "", []int{0}},
+		{"missing predicate", "SELECT created_at FROM posts WHERE id=?", "posts has no tenant_id predicate", nil},
+		{"unknown table", "SELECT 1 FROM secrets WHERE id=?", "secrets is not classified", nil},
+		{"insert column", "INSERT INTO posts (tenant_id, author_user_id, body) VALUES (?,?,?)", "", []int{0}},
+		{"insert without tenant", "INSERT INTO posts (author_user_id, body) VALUES (?,?)", "posts has no tenant_id predicate", nil},
+		{"postgres placeholders", "INSERT INTO comments (tenant_id, post_id) VALUES ($2,$1) RETURNING id", "", []int{1}},
+		{"update", "UPDATE idempotency_keys SET response=? WHERE tenant_id=? AND user_id=?", "", []int{1}},
+		{
+			"aliases and correlated subqueries",
+			`SELECT p.id, (SELECT COUNT(*) FROM reactions r WHERE r.tenant_id=p.tenant_id AND r.user_id=?) FROM posts p WHERE p.tenant_id=?`,
+			"", []int{1},
+		},
+		{
+			"unscoped alias",
+			`SELECT p.id, (SELECT COUNT(*) FROM comments c WHERE c.post_id=p.id) FROM posts p WHERE p.tenant_id=?`,
+			"comments has no tenant_id predicate", nil,
+		},
+		{
+			"child joined with scoped parent",
+			"SELECT c.id FROM conversations c JOIN conversation_members m ON m.conversation_id=c.id AND m.user_id=? WHERE c.tenant_id=?",
+			"", []int{1},
+		},
+		{
+			"child without parent",
+			"SELECT user_id FROM conversation_members WHERE conversation_id=?",
+			"conversation_members is not joined with conversations", nil,
+		},
+		{"postgres row comparison", "SELECT id FROM messages WHERE tenant_id=$1 AND (created_at, id) < ($3, $4) LIMIT $5", "", []int{0}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := newAnalysis(tt.query)
+			if tt.problem == "" && a.problem != "" || !strings.Contains(a.problem, tt.problem) {
+				t.Fatalf("problem = %q, want %q", a.problem, tt.problem)
+			}
+			if tt.problem == "" && !slices.Equal(a.tenantArgs, tt.args) {
+				t.Errorf("tenant args = %v, want %v", a.tenantArgs, tt.args)
+			}
+		})
+	}
+}
+
+func TestCheckTenant(t *testing.T) {
+	a := newAnalysis("SELECT id FROM posts WHERE tenant_id=? AND id=?")
+	if p := a.checkTenant([]interface{}{uint64(1), uint64(7)}, 1); p != "" {
+		t.Errorf("same tenant: %q", p)
+	}
+	if p := a.checkTenant([]interface{}{uint64(2), uint64(7)}, 1); !strings.Contains(p, "does not match") {
+		t.Errorf("other tenant: %q", p)
+	}
+}
+
+func TestEnforceFailsStatements(t *testing.T) {
+	// The inner DBTX is never reached for rejected statements.
+	q := Wrap(nil, Enforce)
+	ctx := context.Background()
+
+	var created string
+	err := q.QueryRowContext(ctx, "SELECT created_at FROM posts WHERE id=?", 1).Scan(&created)
+	if !errors.Is(err, ErrViolation) {
+		t.Errorf("QueryRowContext: err = %v, want ErrViolation", err)
+	}
+	if _, err := q.ExecContext(ctx, "DELETE FROM messages WHERE id=?", 1); !errors.Is(err, ErrViolation) {
+		t.Errorf("ExecContext: err = %v, want ErrViolation", err)
+	}
+	scoped := domain.ContextWithScope(ctx, domain.Scope{TenantID: 1, UserID: 1})
+	if _, err := q.QueryContext(scoped, "SELECT id FROM posts WHERE tenant_id=?", uint64(2)); !errors.Is(err, ErrViolation) {
+		t.Errorf("QueryContext for another tenant: err = %v, want ErrViolation", err)
+	}
+}
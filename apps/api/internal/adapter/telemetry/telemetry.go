@@ -0,0 +1,101 @@
+// Package telemetry configures the OpenTelemetry tracer and meter providers for the API.
+package telemetry
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	otelprom "go.opentelemetry.io/otel/exporters/prometheus"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+)
+
+// Trace exporters selectable with OTEL_TRACES_EXPORTER.
+const (
+	ExporterOTLP   = "otlp"
+	ExporterStdout = "stdout"
+	ExporterNone   = "none"
+)
+
+// Config holds the telemetry settings.
+type Config struct {
+	ServiceName string
+	// TracesExporter is one of ExporterOTLP, ExporterStdout or ExporterNone.
+	TracesExporter string
+}
+
+// ConfigFromEnv reads the configuration from the standard OTEL_* environment variables.
+// Traces are exported over OTLP/HTTP when OTEL_EXPORTER_OTLP_ENDPOINT is set and dropped otherwise,
+// unless OTEL_TRACES_EXPORTER says differently.
+func ConfigFromEnv() Config {
+	cfg := Config{ServiceName: os.Getenv("OTEL_SERVICE_NAME"), TracesExporter: os.Getenv("OTEL_TRACES_EXPORTER")}
+	if cfg.ServiceName == "" {
+		cfg.ServiceName = "sns-api"
+	}
+	if cfg.TracesExporter == "" {
+		cfg.TracesExporter = ExporterNone
+		if os.Getenv("OTEL_EXPORTER_OTLP_ENDPOINT") != "" || os.Getenv("OTEL_EXPORTER_OTLP_TRACES_ENDPOINT") != "" {
+			cfg.TracesExporter = ExporterOTLP
+		}
+	}
+	return cfg
+}
+
+// Setup installs the global tracer provider, meter provider and propagator.
+// It returns the handler serving metrics in the Prometheus exposition format and
+// a shutdown function that flushes buffered telemetry.
+func Setup(ctx context.Context, cfg Config) (http.Handler, func(context.Context) error, error) {
+	res, err := resource.Merge(resource.Default(), resource.NewWithAttributes(semconv.SchemaURL, semconv.ServiceName(cfg.ServiceName)))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	tpOpts := []sdktrace.TracerProviderOption{sdktrace.WithResource(res)}
+	switch cfg.TracesExporter {
+	case ExporterOTLP:
+		exp, err := otlptracehttp.New(ctx)
+		if err != nil {
+			return nil, nil, fmt.Errorf("otlp exporter: %w", err)
+		}
+		tpOpts = append(tpOpts, sdktrace.WithBatcher(exp))
+	case ExporterStdout:
+		exp, err := stdouttrace.New(stdouttrace.WithPrettyPrint())
+		if err != nil {
+			return nil, nil, fmt.Errorf("stdout exporter: %w", err)
+		}
+		tpOpts = append(tpOpts, sdktrace.WithSyncer(exp))
+	case ExporterNone:
+	default:
+		return nil, nil, fmt.Errorf("unknown traces exporter %q", cfg.TracesExporter)
+	}
+	tp := sdktrace.NewTracerProvider(tpOpts...)
+
+	registry := prometheus.NewRegistry()
+	registry.MustRegister(collectors.NewGoCollector(), collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}))
+	promExporter, err := otelprom.New(otelprom.WithRegisterer(registry))
+	if err != nil {
+		return nil, nil, fmt.Errorf("prometheus exporter: %w", err)
+	}
+	mp := sdkmetric.NewMeterProvider(sdkmetric.WithResource(res), sdkmetric.WithReader(promExporter))
+
+	otel.SetTracerProvider(tp)
+	otel.SetMeterProvider(mp)
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+
+	shutdown := func(ctx context.Context) error {
+		return errors.Join(tp.Shutdown(ctx), mp.Shutdown(ctx))
+	}
+	return promhttp.HandlerFor(registry, promhttp.HandlerOpts{}), shutdown, nil
+}
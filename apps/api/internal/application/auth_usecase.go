@@ -2,7 +2,7 @@ package application
 
 import (
 	"context"
-	"errors"
+	"slices"
 	"strings"
 
 	"github.com/example/something-like-sns/apps/api/internal/domain"
@@ -17,46 +17,136 @@ func NewAuthUsecase(store port.Store) port.AuthUsecase {
 	return &authUsecase{store: store}
 }
 
-func (u *authUsecase) ResolveScope(ctx context.Context, tenantSlug, userAuthSub string) (*domain.Scope, error) {
+func (u *authUsecase) ResolveScope(ctx context.Context, tenantSlug, userAuthSub, email string) (*domain.Scope, error) {
+	ctx, span := startSpan(ctx, "AuthUsecase.ResolveScope", domain.Scope{})
+	defer span.End()
+
 	if tenantSlug == "" || userAuthSub == "" {
-		return nil, errors.New("missing tenant slug or user auth sub")
+		return nil, domain.ErrUnauthenticated
 	}
 	tenant, err := u.store.AuthRepository().FindTenantBySlug(ctx, tenantSlug)
 	if err != nil {
 		return nil, err
 	}
+	if tenant.Disabled {
+		return nil, domain.NewPermissionDeniedError("tenant is disabled")
+	}
 
 	userID, err := u.store.AuthRepository().FindOrCreateUser(ctx, userAuthSub, userAuthSub)
 	if err != nil {
 		return nil, err
 	}
+	user, err := u.store.AuthRepository().FindUserByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if user.Suspended {
+		return nil, domain.NewPermissionDeniedError("user is suspended")
+	}
 
-	if err := u.store.AuthRepository().EnsureMembership(ctx, tenant.ID, userID, "member"); err != nil {
+	role, suspended, err := u.store.AuthRepository().FindMembership(ctx, tenant.ID, userID)
+	if err != nil {
 		return nil, err
 	}
+	if suspended {
+		return nil, domain.NewPermissionDeniedError("membership is suspended")
+	}
+	if role == "" {
+		admitted, err := u.admits(ctx, tenant, userID, email)
+		if err != nil || !admitted {
+			return &domain.Scope{TenantID: tenant.ID, UserID: userID}, err
+		}
+		role = domain.RoleMember
+		err = u.store.ExecTx(ctx, func(s port.Store) error {
+			if err := s.AuthRepository().EnsureMembership(ctx, tenant.ID, userID, role); err != nil {
+				return err
+			}
+			return checkMemberQuota(ctx, s, tenant.ID)
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
 
-	return &domain.Scope{TenantID: tenant.ID, UserID: userID}, nil
+	return &domain.Scope{TenantID: tenant.ID, UserID: userID, Role: role}, nil
+}
+
+// admits reports whether the tenant's join policy lets a user with email join without an invitation.
+// Members an admin removed are not admitted again until they are invited or their request to join
+// is approved.
+func (u *authUsecase) admits(ctx context.Context, tenant *domain.Tenant, userID uint64, email string) (bool, error) {
+	if tenant.JoinPolicy == domain.JoinPolicyOpen || tenant.JoinPolicy == domain.JoinPolicyDomain {
+		removed, err := u.store.AuthRepository().IsMemberRemoved(ctx, tenant.ID, userID)
+		if err != nil || removed {
+			return false, err
+		}
+	}
+	switch tenant.JoinPolicy {
+	case domain.JoinPolicyOpen:
+		return true, nil
+	case domain.JoinPolicyDomain:
+		emailDomain := emailDomain(email)
+		if emailDomain == "" {
+			return false, nil
+		}
+		domains, err := u.store.InvitationRepository().FindEmailDomains(ctx, tenant.ID)
+		if err != nil {
+			return false, err
+		}
+		return slices.Contains(domains, emailDomain), nil
+	default:
+		return false, nil
+	}
+}
+
+// emailDomain returns the lowercased domain of an email address, or "" if it has none.
+func emailDomain(email string) string {
+	at := strings.LastIndexByte(email, '@')
+	if at <= 0 {
+		return ""
+	}
+	return strings.ToLower(strings.TrimSpace(email[at+1:]))
 }
 
 func (u *authUsecase) ResolveTenant(ctx context.Context, host string) (*domain.Tenant, error) {
+	ctx, span := startSpan(ctx, "AuthUsecase.ResolveTenant", domain.Scope{})
+	defer span.End()
+
 	host = strings.TrimSpace(host)
 	if host == "" {
-		return nil, errors.New("host is required")
+		return nil, domain.NewValidationError("host", "is required")
+	}
+	tenant, err := u.store.AuthRepository().FindTenantByHost(ctx, host)
+	if err != nil {
+		return nil, err
+	}
+	// A disabled tenant is not served on its hosts.
+	if tenant.Disabled {
+		return nil, domain.NewNotFoundError("tenant", nil)
 	}
-	return u.store.AuthRepository().FindTenantByHost(ctx, host)
+	return tenant, nil
 }
 
-func (u *authUsecase) GetMe(ctx context.Context, userID uint64) (*domain.User, error) {
-	user, err := u.store.AuthRepository().FindUserByID(ctx, userID)
+func (u *authUsecase) GetMe(ctx context.Context, scope domain.Scope) (*domain.User, *domain.Profile, error) {
+	ctx, span := startSpan(ctx, "AuthUsecase.GetMe", scope)
+	defer span.End()
+
+	user, err := u.store.AuthRepository().FindUserByID(ctx, scope.UserID)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	memberships, err := u.store.AuthRepository().FindUserMemberships(ctx, userID)
+	memberships, err := u.store.AuthRepository().FindUserMemberships(ctx, scope.UserID)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
+	}
+
+	// Non-members see their own profile, as FindProfiles falls back to it.
+	profile, err := findProfile(ctx, u.store, scope.TenantID, scope.UserID)
+	if err != nil {
+		return nil, nil, err
 	}
 
 	user.Memberships = memberships
-	return user, nil
+	return user, profile, nil
 }
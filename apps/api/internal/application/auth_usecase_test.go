@@ -0,0 +1,135 @@
+package application_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/example/something-like-sns/apps/api/internal/adapter/dns"
+	"github.com/example/something-like-sns/apps/api/internal/adapter/repository/memory"
+	"github.com/example/something-like-sns/apps/api/internal/application"
+	"github.com/example/something-like-sns/apps/api/internal/domain"
+)
+
+func TestAuthUsecase_DisabledTenantsAndSuspendedUsers(t *testing.T) {
+	ctx := context.Background()
+	store := memory.NewStore()
+	u := application.NewAuthUsecase(store)
+	auth := store.AuthRepository()
+	owner := newTenant(t, store, "acme", 1)[0]
+
+	if _, err := u.ResolveScope(ctx, "acme", "acme-user-0", ""); err != nil {
+		t.Fatalf("ResolveScope: %v", err)
+	}
+
+	if err := auth.SetUserSuspended(ctx, owner.UserID, true); err != nil {
+		t.Fatalf("SetUserSuspended: %v", err)
+	}
+	var denied *domain.PermissionDeniedError
+	if _, err := u.ResolveScope(ctx, "acme", "acme-user-0", ""); !errors.As(err, &denied) {
+		t.Errorf("ResolveScope(suspended user): err = %v, want PermissionDeniedError", err)
+	}
+	if err := auth.SetUserSuspended(ctx, owner.UserID, false); err != nil {
+		t.Fatalf("SetUserSuspended: %v", err)
+	}
+
+	if err := auth.SetTenantDisabled(ctx, owner.TenantID, true); err != nil {
+		t.Fatalf("SetTenantDisabled: %v", err)
+	}
+	if _, err := u.ResolveScope(ctx, "acme", "acme-user-0", ""); !errors.As(err, &denied) {
+		t.Errorf("ResolveScope(disabled tenant): err = %v, want PermissionDeniedError", err)
+	}
+	var notFound *domain.NotFoundError
+	if _, err := u.ResolveTenant(ctx, "acme.localhost"); !errors.As(err, &notFound) {
+		t.Errorf("ResolveTenant(disabled tenant): err = %v, want NotFoundError", err)
+	}
+	// Rejected sign-ins do not join the tenant.
+	if _, err := u.ResolveScope(ctx, "acme", "newcomer", ""); !errors.As(err, &denied) {
+		t.Errorf("ResolveScope(newcomer, disabled tenant): err = %v, want PermissionDeniedError", err)
+	}
+	if members, _ := auth.FindMembers(ctx, owner.TenantID); len(members) != 1 {
+		t.Errorf("members = %d, want 1", len(members))
+	}
+}
+
+func TestAuthUsecase_JoinPolicies(t *testing.T) {
+	ctx := context.Background()
+	store := memory.NewStore()
+	u := application.NewAuthUsecase(store)
+	auth := store.AuthRepository()
+	tenantID := newTenant(t, store, "acme", 1)[0].TenantID
+	if err := store.InvitationRepository().SetEmailDomains(ctx, tenantID, []string{"acme.example"}); err != nil {
+		t.Fatalf("SetEmailDomains: %v", err)
+	}
+
+	tests := []struct {
+		policy, authSub, email string
+		wantRole               string
+	}{
+		{domain.JoinPolicyInvite, "invite-user", "someone@acme.example", ""},
+		{domain.JoinPolicyDomain, "stranger", "someone@elsewhere.example", ""},
+		{domain.JoinPolicyDomain, "no-email", "", ""},
+		{domain.JoinPolicyDomain, "employee", "Someone@ACME.example", domain.RoleMember},
+		{domain.JoinPolicyOpen, "anyone", "", domain.RoleMember},
+	}
+	for _, tt := range tests {
+		if err := auth.SetTenantJoinPolicy(ctx, tenantID, tt.policy); err != nil {
+			t.Fatalf("SetTenantJoinPolicy: %v", err)
+		}
+		scope, err := u.ResolveScope(ctx, "acme", tt.authSub, tt.email)
+		if err != nil {
+			t.Fatalf("ResolveScope(%s, %q) under %s: %v", tt.authSub, tt.email, tt.policy, err)
+		}
+		if scope.TenantID != tenantID || scope.UserID == 0 || scope.Role != tt.wantRole {
+			t.Errorf("ResolveScope(%s, %q) under %s = %+v, want role %q", tt.authSub, tt.email, tt.policy, scope, tt.wantRole)
+		}
+		if role, _ := auth.FindMembershipRole(ctx, tenantID, scope.UserID); role != tt.wantRole {
+			t.Errorf("%s under %s: membership role = %q, want %q", tt.authSub, tt.policy, role, tt.wantRole)
+		}
+	}
+
+	// Members keep their role whatever the policy.
+	if err := auth.SetTenantJoinPolicy(ctx, tenantID, domain.JoinPolicyInvite); err != nil {
+		t.Fatalf("SetTenantJoinPolicy: %v", err)
+	}
+	if scope, err := u.ResolveScope(ctx, "acme", "acme-user-0", ""); err != nil || scope.Role != domain.RoleOwner {
+		t.Errorf("ResolveScope(owner) = %+v, %v; want owner", scope, err)
+	}
+}
+
+func TestAuthUsecase_RemovedMembers(t *testing.T) {
+	ctx := context.Background()
+	store := memory.NewStore()
+	u := application.NewAuthUsecase(store)
+	admin := application.NewTenantAdminUsecase(store, dns.NewFakeResolver())
+	invitations := application.NewInvitationUsecase(store)
+	scopes := newTenant(t, store, "acme", 2)
+	owner, member := scopes[0], scopes[1]
+	if err := store.AuthRepository().SetTenantJoinPolicy(ctx, owner.TenantID, domain.JoinPolicyOpen); err != nil {
+		t.Fatalf("SetTenantJoinPolicy: %v", err)
+	}
+
+	if err := admin.RemoveMember(ctx, owner, member.UserID); err != nil {
+		t.Fatalf("RemoveMember: %v", err)
+	}
+	// The open policy does not let a removed member back in.
+	scope, err := u.ResolveScope(ctx, "acme", "acme-user-1", "")
+	if err != nil || scope.UserID != member.UserID || scope.Role != "" {
+		t.Fatalf("ResolveScope(removed member) = %+v, %v; want no role", scope, err)
+	}
+	if role, _ := store.AuthRepository().FindMembershipRole(ctx, owner.TenantID, member.UserID); role != "" {
+		t.Errorf("membership role after ResolveScope = %q, want none", role)
+	}
+
+	// An invitation does.
+	_, token, err := invitations.CreateInvitation(ctx, owner, domain.RoleMember, 0)
+	if err != nil {
+		t.Fatalf("CreateInvitation: %v", err)
+	}
+	if _, err := invitations.AcceptInvitation(ctx, *scope, token); err != nil {
+		t.Fatalf("AcceptInvitation: %v", err)
+	}
+	if scope, err := u.ResolveScope(ctx, "acme", "acme-user-1", ""); err != nil || scope.Role != domain.RoleMember {
+		t.Errorf("ResolveScope(invited again) = %+v, %v; want member", scope, err)
+	}
+}
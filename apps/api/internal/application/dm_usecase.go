@@ -2,7 +2,6 @@ package application
 
 import (
 	"context"
-	"errors"
 	"strings"
 
 	"github.com/example/something-like-sns/apps/api/internal/domain"
@@ -19,12 +18,25 @@ func NewDMUsecase(store port.Store, ce port.CursorEncoder) port.DMUsecase {
 }
 
 func (u *dmUsecase) GetOrCreateDM(ctx context.Context, scope domain.Scope, otherUserID uint64) (uint64, error) {
+	ctx, span := startSpan(ctx, "DMUsecase.GetOrCreateDM", scope)
+	defer span.End()
+
 	if otherUserID == 0 || otherUserID == scope.UserID {
-		return 0, errors.New("invalid other_user_id")
+		return 0, domain.NewValidationError("other_user_id", "must be another user's ID")
+	}
+	role, err := u.store.AuthRepository().FindMembershipRole(ctx, scope.TenantID, otherUserID)
+	if err != nil {
+		return 0, err
+	}
+	if role == "" {
+		return 0, domain.NewNotFoundError("user", otherUserID)
+	}
+	if err := checkNotBlocked(ctx, u.store, scope.TenantID, scope.UserID, otherUserID); err != nil {
+		return 0, err
 	}
 
 	var convID uint64
-	err := u.store.ExecTx(ctx, func(s port.Store) error {
+	err = u.store.ExecTx(ctx, func(s port.Store) error {
 		var err error
 		convID, err = s.DMRepository().FindDMConversation(ctx, scope.TenantID, scope.UserID, otherUserID)
 		if err != nil {
@@ -41,53 +53,98 @@ func (u *dmUsecase) GetOrCreateDM(ctx context.Context, scope domain.Scope, other
 	return convID, err
 }
 
-func (u *dmUsecase) ListConversations(ctx context.Context, scope domain.Scope, token string) ([]*domain.Conversation, string, error) {
-	const limit = 20
-	cursorTime, cursorID, err := u.cursorEncoder.Decode(token)
-	if err != nil {
-		return nil, "", err
-	}
+func (u *dmUsecase) ListConversations(ctx context.Context, scope domain.Scope, page domain.PageParams) ([]*domain.Conversation, *domain.PageInfo, error) {
+	ctx, span := startSpan(ctx, "DMUsecase.ListConversations", scope)
+	defer span.End()
 
-	convos, err := u.store.DMRepository().FindConversations(ctx, scope.TenantID, scope.UserID, limit, cursorTime, cursorID)
+	limit := conversationPageLimits.size(page.Size)
+	cursor, err := decodeCursor(u.cursorEncoder, scope, cursorKindConversations, page.Token)
 	if err != nil {
-		return nil, "", err
+		return nil, nil, err
 	}
 
-	var nextToken string
-	if len(convos) == limit {
-		lastConvo := convos[len(convos)-1]
-		nextToken = u.cursorEncoder.Encode(lastConvo.CreatedAt, lastConvo.ID)
+	convos, hasMore, err := u.store.DMRepository().FindConversations(ctx, scope.TenantID, scope.UserID, limit, cursor)
+	if err != nil {
+		return nil, nil, err
 	}
 
-	return convos, nextToken, nil
+	return convos, newPageInfo(u.cursorEncoder, scope, cursorKindConversations, convos, hasMore, cursor, conversationKey), nil
 }
 
-func (u *dmUsecase) ListMessages(ctx context.Context, scope domain.Scope, conversationID uint64, token string) ([]*domain.Message, string, error) {
-	const limit = 50
-	cursorTime, cursorID, err := u.cursorEncoder.Decode(token)
+func (u *dmUsecase) ListMessages(ctx context.Context, scope domain.Scope, conversationID uint64, page domain.PageParams) ([]*domain.Message, *domain.PageInfo, error) {
+	ctx, span := startSpan(ctx, "DMUsecase.ListMessages", scope)
+	defer span.End()
+
+	limit := messagePageLimits.size(page.Size)
+	kind := cursorKindMessages(conversationID)
+	cursor, err := decodeCursor(u.cursorEncoder, scope, kind, page.Token)
 	if err != nil {
-		return nil, "", err
+		return nil, nil, err
+	}
+	if err := u.checkMember(ctx, scope, conversationID); err != nil {
+		return nil, nil, err
 	}
 
-	messages, err := u.store.DMRepository().FindMessages(ctx, scope.TenantID, conversationID, limit, cursorTime, cursorID)
+	messages, hasMore, err := u.store.DMRepository().FindMessages(ctx, scope.TenantID, conversationID, limit, cursor)
 	if err != nil {
-		return nil, "", err
+		return nil, nil, err
 	}
-
-	var nextToken string
-	if len(messages) == limit {
-		lastMessage := messages[len(messages)-1]
-		nextToken = u.cursorEncoder.Encode(lastMessage.CreatedAt, lastMessage.ID)
+	if err := attachMessageSenders(ctx, u.store, scope.TenantID, messages...); err != nil {
+		return nil, nil, err
 	}
 
-	return messages, nextToken, nil
+	info := newPageInfo(u.cursorEncoder, scope, kind, messages, hasMore, cursor, messageKey)
+	if page.IncludeTotal {
+		if info.Total, err = u.store.DMRepository().CountMessages(ctx, scope.TenantID, conversationID); err != nil {
+			return nil, nil, err
+		}
+	}
+	return messages, info, nil
 }
 
 func (u *dmUsecase) SendMessage(ctx context.Context, scope domain.Scope, conversationID uint64, body string) (*domain.Message, error) {
+	ctx, span := startSpan(ctx, "DMUsecase.SendMessage", scope)
+	defer span.End()
+
 	body = strings.TrimSpace(body)
-	if body == "" || len(body) > 2000 {
-		return nil, errors.New("invalid body")
+	if body == "" || len(body) > maxBodyLength {
+		return nil, errInvalidBody
+	}
+	if err := u.checkMember(ctx, scope, conversationID); err != nil {
+		return nil, err
+	}
+	blocked, err := u.store.RelationRepository().IsBlockedInConversation(ctx, scope.TenantID, conversationID, scope.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if blocked {
+		return nil, errBlocked
+	}
+	var msg *domain.Message
+	err = u.store.ExecTx(ctx, func(s port.Store) error {
+		var err error
+		if msg, err = s.DMRepository().CreateMessage(ctx, scope.TenantID, conversationID, scope.UserID, body); err != nil {
+			return err
+		}
+		return checkQuota(ctx, s, scope.TenantID, domain.QuotaActionMessage)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := attachMessageSenders(ctx, u.store, scope.TenantID, msg); err != nil {
+		return nil, err
+	}
+	return msg, nil
+}
+
+// checkMember hides conversations the caller is not part of behind a NotFound error.
+func (u *dmUsecase) checkMember(ctx context.Context, scope domain.Scope, conversationID uint64) error {
+	ok, err := u.store.DMRepository().IsMember(ctx, scope.TenantID, conversationID, scope.UserID)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return domain.NewNotFoundError("conversation", conversationID)
 	}
-	// TODO: Check if user is a member of the conversation
-	return u.store.DMRepository().CreateMessage(ctx, scope.TenantID, conversationID, scope.UserID, body)
+	return nil
 }
@@ -0,0 +1,12 @@
+package application
+
+import "github.com/example/something-like-sns/apps/api/internal/domain"
+
+// maxBodyLength is the maximum length in bytes of a post, comment or message body.
+const maxBodyLength = 2000
+
+var (
+	errInvalidBody   = domain.NewValidationError("body", "must not be empty or longer than 2000 bytes")
+	errInvalidCursor = domain.NewValidationError("cursor", "is malformed")
+	errBlocked       = domain.NewPermissionDeniedError("one of the users blocks the other")
+)
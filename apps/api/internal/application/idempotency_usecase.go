@@ -0,0 +1,97 @@
+package application
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"time"
+
+	"github.com/example/something-like-sns/apps/api/internal/domain"
+	"github.com/example/something-like-sns/apps/api/internal/port"
+)
+
+const (
+	// idempotencyTTL is how long a completed response is kept for replay.
+	idempotencyTTL = 24 * time.Hour
+	// maxIdempotencyKeyLength is the maximum length in bytes of an idempotency key.
+	maxIdempotencyKeyLength = 255
+)
+
+var errInvalidIdempotencyKey = domain.NewValidationError("Idempotency-Key", "must not be longer than 255 bytes")
+
+type idempotencyUsecase struct {
+	store port.Store
+	now   func() time.Time
+}
+
+func NewIdempotencyUsecase(store port.Store) port.IdempotencyUsecase {
+	return &idempotencyUsecase{store: store, now: time.Now}
+}
+
+func (u *idempotencyUsecase) Begin(ctx context.Context, scope domain.Scope, procedure, key string, requestHash []byte) ([]byte, error) {
+	ctx, span := startSpan(ctx, "IdempotencyUsecase.Begin", scope)
+	defer span.End()
+
+	if len(key) > maxIdempotencyKeyLength {
+		return nil, errInvalidIdempotencyKey
+	}
+	repo := u.store.IdempotencyRepository()
+	// A concurrent request with the same key may reserve it between Find and Reserve;
+	// the second attempt then sees its record.
+	for attempt := 0; ; attempt++ {
+		rec, err := repo.Find(ctx, scope.TenantID, scope.UserID, procedure, key)
+		var notFound *domain.NotFoundError
+		switch {
+		case errors.As(err, &notFound):
+		case err != nil:
+			return nil, err
+		case !rec.ExpiresAt.After(u.now()):
+			if err := repo.Delete(ctx, scope.TenantID, scope.UserID, procedure, key); err != nil {
+				return nil, err
+			}
+		case !bytes.Equal(rec.RequestHash, requestHash):
+			return nil, domain.NewConflictError("idempotency key", "was used with a different request")
+		case rec.Response == nil:
+			return nil, domain.NewConflictError("idempotency key", "request is still in progress")
+		default:
+			return rec.Response, nil
+		}
+
+		err = repo.Reserve(ctx, &domain.IdempotencyRecord{
+			TenantID:    scope.TenantID,
+			UserID:      scope.UserID,
+			Procedure:   procedure,
+			Key:         key,
+			RequestHash: requestHash,
+			ExpiresAt:   u.now().Add(idempotencyTTL),
+		})
+		var conflict *domain.ConflictError
+		if errors.As(err, &conflict) && attempt == 0 {
+			continue
+		}
+		return nil, err
+	}
+}
+
+func (u *idempotencyUsecase) Complete(ctx context.Context, scope domain.Scope, procedure, key string, response []byte) error {
+	ctx, span := startSpan(ctx, "IdempotencyUsecase.Complete", scope)
+	defer span.End()
+
+	return u.store.IdempotencyRepository().Complete(ctx, scope.TenantID, scope.UserID, procedure, key, response)
+}
+
+// Abort releases a key whose request failed, so that the client can retry it.
+func (u *idempotencyUsecase) Abort(ctx context.Context, scope domain.Scope, procedure, key string) error {
+	ctx, span := startSpan(ctx, "IdempotencyUsecase.Abort", scope)
+	defer span.End()
+
+	return u.store.IdempotencyRepository().Delete(ctx, scope.TenantID, scope.UserID, procedure, key)
+}
+
+// PurgeExpired deletes expired keys across all tenants and returns how many were removed.
+func (u *idempotencyUsecase) PurgeExpired(ctx context.Context) (int64, error) {
+	ctx, span := startSpan(ctx, "IdempotencyUsecase.PurgeExpired", domain.Scope{})
+	defer span.End()
+
+	return u.store.IdempotencyRepository().DeleteExpired(ctx, u.now())
+}
@@ -0,0 +1,49 @@
+package application_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/example/something-like-sns/apps/api/internal/adapter/repository/memory"
+	"github.com/example/something-like-sns/apps/api/internal/application"
+	"github.com/example/something-like-sns/apps/api/internal/domain"
+)
+
+func TestIdempotencyUsecase(t *testing.T) {
+	ctx := context.Background()
+	store := memory.NewStore()
+	u := application.NewIdempotencyUsecase(store)
+	scopes := newTenant(t, store, "acme", 2)
+	const procedure = "/sns.v1.TimelineService/CreatePost"
+	hash, otherHash := []byte("hash-1"), []byte("hash-2")
+
+	if stored, err := u.Begin(ctx, scopes[0], procedure, "key", hash); err != nil || stored != nil {
+		t.Fatalf("Begin = %q, %v; want a fresh reservation", stored, err)
+	}
+	var conflict *domain.ConflictError
+	if _, err := u.Begin(ctx, scopes[0], procedure, "key", hash); !errors.As(err, &conflict) {
+		t.Errorf("Begin while in progress: err = %v, want ConflictError", err)
+	}
+	if err := u.Complete(ctx, scopes[0], procedure, "key", []byte("response")); err != nil {
+		t.Fatalf("Complete: %v", err)
+	}
+	if stored, err := u.Begin(ctx, scopes[0], procedure, "key", hash); err != nil || string(stored) != "response" {
+		t.Errorf("Begin on retry = %q, %v; want the stored response", stored, err)
+	}
+	if _, err := u.Begin(ctx, scopes[0], procedure, "key", otherHash); !errors.As(err, &conflict) {
+		t.Errorf("Begin with a different payload: err = %v, want ConflictError", err)
+	}
+	// Keys are per user.
+	if stored, err := u.Begin(ctx, scopes[1], procedure, "key", otherHash); err != nil || stored != nil {
+		t.Errorf("Begin by another user = %q, %v; want a fresh reservation", stored, err)
+	}
+
+	// An aborted request can be retried.
+	if err := u.Abort(ctx, scopes[1], procedure, "key"); err != nil {
+		t.Fatalf("Abort: %v", err)
+	}
+	if stored, err := u.Begin(ctx, scopes[1], procedure, "key", hash); err != nil || stored != nil {
+		t.Errorf("Begin after Abort = %q, %v; want a fresh reservation", stored, err)
+	}
+}
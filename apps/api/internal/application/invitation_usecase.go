@@ -0,0 +1,290 @@
+package application
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/example/something-like-sns/apps/api/internal/domain"
+	"github.com/example/something-like-sns/apps/api/internal/port"
+)
+
+const (
+	// defaultInvitationTTL is how long an invitation is valid if its creator does not say.
+	defaultInvitationTTL = 7 * 24 * time.Hour
+	maxInvitationTTL     = 30 * 24 * time.Hour
+	// maxJoinMessageLength is the maximum length in bytes of a join request message.
+	maxJoinMessageLength = 500
+	maxEmailDomains      = 50
+)
+
+type invitationUsecase struct {
+	store port.Store
+	now   func() time.Time
+}
+
+func NewInvitationUsecase(store port.Store) port.InvitationUsecase {
+	return &invitationUsecase{store: store, now: time.Now}
+}
+
+func (u *invitationUsecase) CreateInvitation(ctx context.Context, scope domain.Scope, role string, ttl time.Duration) (*domain.Invitation, string, error) {
+	ctx, span := startSpan(ctx, "InvitationUsecase.CreateInvitation", scope)
+	defer span.End()
+
+	if !scope.IsAdmin() {
+		return nil, "", domain.ErrPermissionDenied
+	}
+	if role == "" {
+		role = domain.RoleMember
+	}
+	if !domain.IsValidRole(role) {
+		return nil, "", domain.NewValidationError("role", "must be owner, admin or member")
+	}
+	if role == domain.RoleOwner && scope.Role != domain.RoleOwner {
+		return nil, "", domain.NewPermissionDeniedError("only owners can invite owners")
+	}
+	if ttl == 0 {
+		ttl = defaultInvitationTTL
+	}
+	if ttl < 0 || ttl > maxInvitationTTL {
+		return nil, "", domain.NewValidationError("ttl", "must be at most 30 days")
+	}
+
+	token, err := newRandomToken()
+	if err != nil {
+		return nil, "", err
+	}
+	inv, err := u.store.InvitationRepository().CreateInvitation(ctx, scope.TenantID, &domain.Invitation{
+		Role:            role,
+		CreatedByUserID: scope.UserID,
+		ExpiresAt:       u.now().Add(ttl),
+	}, hashInvitationToken(token))
+	if err != nil {
+		return nil, "", err
+	}
+	return inv, token, nil
+}
+
+func (u *invitationUsecase) ListInvitations(ctx context.Context, scope domain.Scope) ([]*domain.Invitation, error) {
+	ctx, span := startSpan(ctx, "InvitationUsecase.ListInvitations", scope)
+	defer span.End()
+
+	if !scope.IsAdmin() {
+		return nil, domain.ErrPermissionDenied
+	}
+	return u.store.InvitationRepository().FindPendingInvitations(ctx, scope.TenantID, u.now())
+}
+
+func (u *invitationUsecase) RevokeInvitation(ctx context.Context, scope domain.Scope, invitationID uint64) error {
+	ctx, span := startSpan(ctx, "InvitationUsecase.RevokeInvitation", scope)
+	defer span.End()
+
+	if !scope.IsAdmin() {
+		return domain.ErrPermissionDenied
+	}
+	return u.store.InvitationRepository().DeleteInvitation(ctx, scope.TenantID, invitationID)
+}
+
+func (u *invitationUsecase) AcceptInvitation(ctx context.Context, scope domain.Scope, token string) (string, error) {
+	ctx, span := startSpan(ctx, "InvitationUsecase.AcceptInvitation", scope)
+	defer span.End()
+
+	if token == "" {
+		return "", domain.NewValidationError("token", "is required")
+	}
+	// Used and expired invitations are reported like unknown ones, so a token reveals nothing
+	// once it is spent.
+	inv, err := u.store.InvitationRepository().FindInvitationByTokenHash(ctx, scope.TenantID, hashInvitationToken(token))
+	if err != nil {
+		return "", err
+	}
+	now := u.now()
+	if inv.AcceptedByUserID != 0 || !inv.ExpiresAt.After(now) {
+		return "", domain.NewNotFoundError("invitation", nil)
+	}
+	if scope.IsMember() {
+		return "", domain.NewConflictError("membership", "already a member")
+	}
+
+	err = u.store.ExecTx(ctx, func(s port.Store) error {
+		if err := s.InvitationRepository().ClaimInvitation(ctx, scope.TenantID, inv.ID, scope.UserID, now); err != nil {
+			return err
+		}
+		if err := s.AuthRepository().EnsureMembership(ctx, scope.TenantID, scope.UserID, inv.Role); err != nil {
+			return err
+		}
+		return checkMemberQuota(ctx, s, scope.TenantID)
+	})
+	if err != nil {
+		return "", err
+	}
+	return inv.Role, nil
+}
+
+func (u *invitationUsecase) RequestToJoin(ctx context.Context, scope domain.Scope, message string) (*domain.JoinRequest, error) {
+	ctx, span := startSpan(ctx, "InvitationUsecase.RequestToJoin", scope)
+	defer span.End()
+
+	if scope.IsMember() {
+		return nil, domain.NewConflictError("membership", "already a member")
+	}
+	message = strings.TrimSpace(message)
+	if len(message) > maxJoinMessageLength {
+		return nil, domain.NewValidationError("message", "must not be longer than 500 bytes")
+	}
+	return u.store.InvitationRepository().CreateJoinRequest(ctx, scope.TenantID, scope.UserID, message)
+}
+
+func (u *invitationUsecase) ListJoinRequests(ctx context.Context, scope domain.Scope) ([]*domain.JoinRequest, error) {
+	ctx, span := startSpan(ctx, "InvitationUsecase.ListJoinRequests", scope)
+	defer span.End()
+
+	if !scope.IsAdmin() {
+		return nil, domain.ErrPermissionDenied
+	}
+	return u.store.InvitationRepository().FindJoinRequests(ctx, scope.TenantID)
+}
+
+func (u *invitationUsecase) ApproveJoinRequest(ctx context.Context, scope domain.Scope, requestID uint64, role string) error {
+	ctx, span := startSpan(ctx, "InvitationUsecase.ApproveJoinRequest", scope)
+	defer span.End()
+
+	if !scope.IsAdmin() {
+		return domain.ErrPermissionDenied
+	}
+	if role == "" {
+		role = domain.RoleMember
+	}
+	if !domain.IsValidRole(role) {
+		return domain.NewValidationError("role", "must be owner, admin or member")
+	}
+	if role == domain.RoleOwner && scope.Role != domain.RoleOwner {
+		return domain.NewPermissionDeniedError("only owners can make owners")
+	}
+
+	return u.store.ExecTx(ctx, func(s port.Store) error {
+		req, err := s.InvitationRepository().FindJoinRequest(ctx, scope.TenantID, requestID)
+		if err != nil {
+			return err
+		}
+		// Deleting first makes a concurrent approval of the same request fail instead of
+		// adding the member twice.
+		if err := s.InvitationRepository().DeleteJoinRequest(ctx, scope.TenantID, requestID); err != nil {
+			return err
+		}
+		if err := s.AuthRepository().EnsureMembership(ctx, scope.TenantID, req.UserID, role); err != nil {
+			return err
+		}
+		return checkMemberQuota(ctx, s, scope.TenantID)
+	})
+}
+
+func (u *invitationUsecase) RejectJoinRequest(ctx context.Context, scope domain.Scope, requestID uint64) error {
+	ctx, span := startSpan(ctx, "InvitationUsecase.RejectJoinRequest", scope)
+	defer span.End()
+
+	if !scope.IsAdmin() {
+		return domain.ErrPermissionDenied
+	}
+	return u.store.InvitationRepository().DeleteJoinRequest(ctx, scope.TenantID, requestID)
+}
+
+func (u *invitationUsecase) GetJoinPolicy(ctx context.Context, scope domain.Scope) (string, []string, error) {
+	ctx, span := startSpan(ctx, "InvitationUsecase.GetJoinPolicy", scope)
+	defer span.End()
+
+	if !scope.IsAdmin() {
+		return "", nil, domain.ErrPermissionDenied
+	}
+	tenant, err := u.store.AuthRepository().FindTenantByID(ctx, scope.TenantID)
+	if err != nil {
+		return "", nil, err
+	}
+	domains, err := u.store.InvitationRepository().FindEmailDomains(ctx, scope.TenantID)
+	if err != nil {
+		return "", nil, err
+	}
+	return tenant.JoinPolicy, domains, nil
+}
+
+func (u *invitationUsecase) UpdateJoinPolicy(ctx context.Context, scope domain.Scope, policy string, emailDomains []string) error {
+	ctx, span := startSpan(ctx, "InvitationUsecase.UpdateJoinPolicy", scope)
+	defer span.End()
+
+	if !scope.IsAdmin() {
+		return domain.ErrPermissionDenied
+	}
+	if !domain.IsValidJoinPolicy(policy) {
+		return domain.NewValidationError("policy", "must be open, invite or domain")
+	}
+	domains, err := normalizeEmailDomains(emailDomains)
+	if err != nil {
+		return err
+	}
+	if policy == domain.JoinPolicyDomain && len(domains) == 0 {
+		return domain.NewValidationError("allowed_email_domains", "must not be empty for the domain policy")
+	}
+
+	return u.store.ExecTx(ctx, func(s port.Store) error {
+		if err := s.AuthRepository().SetTenantJoinPolicy(ctx, scope.TenantID, policy); err != nil {
+			return err
+		}
+		return s.InvitationRepository().SetEmailDomains(ctx, scope.TenantID, domains)
+	})
+}
+
+// normalizeEmailDomains lowercases, deduplicates and sorts email domains, rejecting anything
+// that is not a plain domain name.
+func normalizeEmailDomains(domains []string) ([]string, error) {
+	if len(domains) > maxEmailDomains {
+		return nil, domain.NewValidationError("allowed_email_domains", "must not have more than 50 domains")
+	}
+	out := make([]string, 0, len(domains))
+	for _, d := range domains {
+		d = strings.ToLower(strings.TrimSpace(d))
+		if !isDomainName(d) {
+			return nil, domain.NewValidationError("allowed_email_domains", "must be domain names such as example.com")
+		}
+		out = append(out, d)
+	}
+	slices.Sort(out)
+	return slices.Compact(out), nil
+}
+
+// isDomainName reports whether d looks like a lowercase domain name with at least two labels.
+func isDomainName(d string) bool {
+	if len(d) > 253 || !strings.Contains(d, ".") {
+		return false
+	}
+	for _, label := range strings.Split(d, ".") {
+		if label == "" || len(label) > 63 || label[0] == '-' || label[len(label)-1] == '-' {
+			return false
+		}
+		for _, c := range label {
+			if !(c >= 'a' && c <= 'z' || c >= '0' && c <= '9' || c == '-') {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// newRandomToken returns a random URL-safe token.
+func newRandomToken() (string, error) {
+	b := make([]byte, 24)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// hashInvitationToken returns the hash invitations are stored under, so a leaked database does
+// not leak usable tokens.
+func hashInvitationToken(token string) []byte {
+	sum := sha256.Sum256([]byte(token))
+	return sum[:]
+}
@@ -0,0 +1,200 @@
+package application_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/example/something-like-sns/apps/api/internal/adapter/repository/memory"
+	"github.com/example/something-like-sns/apps/api/internal/application"
+	"github.com/example/something-like-sns/apps/api/internal/domain"
+	"github.com/example/something-like-sns/apps/api/internal/port"
+)
+
+// newcomer signs a new user in to the tenant without joining it and returns their scope.
+func newcomer(t *testing.T, store port.Store, tenantID uint64, authSub string) domain.Scope {
+	t.Helper()
+	userID, err := store.AuthRepository().FindOrCreateUser(context.Background(), authSub, authSub)
+	if err != nil {
+		t.Fatalf("FindOrCreateUser: %v", err)
+	}
+	return domain.Scope{TenantID: tenantID, UserID: userID}
+}
+
+func TestInvitationUsecase_Invitations(t *testing.T) {
+	ctx := context.Background()
+	store := memory.NewStore()
+	u := application.NewInvitationUsecase(store)
+	scopes := newTenant(t, store, "acme", 2)
+	owner, member := scopes[0], scopes[1]
+	admin := owner
+	admin.Role = domain.RoleAdmin
+
+	var denied *domain.PermissionDeniedError
+	if _, _, err := u.CreateInvitation(ctx, member, domain.RoleMember, 0); !errors.As(err, &denied) {
+		t.Errorf("CreateInvitation(member): err = %v, want PermissionDeniedError", err)
+	}
+	if _, _, err := u.CreateInvitation(ctx, admin, domain.RoleOwner, 0); !errors.As(err, &denied) {
+		t.Errorf("CreateInvitation(owner role by admin): err = %v, want PermissionDeniedError", err)
+	}
+	var invalid *domain.ValidationError
+	if _, _, err := u.CreateInvitation(ctx, owner, "guest", 0); !errors.As(err, &invalid) {
+		t.Errorf("CreateInvitation(role guest): err = %v, want ValidationError", err)
+	}
+	if _, _, err := u.CreateInvitation(ctx, owner, domain.RoleMember, 31*24*time.Hour); !errors.As(err, &invalid) {
+		t.Errorf("CreateInvitation(31 days): err = %v, want ValidationError", err)
+	}
+
+	inv, token, err := u.CreateInvitation(ctx, admin, domain.RoleAdmin, 0)
+	if err != nil || token == "" || inv.Role != domain.RoleAdmin {
+		t.Fatalf("CreateInvitation = %+v, %q, %v", inv, token, err)
+	}
+	if d := time.Until(inv.ExpiresAt); d < 6*24*time.Hour || d > 7*24*time.Hour {
+		t.Errorf("invitation expires in %s, want 7 days", d)
+	}
+	if invs, err := u.ListInvitations(ctx, owner); err != nil || len(invs) != 1 || invs[0].ID != inv.ID {
+		t.Errorf("ListInvitations = %+v, %v; want invitation %d", invs, err, inv.ID)
+	}
+
+	var conflict *domain.ConflictError
+	if _, err := u.AcceptInvitation(ctx, member, token); !errors.As(err, &conflict) {
+		t.Errorf("AcceptInvitation(member): err = %v, want ConflictError", err)
+	}
+	dave := newcomer(t, store, owner.TenantID, "dave")
+	if role, err := u.AcceptInvitation(ctx, dave, token); err != nil || role != domain.RoleAdmin {
+		t.Fatalf("AcceptInvitation = %q, %v; want admin", role, err)
+	}
+	if role, _ := store.AuthRepository().FindMembershipRole(ctx, owner.TenantID, dave.UserID); role != domain.RoleAdmin {
+		t.Errorf("dave's role = %q, want admin", role)
+	}
+	var notFound *domain.NotFoundError
+	erin := newcomer(t, store, owner.TenantID, "erin")
+	if _, err := u.AcceptInvitation(ctx, erin, token); !errors.As(err, &notFound) {
+		t.Errorf("AcceptInvitation(used token): err = %v, want NotFoundError", err)
+	}
+	if _, err := u.AcceptInvitation(ctx, erin, "not-a-token"); !errors.As(err, &notFound) {
+		t.Errorf("AcceptInvitation(unknown token): err = %v, want NotFoundError", err)
+	}
+	if invs, _ := u.ListInvitations(ctx, owner); len(invs) != 0 {
+		t.Errorf("ListInvitations after accept = %+v, want none", invs)
+	}
+
+	// A token only works in the tenant it was issued for.
+	other := newTenant(t, store, "other", 1)[0]
+	_, token, err = u.CreateInvitation(ctx, other, domain.RoleMember, time.Hour)
+	if err != nil {
+		t.Fatalf("CreateInvitation: %v", err)
+	}
+	if _, err := u.AcceptInvitation(ctx, erin, token); !errors.As(err, &notFound) {
+		t.Errorf("AcceptInvitation(other tenant's token): err = %v, want NotFoundError", err)
+	}
+
+	revoked, token, err := u.CreateInvitation(ctx, owner, domain.RoleMember, time.Hour)
+	if err != nil {
+		t.Fatalf("CreateInvitation: %v", err)
+	}
+	if err := u.RevokeInvitation(ctx, member, revoked.ID); !errors.As(err, &denied) {
+		t.Errorf("RevokeInvitation(member): err = %v, want PermissionDeniedError", err)
+	}
+	if err := u.RevokeInvitation(ctx, owner, revoked.ID); err != nil {
+		t.Fatalf("RevokeInvitation: %v", err)
+	}
+	if _, err := u.AcceptInvitation(ctx, erin, token); !errors.As(err, &notFound) {
+		t.Errorf("AcceptInvitation(revoked): err = %v, want NotFoundError", err)
+	}
+}
+
+func TestInvitationUsecase_JoinRequests(t *testing.T) {
+	ctx := context.Background()
+	store := memory.NewStore()
+	u := application.NewInvitationUsecase(store)
+	scopes := newTenant(t, store, "acme", 2)
+	owner, member := scopes[0], scopes[1]
+
+	var conflict *domain.ConflictError
+	if _, err := u.RequestToJoin(ctx, member, "hi"); !errors.As(err, &conflict) {
+		t.Errorf("RequestToJoin(member): err = %v, want ConflictError", err)
+	}
+	dave, erin := newcomer(t, store, owner.TenantID, "dave"), newcomer(t, store, owner.TenantID, "erin")
+	daveReq, err := u.RequestToJoin(ctx, dave, "  I work here  ")
+	if err != nil || daveReq.Message != "I work here" {
+		t.Fatalf("RequestToJoin = %+v, %v", daveReq, err)
+	}
+	if _, err := u.RequestToJoin(ctx, dave, "again"); !errors.As(err, &conflict) {
+		t.Errorf("RequestToJoin twice: err = %v, want ConflictError", err)
+	}
+	erinReq, err := u.RequestToJoin(ctx, erin, "")
+	if err != nil {
+		t.Fatalf("RequestToJoin: %v", err)
+	}
+
+	var denied *domain.PermissionDeniedError
+	if _, err := u.ListJoinRequests(ctx, member); !errors.As(err, &denied) {
+		t.Errorf("ListJoinRequests(member): err = %v, want PermissionDeniedError", err)
+	}
+	if reqs, err := u.ListJoinRequests(ctx, owner); err != nil || len(reqs) != 2 || reqs[0].UserID != dave.UserID {
+		t.Errorf("ListJoinRequests = %+v, %v; want dave's and erin's", reqs, err)
+	}
+
+	if err := u.ApproveJoinRequest(ctx, member, daveReq.ID, ""); !errors.As(err, &denied) {
+		t.Errorf("ApproveJoinRequest(member): err = %v, want PermissionDeniedError", err)
+	}
+	if err := u.ApproveJoinRequest(ctx, owner, daveReq.ID, ""); err != nil {
+		t.Fatalf("ApproveJoinRequest: %v", err)
+	}
+	if role, _ := store.AuthRepository().FindMembershipRole(ctx, owner.TenantID, dave.UserID); role != domain.RoleMember {
+		t.Errorf("dave's role = %q, want member", role)
+	}
+	var notFound *domain.NotFoundError
+	if err := u.ApproveJoinRequest(ctx, owner, daveReq.ID, ""); !errors.As(err, &notFound) {
+		t.Errorf("ApproveJoinRequest twice: err = %v, want NotFoundError", err)
+	}
+	if err := u.RejectJoinRequest(ctx, owner, erinReq.ID); err != nil {
+		t.Fatalf("RejectJoinRequest: %v", err)
+	}
+	if role, _ := store.AuthRepository().FindMembershipRole(ctx, owner.TenantID, erin.UserID); role != "" {
+		t.Errorf("erin's role after rejection = %q, want none", role)
+	}
+	if reqs, _ := u.ListJoinRequests(ctx, owner); len(reqs) != 0 {
+		t.Errorf("ListJoinRequests after approval and rejection = %+v, want none", reqs)
+	}
+}
+
+func TestInvitationUsecase_JoinPolicy(t *testing.T) {
+	ctx := context.Background()
+	store := memory.NewStore()
+	u := application.NewInvitationUsecase(store)
+	scopes := newTenant(t, store, "acme", 2)
+	owner, member := scopes[0], scopes[1]
+
+	if policy, domains, err := u.GetJoinPolicy(ctx, owner); err != nil || policy != domain.JoinPolicyInvite || len(domains) != 0 {
+		t.Errorf("GetJoinPolicy = %q, %v, %v; want invite without domains", policy, domains, err)
+	}
+	var denied *domain.PermissionDeniedError
+	if err := u.UpdateJoinPolicy(ctx, member, domain.JoinPolicyOpen, nil); !errors.As(err, &denied) {
+		t.Errorf("UpdateJoinPolicy(member): err = %v, want PermissionDeniedError", err)
+	}
+	var invalid *domain.ValidationError
+	for _, tt := range []struct {
+		policy  string
+		domains []string
+	}{
+		{"closed", nil},
+		{domain.JoinPolicyDomain, nil},
+		{domain.JoinPolicyDomain, []string{"user@acme.example"}},
+		{domain.JoinPolicyDomain, []string{"localhost"}},
+	} {
+		if err := u.UpdateJoinPolicy(ctx, owner, tt.policy, tt.domains); !errors.As(err, &invalid) {
+			t.Errorf("UpdateJoinPolicy(%q, %v): err = %v, want ValidationError", tt.policy, tt.domains, err)
+		}
+	}
+
+	if err := u.UpdateJoinPolicy(ctx, owner, domain.JoinPolicyDomain, []string{" ACME.example", "sub.acme.example", "acme.example"}); err != nil {
+		t.Fatalf("UpdateJoinPolicy: %v", err)
+	}
+	policy, domains, err := u.GetJoinPolicy(ctx, owner)
+	if err != nil || policy != domain.JoinPolicyDomain || len(domains) != 2 || domains[0] != "acme.example" || domains[1] != "sub.acme.example" {
+		t.Errorf("GetJoinPolicy = %q, %v, %v; want domain with acme.example and sub.acme.example", policy, domains, err)
+	}
+}
@@ -0,0 +1,214 @@
+package application
+
+import (
+	"context"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/example/something-like-sns/apps/api/internal/domain"
+	"github.com/example/something-like-sns/apps/api/internal/port"
+)
+
+const (
+	maxReportReasonLength   = 500
+	maxModerationNoteLength = 500
+)
+
+type moderationUsecase struct {
+	store         port.Store
+	cursorEncoder port.CursorEncoder
+	now           func() time.Time
+}
+
+func NewModerationUsecase(store port.Store, ce port.CursorEncoder) port.ModerationUsecase {
+	return &moderationUsecase{store: store, cursorEncoder: ce, now: time.Now}
+}
+
+func (u *moderationUsecase) ReportContent(ctx context.Context, scope domain.Scope, targetType domain.ReportTargetType, targetID uint64, reason string) (*domain.Report, error) {
+	ctx, span := startSpan(ctx, "ModerationUsecase.ReportContent", scope)
+	defer span.End()
+
+	reason = strings.TrimSpace(reason)
+	if utf8.RuneCountInString(reason) > maxReportReasonLength {
+		return nil, domain.NewValidationError("reason", "must be at most 500 characters")
+	}
+	report := &domain.Report{TargetType: targetType, TargetID: targetID, ReporterUserID: scope.UserID, Reason: reason}
+	switch targetType {
+	case domain.ReportTargetPost:
+		p, err := u.store.TimelineRepository().FindPostByID(ctx, scope.TenantID, targetID)
+		if err != nil {
+			return nil, err
+		}
+		report.AuthorUserID, report.Content = p.AuthorUserID, p.Body
+	case domain.ReportTargetComment:
+		c, err := u.store.TimelineRepository().FindCommentByID(ctx, scope.TenantID, targetID)
+		if err != nil {
+			return nil, err
+		}
+		report.AuthorUserID, report.Content = c.AuthorUserID, c.Body
+	case domain.ReportTargetMessage:
+		m, err := u.store.DMRepository().FindMessageByID(ctx, scope.TenantID, targetID)
+		if err != nil {
+			return nil, err
+		}
+		// Messages are only visible to the conversation's members.
+		ok, err := u.store.DMRepository().IsMember(ctx, scope.TenantID, m.ConversationID, scope.UserID)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			return nil, domain.NewNotFoundError("message", targetID)
+		}
+		report.AuthorUserID, report.Content = m.SenderUserID, m.Body
+	default:
+		return nil, domain.NewValidationError("target_type", "must be post, comment or message")
+	}
+	if report.AuthorUserID == scope.UserID {
+		return nil, domain.NewValidationError("target_id", "cannot report your own content")
+	}
+	return u.store.ModerationRepository().CreateReport(ctx, scope.TenantID, report)
+}
+
+func (u *moderationUsecase) ListReports(ctx context.Context, scope domain.Scope, status string, page domain.PageParams) ([]*domain.Report, *domain.PageInfo, error) {
+	ctx, span := startSpan(ctx, "ModerationUsecase.ListReports", scope)
+	defer span.End()
+
+	if !scope.IsAdmin() {
+		return nil, nil, domain.ErrPermissionDenied
+	}
+	if status == "" {
+		status = domain.ReportOpen
+	}
+	if !domain.IsValidReportStatus(status) {
+		return nil, nil, domain.NewValidationError("status", "must be open or resolved")
+	}
+	limit := reportPageLimits.size(page.Size)
+	kind := cursorKindReports(status)
+	cursor, err := decodeCursor(u.cursorEncoder, scope, kind, page.Token)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	reports, hasMore, err := u.store.ModerationRepository().FindReports(ctx, scope.TenantID, status, limit, cursor)
+	if err != nil {
+		return nil, nil, err
+	}
+	return reports, newPageInfo(u.cursorEncoder, scope, kind, reports, hasMore, cursor, reportKey), nil
+}
+
+func (u *moderationUsecase) ResolveReport(ctx context.Context, scope domain.Scope, reportID uint64, action domain.ModerationAction, note string) (*domain.Report, error) {
+	ctx, span := startSpan(ctx, "ModerationUsecase.ResolveReport", scope)
+	defer span.End()
+
+	if !scope.IsAdmin() {
+		return nil, domain.ErrPermissionDenied
+	}
+	if !domain.IsValidModerationAction(action) {
+		return nil, domain.NewValidationError("action", "must be dismiss, hide, delete, warn or suspend_author")
+	}
+	note = strings.TrimSpace(note)
+	if utf8.RuneCountInString(note) > maxModerationNoteLength {
+		return nil, domain.NewValidationError("note", "must be at most 500 characters")
+	}
+
+	var resolved *domain.Report
+	err := u.store.ExecTx(ctx, func(s port.Store) error {
+		report, err := s.ModerationRepository().FindReport(ctx, scope.TenantID, reportID)
+		if err != nil {
+			return err
+		}
+		if report.Status != domain.ReportOpen {
+			return domain.NewFailedPreconditionError("report is already resolved")
+		}
+		event := &domain.AuditEvent{
+			ActorUserID: scope.UserID,
+			Action:      string(action),
+			TargetType:  string(report.TargetType),
+			TargetID:    report.TargetID,
+			ReportID:    report.ID,
+			Note:        note,
+		}
+		switch action {
+		case domain.ModerationHide, domain.ModerationDelete:
+			if err := s.ModerationRepository().RemoveContent(ctx, scope.TenantID, report.TargetType, report.TargetID, action == domain.ModerationDelete); err != nil {
+				return err
+			}
+		case domain.ModerationWarn:
+			event.TargetType, event.TargetID = domain.AuditTargetUser, report.AuthorUserID
+		case domain.ModerationSuspendAuthor:
+			if err := suspendMember(ctx, s, scope, report.AuthorUserID); err != nil {
+				return err
+			}
+			event.TargetType, event.TargetID = domain.AuditTargetUser, report.AuthorUserID
+		}
+		if err := s.ModerationRepository().ResolveReports(ctx, scope.TenantID, report.TargetType, report.TargetID, scope.UserID, action, u.now()); err != nil {
+			return err
+		}
+		if err := s.ModerationRepository().AppendAuditEvent(ctx, scope.TenantID, event); err != nil {
+			return err
+		}
+		resolved, err = s.ModerationRepository().FindReport(ctx, scope.TenantID, reportID)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resolved, nil
+}
+
+func (u *moderationUsecase) ReinstateMember(ctx context.Context, scope domain.Scope, userID uint64) error {
+	ctx, span := startSpan(ctx, "ModerationUsecase.ReinstateMember", scope)
+	defer span.End()
+
+	if !scope.IsAdmin() {
+		return domain.ErrPermissionDenied
+	}
+	return u.store.ExecTx(ctx, func(s port.Store) error {
+		if err := s.AuthRepository().SetMembershipSuspended(ctx, scope.TenantID, userID, false); err != nil {
+			return err
+		}
+		return s.ModerationRepository().AppendAuditEvent(ctx, scope.TenantID, &domain.AuditEvent{
+			ActorUserID: scope.UserID,
+			Action:      domain.AuditReinstateMember,
+			TargetType:  domain.AuditTargetUser,
+			TargetID:    userID,
+		})
+	})
+}
+
+func (u *moderationUsecase) ListAuditEvents(ctx context.Context, scope domain.Scope, page domain.PageParams) ([]*domain.AuditEvent, *domain.PageInfo, error) {
+	ctx, span := startSpan(ctx, "ModerationUsecase.ListAuditEvents", scope)
+	defer span.End()
+
+	if !scope.IsAdmin() {
+		return nil, nil, domain.ErrPermissionDenied
+	}
+	limit := auditEventPageLimits.size(page.Size)
+	cursor, err := decodeCursor(u.cursorEncoder, scope, cursorKindAuditEvents, page.Token)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	events, hasMore, err := u.store.ModerationRepository().FindAuditEvents(ctx, scope.TenantID, limit, cursor)
+	if err != nil {
+		return nil, nil, err
+	}
+	return events, newPageInfo(u.cursorEncoder, scope, cursorKindAuditEvents, events, hasMore, cursor, auditEventKey), nil
+}
+
+// suspendMember suspends the membership of userID on behalf of scope. Like removing a member, it
+// takes an owner to suspend an owner.
+func suspendMember(ctx context.Context, s port.Store, scope domain.Scope, userID uint64) error {
+	if userID == scope.UserID {
+		return domain.NewValidationError("action", "cannot suspend yourself")
+	}
+	role, err := memberRole(ctx, s, scope.TenantID, userID)
+	if err != nil {
+		return err
+	}
+	if role == domain.RoleOwner && scope.Role != domain.RoleOwner {
+		return domain.NewPermissionDeniedError("only owners can suspend owners")
+	}
+	return s.AuthRepository().SetMembershipSuspended(ctx, scope.TenantID, userID, true)
+}
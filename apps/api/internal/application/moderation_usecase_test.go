@@ -0,0 +1,230 @@
+package application_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/example/something-like-sns/apps/api/internal/adapter/cursor"
+	"github.com/example/something-like-sns/apps/api/internal/adapter/repository/memory"
+	"github.com/example/something-like-sns/apps/api/internal/application"
+	"github.com/example/something-like-sns/apps/api/internal/domain"
+)
+
+func TestModerationUsecase_ReportAndResolve(t *testing.T) {
+	ctx := context.Background()
+	store := memory.NewStore()
+	ce := cursor.NewHMACEncoder([]byte("test"))
+	u := application.NewModerationUsecase(store, ce)
+	timeline := application.NewTimelineUsecase(store, ce)
+	dm := application.NewDMUsecase(store, ce)
+	scopes := newTenant(t, store, "acme", 3)
+	alice, bob, carol := scopes[0], scopes[1], scopes[2]
+	beta := newTenant(t, store, "beta", 1)[0]
+
+	post, err := timeline.CreatePost(ctx, bob, "spam")
+	if err != nil {
+		t.Fatalf("CreatePost: %v", err)
+	}
+	comment, err := timeline.CreateComment(ctx, bob, post.ID, "more spam")
+	if err != nil {
+		t.Fatalf("CreateComment: %v", err)
+	}
+	conv, err := dm.GetOrCreateDM(ctx, bob, alice.UserID)
+	if err != nil {
+		t.Fatalf("GetOrCreateDM: %v", err)
+	}
+	msg, err := dm.SendMessage(ctx, bob, conv, "rude")
+	if err != nil {
+		t.Fatalf("SendMessage: %v", err)
+	}
+
+	var invalid *domain.ValidationError
+	var notFound *domain.NotFoundError
+	var conflict *domain.ConflictError
+	if _, err := u.ReportContent(ctx, bob, domain.ReportTargetPost, post.ID, ""); !errors.As(err, &invalid) {
+		t.Errorf("ReportContent(own post): err = %v, want ValidationError", err)
+	}
+	if _, err := u.ReportContent(ctx, carol, "", post.ID, ""); !errors.As(err, &invalid) {
+		t.Errorf("ReportContent(no target type): err = %v, want ValidationError", err)
+	}
+	if _, err := u.ReportContent(ctx, beta, domain.ReportTargetPost, post.ID, ""); !errors.As(err, &notFound) {
+		t.Errorf("ReportContent(another tenant's post): err = %v, want NotFoundError", err)
+	}
+	// Only the conversation's members see a message.
+	if _, err := u.ReportContent(ctx, carol, domain.ReportTargetMessage, msg.ID, ""); !errors.As(err, &notFound) {
+		t.Errorf("ReportContent(message of another conversation): err = %v, want NotFoundError", err)
+	}
+
+	postReport, err := u.ReportContent(ctx, carol, domain.ReportTargetPost, post.ID, " off-topic ")
+	if err != nil {
+		t.Fatalf("ReportContent(post): %v", err)
+	}
+	if postReport.AuthorUserID != bob.UserID || postReport.Reason != "off-topic" || postReport.Content != "spam" || postReport.Status != domain.ReportOpen {
+		t.Errorf("ReportContent = %+v", postReport)
+	}
+	if _, err := u.ReportContent(ctx, carol, domain.ReportTargetPost, post.ID, ""); !errors.As(err, &conflict) {
+		t.Errorf("ReportContent twice: err = %v, want ConflictError", err)
+	}
+	if _, err := u.ReportContent(ctx, alice, domain.ReportTargetPost, post.ID, ""); err != nil {
+		t.Fatalf("ReportContent(post, alice): %v", err)
+	}
+	commentReport, err := u.ReportContent(ctx, carol, domain.ReportTargetComment, comment.ID, "")
+	if err != nil {
+		t.Fatalf("ReportContent(comment): %v", err)
+	}
+	msgReport, err := u.ReportContent(ctx, alice, domain.ReportTargetMessage, msg.ID, "")
+	if err != nil {
+		t.Fatalf("ReportContent(message): %v", err)
+	}
+
+	if _, _, err := u.ListReports(ctx, carol, "", domain.PageParams{}); !errors.Is(err, domain.ErrPermissionDenied) {
+		t.Errorf("ListReports(member): err = %v, want ErrPermissionDenied", err)
+	}
+	if _, _, err := u.ListReports(ctx, alice, "closed", domain.PageParams{}); !errors.As(err, &invalid) {
+		t.Errorf("ListReports(unknown status): err = %v, want ValidationError", err)
+	}
+	first, page, err := u.ListReports(ctx, alice, "", domain.PageParams{Size: 3})
+	if err != nil || len(first) != 3 || !page.HasMore || first[0].ID != postReport.ID {
+		t.Fatalf("ListReports = %v, %+v, %v; want 3 open reports, oldest first", first, page, err)
+	}
+	rest, _, err := u.ListReports(ctx, alice, "", domain.PageParams{Size: 3, Token: page.Next})
+	if err != nil || len(rest) != 1 || rest[0].ID != msgReport.ID {
+		t.Fatalf("ListReports next page = %v, %v", rest, err)
+	}
+	if _, _, err := u.ListReports(ctx, alice, domain.ReportResolved, domain.PageParams{Token: page.Next}); err == nil {
+		t.Error("ListReports(resolved) with a cursor of the open list: err = nil, want an error")
+	}
+
+	if _, err := u.ResolveReport(ctx, carol, postReport.ID, domain.ModerationHide, ""); !errors.Is(err, domain.ErrPermissionDenied) {
+		t.Errorf("ResolveReport(member): err = %v, want ErrPermissionDenied", err)
+	}
+	if _, err := u.ResolveReport(ctx, alice, postReport.ID, "ban", ""); !errors.As(err, &invalid) {
+		t.Errorf("ResolveReport(unknown action): err = %v, want ValidationError", err)
+	}
+	if _, err := u.ResolveReport(ctx, beta, postReport.ID, domain.ModerationHide, ""); !errors.As(err, &notFound) {
+		t.Errorf("ResolveReport(another tenant's report): err = %v, want NotFoundError", err)
+	}
+
+	// Hiding the post resolves both of its reports.
+	resolved, err := u.ResolveReport(ctx, alice, postReport.ID, domain.ModerationHide, "spam")
+	if err != nil {
+		t.Fatalf("ResolveReport(hide): %v", err)
+	}
+	if resolved.Status != domain.ReportResolved || resolved.Action != domain.ModerationHide || resolved.ResolvedByUserID != alice.UserID || resolved.ResolvedAt.IsZero() {
+		t.Errorf("ResolveReport = %+v", resolved)
+	}
+	if feed, _, err := timeline.ListFeed(ctx, carol, domain.PageParams{}); err != nil || len(feed) != 0 {
+		t.Errorf("ListFeed after hiding = %v, %v; want none", feed, err)
+	}
+	if open, _, err := u.ListReports(ctx, alice, "", domain.PageParams{}); err != nil || len(open) != 2 {
+		t.Errorf("ListReports after hiding = %v, %v; want the comment and message reports", open, err)
+	}
+	var precondition *domain.FailedPreconditionError
+	if _, err := u.ResolveReport(ctx, alice, postReport.ID, domain.ModerationDismiss, ""); !errors.As(err, &precondition) {
+		t.Errorf("ResolveReport(resolved report): err = %v, want FailedPreconditionError", err)
+	}
+
+	if _, err := u.ResolveReport(ctx, alice, commentReport.ID, domain.ModerationDismiss, ""); err != nil {
+		t.Fatalf("ResolveReport(dismiss): %v", err)
+	}
+	if _, err := u.ResolveReport(ctx, alice, msgReport.ID, domain.ModerationWarn, "be nice"); err != nil {
+		t.Fatalf("ResolveReport(warn): %v", err)
+	}
+
+	if _, _, err := u.ListAuditEvents(ctx, carol, domain.PageParams{}); !errors.Is(err, domain.ErrPermissionDenied) {
+		t.Errorf("ListAuditEvents(member): err = %v, want ErrPermissionDenied", err)
+	}
+	events, _, err := u.ListAuditEvents(ctx, alice, domain.PageParams{})
+	if err != nil || len(events) != 3 {
+		t.Fatalf("ListAuditEvents = %v, %v; want 3 events", events, err)
+	}
+	// Newest first; a warning targets the author.
+	if e := events[0]; e.Action != string(domain.ModerationWarn) || e.TargetType != domain.AuditTargetUser || e.TargetID != bob.UserID || e.ReportID != msgReport.ID || e.Note != "be nice" {
+		t.Errorf("warn event = %+v", e)
+	}
+	if e := events[2]; e.Action != string(domain.ModerationHide) || e.TargetType != string(domain.ReportTargetPost) || e.TargetID != post.ID || e.ActorUserID != alice.UserID {
+		t.Errorf("hide event = %+v", e)
+	}
+	if events, _, err := u.ListAuditEvents(ctx, beta, domain.PageParams{}); err != nil || len(events) != 0 {
+		t.Errorf("ListAuditEvents(beta) = %v, %v; want none", events, err)
+	}
+}
+
+func TestModerationUsecase_SuspendAuthor(t *testing.T) {
+	ctx := context.Background()
+	store := memory.NewStore()
+	ce := cursor.NewHMACEncoder([]byte("test"))
+	u := application.NewModerationUsecase(store, ce)
+	auth := application.NewAuthUsecase(store)
+	timeline := application.NewTimelineUsecase(store, ce)
+	scopes := newTenant(t, store, "acme", 3)
+	owner, bob, admin := scopes[0], scopes[1], scopes[2]
+	if err := store.AuthRepository().UpdateMembershipRole(ctx, admin.TenantID, admin.UserID, domain.RoleAdmin); err != nil {
+		t.Fatalf("UpdateMembershipRole: %v", err)
+	}
+	admin.Role = domain.RoleAdmin
+
+	report := func(author, reporter domain.Scope) *domain.Report {
+		t.Helper()
+		post, err := timeline.CreatePost(ctx, author, "post")
+		if err != nil {
+			t.Fatalf("CreatePost: %v", err)
+		}
+		r, err := u.ReportContent(ctx, reporter, domain.ReportTargetPost, post.ID, "")
+		if err != nil {
+			t.Fatalf("ReportContent: %v", err)
+		}
+		return r
+	}
+
+	var denied *domain.PermissionDeniedError
+	var invalid *domain.ValidationError
+	ownerReport := report(owner, bob)
+	if _, err := u.ResolveReport(ctx, admin, ownerReport.ID, domain.ModerationSuspendAuthor, ""); !errors.As(err, &denied) {
+		t.Errorf("ResolveReport(admin suspends owner): err = %v, want PermissionDeniedError", err)
+	}
+	if _, err := u.ResolveReport(ctx, owner, ownerReport.ID, domain.ModerationSuspendAuthor, ""); !errors.As(err, &invalid) {
+		t.Errorf("ResolveReport(owner suspends themselves): err = %v, want ValidationError", err)
+	}
+	// A failed action leaves the report open.
+	if _, err := u.ResolveReport(ctx, owner, ownerReport.ID, domain.ModerationDismiss, ""); err != nil {
+		t.Errorf("ResolveReport(dismiss) after failed suspensions: %v", err)
+	}
+
+	if _, err := u.ResolveReport(ctx, admin, report(bob, owner).ID, domain.ModerationSuspendAuthor, "repeated spam"); err != nil {
+		t.Fatalf("ResolveReport(suspend_author): %v", err)
+	}
+	if _, err := auth.ResolveScope(ctx, "acme", "acme-user-1", ""); !errors.As(err, &denied) {
+		t.Errorf("ResolveScope(suspended member): err = %v, want PermissionDeniedError", err)
+	}
+	members, err := store.AuthRepository().FindMembers(ctx, bob.TenantID)
+	if err != nil {
+		t.Fatalf("FindMembers: %v", err)
+	}
+	for _, m := range members {
+		if m.Suspended != (m.UserID == bob.UserID) {
+			t.Errorf("member %d Suspended = %v", m.UserID, m.Suspended)
+		}
+	}
+
+	if err := u.ReinstateMember(ctx, bob, bob.UserID); !errors.Is(err, domain.ErrPermissionDenied) {
+		t.Errorf("ReinstateMember(member): err = %v, want ErrPermissionDenied", err)
+	}
+	if err := u.ReinstateMember(ctx, admin, bob.UserID); err != nil {
+		t.Fatalf("ReinstateMember: %v", err)
+	}
+	if _, err := auth.ResolveScope(ctx, "acme", "acme-user-1", ""); err != nil {
+		t.Errorf("ResolveScope after ReinstateMember: %v", err)
+	}
+	events, _, err := u.ListAuditEvents(ctx, admin, domain.PageParams{})
+	if err != nil || len(events) != 3 {
+		t.Fatalf("ListAuditEvents = %v, %v; want dismiss, suspend_author and reinstate_member", events, err)
+	}
+	if e := events[0]; e.Action != domain.AuditReinstateMember || e.TargetType != domain.AuditTargetUser || e.TargetID != bob.UserID || e.ReportID != 0 {
+		t.Errorf("reinstate event = %+v", e)
+	}
+	if e := events[1]; e.Action != string(domain.ModerationSuspendAuthor) || e.TargetID != bob.UserID || e.Note != "repeated spam" {
+		t.Errorf("suspend event = %+v", e)
+	}
+}
@@ -0,0 +1,98 @@
+package application
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/example/something-like-sns/apps/api/internal/domain"
+	"github.com/example/something-like-sns/apps/api/internal/port"
+)
+
+// Cursor kinds. A cursor is only accepted by the list, and the post or conversation, it was issued for.
+const (
+	cursorKindFeed          = "feed"
+	cursorKindConversations = "conversations"
+	cursorKindAuditEvents   = "audit_events"
+)
+
+func cursorKindComments(postID uint64) string {
+	return fmt.Sprintf("comments:%d", postID)
+}
+
+func cursorKindMessages(conversationID uint64) string {
+	return fmt.Sprintf("messages:%d", conversationID)
+}
+
+func cursorKindMembers(filter domain.MemberFilter) string {
+	return fmt.Sprintf("members:%s:%s", filter.Role, filter.Prefix)
+}
+
+func cursorKindReports(status string) string {
+	return fmt.Sprintf("reports:%s", status)
+}
+
+// pageLimits are the default and maximum page sizes of a list.
+type pageLimits struct {
+	def, max int
+}
+
+var (
+	feedPageLimits         = pageLimits{def: 20, max: 100}
+	commentPageLimits      = pageLimits{def: 50, max: 200}
+	conversationPageLimits = pageLimits{def: 20, max: 100}
+	messagePageLimits      = pageLimits{def: 50, max: 200}
+	memberPageLimits       = pageLimits{def: 20, max: 100}
+	reportPageLimits       = pageLimits{def: 20, max: 100}
+	auditEventPageLimits   = pageLimits{def: 50, max: 200}
+)
+
+// size returns the page size for a client-requested size, clamped to the maximum.
+func (l pageLimits) size(requested uint32) int {
+	if requested == 0 {
+		return l.def
+	}
+	if int64(requested) > int64(l.max) {
+		return l.max
+	}
+	return int(requested)
+}
+
+// decodeCursor decodes a client token for the list kind in the caller's tenant.
+func decodeCursor(ce port.CursorEncoder, scope domain.Scope, kind, token string) (domain.Cursor, error) {
+	c, err := ce.Decode(scope.TenantID, kind, token)
+	if err != nil {
+		return domain.Cursor{}, errInvalidCursor
+	}
+	return c, nil
+}
+
+// newPageInfo returns the cursors around items, the page fetched at cursor. hasMore reports whether
+// more items follow in the cursor's direction. key returns the sort key of an item.
+func newPageInfo[T any](ce port.CursorEncoder, scope domain.Scope, kind string, items []T, hasMore bool, cursor domain.Cursor, key func(T) (time.Time, uint64)) *domain.PageInfo {
+	info := &domain.PageInfo{HasMore: hasMore}
+	if len(items) == 0 {
+		return info
+	}
+	// The side the cursor came from always has items.
+	hasNext, hasPrev := hasMore, !cursor.IsZero()
+	if cursor.Direction == domain.PageBackward {
+		hasNext, hasPrev = true, hasMore
+	}
+	if hasNext {
+		t, id := key(items[len(items)-1])
+		info.Next = ce.Encode(scope.TenantID, kind, domain.Cursor{Time: t, ID: id, Direction: domain.PageForward})
+	}
+	if hasPrev {
+		t, id := key(items[0])
+		info.Prev = ce.Encode(scope.TenantID, kind, domain.Cursor{Time: t, ID: id, Direction: domain.PageBackward})
+	}
+	return info
+}
+
+func postKey(p *domain.Post) (time.Time, uint64)                       { return p.CreatedAt, p.ID }
+func commentKey(c *domain.Comment) (time.Time, uint64)                 { return c.CreatedAt, c.ID }
+func conversationKey(c *domain.Conversation) (time.Time, uint64)       { return c.CreatedAt, c.ID }
+func messageKey(m *domain.Message) (time.Time, uint64)                 { return m.CreatedAt, m.ID }
+func directoryMemberKey(m *domain.DirectoryMember) (time.Time, uint64) { return m.JoinedAt, m.UserID }
+func reportKey(r *domain.Report) (time.Time, uint64)                   { return r.CreatedAt, r.ID }
+func auditEventKey(e *domain.AuditEvent) (time.Time, uint64)           { return e.CreatedAt, e.ID }
@@ -0,0 +1,213 @@
+package application
+
+import (
+	"context"
+	"net/url"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/example/something-like-sns/apps/api/internal/domain"
+	"github.com/example/something-like-sns/apps/api/internal/port"
+)
+
+const (
+	maxDisplayNameLength = 64
+	minHandleLength      = 3
+	maxHandleLength      = 30
+	maxBioLength         = 280
+	maxAvatarURLLength   = 512
+)
+
+type profileUsecase struct {
+	store         port.Store
+	cursorEncoder port.CursorEncoder
+}
+
+func NewProfileUsecase(store port.Store, ce port.CursorEncoder) port.ProfileUsecase {
+	return &profileUsecase{store: store, cursorEncoder: ce}
+}
+
+func (u *profileUsecase) GetProfile(ctx context.Context, scope domain.Scope, userID uint64) (*domain.Profile, error) {
+	ctx, span := startSpan(ctx, "ProfileUsecase.GetProfile", scope)
+	defer span.End()
+
+	if userID == 0 {
+		userID = scope.UserID
+	}
+	role, err := u.store.AuthRepository().FindMembershipRole(ctx, scope.TenantID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if role == "" {
+		return nil, domain.NewNotFoundError("user", userID)
+	}
+	return findProfile(ctx, u.store, scope.TenantID, userID)
+}
+
+func (u *profileUsecase) GetProfileSettings(ctx context.Context, scope domain.Scope) (*domain.ProfileSettings, error) {
+	ctx, span := startSpan(ctx, "ProfileUsecase.GetProfileSettings", scope)
+	defer span.End()
+
+	return u.store.ProfileRepository().FindProfileSettings(ctx, scope.TenantID, scope.UserID)
+}
+
+func (u *profileUsecase) UpdateProfile(ctx context.Context, scope domain.Scope, p *domain.Profile) (*domain.Profile, error) {
+	ctx, span := startSpan(ctx, "ProfileUsecase.UpdateProfile", scope)
+	defer span.End()
+
+	updated := &domain.Profile{
+		UserID:      scope.UserID,
+		DisplayName: strings.TrimSpace(p.DisplayName),
+		Handle:      strings.ToLower(strings.TrimPrefix(strings.TrimSpace(p.Handle), "@")),
+		Bio:         strings.TrimSpace(p.Bio),
+		AvatarURL:   strings.TrimSpace(p.AvatarURL),
+	}
+	if n := utf8.RuneCountInString(updated.DisplayName); n == 0 || n > maxDisplayNameLength {
+		return nil, domain.NewValidationError("display_name", "must be 1 to 64 characters")
+	}
+	if updated.Handle != "" && !isValidHandle(updated.Handle) {
+		return nil, domain.NewValidationError("handle", "must be 3 to 30 letters, digits or underscores")
+	}
+	if utf8.RuneCountInString(updated.Bio) > maxBioLength {
+		return nil, domain.NewValidationError("bio", "must be at most 280 characters")
+	}
+	if updated.AvatarURL != "" && !isValidAvatarURL(updated.AvatarURL) {
+		return nil, domain.NewValidationError("avatar_url", "must be an http or https URL of at most 512 bytes")
+	}
+
+	err := u.store.ExecTx(ctx, func(s port.Store) error {
+		return s.ProfileRepository().UpdateProfile(ctx, scope.TenantID, updated)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return findProfile(ctx, u.store, scope.TenantID, scope.UserID)
+}
+
+func (u *profileUsecase) UpdateTenantProfile(ctx context.Context, scope domain.Scope, o domain.ProfileOverride) (*domain.Profile, error) {
+	ctx, span := startSpan(ctx, "ProfileUsecase.UpdateTenantProfile", scope)
+	defer span.End()
+
+	o.DisplayName = strings.TrimSpace(o.DisplayName)
+	o.AvatarURL = strings.TrimSpace(o.AvatarURL)
+	if utf8.RuneCountInString(o.DisplayName) > maxDisplayNameLength {
+		return nil, domain.NewValidationError("display_name", "must be at most 64 characters")
+	}
+	if o.AvatarURL != "" && !isValidAvatarURL(o.AvatarURL) {
+		return nil, domain.NewValidationError("avatar_url", "must be an http or https URL of at most 512 bytes")
+	}
+
+	err := u.store.ExecTx(ctx, func(s port.Store) error {
+		return s.ProfileRepository().UpdateProfileOverride(ctx, scope.TenantID, scope.UserID, o)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return findProfile(ctx, u.store, scope.TenantID, scope.UserID)
+}
+
+func (u *profileUsecase) ListMembers(ctx context.Context, scope domain.Scope, filter domain.MemberFilter, page domain.PageParams) ([]*domain.DirectoryMember, *domain.PageInfo, error) {
+	ctx, span := startSpan(ctx, "ProfileUsecase.ListMembers", scope)
+	defer span.End()
+
+	filter.Prefix = strings.ToLower(strings.TrimPrefix(strings.TrimSpace(filter.Prefix), "@"))
+	if utf8.RuneCountInString(filter.Prefix) > maxDisplayNameLength {
+		return nil, nil, domain.NewValidationError("query", "must be at most 64 characters")
+	}
+	if filter.Role != "" && !domain.IsValidRole(filter.Role) {
+		return nil, nil, domain.NewValidationError("role", "must be owner, admin or member")
+	}
+	limit := memberPageLimits.size(page.Size)
+	kind := cursorKindMembers(filter)
+	cursor, err := decodeCursor(u.cursorEncoder, scope, kind, page.Token)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	members, hasMore, err := u.store.ProfileRepository().FindDirectoryMembers(ctx, scope.TenantID, scope.UserID, filter, limit, cursor)
+	if err != nil {
+		return nil, nil, err
+	}
+	return members, newPageInfo(u.cursorEncoder, scope, kind, members, hasMore, cursor, directoryMemberKey), nil
+}
+
+// findProfile returns the profile of userID as the tenant's members see it.
+func findProfile(ctx context.Context, store port.Store, tenantID, userID uint64) (*domain.Profile, error) {
+	profiles, err := store.ProfileRepository().FindProfiles(ctx, tenantID, []uint64{userID})
+	if err != nil {
+		return nil, err
+	}
+	if len(profiles) == 0 {
+		return nil, domain.NewNotFoundError("user", userID)
+	}
+	return profiles[0], nil
+}
+
+// isValidHandle reports whether handle, already lowercased, is made of allowed characters only.
+func isValidHandle(handle string) bool {
+	if len(handle) < minHandleLength || len(handle) > maxHandleLength {
+		return false
+	}
+	for _, c := range handle {
+		if (c < 'a' || c > 'z') && (c < '0' || c > '9') && c != '_' {
+			return false
+		}
+	}
+	return true
+}
+
+func isValidAvatarURL(s string) bool {
+	if len(s) > maxAvatarURLLength {
+		return false
+	}
+	u, err := url.Parse(s)
+	return err == nil && (u.Scheme == "http" || u.Scheme == "https") && u.Host != ""
+}
+
+// attachProfiles looks up the profiles of the users of items in a single query and passes
+// each item with its user's profile to attach. Items whose user has no profile are skipped.
+func attachProfiles[T any](ctx context.Context, store port.Store, tenantID uint64, items []T, userID func(T) uint64, attach func(T, *domain.Profile)) error {
+	if len(items) == 0 {
+		return nil
+	}
+	seen := make(map[uint64]bool, len(items))
+	ids := make([]uint64, 0, len(items))
+	for _, item := range items {
+		if id := userID(item); !seen[id] {
+			seen[id] = true
+			ids = append(ids, id)
+		}
+	}
+	profiles, err := store.ProfileRepository().FindProfiles(ctx, tenantID, ids)
+	if err != nil {
+		return err
+	}
+	byID := make(map[uint64]*domain.Profile, len(profiles))
+	for _, p := range profiles {
+		byID[p.UserID] = p
+	}
+	for _, item := range items {
+		if p, ok := byID[userID(item)]; ok {
+			attach(item, p)
+		}
+	}
+	return nil
+}
+
+func attachPostAuthors(ctx context.Context, store port.Store, tenantID uint64, posts ...*domain.Post) error {
+	return attachProfiles(ctx, store, tenantID, posts,
+		func(p *domain.Post) uint64 { return p.AuthorUserID },
+		func(p *domain.Post, author *domain.Profile) { p.Author = author })
+}
+
+func attachCommentAuthors(ctx context.Context, store port.Store, tenantID uint64, comments ...*domain.Comment) error {
+	return attachProfiles(ctx, store, tenantID, comments,
+		func(c *domain.Comment) uint64 { return c.AuthorUserID },
+		func(c *domain.Comment, author *domain.Profile) { c.Author = author })
+}
+
+func attachMessageSenders(ctx context.Context, store port.Store, tenantID uint64, messages ...*domain.Message) error {
+	return attachProfiles(ctx, store, tenantID, messages,
+		func(m *domain.Message) uint64 { return m.SenderUserID },
+		func(m *domain.Message, sender *domain.Profile) { m.Sender = sender })
+}
@@ -0,0 +1,249 @@
+package application_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/example/something-like-sns/apps/api/internal/adapter/cursor"
+	"github.com/example/something-like-sns/apps/api/internal/adapter/repository/memory"
+	"github.com/example/something-like-sns/apps/api/internal/application"
+	"github.com/example/something-like-sns/apps/api/internal/domain"
+)
+
+func TestProfileUsecase(t *testing.T) {
+	ctx := context.Background()
+	store := memory.NewStore()
+	u := application.NewProfileUsecase(store, cursor.NewHMACEncoder([]byte("test")))
+	scopes := newTenant(t, store, "acme", 2)
+	alice, bob := scopes[0], scopes[1]
+	beta := newTenant(t, store, "beta", 1)[0]
+
+	if got, err := u.GetProfile(ctx, alice, 0); err != nil || got.UserID != alice.UserID || got.DisplayName != "User" {
+		t.Fatalf("GetProfile(self) = %+v, %v; want alice's profile", got, err)
+	}
+	var notFound *domain.NotFoundError
+	if _, err := u.GetProfile(ctx, alice, beta.UserID); !errors.As(err, &notFound) {
+		t.Errorf("GetProfile(another tenant's user): err = %v, want NotFoundError", err)
+	}
+
+	var invalid *domain.ValidationError
+	for _, p := range []domain.Profile{
+		{DisplayName: " "},
+		{DisplayName: strings.Repeat("あ", 65)},
+		{DisplayName: "Alice", Handle: "al"},
+		{DisplayName: "Alice", Handle: "alice!"},
+		{DisplayName: "Alice", Bio: strings.Repeat("あ", 281)},
+		{DisplayName: "Alice", AvatarURL: "javascript:alert(1)"},
+		{DisplayName: "Alice", AvatarURL: "https://example.com/" + strings.Repeat("a", 500)},
+	} {
+		if _, err := u.UpdateProfile(ctx, alice, &p); !errors.As(err, &invalid) {
+			t.Errorf("UpdateProfile(%+v): err = %v, want ValidationError", p, err)
+		}
+	}
+
+	got, err := u.UpdateProfile(ctx, alice, &domain.Profile{UserID: bob.UserID, DisplayName: " Alice ", Handle: "@Alice_1", Bio: "Hello", AvatarURL: "https://example.com/a.png"})
+	if err != nil {
+		t.Fatalf("UpdateProfile: %v", err)
+	}
+	want := domain.Profile{UserID: alice.UserID, DisplayName: "Alice", Handle: "alice_1", Bio: "Hello", AvatarURL: "https://example.com/a.png"}
+	if *got != want {
+		t.Errorf("UpdateProfile = %+v, want %+v", *got, want)
+	}
+	if got, err := u.GetProfile(ctx, bob, alice.UserID); err != nil || *got != want {
+		t.Errorf("GetProfile(alice) = %+v, %v; want %+v", got, err, want)
+	}
+	var conflict *domain.ConflictError
+	if _, err := u.UpdateProfile(ctx, bob, &domain.Profile{DisplayName: "Bob", Handle: "ALICE_1"}); !errors.As(err, &conflict) {
+		t.Errorf("UpdateProfile(taken handle): err = %v, want ConflictError", err)
+	}
+	if _, err := u.UpdateProfile(ctx, beta, &domain.Profile{DisplayName: "Beta", Handle: "alice_1"}); err != nil {
+		t.Errorf("UpdateProfile(handle taken in another tenant): %v", err)
+	}
+}
+
+func TestProfileUsecase_Authors(t *testing.T) {
+	ctx := context.Background()
+	store := memory.NewStore()
+	ce := cursor.NewHMACEncoder([]byte("test"))
+	profiles := application.NewProfileUsecase(store, ce)
+	timeline := application.NewTimelineUsecase(store, ce)
+	dm := application.NewDMUsecase(store, ce)
+	scopes := newTenant(t, store, "acme", 2)
+	alice, bob := scopes[0], scopes[1]
+
+	for _, s := range []struct {
+		scope domain.Scope
+		name  string
+	}{{alice, "Alice"}, {bob, "Bob"}} {
+		if _, err := profiles.UpdateProfile(ctx, s.scope, &domain.Profile{DisplayName: s.name, Handle: strings.ToLower(s.name)}); err != nil {
+			t.Fatalf("UpdateProfile: %v", err)
+		}
+	}
+
+	post, err := timeline.CreatePost(ctx, alice, "post")
+	if err != nil || post.Author == nil || post.Author.Handle != "alice" {
+		t.Fatalf("CreatePost = %+v, %v; want alice as the author", post, err)
+	}
+	if _, err := timeline.CreatePost(ctx, bob, "reply"); err != nil {
+		t.Fatalf("CreatePost: %v", err)
+	}
+	posts, _, err := timeline.ListFeed(ctx, bob, domain.PageParams{})
+	if err != nil || len(posts) != 2 {
+		t.Fatalf("ListFeed = %v, %v; want 2 posts", posts, err)
+	}
+	for _, p := range posts {
+		if p.Author == nil || p.Author.UserID != p.AuthorUserID {
+			t.Errorf("ListFeed: post %d has author %+v, want user %d", p.ID, p.Author, p.AuthorUserID)
+		}
+	}
+
+	if c, err := timeline.CreateComment(ctx, bob, post.ID, "comment"); err != nil || c.Author == nil || c.Author.DisplayName != "Bob" {
+		t.Fatalf("CreateComment = %+v, %v; want bob as the author", c, err)
+	}
+	comments, _, err := timeline.ListComments(ctx, alice, post.ID, domain.PageParams{})
+	if err != nil || len(comments) != 1 || comments[0].Author == nil || comments[0].Author.Handle != "bob" {
+		t.Errorf("ListComments = %v, %v; want bob's comment with their profile", comments, err)
+	}
+
+	convID, err := dm.GetOrCreateDM(ctx, alice, bob.UserID)
+	if err != nil {
+		t.Fatalf("GetOrCreateDM: %v", err)
+	}
+	if m, err := dm.SendMessage(ctx, alice, convID, "hi"); err != nil || m.Sender == nil || m.Sender.Handle != "alice" {
+		t.Fatalf("SendMessage = %+v, %v; want alice as the sender", m, err)
+	}
+	messages, _, err := dm.ListMessages(ctx, bob, convID, domain.PageParams{})
+	if err != nil || len(messages) != 1 || messages[0].Sender == nil || messages[0].Sender.DisplayName != "Alice" {
+		t.Errorf("ListMessages = %v, %v; want alice's message with their profile", messages, err)
+	}
+}
+
+func TestProfileUsecase_ListMembers(t *testing.T) {
+	ctx := context.Background()
+	store := memory.NewStore()
+	u := application.NewProfileUsecase(store, cursor.NewHMACEncoder([]byte("test")))
+	scopes := newTenant(t, store, "acme", 5)
+	alice := scopes[0]
+	if _, err := u.UpdateProfile(ctx, scopes[1], &domain.Profile{DisplayName: "Bob", Handle: "bobby"}); err != nil {
+		t.Fatalf("UpdateProfile: %v", err)
+	}
+
+	var ids []uint64
+	page := domain.PageParams{Size: 2}
+	for {
+		members, info, err := u.ListMembers(ctx, alice, domain.MemberFilter{}, page)
+		if err != nil {
+			t.Fatalf("ListMembers: %v", err)
+		}
+		for _, m := range members {
+			ids = append(ids, m.UserID)
+		}
+		if !info.HasMore {
+			break
+		}
+		page.Token = info.Next
+	}
+	if len(ids) != len(scopes) {
+		t.Fatalf("ListMembers paged through %v, want all %d members", ids, len(scopes))
+	}
+	for i, s := range scopes {
+		if ids[i] != s.UserID {
+			t.Errorf("ListMembers[%d] = user %d, want %d (join order)", i, ids[i], s.UserID)
+		}
+	}
+
+	for _, query := range []string{"bo", " @BOB", "@bobb"} {
+		members, _, err := u.ListMembers(ctx, alice, domain.MemberFilter{Prefix: query}, domain.PageParams{})
+		if err != nil || len(members) != 1 || members[0].UserID != scopes[1].UserID {
+			t.Errorf("ListMembers(%q) = %v, %v; want only bob", query, members, err)
+		}
+	}
+	if members, _, err := u.ListMembers(ctx, alice, domain.MemberFilter{Role: domain.RoleOwner}, domain.PageParams{}); err != nil || len(members) != 1 || members[0].UserID != alice.UserID {
+		t.Errorf("ListMembers(owners) = %v, %v; want only alice", members, err)
+	}
+
+	_, info, err := u.ListMembers(ctx, alice, domain.MemberFilter{}, domain.PageParams{Size: 2})
+	if err != nil {
+		t.Fatalf("ListMembers: %v", err)
+	}
+	var invalid *domain.ValidationError
+	if _, _, err := u.ListMembers(ctx, alice, domain.MemberFilter{Prefix: "u"}, domain.PageParams{Token: info.Next}); !errors.As(err, &invalid) {
+		t.Errorf("ListMembers(cursor from another query): err = %v, want ValidationError", err)
+	}
+	for _, f := range []domain.MemberFilter{{Prefix: strings.Repeat("a", 65)}, {Role: "superuser"}} {
+		if _, _, err := u.ListMembers(ctx, alice, f, domain.PageParams{}); !errors.As(err, &invalid) {
+			t.Errorf("ListMembers(%+v): err = %v, want ValidationError", f, err)
+		}
+	}
+}
+
+func TestProfileUsecase_TenantProfile(t *testing.T) {
+	ctx := context.Background()
+	store := memory.NewStore()
+	ce := cursor.NewHMACEncoder([]byte("test"))
+	u := application.NewProfileUsecase(store, ce)
+	timeline := application.NewTimelineUsecase(store, ce)
+	scopes := newTenant(t, store, "acme", 2)
+	alice, bob := scopes[0], scopes[1]
+	beta := domain.Scope{TenantID: newTenant(t, store, "beta", 1)[0].TenantID, UserID: alice.UserID, Role: domain.RoleMember}
+	if err := store.AuthRepository().EnsureMembership(ctx, beta.TenantID, alice.UserID, beta.Role); err != nil {
+		t.Fatalf("EnsureMembership: %v", err)
+	}
+	if _, err := u.UpdateProfile(ctx, alice, &domain.Profile{DisplayName: "Alice", AvatarURL: "https://example.com/a.png"}); err != nil {
+		t.Fatalf("UpdateProfile: %v", err)
+	}
+
+	var invalid *domain.ValidationError
+	for _, o := range []domain.ProfileOverride{
+		{DisplayName: strings.Repeat("あ", 65)},
+		{AvatarURL: "ftp://example.com/a.png"},
+	} {
+		if _, err := u.UpdateTenantProfile(ctx, alice, o); !errors.As(err, &invalid) {
+			t.Errorf("UpdateTenantProfile(%+v): err = %v, want ValidationError", o, err)
+		}
+	}
+
+	got, err := u.UpdateTenantProfile(ctx, alice, domain.ProfileOverride{DisplayName: " Ally "})
+	if err != nil {
+		t.Fatalf("UpdateTenantProfile: %v", err)
+	}
+	if got.DisplayName != "Ally" || got.AvatarURL != "https://example.com/a.png" {
+		t.Errorf("UpdateTenantProfile = %+v, want Ally with the user's own avatar", *got)
+	}
+	if p, err := u.GetProfile(ctx, bob, alice.UserID); err != nil || p.DisplayName != "Ally" {
+		t.Errorf("GetProfile(acme) = %+v, %v; want Ally", p, err)
+	}
+	if p, err := u.GetProfile(ctx, beta, 0); err != nil || p.DisplayName != "Alice" {
+		t.Errorf("GetProfile(beta) = %+v, %v; want Alice", p, err)
+	}
+	if post, err := timeline.CreatePost(ctx, alice, "hi"); err != nil || post.Author == nil || post.Author.DisplayName != "Ally" {
+		t.Errorf("CreatePost = %+v, %v; want Ally as the author", post, err)
+	}
+	if members, _, err := u.ListMembers(ctx, bob, domain.MemberFilter{Prefix: "all"}, domain.PageParams{}); err != nil || len(members) != 1 {
+		t.Errorf("ListMembers(all) = %v, %v; want alice", members, err)
+	}
+	auth := application.NewAuthUsecase(store)
+	if _, p, err := auth.GetMe(ctx, alice); err != nil || p.DisplayName != "Ally" || p.AvatarURL != "https://example.com/a.png" {
+		t.Errorf("GetMe(acme) = %+v, %v; want Ally with the user's own avatar", p, err)
+	}
+	if _, p, err := auth.GetMe(ctx, beta); err != nil || p.DisplayName != "Alice" {
+		t.Errorf("GetMe(beta) = %+v, %v; want Alice", p, err)
+	}
+
+	// Renaming the user keeps the override in acme and shows everywhere else.
+	if p, err := u.UpdateProfile(ctx, alice, &domain.Profile{DisplayName: "Alice B."}); err != nil || p.DisplayName != "Ally" {
+		t.Errorf("UpdateProfile = %+v, %v; want Ally as acme sees it", p, err)
+	}
+	settings, err := u.GetProfileSettings(ctx, alice)
+	if err != nil {
+		t.Fatalf("GetProfileSettings: %v", err)
+	}
+	if settings.DisplayName != "Alice B." || settings.Override.DisplayName != "Ally" {
+		t.Errorf("GetProfileSettings = %+v, want Alice B. overridden by Ally", *settings)
+	}
+	if p, err := u.GetProfile(ctx, beta, 0); err != nil || p.DisplayName != "Alice B." {
+		t.Errorf("GetProfile(beta) = %+v, %v; want Alice B.", p, err)
+	}
+}
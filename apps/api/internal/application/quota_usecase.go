@@ -0,0 +1,94 @@
+package application
+
+import (
+	"context"
+
+	"github.com/example/something-like-sns/apps/api/internal/domain"
+	"github.com/example/something-like-sns/apps/api/internal/port"
+)
+
+type quotaUsecase struct {
+	store port.Store
+}
+
+func NewQuotaUsecase(store port.Store) port.QuotaUsecase {
+	return &quotaUsecase{store: store}
+}
+
+func (u *quotaUsecase) GetPlan(ctx context.Context, tenantID uint64) (*domain.Plan, error) {
+	ctx, span := startSpan(ctx, "QuotaUsecase.GetPlan", domain.Scope{TenantID: tenantID})
+	defer span.End()
+
+	return tenantPlan(ctx, u.store, tenantID)
+}
+
+func (u *quotaUsecase) GetUsage(ctx context.Context, scope domain.Scope) (*domain.Plan, *domain.TenantUsage, error) {
+	ctx, span := startSpan(ctx, "QuotaUsecase.GetUsage", scope)
+	defer span.End()
+
+	if !scope.IsAdmin() {
+		return nil, nil, domain.ErrPermissionDenied
+	}
+	plan, err := u.GetPlan(ctx, scope.TenantID)
+	if err != nil {
+		return nil, nil, err
+	}
+	usage, err := u.store.PlanRepository().GetUsage(ctx, scope.TenantID)
+	if err != nil {
+		return nil, nil, err
+	}
+	return plan, usage, nil
+}
+
+// tenantPlan returns the tenant's plan, reusing the one the request loaded already if there is one.
+func tenantPlan(ctx context.Context, store port.Store, tenantID uint64) (*domain.Plan, error) {
+	if plan, ok := domain.PlanFromContext(ctx, tenantID); ok {
+		return plan, nil
+	}
+	return store.PlanRepository().FindTenantPlan(ctx, tenantID)
+}
+
+// checkQuota rejects a post, comment or message once it has taken the tenant past its daily post
+// or storage quota. It must run in the transaction of the write, after the write has updated the
+// usage counters.
+func checkQuota(ctx context.Context, store port.Store, tenantID uint64, action domain.QuotaAction) error {
+	plan, err := tenantPlan(ctx, store, tenantID)
+	if err != nil {
+		return err
+	}
+	checkPosts := action == domain.QuotaActionPost && plan.DailyPosts > 0
+	if plan.StorageBytes == 0 && !checkPosts {
+		return nil
+	}
+	usage, err := store.PlanRepository().GetUsage(ctx, tenantID)
+	if err != nil {
+		return err
+	}
+	if checkPosts && usage.PostsToday > plan.DailyPosts {
+		return domain.ErrQuotaExceeded
+	}
+	if plan.StorageBytes > 0 && usage.StorageBytes > plan.StorageBytes {
+		return domain.ErrQuotaExceeded
+	}
+	return nil
+}
+
+// checkMemberQuota rejects a new member once they take the tenant past its plan member limit. Like
+// checkQuota, it must run in the transaction that added the member, after EnsureMembership.
+func checkMemberQuota(ctx context.Context, store port.Store, tenantID uint64) error {
+	plan, err := tenantPlan(ctx, store, tenantID)
+	if err != nil {
+		return err
+	}
+	if plan.MaxMembers == 0 {
+		return nil
+	}
+	usage, err := store.PlanRepository().GetUsage(ctx, tenantID)
+	if err != nil {
+		return err
+	}
+	if usage.Members > plan.MaxMembers {
+		return domain.ErrQuotaExceeded
+	}
+	return nil
+}
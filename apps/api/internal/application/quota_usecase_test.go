@@ -0,0 +1,89 @@
+package application_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/example/something-like-sns/apps/api/internal/adapter/cursor"
+	"github.com/example/something-like-sns/apps/api/internal/adapter/repository/memory"
+	"github.com/example/something-like-sns/apps/api/internal/application"
+	"github.com/example/something-like-sns/apps/api/internal/domain"
+)
+
+func TestQuotaUsecase_Quotas(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	store := memory.NewStore(
+		memory.WithClock(func() time.Time { return now }),
+		memory.WithPlans(domain.Plan{Name: "free", DailyPosts: 2, StorageBytes: 20, MaxMembers: 3}),
+	)
+	ce := cursor.NewHMACEncoder([]byte("test"))
+	timeline := application.NewTimelineUsecase(store, ce)
+	dm := application.NewDMUsecase(store, ce)
+	quota := application.NewQuotaUsecase(store)
+	scopes := newTenant(t, store, "acme", 2)
+	alice, bob := scopes[0], scopes[1]
+
+	checkUsage := func(what string, want domain.TenantUsage) {
+		t.Helper()
+		_, usage, err := quota.GetUsage(ctx, alice)
+		if err != nil {
+			t.Fatalf("GetUsage %s: %v", what, err)
+		}
+		if *usage != want {
+			t.Errorf("GetUsage %s = %+v, want %+v", what, *usage, want)
+		}
+	}
+
+	for _, body := range []string{"post 1", "post 2"} {
+		if _, err := timeline.CreatePost(ctx, alice, body); err != nil {
+			t.Fatalf("CreatePost(%q): %v", body, err)
+		}
+	}
+	if _, err := timeline.CreatePost(ctx, alice, "post 3"); !errors.Is(err, domain.ErrQuotaExceeded) {
+		t.Fatalf("CreatePost over the daily quota: err = %v, want ErrQuotaExceeded", err)
+	}
+	checkUsage("after the rejected post", domain.TenantUsage{PostsToday: 2, StorageBytes: 12, Members: 2})
+
+	// The plan the request loaded already is used instead of the stored one.
+	unlimited := domain.ContextWithPlan(ctx, alice.TenantID, &domain.Plan{Name: "free"})
+	if _, err := timeline.CreatePost(unlimited, alice, "p"); err != nil {
+		t.Fatalf("CreatePost with an unlimited plan in the context: %v", err)
+	}
+
+	now = now.Add(24 * time.Hour)
+	checkUsage("the next day", domain.TenantUsage{PostsToday: 0, StorageBytes: 13, Members: 2})
+	post, err := timeline.CreatePost(ctx, alice, "post 4")
+	if err != nil {
+		t.Fatalf("CreatePost the next day: %v", err)
+	}
+
+	if _, err := timeline.CreateComment(ctx, bob, post.ID, "too long"); !errors.Is(err, domain.ErrQuotaExceeded) {
+		t.Errorf("CreateComment over the storage quota: err = %v, want ErrQuotaExceeded", err)
+	}
+	if _, err := timeline.CreateComment(ctx, bob, post.ID, "f"); err != nil {
+		t.Fatalf("CreateComment that fills the storage quota: %v", err)
+	}
+	conv, err := dm.GetOrCreateDM(ctx, bob, alice.UserID)
+	if err != nil {
+		t.Fatalf("GetOrCreateDM: %v", err)
+	}
+	if _, err := dm.SendMessage(ctx, bob, conv, "hi"); !errors.Is(err, domain.ErrQuotaExceeded) {
+		t.Errorf("SendMessage over the storage quota: err = %v, want ErrQuotaExceeded", err)
+	}
+	checkUsage("with full storage", domain.TenantUsage{PostsToday: 1, StorageBytes: 20, Members: 2})
+
+	auth := application.NewAuthUsecase(store)
+	if err := store.AuthRepository().SetTenantJoinPolicy(ctx, alice.TenantID, domain.JoinPolicyOpen); err != nil {
+		t.Fatalf("SetTenantJoinPolicy: %v", err)
+	}
+	if scope, err := auth.ResolveScope(ctx, "acme", "newcomer-1", ""); err != nil || !scope.IsMember() {
+		t.Fatalf("ResolveScope of the third member = %+v, %v; want a member", scope, err)
+	}
+	if _, err := auth.ResolveScope(ctx, "acme", "newcomer-2", ""); !errors.Is(err, domain.ErrQuotaExceeded) {
+		t.Errorf("ResolveScope over the member limit: err = %v, want ErrQuotaExceeded", err)
+	}
+	checkUsage("with the member limit reached", domain.TenantUsage{PostsToday: 1, StorageBytes: 20, Members: 3})
+}
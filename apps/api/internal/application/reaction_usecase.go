@@ -2,7 +2,6 @@ package application
 
 import (
 	"context"
-	"errors"
 
 	v1 "github.com/example/something-like-sns/apps/api/gen/sns/v1"
 	"github.com/example/something-like-sns/apps/api/internal/domain"
@@ -18,6 +17,9 @@ func NewReactionUsecase(store port.Store) port.ReactionUsecase {
 }
 
 func (u *reactionUsecase) ToggleReaction(ctx context.Context, scope domain.Scope, targetType v1.TargetType, targetID uint64, reactionType string) (*domain.Reaction, error) {
+	ctx, span := startSpan(ctx, "ReactionUsecase.ToggleReaction", scope)
+	defer span.End()
+
 	if reactionType == "" {
 		reactionType = "like"
 	}
@@ -29,7 +31,10 @@ func (u *reactionUsecase) ToggleReaction(ctx context.Context, scope domain.Scope
 	case v1.TargetType_COMMENT:
 		domainTargetType = domain.ReactionTargetComment
 	default:
-		return nil, errors.New("invalid target type")
+		return nil, domain.NewValidationError("target_type", "must be POST or COMMENT")
+	}
+	if err := u.checkTarget(ctx, scope, domainTargetType, targetID); err != nil {
+		return nil, err
 	}
 
 	active, err := u.store.ReactionRepository().Toggle(ctx, scope.TenantID, scope.UserID, domainTargetType, targetID, reactionType)
@@ -44,3 +49,25 @@ func (u *reactionUsecase) ToggleReaction(ctx context.Context, scope domain.Scope
 
 	return &domain.Reaction{Active: active, Total: total}, nil
 }
+
+// checkTarget verifies that the reacted-to post or comment exists in the caller's tenant, and
+// that neither the caller nor its author blocks the other. A comment's post author counts as
+// well, as they could not comment under that post either.
+func (u *reactionUsecase) checkTarget(ctx context.Context, scope domain.Scope, targetType domain.ReactionTargetType, targetID uint64) error {
+	postID := targetID
+	if targetType == domain.ReactionTargetComment {
+		comment, err := u.store.TimelineRepository().FindCommentByID(ctx, scope.TenantID, targetID)
+		if err != nil {
+			return err
+		}
+		if err := checkNotBlocked(ctx, u.store, scope.TenantID, scope.UserID, comment.AuthorUserID); err != nil {
+			return err
+		}
+		postID = comment.PostID
+	}
+	post, err := u.store.TimelineRepository().FindPostByID(ctx, scope.TenantID, postID)
+	if err != nil {
+		return err
+	}
+	return checkNotBlocked(ctx, u.store, scope.TenantID, scope.UserID, post.AuthorUserID)
+}
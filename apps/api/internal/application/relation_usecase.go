@@ -0,0 +1,92 @@
+package application
+
+import (
+	"context"
+
+	"github.com/example/something-like-sns/apps/api/internal/domain"
+	"github.com/example/something-like-sns/apps/api/internal/port"
+)
+
+type relationUsecase struct {
+	store port.Store
+}
+
+func NewRelationUsecase(store port.Store) port.RelationUsecase {
+	return &relationUsecase{store: store}
+}
+
+func (u *relationUsecase) BlockUser(ctx context.Context, scope domain.Scope, userID uint64) error {
+	ctx, span := startSpan(ctx, "RelationUsecase.BlockUser", scope)
+	defer span.End()
+
+	return u.add(ctx, scope, userID, domain.RelationBlock)
+}
+
+func (u *relationUsecase) UnblockUser(ctx context.Context, scope domain.Scope, userID uint64) error {
+	ctx, span := startSpan(ctx, "RelationUsecase.UnblockUser", scope)
+	defer span.End()
+
+	return u.remove(ctx, scope, userID, domain.RelationBlock)
+}
+
+func (u *relationUsecase) MuteUser(ctx context.Context, scope domain.Scope, userID uint64) error {
+	ctx, span := startSpan(ctx, "RelationUsecase.MuteUser", scope)
+	defer span.End()
+
+	return u.add(ctx, scope, userID, domain.RelationMute)
+}
+
+func (u *relationUsecase) UnmuteUser(ctx context.Context, scope domain.Scope, userID uint64) error {
+	ctx, span := startSpan(ctx, "RelationUsecase.UnmuteUser", scope)
+	defer span.End()
+
+	return u.remove(ctx, scope, userID, domain.RelationMute)
+}
+
+// add sets the relation on another member of the tenant.
+func (u *relationUsecase) add(ctx context.Context, scope domain.Scope, userID uint64, kind domain.RelationKind) error {
+	if err := u.checkOtherMember(ctx, scope, userID); err != nil {
+		return err
+	}
+	return u.store.RelationRepository().AddRelation(ctx, scope.TenantID, scope.UserID, userID, kind)
+}
+
+// remove lifts the relation, if there is one. The relations of users who left the tenant stay
+// in place and apply again if they rejoin.
+func (u *relationUsecase) remove(ctx context.Context, scope domain.Scope, userID uint64, kind domain.RelationKind) error {
+	if err := u.checkOtherMember(ctx, scope, userID); err != nil {
+		return err
+	}
+	return u.store.RelationRepository().RemoveRelation(ctx, scope.TenantID, scope.UserID, userID, kind)
+}
+
+// checkOtherMember verifies that userID is a member of the tenant other than the caller.
+func (u *relationUsecase) checkOtherMember(ctx context.Context, scope domain.Scope, userID uint64) error {
+	if userID == 0 || userID == scope.UserID {
+		return domain.NewValidationError("user_id", "must be another user's ID")
+	}
+	role, err := u.store.AuthRepository().FindMembershipRole(ctx, scope.TenantID, userID)
+	if err != nil {
+		return err
+	}
+	if role == "" {
+		return domain.NewNotFoundError("user", userID)
+	}
+	return nil
+}
+
+// checkNotBlocked returns a PermissionDeniedError if either user blocks the other. Users never
+// block themselves.
+func checkNotBlocked(ctx context.Context, store port.Store, tenantID, userID, otherID uint64) error {
+	if userID == otherID {
+		return nil
+	}
+	blocked, err := store.RelationRepository().IsBlocked(ctx, tenantID, userID, otherID)
+	if err != nil {
+		return err
+	}
+	if blocked {
+		return errBlocked
+	}
+	return nil
+}
@@ -0,0 +1,150 @@
+package application_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	v1 "github.com/example/something-like-sns/apps/api/gen/sns/v1"
+	"github.com/example/something-like-sns/apps/api/internal/adapter/cursor"
+	"github.com/example/something-like-sns/apps/api/internal/adapter/repository/memory"
+	"github.com/example/something-like-sns/apps/api/internal/application"
+	"github.com/example/something-like-sns/apps/api/internal/domain"
+)
+
+func TestRelationUsecase_Block(t *testing.T) {
+	ctx := context.Background()
+	store := memory.NewStore()
+	ce := cursor.NewHMACEncoder([]byte("test"))
+	relations := application.NewRelationUsecase(store)
+	timeline := application.NewTimelineUsecase(store, ce)
+	reactions := application.NewReactionUsecase(store)
+	dm := application.NewDMUsecase(store, ce)
+	scopes := newTenant(t, store, "acme", 3)
+	alice, bob, carol := scopes[0], scopes[1], scopes[2]
+	beta := newTenant(t, store, "beta", 1)[0]
+
+	var invalid *domain.ValidationError
+	var notFound *domain.NotFoundError
+	if err := relations.BlockUser(ctx, alice, alice.UserID); !errors.As(err, &invalid) {
+		t.Errorf("BlockUser(self): err = %v, want ValidationError", err)
+	}
+	if err := relations.BlockUser(ctx, alice, beta.UserID); !errors.As(err, &notFound) {
+		t.Errorf("BlockUser(another tenant's user): err = %v, want NotFoundError", err)
+	}
+
+	conv, err := dm.GetOrCreateDM(ctx, alice, bob.UserID)
+	if err != nil {
+		t.Fatalf("GetOrCreateDM: %v", err)
+	}
+	post, err := timeline.CreatePost(ctx, alice, "post")
+	if err != nil {
+		t.Fatalf("CreatePost: %v", err)
+	}
+	comment, err := timeline.CreateComment(ctx, alice, post.ID, "comment")
+	if err != nil {
+		t.Fatalf("CreateComment: %v", err)
+	}
+	if err := relations.BlockUser(ctx, alice, bob.UserID); err != nil {
+		t.Fatalf("BlockUser: %v", err)
+	}
+
+	// The block stops both members, whoever set it.
+	var denied *domain.PermissionDeniedError
+	for _, s := range []domain.Scope{alice, bob} {
+		other := bob.UserID
+		if s == bob {
+			other = alice.UserID
+		}
+		if _, err := dm.GetOrCreateDM(ctx, s, other); !errors.As(err, &denied) {
+			t.Errorf("GetOrCreateDM(%d): err = %v, want PermissionDeniedError", s.UserID, err)
+		}
+		if _, err := dm.SendMessage(ctx, s, conv, "hi"); !errors.As(err, &denied) {
+			t.Errorf("SendMessage(%d): err = %v, want PermissionDeniedError", s.UserID, err)
+		}
+	}
+	if _, err := timeline.CreateComment(ctx, bob, post.ID, "reply"); !errors.As(err, &denied) {
+		t.Errorf("CreateComment(on the blocker's post): err = %v, want PermissionDeniedError", err)
+	}
+	if _, err := reactions.ToggleReaction(ctx, bob, v1.TargetType_POST, post.ID, ""); !errors.As(err, &denied) {
+		t.Errorf("ToggleReaction(the blocker's post): err = %v, want PermissionDeniedError", err)
+	}
+	if _, err := reactions.ToggleReaction(ctx, bob, v1.TargetType_COMMENT, comment.ID, ""); !errors.As(err, &denied) {
+		t.Errorf("ToggleReaction(the blocker's comment): err = %v, want PermissionDeniedError", err)
+	}
+	reply, err := timeline.CreateComment(ctx, carol, post.ID, "reply")
+	if err != nil {
+		t.Fatalf("CreateComment(carol): %v", err)
+	}
+	if _, err := reactions.ToggleReaction(ctx, bob, v1.TargetType_COMMENT, reply.ID, ""); !errors.As(err, &denied) {
+		t.Errorf("ToggleReaction(a comment under the blocker's post): err = %v, want PermissionDeniedError", err)
+	}
+	if _, err := timeline.CreateComment(ctx, alice, post.ID, "own post"); err != nil {
+		t.Errorf("CreateComment(on their own post): %v", err)
+	}
+
+	if err := relations.UnblockUser(ctx, alice, bob.UserID); err != nil {
+		t.Fatalf("UnblockUser: %v", err)
+	}
+	if _, err := dm.SendMessage(ctx, bob, conv, "hi"); err != nil {
+		t.Errorf("SendMessage after UnblockUser: %v", err)
+	}
+	if _, err := timeline.CreateComment(ctx, bob, post.ID, "reply"); err != nil {
+		t.Errorf("CreateComment after UnblockUser: %v", err)
+	}
+	if _, err := reactions.ToggleReaction(ctx, bob, v1.TargetType_COMMENT, reply.ID, ""); err != nil {
+		t.Errorf("ToggleReaction(a comment under the post) after UnblockUser: %v", err)
+	}
+}
+
+func TestRelationUsecase_Mute(t *testing.T) {
+	ctx := context.Background()
+	store := memory.NewStore()
+	ce := cursor.NewHMACEncoder([]byte("test"))
+	relations := application.NewRelationUsecase(store)
+	timeline := application.NewTimelineUsecase(store, ce)
+	dm := application.NewDMUsecase(store, ce)
+	scopes := newTenant(t, store, "acme", 2)
+	alice, bob := scopes[0], scopes[1]
+
+	for _, s := range scopes {
+		if _, err := timeline.CreatePost(ctx, s, "post"); err != nil {
+			t.Fatalf("CreatePost: %v", err)
+		}
+	}
+	feedAuthors := func(s domain.Scope) []uint64 {
+		t.Helper()
+		posts, _, err := timeline.ListFeed(ctx, s, domain.PageParams{})
+		if err != nil {
+			t.Fatalf("ListFeed: %v", err)
+		}
+		ids := make([]uint64, len(posts))
+		for i, p := range posts {
+			ids[i] = p.AuthorUserID
+		}
+		return ids
+	}
+
+	for i := 0; i < 2; i++ {
+		if err := relations.MuteUser(ctx, alice, bob.UserID); err != nil {
+			t.Fatalf("MuteUser: %v", err)
+		}
+	}
+	if got := feedAuthors(alice); len(got) != 1 || got[0] != alice.UserID {
+		t.Errorf("ListFeed(muter) authors = %v, want only alice", got)
+	}
+	if got := feedAuthors(bob); len(got) != 2 {
+		t.Errorf("ListFeed(muted) authors = %v, want both", got)
+	}
+	// Muting only hides posts; the members can still talk.
+	if _, err := dm.GetOrCreateDM(ctx, bob, alice.UserID); err != nil {
+		t.Errorf("GetOrCreateDM(muter): %v", err)
+	}
+
+	if err := relations.UnmuteUser(ctx, alice, bob.UserID); err != nil {
+		t.Fatalf("UnmuteUser: %v", err)
+	}
+	if got := feedAuthors(alice); len(got) != 2 {
+		t.Errorf("ListFeed after UnmuteUser authors = %v, want both", got)
+	}
+}
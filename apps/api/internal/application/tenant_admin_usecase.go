@@ -0,0 +1,339 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/example/something-like-sns/apps/api/internal/domain"
+	"github.com/example/something-like-sns/apps/api/internal/port"
+)
+
+const (
+	maxTenantNameLength = 128
+	// maxTenantDomains limits the domains a tenant can claim, verified or not.
+	maxTenantDomains = 10
+)
+
+type tenantAdminUsecase struct {
+	store    port.Store
+	resolver port.DomainResolver
+}
+
+func NewTenantAdminUsecase(store port.Store, resolver port.DomainResolver) port.TenantAdminUsecase {
+	return &tenantAdminUsecase{store: store, resolver: resolver}
+}
+
+func (u *tenantAdminUsecase) GetTenant(ctx context.Context, scope domain.Scope) (*domain.Tenant, error) {
+	ctx, span := startSpan(ctx, "TenantAdminUsecase.GetTenant", scope)
+	defer span.End()
+
+	if !scope.IsAdmin() {
+		return nil, domain.ErrPermissionDenied
+	}
+	return u.store.AuthRepository().FindTenantByID(ctx, scope.TenantID)
+}
+
+func (u *tenantAdminUsecase) UpdateTenant(ctx context.Context, scope domain.Scope, name string) (*domain.Tenant, error) {
+	ctx, span := startSpan(ctx, "TenantAdminUsecase.UpdateTenant", scope)
+	defer span.End()
+
+	if !scope.IsAdmin() {
+		return nil, domain.ErrPermissionDenied
+	}
+	name = strings.TrimSpace(name)
+	if name == "" || utf8.RuneCountInString(name) > maxTenantNameLength {
+		return nil, domain.NewValidationError("name", "must be 1 to 128 characters")
+	}
+	if err := u.store.AuthRepository().RenameTenant(ctx, scope.TenantID, name); err != nil {
+		return nil, err
+	}
+	return u.store.AuthRepository().FindTenantByID(ctx, scope.TenantID)
+}
+
+func (u *tenantAdminUsecase) ListTenantMembers(ctx context.Context, scope domain.Scope) ([]*domain.Member, error) {
+	ctx, span := startSpan(ctx, "TenantAdminUsecase.ListTenantMembers", scope)
+	defer span.End()
+
+	if !scope.IsAdmin() {
+		return nil, domain.ErrPermissionDenied
+	}
+	return u.store.AuthRepository().FindMembers(ctx, scope.TenantID)
+}
+
+func (u *tenantAdminUsecase) UpdateMemberRole(ctx context.Context, scope domain.Scope, userID uint64, role string) error {
+	ctx, span := startSpan(ctx, "TenantAdminUsecase.UpdateMemberRole", scope)
+	defer span.End()
+
+	if !scope.IsAdmin() {
+		return domain.ErrPermissionDenied
+	}
+	if !domain.IsValidRole(role) {
+		return domain.NewValidationError("role", "must be owner, admin or member")
+	}
+	return u.store.ExecTx(ctx, func(s port.Store) error {
+		current, err := memberRole(ctx, s, scope.TenantID, userID)
+		if err != nil {
+			return err
+		}
+		if (current == domain.RoleOwner || role == domain.RoleOwner) && scope.Role != domain.RoleOwner {
+			return domain.NewPermissionDeniedError("only owners can change owners")
+		}
+		if current == domain.RoleOwner && role != domain.RoleOwner {
+			if err := checkNotLastOwner(ctx, s, scope.TenantID, userID, "cannot demote the last owner"); err != nil {
+				return err
+			}
+		}
+		return s.AuthRepository().UpdateMembershipRole(ctx, scope.TenantID, userID, role)
+	})
+}
+
+func (u *tenantAdminUsecase) RemoveMember(ctx context.Context, scope domain.Scope, userID uint64) error {
+	ctx, span := startSpan(ctx, "TenantAdminUsecase.RemoveMember", scope)
+	defer span.End()
+
+	if !scope.IsAdmin() {
+		return domain.ErrPermissionDenied
+	}
+	return u.store.ExecTx(ctx, func(s port.Store) error {
+		current, err := memberRole(ctx, s, scope.TenantID, userID)
+		if err != nil {
+			return err
+		}
+		if current == domain.RoleOwner {
+			if scope.Role != domain.RoleOwner {
+				return domain.NewPermissionDeniedError("only owners can remove owners")
+			}
+			if err := checkNotLastOwner(ctx, s, scope.TenantID, userID, "cannot remove the last owner"); err != nil {
+				return err
+			}
+		}
+		return s.AuthRepository().RemoveMembership(ctx, scope.TenantID, userID)
+	})
+}
+
+func (u *tenantAdminUsecase) TransferOwnership(ctx context.Context, scope domain.Scope, userID uint64) error {
+	ctx, span := startSpan(ctx, "TenantAdminUsecase.TransferOwnership", scope)
+	defer span.End()
+
+	if scope.Role != domain.RoleOwner {
+		return domain.NewPermissionDeniedError("only owners can transfer ownership")
+	}
+	if userID == scope.UserID {
+		return domain.NewValidationError("user_id", "must be another member")
+	}
+	return u.store.ExecTx(ctx, func(s port.Store) error {
+		if _, err := memberRole(ctx, s, scope.TenantID, userID); err != nil {
+			return err
+		}
+		if err := s.AuthRepository().UpdateMembershipRole(ctx, scope.TenantID, userID, domain.RoleOwner); err != nil {
+			return err
+		}
+		return s.AuthRepository().UpdateMembershipRole(ctx, scope.TenantID, scope.UserID, domain.RoleAdmin)
+	})
+}
+
+func (u *tenantAdminUsecase) ListDomains(ctx context.Context, scope domain.Scope) ([]*domain.TenantDomain, error) {
+	ctx, span := startSpan(ctx, "TenantAdminUsecase.ListDomains", scope)
+	defer span.End()
+
+	if !scope.IsAdmin() {
+		return nil, domain.ErrPermissionDenied
+	}
+	return u.store.AuthRepository().FindTenantDomains(ctx, scope.TenantID)
+}
+
+func (u *tenantAdminUsecase) AddDomain(ctx context.Context, scope domain.Scope, host string) (*domain.TenantDomain, error) {
+	ctx, span := startSpan(ctx, "TenantAdminUsecase.AddDomain", scope)
+	defer span.End()
+
+	if !scope.IsAdmin() {
+		return nil, domain.ErrPermissionDenied
+	}
+	host = strings.ToLower(strings.TrimSpace(host))
+	if !isDomainName(host) {
+		return nil, domain.NewValidationError("host", "must be a domain name such as sns.example.com")
+	}
+	token, err := newRandomToken()
+	if err != nil {
+		return nil, err
+	}
+	var added *domain.TenantDomain
+	err = u.store.ExecTx(ctx, func(s port.Store) error {
+		domains, err := s.AuthRepository().FindTenantDomains(ctx, scope.TenantID)
+		if err != nil {
+			return err
+		}
+		if added = findDomain(domains, host); added != nil {
+			return nil
+		}
+		if len(domains) >= maxTenantDomains {
+			return domain.NewConflictError("tenant domain", "the tenant already has 10 domains")
+		}
+		// Domains added by admins are unverified until the tenant proves it controls host. Other
+		// tenants' claims are not checked, so adding a domain tells nothing about them.
+		if err := s.AuthRepository().AddTenantDomain(ctx, scope.TenantID, &domain.TenantDomain{Host: host, VerificationToken: token}); err != nil {
+			return err
+		}
+		// Read it back for the creation time the repository assigned.
+		if domains, err = s.AuthRepository().FindTenantDomains(ctx, scope.TenantID); err != nil {
+			return err
+		}
+		added = findDomain(domains, host)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return added, nil
+}
+
+func (u *tenantAdminUsecase) VerifyDomain(ctx context.Context, scope domain.Scope, host string) (*domain.TenantDomain, error) {
+	ctx, span := startSpan(ctx, "TenantAdminUsecase.VerifyDomain", scope)
+	defer span.End()
+
+	if !scope.IsAdmin() {
+		return nil, domain.ErrPermissionDenied
+	}
+	host = strings.ToLower(strings.TrimSpace(host))
+	domains, err := u.store.AuthRepository().FindTenantDomains(ctx, scope.TenantID)
+	if err != nil {
+		return nil, err
+	}
+	d := findDomain(domains, host)
+	if d == nil {
+		return nil, domain.NewNotFoundError("tenant domain", nil)
+	}
+	if d.Verified {
+		return d, nil
+	}
+	if d.VerificationToken == "" {
+		return nil, domain.NewFailedPreconditionError("the domain has no challenge; remove and add it again")
+	}
+	// The lookup goes out to DNS, so it is not made inside a transaction.
+	records, err := u.resolver.LookupTXT(ctx, d.ChallengeName())
+	if err != nil {
+		// Resolver errors name servers and addresses, so they stay in the log.
+		slog.WarnContext(ctx, "domain challenge lookup failed", slog.String("host", host), slog.Any("error", err))
+		return nil, domain.NewFailedPreconditionError("TXT record not found yet")
+	}
+	if !containsRecord(records, d.ChallengeValue()) {
+		return nil, domain.NewFailedPreconditionError(fmt.Sprintf("no TXT record %q found at %s", d.ChallengeValue(), d.ChallengeName()))
+	}
+	err = u.store.AuthRepository().VerifyTenantDomain(ctx, scope.TenantID, host)
+	var conflict *domain.ConflictError
+	if errors.As(err, &conflict) {
+		return nil, domain.NewFailedPreconditionError("the domain cannot be verified")
+	} else if err != nil {
+		return nil, err
+	}
+	d.Verified, d.VerificationToken = true, ""
+	return d, nil
+}
+
+func (u *tenantAdminUsecase) RemoveDomain(ctx context.Context, scope domain.Scope, host string) error {
+	ctx, span := startSpan(ctx, "TenantAdminUsecase.RemoveDomain", scope)
+	defer span.End()
+
+	if !scope.IsAdmin() {
+		return domain.ErrPermissionDenied
+	}
+	host = strings.ToLower(strings.TrimSpace(host))
+	return u.store.ExecTx(ctx, func(s port.Store) error {
+		tenant, err := s.AuthRepository().FindTenantByID(ctx, scope.TenantID)
+		if err != nil {
+			return err
+		}
+		if !tenant.AllowSubdomainFallback {
+			domains, err := s.AuthRepository().FindTenantDomains(ctx, scope.TenantID)
+			if err != nil {
+				return err
+			}
+			if d := findDomain(domains, host); d != nil && d.Verified && countVerified(domains) == 1 {
+				return domain.NewFailedPreconditionError("cannot remove the last verified domain while the subdomain fallback is off")
+			}
+		}
+		return s.AuthRepository().RemoveTenantDomain(ctx, scope.TenantID, host)
+	})
+}
+
+func (u *tenantAdminUsecase) SetSubdomainFallback(ctx context.Context, scope domain.Scope, allowed bool) error {
+	ctx, span := startSpan(ctx, "TenantAdminUsecase.SetSubdomainFallback", scope)
+	defer span.End()
+
+	if !scope.IsAdmin() {
+		return domain.ErrPermissionDenied
+	}
+	return u.store.ExecTx(ctx, func(s port.Store) error {
+		if !allowed {
+			domains, err := s.AuthRepository().FindTenantDomains(ctx, scope.TenantID)
+			if err != nil {
+				return err
+			}
+			if countVerified(domains) == 0 {
+				return domain.NewFailedPreconditionError("the tenant needs a verified domain before turning off the subdomain fallback")
+			}
+		}
+		return s.AuthRepository().SetTenantSubdomainFallback(ctx, scope.TenantID, allowed)
+	})
+}
+
+// findDomain returns the domain with host, or nil if there is none.
+func findDomain(domains []*domain.TenantDomain, host string) *domain.TenantDomain {
+	for _, d := range domains {
+		if d.Host == host {
+			return d
+		}
+	}
+	return nil
+}
+
+func countVerified(domains []*domain.TenantDomain) int {
+	n := 0
+	for _, d := range domains {
+		if d.Verified {
+			n++
+		}
+	}
+	return n
+}
+
+// containsRecord reports whether one of the TXT records is value. Resolvers return each record
+// as the concatenation of its strings, so no joining is needed here.
+func containsRecord(records []string, value string) bool {
+	for _, r := range records {
+		if strings.TrimSpace(r) == value {
+			return true
+		}
+	}
+	return false
+}
+
+// memberRole returns the role of userID in the tenant, or a NotFoundError if they are not a member.
+func memberRole(ctx context.Context, s port.Store, tenantID, userID uint64) (string, error) {
+	role, err := s.AuthRepository().FindMembershipRole(ctx, tenantID, userID)
+	if err != nil {
+		return "", err
+	}
+	if role == "" {
+		return "", domain.NewNotFoundError("membership", userID)
+	}
+	return role, nil
+}
+
+// checkNotLastOwner returns a PermissionDeniedError with reason if userID is the tenant's only owner.
+// It locks the owners, so two owners demoting each other at once cannot leave the tenant without
+// one: the second waits for the first to commit and then sees one owner fewer.
+func checkNotLastOwner(ctx context.Context, s port.Store, tenantID, userID uint64, reason string) error {
+	owners, err := s.AuthRepository().LockOwners(ctx, tenantID)
+	if err != nil {
+		return err
+	}
+	if domain.IsLastOwner(owners, userID) {
+		return domain.NewPermissionDeniedError(reason)
+	}
+	return nil
+}
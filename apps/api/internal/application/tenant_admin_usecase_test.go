@@ -0,0 +1,375 @@
+package application_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/example/something-like-sns/apps/api/internal/adapter/dns"
+	"github.com/example/something-like-sns/apps/api/internal/adapter/repository/memory"
+	"github.com/example/something-like-sns/apps/api/internal/application"
+	"github.com/example/something-like-sns/apps/api/internal/domain"
+)
+
+func TestTenantAdminUsecase_Tenant(t *testing.T) {
+	ctx := context.Background()
+	store := memory.NewStore()
+	u := application.NewTenantAdminUsecase(store, dns.NewFakeResolver())
+	scopes := newTenant(t, store, "acme", 2)
+	owner, member := scopes[0], scopes[1]
+
+	var denied *domain.PermissionDeniedError
+	if _, err := u.GetTenant(ctx, member); !errors.As(err, &denied) {
+		t.Errorf("GetTenant(member): err = %v, want PermissionDeniedError", err)
+	}
+	if _, err := u.UpdateTenant(ctx, member, "Acme"); !errors.As(err, &denied) {
+		t.Errorf("UpdateTenant(member): err = %v, want PermissionDeniedError", err)
+	}
+	var invalid *domain.ValidationError
+	for _, name := range []string{" ", strings.Repeat("あ", 129)} {
+		if _, err := u.UpdateTenant(ctx, owner, name); !errors.As(err, &invalid) {
+			t.Errorf("UpdateTenant(%q): err = %v, want ValidationError", name, err)
+		}
+	}
+	if got, err := u.UpdateTenant(ctx, owner, "  Acme Inc  "); err != nil || got.Name != "Acme Inc" || got.Slug != "acme" {
+		t.Fatalf("UpdateTenant = %+v, %v; want acme named Acme Inc", got, err)
+	}
+	if got, err := u.GetTenant(ctx, owner); err != nil || got.Name != "Acme Inc" {
+		t.Errorf("GetTenant = %+v, %v; want Acme Inc", got, err)
+	}
+}
+
+func TestTenantAdminUsecase_Members(t *testing.T) {
+	ctx := context.Background()
+	store := memory.NewStore()
+	u := application.NewTenantAdminUsecase(store, dns.NewFakeResolver())
+	scopes := newTenant(t, store, "acme", 4)
+	owner, admin, carol, dave := scopes[0], scopes[1], scopes[2], scopes[3]
+	if err := u.UpdateMemberRole(ctx, owner, admin.UserID, domain.RoleAdmin); err != nil {
+		t.Fatalf("UpdateMemberRole(admin): %v", err)
+	}
+	admin.Role = domain.RoleAdmin
+
+	var denied *domain.PermissionDeniedError
+	if _, err := u.ListTenantMembers(ctx, carol); !errors.As(err, &denied) {
+		t.Errorf("ListTenantMembers(member): err = %v, want PermissionDeniedError", err)
+	}
+	members, err := u.ListTenantMembers(ctx, admin)
+	if err != nil || len(members) != 4 || members[1].Role != domain.RoleAdmin || members[0].JoinedAt.IsZero() {
+		t.Fatalf("ListTenantMembers = %v, %v; want 4 members with join times", members, err)
+	}
+
+	if err := u.UpdateMemberRole(ctx, carol, dave.UserID, domain.RoleAdmin); !errors.As(err, &denied) {
+		t.Errorf("UpdateMemberRole(by member): err = %v, want PermissionDeniedError", err)
+	}
+	if err := u.UpdateMemberRole(ctx, admin, carol.UserID, domain.RoleOwner); !errors.As(err, &denied) {
+		t.Errorf("UpdateMemberRole(admin makes owner): err = %v, want PermissionDeniedError", err)
+	}
+	if err := u.UpdateMemberRole(ctx, admin, owner.UserID, domain.RoleMember); !errors.As(err, &denied) {
+		t.Errorf("UpdateMemberRole(admin demotes owner): err = %v, want PermissionDeniedError", err)
+	}
+	if err := u.UpdateMemberRole(ctx, owner, owner.UserID, domain.RoleAdmin); !errors.As(err, &denied) {
+		t.Errorf("UpdateMemberRole(last owner): err = %v, want PermissionDeniedError", err)
+	}
+	var invalid *domain.ValidationError
+	if err := u.UpdateMemberRole(ctx, owner, carol.UserID, "guest"); !errors.As(err, &invalid) {
+		t.Errorf("UpdateMemberRole(guest): err = %v, want ValidationError", err)
+	}
+	var notFound *domain.NotFoundError
+	outsider := newTenant(t, store, "other", 1)[0]
+	if err := u.UpdateMemberRole(ctx, owner, outsider.UserID, domain.RoleMember); !errors.As(err, &notFound) {
+		t.Errorf("UpdateMemberRole(non-member): err = %v, want NotFoundError", err)
+	}
+	if err := u.UpdateMemberRole(ctx, admin, carol.UserID, domain.RoleAdmin); err != nil {
+		t.Fatalf("UpdateMemberRole: %v", err)
+	}
+	if role, _ := store.AuthRepository().FindMembershipRole(ctx, owner.TenantID, carol.UserID); role != domain.RoleAdmin {
+		t.Errorf("carol's role = %q, want admin", role)
+	}
+
+	if err := u.RemoveMember(ctx, admin, owner.UserID); !errors.As(err, &denied) {
+		t.Errorf("RemoveMember(admin removes owner): err = %v, want PermissionDeniedError", err)
+	}
+	if err := u.RemoveMember(ctx, owner, owner.UserID); !errors.As(err, &denied) {
+		t.Errorf("RemoveMember(last owner): err = %v, want PermissionDeniedError", err)
+	}
+	if err := u.RemoveMember(ctx, admin, dave.UserID); err != nil {
+		t.Fatalf("RemoveMember: %v", err)
+	}
+	if role, _ := store.AuthRepository().FindMembershipRole(ctx, owner.TenantID, dave.UserID); role != "" {
+		t.Errorf("dave's role after removal = %q, want none", role)
+	}
+	if err := u.RemoveMember(ctx, admin, dave.UserID); !errors.As(err, &notFound) {
+		t.Errorf("RemoveMember twice: err = %v, want NotFoundError", err)
+	}
+
+	// With a second owner, the first can step down.
+	if err := u.UpdateMemberRole(ctx, owner, carol.UserID, domain.RoleOwner); err != nil {
+		t.Fatalf("UpdateMemberRole(owner): %v", err)
+	}
+	if err := u.UpdateMemberRole(ctx, owner, owner.UserID, domain.RoleMember); err != nil {
+		t.Errorf("UpdateMemberRole(owner steps down): %v", err)
+	}
+}
+
+func TestTenantAdminUsecase_RacingDemotions(t *testing.T) {
+	ctx := context.Background()
+	store := memory.NewStore()
+	u := application.NewTenantAdminUsecase(store, dns.NewFakeResolver())
+	scopes := newTenant(t, store, "acme", 2)
+	alice, bob := scopes[0], scopes[1]
+	if err := u.UpdateMemberRole(ctx, alice, bob.UserID, domain.RoleOwner); err != nil {
+		t.Fatalf("UpdateMemberRole(owner): %v", err)
+	}
+	bob.Role = domain.RoleOwner
+
+	// Each owner demotes the other at once; only one may win.
+	var wg sync.WaitGroup
+	errs := make([]error, 2)
+	for i, pair := range [][2]domain.Scope{{alice, bob}, {bob, alice}} {
+		wg.Add(1)
+		go func(i int, by, target domain.Scope) {
+			defer wg.Done()
+			errs[i] = u.UpdateMemberRole(ctx, by, target.UserID, domain.RoleAdmin)
+		}(i, pair[0], pair[1])
+	}
+	wg.Wait()
+
+	var denied *domain.PermissionDeniedError
+	if (errs[0] == nil) == (errs[1] == nil) || !errors.As(errors.Join(errs...), &denied) {
+		t.Errorf("racing demotions = %v; want one success and one PermissionDeniedError", errs)
+	}
+	members, err := u.ListTenantMembers(ctx, alice)
+	if err != nil {
+		t.Fatalf("ListTenantMembers: %v", err)
+	}
+	owners := 0
+	for _, m := range members {
+		if m.Role == domain.RoleOwner {
+			owners++
+		}
+	}
+	if owners != 1 {
+		t.Errorf("owners after racing demotions = %d, want 1", owners)
+	}
+}
+
+func TestTenantAdminUsecase_TransferOwnership(t *testing.T) {
+	ctx := context.Background()
+	store := memory.NewStore()
+	u := application.NewTenantAdminUsecase(store, dns.NewFakeResolver())
+	scopes := newTenant(t, store, "acme", 2)
+	owner, member := scopes[0], scopes[1]
+	admin := member
+	admin.Role = domain.RoleAdmin
+
+	var denied *domain.PermissionDeniedError
+	if err := u.TransferOwnership(ctx, admin, owner.UserID); !errors.As(err, &denied) {
+		t.Errorf("TransferOwnership(admin): err = %v, want PermissionDeniedError", err)
+	}
+	var invalid *domain.ValidationError
+	if err := u.TransferOwnership(ctx, owner, owner.UserID); !errors.As(err, &invalid) {
+		t.Errorf("TransferOwnership(self): err = %v, want ValidationError", err)
+	}
+	var notFound *domain.NotFoundError
+	outsider := newTenant(t, store, "other", 1)[0]
+	if err := u.TransferOwnership(ctx, owner, outsider.UserID); !errors.As(err, &notFound) {
+		t.Errorf("TransferOwnership(non-member): err = %v, want NotFoundError", err)
+	}
+
+	if err := u.TransferOwnership(ctx, owner, member.UserID); err != nil {
+		t.Fatalf("TransferOwnership: %v", err)
+	}
+	auth := store.AuthRepository()
+	if role, _ := auth.FindMembershipRole(ctx, owner.TenantID, member.UserID); role != domain.RoleOwner {
+		t.Errorf("new owner's role = %q, want owner", role)
+	}
+	if role, _ := auth.FindMembershipRole(ctx, owner.TenantID, owner.UserID); role != domain.RoleAdmin {
+		t.Errorf("previous owner's role = %q, want admin", role)
+	}
+}
+
+func TestTenantAdminUsecase_Domains(t *testing.T) {
+	ctx := context.Background()
+	store := memory.NewStore()
+	resolver := dns.NewFakeResolver()
+	u := application.NewTenantAdminUsecase(store, resolver)
+	scopes := newTenant(t, store, "acme", 2)
+	owner, member := scopes[0], scopes[1]
+	other := newTenant(t, store, "other", 1)[0]
+
+	var denied *domain.PermissionDeniedError
+	if _, err := u.AddDomain(ctx, member, "sns.acme.example"); !errors.As(err, &denied) {
+		t.Errorf("AddDomain(member): err = %v, want PermissionDeniedError", err)
+	}
+	var invalid *domain.ValidationError
+	for _, host := range []string{"localhost", "sns.acme.example:8080", "https://sns.acme.example"} {
+		if _, err := u.AddDomain(ctx, owner, host); !errors.As(err, &invalid) {
+			t.Errorf("AddDomain(%q): err = %v, want ValidationError", host, err)
+		}
+	}
+	added, err := u.AddDomain(ctx, owner, " SNS.Acme.example ")
+	if err != nil {
+		t.Fatalf("AddDomain: %v", err)
+	}
+	if added.Host != "sns.acme.example" || added.Verified || added.CreatedAt.IsZero() ||
+		added.ChallengeName() != "_sns-challenge.sns.acme.example" || !strings.HasPrefix(added.ChallengeValue(), "sns-domain-verification=") {
+		t.Errorf("AddDomain = %+v, want sns.acme.example pending with a challenge", added)
+	}
+	if again, err := u.AddDomain(ctx, owner, "sns.acme.example"); err != nil || again.ChallengeValue() != added.ChallengeValue() {
+		t.Errorf("AddDomain again = %+v, %v; want the same challenge", again, err)
+	}
+	// Another tenant's claim neither blocks nor reveals this one.
+	claim, err := u.AddDomain(ctx, other, "sns.acme.example")
+	if err != nil || claim.ChallengeValue() == added.ChallengeValue() {
+		t.Fatalf("AddDomain(domain claimed by another tenant) = %+v, %v; want its own challenge", claim, err)
+	}
+	domains, err := u.ListDomains(ctx, owner)
+	if err != nil || len(domains) != 1 || domains[0].Host != "sns.acme.example" || domains[0].Verified {
+		t.Fatalf("ListDomains = %v, %v; want sns.acme.example unverified", domains, err)
+	}
+	// Until its challenge is published, the domain neither verifies nor resolves to the tenant.
+	var precondition *domain.FailedPreconditionError
+	if _, err := u.VerifyDomain(ctx, owner, "sns.acme.example"); !errors.As(err, &precondition) {
+		t.Errorf("VerifyDomain without the TXT record: err = %v, want FailedPreconditionError", err)
+	}
+	resolver.Set(added.ChallengeName(), "v=spf1 -all", "sns-domain-verification=wrong")
+	if _, err := u.VerifyDomain(ctx, owner, "sns.acme.example"); !errors.As(err, &precondition) {
+		t.Errorf("VerifyDomain with another token: err = %v, want FailedPreconditionError", err)
+	}
+	if _, err := store.AuthRepository().FindTenantByHost(ctx, "sns.acme.example"); err == nil {
+		t.Error("FindTenantByHost resolved an unverified domain")
+	}
+	resolver.Set(added.ChallengeName(), "v=spf1 -all", added.ChallengeValue())
+	var notFound *domain.NotFoundError
+	if _, err := u.VerifyDomain(ctx, other, "unclaimed.acme.example"); !errors.As(err, &notFound) {
+		t.Errorf("VerifyDomain(domain the tenant has not added): err = %v, want NotFoundError", err)
+	}
+	if _, err := u.VerifyDomain(ctx, member, "sns.acme.example"); !errors.As(err, &denied) {
+		t.Errorf("VerifyDomain(member): err = %v, want PermissionDeniedError", err)
+	}
+	// Verifying again after the record is gone keeps the domain verified.
+	for i := 0; i < 2; i++ {
+		if d, err := u.VerifyDomain(ctx, owner, "SNS.acme.example"); err != nil || !d.Verified {
+			t.Fatalf("VerifyDomain = %+v, %v; want verified", d, err)
+		}
+		resolver.Set(added.ChallengeName())
+	}
+	if got, err := store.AuthRepository().FindTenantByHost(ctx, "sns.acme.example"); err != nil || got.ID != owner.TenantID {
+		t.Errorf("FindTenantByHost(verified domain) = %+v, %v; want tenant %d", got, err, owner.TenantID)
+	}
+	resolver.Set(claim.ChallengeName(), claim.ChallengeValue())
+	if _, err := u.VerifyDomain(ctx, other, "sns.acme.example"); !errors.As(err, &precondition) {
+		t.Errorf("VerifyDomain(domain verified by another tenant): err = %v, want FailedPreconditionError", err)
+	}
+	resolver.Set(claim.ChallengeName())
+
+	if err := u.RemoveDomain(ctx, other, "unclaimed.acme.example"); !errors.As(err, &notFound) {
+		t.Errorf("RemoveDomain(domain the tenant has not added): err = %v, want NotFoundError", err)
+	}
+	// Withdrawing the other tenant's claim leaves the verified domain alone.
+	if err := u.RemoveDomain(ctx, other, "sns.acme.example"); err != nil {
+		t.Fatalf("RemoveDomain(other tenant's claim): %v", err)
+	}
+	if got, err := store.AuthRepository().FindTenantByHost(ctx, "sns.acme.example"); err != nil || got.ID != owner.TenantID {
+		t.Errorf("FindTenantByHost after the other claim is removed = %+v, %v; want tenant %d", got, err, owner.TenantID)
+	}
+	if err := u.RemoveDomain(ctx, owner, "sns.acme.example"); err != nil {
+		t.Fatalf("RemoveDomain: %v", err)
+	}
+	for i := 0; i < 10; i++ {
+		if _, err := u.AddDomain(ctx, owner, strings.Repeat("a", i+1)+".acme.example"); err != nil {
+			t.Fatalf("AddDomain %d: %v", i, err)
+		}
+	}
+	var conflict *domain.ConflictError
+	if _, err := u.AddDomain(ctx, owner, "more.acme.example"); !errors.As(err, &conflict) {
+		t.Errorf("AddDomain(11th): err = %v, want ConflictError", err)
+	}
+}
+
+// failingResolver fails every lookup with an error that names the DNS server.
+type failingResolver struct{}
+
+func (failingResolver) LookupTXT(ctx context.Context, name string) ([]string, error) {
+	return nil, errors.New("lookup " + name + " on 10.0.0.53:53: server misbehaving")
+}
+
+func TestTenantAdminUsecase_VerifyDomainLookupError(t *testing.T) {
+	ctx := context.Background()
+	store := memory.NewStore()
+	u := application.NewTenantAdminUsecase(store, failingResolver{})
+	owner := newTenant(t, store, "acme", 1)[0]
+	if _, err := u.AddDomain(ctx, owner, "sns.acme.example"); err != nil {
+		t.Fatalf("AddDomain: %v", err)
+	}
+
+	// The resolver's error is logged, not returned: it would tell the caller about the network.
+	var precondition *domain.FailedPreconditionError
+	_, err := u.VerifyDomain(ctx, owner, "sns.acme.example")
+	if !errors.As(err, &precondition) || precondition.Reason != "TXT record not found yet" {
+		t.Errorf("VerifyDomain with a failing resolver: err = %v, want FailedPreconditionError \"TXT record not found yet\"", err)
+	}
+}
+
+func TestTenantAdminUsecase_SubdomainFallback(t *testing.T) {
+	ctx := context.Background()
+	store := memory.NewStore()
+	resolver := dns.NewFakeResolver()
+	u := application.NewTenantAdminUsecase(store, resolver)
+	scopes := newTenant(t, store, "acme", 2)
+	owner, member := scopes[0], scopes[1]
+	auth := store.AuthRepository()
+
+	if tenant, err := u.GetTenant(ctx, owner); err != nil || !tenant.AllowSubdomainFallback {
+		t.Fatalf("GetTenant = %+v, %v; want the subdomain fallback allowed", tenant, err)
+	}
+	var denied *domain.PermissionDeniedError
+	if err := u.SetSubdomainFallback(ctx, member, false); !errors.As(err, &denied) {
+		t.Errorf("SetSubdomainFallback(member): err = %v, want PermissionDeniedError", err)
+	}
+	// Without a verified domain the tenant could not be reached at all.
+	var precondition *domain.FailedPreconditionError
+	if err := u.SetSubdomainFallback(ctx, owner, false); !errors.As(err, &precondition) {
+		t.Errorf("SetSubdomainFallback(false) without a verified domain: err = %v, want FailedPreconditionError", err)
+	}
+	d, err := u.AddDomain(ctx, owner, "sns.acme.example")
+	if err != nil {
+		t.Fatalf("AddDomain: %v", err)
+	}
+	resolver.Set(d.ChallengeName(), d.ChallengeValue())
+	if _, err := u.VerifyDomain(ctx, owner, d.Host); err != nil {
+		t.Fatalf("VerifyDomain: %v", err)
+	}
+	if _, err := u.AddDomain(ctx, owner, "pending.acme.example"); err != nil {
+		t.Fatalf("AddDomain: %v", err)
+	}
+	if err := u.SetSubdomainFallback(ctx, owner, false); err != nil {
+		t.Fatalf("SetSubdomainFallback(false): %v", err)
+	}
+	if _, err := auth.FindTenantByHost(ctx, "acme.localhost"); err == nil {
+		t.Error("FindTenantByHost resolved the slug subdomain with the fallback off")
+	}
+	if got, err := auth.FindTenantByHost(ctx, d.Host); err != nil || got.ID != owner.TenantID {
+		t.Errorf("FindTenantByHost(verified domain) = %+v, %v; want tenant %d", got, err, owner.TenantID)
+	}
+
+	// Pending domains can go, but not the last verified one.
+	if err := u.RemoveDomain(ctx, owner, "pending.acme.example"); err != nil {
+		t.Errorf("RemoveDomain(pending): %v", err)
+	}
+	if err := u.RemoveDomain(ctx, owner, d.Host); !errors.As(err, &precondition) {
+		t.Errorf("RemoveDomain(last verified domain): err = %v, want FailedPreconditionError", err)
+	}
+	if err := u.SetSubdomainFallback(ctx, owner, true); err != nil {
+		t.Fatalf("SetSubdomainFallback(true): %v", err)
+	}
+	if err := u.RemoveDomain(ctx, owner, d.Host); err != nil {
+		t.Errorf("RemoveDomain with the fallback on: %v", err)
+	}
+	if got, err := auth.FindTenantByHost(ctx, "acme.localhost"); err != nil || got.ID != owner.TenantID {
+		t.Errorf("FindTenantByHost(slug subdomain) = %+v, %v; want tenant %d", got, err, owner.TenantID)
+	}
+}
@@ -2,7 +2,6 @@ package application
 
 import (
 	"context"
-	"errors"
 	"strings"
 
 	"github.com/example/something-like-sns/apps/api/internal/domain"
@@ -19,56 +18,108 @@ func NewTimelineUsecase(store port.Store, ce port.CursorEncoder) port.TimelineUs
 }
 
 func (u *timelineUsecase) CreatePost(ctx context.Context, scope domain.Scope, body string) (*domain.Post, error) {
+	ctx, span := startSpan(ctx, "TimelineUsecase.CreatePost", scope)
+	defer span.End()
+
 	body = strings.TrimSpace(body)
-	if body == "" || len(body) > 2000 {
-		return nil, errors.New("invalid body")
+	if body == "" || len(body) > maxBodyLength {
+		return nil, errInvalidBody
+	}
+	var post *domain.Post
+	err := u.store.ExecTx(ctx, func(s port.Store) error {
+		var err error
+		if post, err = s.TimelineRepository().CreatePost(ctx, scope.TenantID, scope.UserID, body); err != nil {
+			return err
+		}
+		return checkQuota(ctx, s, scope.TenantID, domain.QuotaActionPost)
+	})
+	if err != nil {
+		return nil, err
 	}
-	return u.store.TimelineRepository().CreatePost(ctx, scope.TenantID, scope.UserID, body)
+	if err := attachPostAuthors(ctx, u.store, scope.TenantID, post); err != nil {
+		return nil, err
+	}
+	return post, nil
 }
 
-func (u *timelineUsecase) ListFeed(ctx context.Context, scope domain.Scope, token string) ([]*domain.Post, string, error) {
-	const limit = 20
-	cursorTime, cursorID, err := u.cursorEncoder.Decode(token)
+func (u *timelineUsecase) ListFeed(ctx context.Context, scope domain.Scope, page domain.PageParams) ([]*domain.Post, *domain.PageInfo, error) {
+	ctx, span := startSpan(ctx, "TimelineUsecase.ListFeed", scope)
+	defer span.End()
+
+	limit := feedPageLimits.size(page.Size)
+	cursor, err := decodeCursor(u.cursorEncoder, scope, cursorKindFeed, page.Token)
 	if err != nil {
-		return nil, "", err
+		return nil, nil, err
 	}
 
-	posts, err := u.store.TimelineRepository().FindFeed(ctx, scope.TenantID, scope.UserID, limit, cursorTime, cursorID)
+	posts, hasMore, err := u.store.TimelineRepository().FindFeed(ctx, scope.TenantID, scope.UserID, limit, cursor)
 	if err != nil {
-		return nil, "", err
+		return nil, nil, err
 	}
-
-	var nextToken string
-	if len(posts) == limit {
-		lastPost := posts[len(posts)-1]
-		nextToken = u.cursorEncoder.Encode(lastPost.CreatedAt, lastPost.ID)
+	if err := attachPostAuthors(ctx, u.store, scope.TenantID, posts...); err != nil {
+		return nil, nil, err
 	}
 
-	return posts, nextToken, nil
+	return posts, newPageInfo(u.cursorEncoder, scope, cursorKindFeed, posts, hasMore, cursor, postKey), nil
 }
 
 func (u *timelineUsecase) CreateComment(ctx context.Context, scope domain.Scope, postID uint64, body string) (*domain.Comment, error) {
+	ctx, span := startSpan(ctx, "TimelineUsecase.CreateComment", scope)
+	defer span.End()
+
 	body = strings.TrimSpace(body)
-	if body == "" || len(body) > 2000 {
-		return nil, errors.New("invalid body")
+	if body == "" || len(body) > maxBodyLength {
+		return nil, errInvalidBody
+	}
+	post, err := u.store.TimelineRepository().FindPostByID(ctx, scope.TenantID, postID)
+	if err != nil {
+		return nil, err
 	}
-	return u.store.TimelineRepository().CreateComment(ctx, scope.TenantID, postID, scope.UserID, body)
+	if err := checkNotBlocked(ctx, u.store, scope.TenantID, scope.UserID, post.AuthorUserID); err != nil {
+		return nil, err
+	}
+	var comment *domain.Comment
+	err = u.store.ExecTx(ctx, func(s port.Store) error {
+		var err error
+		if comment, err = s.TimelineRepository().CreateComment(ctx, scope.TenantID, postID, scope.UserID, body); err != nil {
+			return err
+		}
+		return checkQuota(ctx, s, scope.TenantID, domain.QuotaActionComment)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := attachCommentAuthors(ctx, u.store, scope.TenantID, comment); err != nil {
+		return nil, err
+	}
+	return comment, nil
 }
 
-func (u *timelineUsecase) ListComments(ctx context.Context, scope domain.Scope, postID uint64, token string) ([]*domain.Comment, string, error) {
-    const limit = 50
-    cursorTime, cursorID, err := u.cursorEncoder.Decode(token)
-    if err != nil {
-        return nil, "", err
-    }
-    comments, err := u.store.TimelineRepository().FindCommentsByPostID(ctx, scope.TenantID, postID, limit, cursorTime, cursorID)
-    if err != nil {
-        return nil, "", err
-    }
-    var nextToken string
-    if len(comments) == limit {
-        last := comments[len(comments)-1]
-        nextToken = u.cursorEncoder.Encode(last.CreatedAt, last.ID)
-    }
-    return comments, nextToken, nil
+func (u *timelineUsecase) ListComments(ctx context.Context, scope domain.Scope, postID uint64, page domain.PageParams) ([]*domain.Comment, *domain.PageInfo, error) {
+	ctx, span := startSpan(ctx, "TimelineUsecase.ListComments", scope)
+	defer span.End()
+
+	limit := commentPageLimits.size(page.Size)
+	kind := cursorKindComments(postID)
+	cursor, err := decodeCursor(u.cursorEncoder, scope, kind, page.Token)
+	if err != nil {
+		return nil, nil, err
+	}
+	if _, err := u.store.TimelineRepository().FindPostByID(ctx, scope.TenantID, postID); err != nil {
+		return nil, nil, err
+	}
+	comments, hasMore, err := u.store.TimelineRepository().FindCommentsByPostID(ctx, scope.TenantID, postID, limit, cursor)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := attachCommentAuthors(ctx, u.store, scope.TenantID, comments...); err != nil {
+		return nil, nil, err
+	}
+	info := newPageInfo(u.cursorEncoder, scope, kind, comments, hasMore, cursor, commentKey)
+	if page.IncludeTotal {
+		if info.Total, err = u.store.TimelineRepository().CountComments(ctx, scope.TenantID, postID); err != nil {
+			return nil, nil, err
+		}
+	}
+	return comments, info, nil
 }
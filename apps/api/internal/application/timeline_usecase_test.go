@@ -0,0 +1,156 @@
+package application_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/example/something-like-sns/apps/api/internal/adapter/cursor"
+	"github.com/example/something-like-sns/apps/api/internal/adapter/repository/memory"
+	"github.com/example/something-like-sns/apps/api/internal/application"
+	"github.com/example/something-like-sns/apps/api/internal/domain"
+	"github.com/example/something-like-sns/apps/api/internal/port"
+)
+
+// newTenant creates a store holding a tenant with the given number of members and
+// returns their scopes, the first one being the owner.
+func newTenant(t *testing.T, store port.Store, slug string, members int) []domain.Scope {
+	t.Helper()
+	ctx := context.Background()
+	auth := store.AuthRepository()
+	tenant, err := auth.CreateTenant(ctx, slug, slug)
+	if err != nil {
+		t.Fatalf("CreateTenant: %v", err)
+	}
+	scopes := make([]domain.Scope, members)
+	for i := range scopes {
+		userID, err := auth.FindOrCreateUser(ctx, fmt.Sprintf("%s-user-%d", slug, i), "User")
+		if err != nil {
+			t.Fatalf("FindOrCreateUser: %v", err)
+		}
+		role := domain.RoleMember
+		if i == 0 {
+			role = domain.RoleOwner
+		}
+		if err := auth.EnsureMembership(ctx, tenant.ID, userID, role); err != nil {
+			t.Fatalf("EnsureMembership: %v", err)
+		}
+		scopes[i] = domain.Scope{TenantID: tenant.ID, UserID: userID, Role: role}
+	}
+	return scopes
+}
+
+func TestTimelineUsecase_ListFeedPaging(t *testing.T) {
+	ctx := context.Background()
+	store := memory.NewStore()
+	u := application.NewTimelineUsecase(store, cursor.NewHMACEncoder([]byte("test")))
+	acme := newTenant(t, store, "acme", 1)[0]
+	beta := newTenant(t, store, "beta", 1)[0]
+
+	for i := 0; i < 5; i++ {
+		if _, err := u.CreatePost(ctx, acme, fmt.Sprint("post ", i)); err != nil {
+			t.Fatalf("CreatePost: %v", err)
+		}
+	}
+
+	var bodies []string
+	var pages int
+	page := domain.PageParams{Size: 2}
+	for {
+		posts, info, err := u.ListFeed(ctx, acme, page)
+		if err != nil {
+			t.Fatalf("ListFeed: %v", err)
+		}
+		pages++
+		for _, p := range posts {
+			bodies = append(bodies, p.Body)
+		}
+		if info.HasMore != (info.Next != "") {
+			t.Errorf("page %d: HasMore = %v with next cursor %q", pages, info.HasMore, info.Next)
+		}
+		if (pages > 1) != (info.Prev != "") {
+			t.Errorf("page %d: prev cursor %q", pages, info.Prev)
+		}
+		if info.Next == "" {
+			break
+		}
+		page.Token = info.Next
+	}
+	if got, want := strings.Join(bodies, ","), "post 4,post 3,post 2,post 1,post 0"; got != want || pages != 3 {
+		t.Errorf("paged through %q in %d pages, want %q in 3", got, pages, want)
+	}
+
+	_, first, err := u.ListFeed(ctx, acme, domain.PageParams{Size: 2})
+	if err != nil {
+		t.Fatalf("ListFeed: %v", err)
+	}
+	var invalid *domain.ValidationError
+	if _, _, err := u.ListFeed(ctx, beta, domain.PageParams{Token: first.Next}); !errors.As(err, &invalid) {
+		t.Errorf("ListFeed with another tenant's cursor: err = %v, want ValidationError", err)
+	}
+	if _, _, err := u.ListFeed(ctx, acme, domain.PageParams{Token: first.Next + "x"}); !errors.As(err, &invalid) {
+		t.Errorf("ListFeed with a tampered cursor: err = %v, want ValidationError", err)
+	}
+}
+
+func TestTimelineUsecase_PageSizeIsCapped(t *testing.T) {
+	ctx := context.Background()
+	store := memory.NewStore()
+	u := application.NewTimelineUsecase(store, cursor.NewHMACEncoder([]byte("test")))
+	scope := newTenant(t, store, "acme", 1)[0]
+
+	for i := 0; i < 101; i++ {
+		if _, err := store.TimelineRepository().CreatePost(ctx, scope.TenantID, scope.UserID, "post"); err != nil {
+			t.Fatalf("CreatePost: %v", err)
+		}
+	}
+	for _, tc := range []struct {
+		size uint32
+		want int
+	}{{0, 20}, {7, 7}, {1000, 100}} {
+		posts, info, err := u.ListFeed(ctx, scope, domain.PageParams{Size: tc.size})
+		if err != nil || len(posts) != tc.want || !info.HasMore {
+			t.Errorf("ListFeed(page_size %d) = %d posts, %+v, %v; want %d with more", tc.size, len(posts), info, err, tc.want)
+		}
+	}
+}
+
+func TestTimelineUsecase_Comments(t *testing.T) {
+	ctx := context.Background()
+	store := memory.NewStore()
+	u := application.NewTimelineUsecase(store, cursor.NewHMACEncoder([]byte("test")))
+	scopes := newTenant(t, store, "acme", 2)
+	beta := newTenant(t, store, "beta", 1)[0]
+
+	post, err := u.CreatePost(ctx, scopes[0], "post")
+	if err != nil {
+		t.Fatalf("CreatePost: %v", err)
+	}
+	var invalid *domain.ValidationError
+	if _, err := u.CreateComment(ctx, scopes[1], post.ID, "  "); !errors.As(err, &invalid) {
+		t.Errorf("CreateComment with a blank body: err = %v, want ValidationError", err)
+	}
+	var notFound *domain.NotFoundError
+	if _, err := u.CreateComment(ctx, beta, post.ID, "hi"); !errors.As(err, &notFound) {
+		t.Errorf("CreateComment on another tenant's post: err = %v, want NotFoundError", err)
+	}
+	for i := 0; i < 3; i++ {
+		if _, err := u.CreateComment(ctx, scopes[1], post.ID, fmt.Sprint("comment ", i)); err != nil {
+			t.Fatalf("CreateComment: %v", err)
+		}
+	}
+
+	comments, info, err := u.ListComments(ctx, scopes[0], post.ID, domain.PageParams{Size: 2, IncludeTotal: true})
+	if err != nil || len(comments) != 2 || !info.HasMore || info.Total != 3 {
+		t.Fatalf("ListComments = %d comments, %+v, %v; want 2 of 3 with more", len(comments), info, err)
+	}
+	other, err := u.CreatePost(ctx, scopes[0], "other")
+	if err != nil {
+		t.Fatalf("CreatePost: %v", err)
+	}
+	if _, _, err := u.ListComments(ctx, scopes[0], other.ID, domain.PageParams{Token: info.Next}); !errors.As(err, &invalid) {
+		t.Errorf("ListComments with another post's cursor: err = %v, want ValidationError", err)
+	}
+}
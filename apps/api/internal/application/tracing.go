@@ -0,0 +1,21 @@
+package application
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/example/something-like-sns/apps/api/internal/domain"
+)
+
+var tracer = otel.Tracer("github.com/example/something-like-sns/apps/api/internal/application")
+
+// startSpan starts a span for a usecase method, tagged with the tenant and user of scope.
+func startSpan(ctx context.Context, name string, scope domain.Scope) (context.Context, trace.Span) {
+	return tracer.Start(ctx, name, trace.WithAttributes(
+		attribute.Int64("sns.tenant_id", int64(scope.TenantID)),
+		attribute.Int64("enduser.id", int64(scope.UserID)),
+	))
+}
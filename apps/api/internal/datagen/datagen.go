@@ -0,0 +1,432 @@
+// Package datagen generates synthetic tenants, users, posts, comments, reactions and DMs for load
+// and pagination tests, and bulk-loads them through port.BulkRepository.
+//
+// User activity and popularity follow Zipf distributions: a few users write most posts,
+// comments and messages, and a few users and posts receive most comments, likes and DMs. The
+// data is a function of Config alone, so the same seed and end time reproduce it exactly.
+package datagen
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"strings"
+	"time"
+
+	"github.com/example/something-like-sns/apps/api/internal/domain"
+	"github.com/example/something-like-sns/apps/api/internal/port"
+)
+
+// Config sizes the generated data. Counts other than Tenants are per tenant.
+type Config struct {
+	Seed uint64
+	// Prefix starts the tenant slugs ("<prefix>-1", ...) and auth subjects, so several data
+	// sets can be loaded into one database.
+	Prefix        string
+	Tenants       int
+	Users         int
+	Posts         int
+	Comments      int
+	Reactions     int
+	Conversations int
+	Messages      int
+	// Timestamps are spread over the Span before End.
+	End  time.Time
+	Span time.Duration
+	// ZipfS is the Zipf exponent, above 1. Higher values concentrate activity on fewer users.
+	ZipfS     float64
+	BatchSize int
+	// Progress, if set, is called after every batch.
+	Progress func(Progress)
+}
+
+// Progress reports how many rows of a table have been loaded for a tenant.
+type Progress struct {
+	Tenant      string
+	Table       string
+	Done, Total int
+}
+
+// ErrExists is returned when a tenant to generate already exists.
+var ErrExists = errors.New("tenant already exists")
+
+func (c Config) validate() error {
+	switch {
+	case c.Prefix == "":
+		return domain.NewValidationError("prefix", "is required")
+	case c.Tenants < 1:
+		return domain.NewValidationError("tenants", "must be at least 1")
+	case c.Users < 2:
+		return domain.NewValidationError("users", "must be at least 2")
+	case c.Posts < 0 || c.Comments < 0 || c.Reactions < 0 || c.Conversations < 0 || c.Messages < 0:
+		return domain.NewValidationError("counts", "must not be negative")
+	case c.Messages > 0 && c.Conversations == 0:
+		return domain.NewValidationError("messages", "need conversations")
+	case c.Span <= 0:
+		return domain.NewValidationError("span", "must be positive")
+	case c.ZipfS <= 1:
+		return domain.NewValidationError("zipf", "must be above 1")
+	case c.BatchSize < 1:
+		return domain.NewValidationError("batch", "must be at least 1")
+	}
+	return nil
+}
+
+// Run generates and loads the data. The tenants must not exist yet, and nothing else may write
+// to the database meanwhile, as IDs are allocated ahead of the inserts.
+func Run(ctx context.Context, store port.Store, cfg Config) error {
+	if err := cfg.validate(); err != nil {
+		return err
+	}
+	bulk := store.BulkRepository()
+	ids := map[string]uint64{}
+	for _, table := range []string{"users", "posts", "comments", "conversations", "messages"} {
+		next, err := bulk.NextID(ctx, table)
+		if err != nil {
+			return err
+		}
+		ids[table] = next
+	}
+	for i := 0; i < cfg.Tenants; i++ {
+		g := &generator{
+			cfg:  cfg,
+			bulk: bulk,
+			ids:  ids,
+			// Each tenant has its own stream, so its data does not depend on the other tenants.
+			rng:  rand.New(rand.NewPCG(cfg.Seed, uint64(i))),
+			slug: fmt.Sprintf("%s-%d", cfg.Prefix, i+1),
+		}
+		if err := g.run(ctx, store.AuthRepository()); err != nil {
+			return fmt.Errorf("tenant %s: %w", g.slug, err)
+		}
+	}
+	return nil
+}
+
+// generator generates one tenant.
+type generator struct {
+	cfg      Config
+	bulk     port.BulkRepository
+	ids      map[string]uint64
+	rng      *rand.Rand
+	slug     string
+	tenantID uint64
+
+	users []uint64
+	// active and popular map Zipf ranks to indexes into users.
+	active, popular []int
+	userZipf        *rand.Zipf
+	posts           []item
+	comments        []item
+}
+
+// item is a generated row that others refer to, with its time in Unix seconds.
+type item struct {
+	id   uint64
+	unix int64
+}
+
+func (g *generator) run(ctx context.Context, auth port.AuthRepository) error {
+	t, err := auth.CreateTenant(ctx, g.slug, fmt.Sprintf("Generated %s", g.slug))
+	var conflict *domain.ConflictError
+	if errors.As(err, &conflict) {
+		return fmt.Errorf("%w; use another prefix", ErrExists)
+	}
+	if err != nil {
+		return err
+	}
+	g.tenantID = t.ID
+
+	steps := []func(context.Context) error{g.genUsers, g.genPosts, g.genComments, g.genReactions, g.genConversations}
+	for _, step := range steps {
+		if err := step(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (g *generator) nextID(table string) uint64 {
+	id := g.ids[table]
+	g.ids[table]++
+	return id
+}
+
+// batch collects rows and loads them BatchSize at a time.
+type batch[T any] struct {
+	g      *generator
+	table  string
+	total  int
+	done   int
+	rows   []T
+	insert func(ctx context.Context, rows []T) error
+}
+
+func newBatch[T any](g *generator, table string, total int, insert func(ctx context.Context, rows []T) error) *batch[T] {
+	return &batch[T]{g: g, table: table, total: total, rows: make([]T, 0, min(total, g.cfg.BatchSize)), insert: insert}
+}
+
+func (b *batch[T]) add(ctx context.Context, row T) error {
+	b.rows = append(b.rows, row)
+	if len(b.rows) < b.g.cfg.BatchSize {
+		return nil
+	}
+	return b.flush(ctx)
+}
+
+func (b *batch[T]) flush(ctx context.Context) error {
+	if len(b.rows) == 0 {
+		return nil
+	}
+	if err := b.insert(ctx, b.rows); err != nil {
+		return fmt.Errorf("%s: %w", b.table, err)
+	}
+	b.done += len(b.rows)
+	b.rows = b.rows[:0]
+	if b.g.cfg.Progress != nil {
+		b.g.cfg.Progress(Progress{Tenant: b.g.slug, Table: b.table, Done: b.done, Total: b.total})
+	}
+	return nil
+}
+
+// randomTime returns a time in [from, End) as Unix seconds.
+func (g *generator) randomTime(from int64) int64 {
+	end := g.cfg.End.Unix()
+	if from >= end {
+		return end
+	}
+	return from + g.rng.Int64N(end-from)
+}
+
+func (g *generator) start() int64 {
+	return g.cfg.End.Add(-g.cfg.Span).Unix()
+}
+
+// activeUser picks an author, weighted by activity.
+func (g *generator) activeUser() uint64 {
+	return g.users[g.active[g.userZipf.Uint64()]]
+}
+
+// popularUser picks a recipient, weighted by popularity.
+func (g *generator) popularUser() uint64 {
+	return g.users[g.popular[g.userZipf.Uint64()]]
+}
+
+func (g *generator) genUsers(ctx context.Context) error {
+	n := g.cfg.Users
+	users := newBatch(g, "users", n, g.bulk.InsertUsers)
+	members := newBatch(g, "tenant_memberships", n, func(ctx context.Context, rows []*domain.Member) error {
+		return g.bulk.InsertMemberships(ctx, g.tenantID, rows)
+	})
+	g.users = make([]uint64, n)
+	for i := range g.users {
+		id := g.nextID("users")
+		g.users[i] = id
+		if err := users.add(ctx, &domain.User{ID: id, AuthSub: fmt.Sprintf("%s-u%d", g.slug, i+1), DisplayName: fmt.Sprintf("User %d", i+1)}); err != nil {
+			return err
+		}
+	}
+	if err := users.flush(ctx); err != nil {
+		return err
+	}
+	// The first user owns the tenant and one in a hundred administers it.
+	for i, id := range g.users {
+		role := domain.RoleMember
+		switch {
+		case i == 0:
+			role = domain.RoleOwner
+		case i%100 == 0:
+			role = domain.RoleAdmin
+		}
+		if err := members.add(ctx, &domain.Member{UserID: id, Role: role}); err != nil {
+			return err
+		}
+	}
+	if err := members.flush(ctx); err != nil {
+		return err
+	}
+
+	g.active = g.rng.Perm(n)
+	g.popular = g.rng.Perm(n)
+	g.userZipf = rand.NewZipf(g.rng, g.cfg.ZipfS, 1, uint64(n-1))
+	return nil
+}
+
+func (g *generator) genPosts(ctx context.Context) error {
+	n := g.cfg.Posts
+	b := newBatch(g, "posts", n, func(ctx context.Context, rows []*domain.Post) error {
+		return g.bulk.InsertPosts(ctx, g.tenantID, rows)
+	})
+	g.posts = make([]item, 0, n)
+	start, span := g.start(), int64(g.cfg.Span/time.Second)
+	for i := 0; i < n; i++ {
+		// Posts are evenly spread and in ID order, as when written live.
+		unix := start + span*int64(i)/int64(n) + g.rng.Int64N(max(span/int64(n), 1))
+		p := &domain.Post{ID: g.nextID("posts"), AuthorUserID: g.activeUser(), Body: g.text(3, 40), CreatedAt: time.Unix(unix, 0).UTC()}
+		g.posts = append(g.posts, item{p.ID, unix})
+		if err := b.add(ctx, p); err != nil {
+			return err
+		}
+	}
+	return b.flush(ctx)
+}
+
+// zipfPicker picks items weighted by a Zipf distribution over a random ranking.
+type zipfPicker struct {
+	zipf *rand.Zipf
+	rank []int
+}
+
+func (g *generator) newPicker(n int) *zipfPicker {
+	return &zipfPicker{zipf: rand.NewZipf(g.rng, g.cfg.ZipfS, 1, uint64(n-1)), rank: g.rng.Perm(n)}
+}
+
+func (p *zipfPicker) pick() int {
+	return p.rank[p.zipf.Uint64()]
+}
+
+func (g *generator) genComments(ctx context.Context) error {
+	n := g.cfg.Comments
+	if n == 0 || len(g.posts) == 0 {
+		return nil
+	}
+	b := newBatch(g, "comments", n, func(ctx context.Context, rows []*domain.Comment) error {
+		return g.bulk.InsertComments(ctx, g.tenantID, rows)
+	})
+	popular := g.newPicker(len(g.posts))
+	g.comments = make([]item, 0, n)
+	for i := 0; i < n; i++ {
+		post := g.posts[popular.pick()]
+		// Most comments come within hours of the post.
+		unix := min(post.unix+int64(g.rng.ExpFloat64()*float64(2*time.Hour/time.Second)), g.cfg.End.Unix())
+		c := &domain.Comment{ID: g.nextID("comments"), PostID: post.id, AuthorUserID: g.activeUser(), Body: g.text(1, 20), CreatedAt: time.Unix(unix, 0).UTC()}
+		g.comments = append(g.comments, item{c.ID, unix})
+		if err := b.add(ctx, c); err != nil {
+			return err
+		}
+	}
+	return b.flush(ctx)
+}
+
+func (g *generator) genReactions(ctx context.Context) error {
+	// Each user likes a target at most once.
+	n := min(g.cfg.Reactions, (len(g.posts)+len(g.comments))*len(g.users))
+	if n == 0 {
+		return nil
+	}
+	b := newBatch(g, "reactions", n, func(ctx context.Context, rows []*domain.ReactionRecord) error {
+		return g.bulk.InsertReactions(ctx, g.tenantID, rows)
+	})
+	type key struct {
+		target domain.ReactionTargetType
+		id     uint64
+		user   uint64
+	}
+	seen := make(map[key]struct{}, n)
+	var posts, comments *zipfPicker
+	if len(g.posts) > 0 {
+		posts = g.newPicker(len(g.posts))
+	}
+	if len(g.comments) > 0 {
+		comments = g.newPicker(len(g.comments))
+	}
+	// Popular targets saturate; give up on a reaction after a few draws.
+	for added, attempts := 0, 0; added < n && attempts < 10*n; attempts++ {
+		rec := &domain.ReactionRecord{TargetType: domain.ReactionTargetPost, UserID: g.activeUser(), Type: "like"}
+		var target item
+		if comments != nil && (posts == nil || g.rng.IntN(10) == 0) {
+			rec.TargetType, target = domain.ReactionTargetComment, g.comments[comments.pick()]
+		} else {
+			target = g.posts[posts.pick()]
+		}
+		k := key{rec.TargetType, target.id, rec.UserID}
+		if _, ok := seen[k]; ok {
+			continue
+		}
+		seen[k] = struct{}{}
+		rec.TargetID = target.id
+		rec.CreatedAt = time.Unix(g.randomTime(target.unix), 0).UTC()
+		if err := b.add(ctx, rec); err != nil {
+			return err
+		}
+		added++
+	}
+	return b.flush(ctx)
+}
+
+func (g *generator) genConversations(ctx context.Context) error {
+	users := len(g.users)
+	n := min(g.cfg.Conversations, users*(users-1)/2)
+	if n == 0 {
+		return nil
+	}
+	b := newBatch(g, "conversations", n, func(ctx context.Context, rows []*domain.Conversation) error {
+		return g.bulk.InsertConversations(ctx, g.tenantID, rows)
+	})
+	convs := make([]*domain.Conversation, 0, n)
+	seen := make(map[[2]uint64]struct{}, n)
+	for attempts := 0; len(convs) < n && attempts < 10*n; attempts++ {
+		a, c := g.activeUser(), g.popularUser()
+		if a == c {
+			continue
+		}
+		pair := [2]uint64{min(a, c), max(a, c)}
+		if _, ok := seen[pair]; ok {
+			continue
+		}
+		seen[pair] = struct{}{}
+		conv := &domain.Conversation{ID: g.nextID("conversations"), CreatedAt: time.Unix(g.randomTime(g.start()), 0).UTC(), MemberUserIDs: pair[:]}
+		convs = append(convs, conv)
+		if err := b.add(ctx, conv); err != nil {
+			return err
+		}
+	}
+	if err := b.flush(ctx); err != nil {
+		return err
+	}
+	return g.genMessages(ctx, convs)
+}
+
+func (g *generator) genMessages(ctx context.Context, convs []*domain.Conversation) error {
+	n := g.cfg.Messages
+	if n == 0 || len(convs) == 0 {
+		return nil
+	}
+	b := newBatch(g, "messages", n, func(ctx context.Context, rows []*domain.Message) error {
+		return g.bulk.InsertMessages(ctx, g.tenantID, rows)
+	})
+	busy := g.newPicker(len(convs))
+	for i := 0; i < n; i++ {
+		conv := convs[busy.pick()]
+		m := &domain.Message{
+			ID:             g.nextID("messages"),
+			ConversationID: conv.ID,
+			SenderUserID:   conv.MemberUserIDs[g.rng.IntN(len(conv.MemberUserIDs))],
+			Body:           g.text(1, 15),
+			CreatedAt:      time.Unix(g.randomTime(conv.CreatedAt.Unix()), 0).UTC(),
+		}
+		if err := b.add(ctx, m); err != nil {
+			return err
+		}
+	}
+	return b.flush(ctx)
+}
+
+var words = strings.Fields(`the a an and or but so to of in on at for with from about today tomorrow
+	yesterday team project release deploy review meeting lunch coffee idea plan update bug fix test
+	design launch customer feedback metrics dashboard latency query index cache page feed comment
+	great nice thanks agreed maybe soon later done shipped blocked`)
+
+// text returns between min and max random words.
+func (g *generator) text(minWords, maxWords int) string {
+	n := minWords + g.rng.IntN(maxWords-minWords+1)
+	var b strings.Builder
+	for i := 0; i < n; i++ {
+		if i > 0 {
+			b.WriteByte(' ')
+		}
+		b.WriteString(words[g.rng.IntN(len(words))])
+	}
+	return b.String()
+}
@@ -0,0 +1,163 @@
+package datagen_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/example/something-like-sns/apps/api/internal/adapter/repository/memory"
+	"github.com/example/something-like-sns/apps/api/internal/datagen"
+	"github.com/example/something-like-sns/apps/api/internal/domain"
+	"github.com/example/something-like-sns/apps/api/internal/port"
+)
+
+// recordingStore records the rows loaded through its BulkRepository.
+type recordingStore struct {
+	port.Store
+	rows map[string][]string
+}
+
+func (s *recordingStore) BulkRepository() port.BulkRepository {
+	return &recordingBulk{BulkRepository: s.Store.BulkRepository(), rows: s.rows}
+}
+
+type recordingBulk struct {
+	port.BulkRepository
+	rows map[string][]string
+}
+
+func record[T any](rows map[string][]string, table string, batch []T) {
+	for _, row := range batch {
+		rows[table] = append(rows[table], fmt.Sprintf("%+v", row))
+	}
+}
+
+func (r *recordingBulk) InsertUsers(ctx context.Context, users []*domain.User) error {
+	record(r.rows, "users", users)
+	return r.BulkRepository.InsertUsers(ctx, users)
+}
+
+func (r *recordingBulk) InsertPosts(ctx context.Context, tenantID uint64, posts []*domain.Post) error {
+	record(r.rows, "posts", posts)
+	return r.BulkRepository.InsertPosts(ctx, tenantID, posts)
+}
+
+func (r *recordingBulk) InsertComments(ctx context.Context, tenantID uint64, comments []*domain.Comment) error {
+	record(r.rows, "comments", comments)
+	return r.BulkRepository.InsertComments(ctx, tenantID, comments)
+}
+
+func (r *recordingBulk) InsertReactions(ctx context.Context, tenantID uint64, reactions []*domain.ReactionRecord) error {
+	record(r.rows, "reactions", reactions)
+	return r.BulkRepository.InsertReactions(ctx, tenantID, reactions)
+}
+
+func (r *recordingBulk) InsertConversations(ctx context.Context, tenantID uint64, conversations []*domain.Conversation) error {
+	record(r.rows, "conversations", conversations)
+	return r.BulkRepository.InsertConversations(ctx, tenantID, conversations)
+}
+
+func (r *recordingBulk) InsertMessages(ctx context.Context, tenantID uint64, messages []*domain.Message) error {
+	record(r.rows, "messages", messages)
+	return r.BulkRepository.InsertMessages(ctx, tenantID, messages)
+}
+
+func testConfig() datagen.Config {
+	return datagen.Config{
+		Seed:          42,
+		Prefix:        "gen",
+		Tenants:       2,
+		Users:         30,
+		Posts:         200,
+		Comments:      300,
+		Reactions:     500,
+		Conversations: 40,
+		Messages:      400,
+		End:           time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC),
+		Span:          30 * 24 * time.Hour,
+		ZipfS:         1.2,
+		BatchSize:     64,
+	}
+}
+
+func TestRun(t *testing.T) {
+	ctx := context.Background()
+	cfg := testConfig()
+	done := map[[2]string]int{}
+	cfg.Progress = func(p datagen.Progress) {
+		if p.Done > p.Total {
+			t.Errorf("progress %+v: done beyond total", p)
+		}
+		done[[2]string{p.Tenant, p.Table}] = p.Done
+	}
+	store := memory.NewStore()
+	if err := datagen.Run(ctx, store, cfg); err != nil {
+		t.Fatalf("Run: %v", err)
+	}
+	loaded := map[string]int{}
+	for key, n := range done {
+		loaded[key[1]] += n
+	}
+	want := map[string]int{
+		"users": 60, "tenant_memberships": 60, "posts": 400, "comments": 600, "reactions": 1000, "conversations": 80, "messages": 800,
+	}
+	for table, n := range want {
+		if loaded[table] != n {
+			t.Errorf("%s: loaded %d rows, want %d", table, loaded[table], n)
+		}
+	}
+
+	auth := store.AuthRepository()
+	tenant, err := auth.FindTenantBySlug(ctx, "gen-2")
+	if err != nil {
+		t.Fatalf("FindTenantBySlug: %v", err)
+	}
+	owner, err := auth.FindUserByAuthSub(ctx, "gen-2-u1")
+	if err != nil {
+		t.Fatalf("FindUserByAuthSub: %v", err)
+	}
+	if role, err := auth.FindMembershipRole(ctx, tenant.ID, owner.ID); err != nil || role != domain.RoleOwner {
+		t.Errorf("first user role = %q, %v; want owner", role, err)
+	}
+	posts, hasMore, err := store.TimelineRepository().FindFeed(ctx, tenant.ID, owner.ID, 20, domain.Cursor{})
+	if err != nil || len(posts) != 20 || !hasMore {
+		t.Fatalf("feed = %d posts, hasMore %v, %v; want a full page", len(posts), hasMore, err)
+	}
+	for _, p := range posts {
+		if p.CreatedAt.Before(cfg.End.Add(-cfg.Span)) || p.CreatedAt.After(cfg.End) {
+			t.Errorf("post %d created at %v, outside the window", p.ID, p.CreatedAt)
+		}
+	}
+
+	if err := datagen.Run(ctx, store, cfg); !errors.Is(err, datagen.ErrExists) {
+		t.Errorf("second run: err = %v, want ErrExists", err)
+	}
+}
+
+func TestRunIsDeterministic(t *testing.T) {
+	ctx := context.Background()
+	var runs [2]map[string][]string
+	for i := range runs {
+		store := &recordingStore{Store: memory.NewStore(), rows: map[string][]string{}}
+		if err := datagen.Run(ctx, store, testConfig()); err != nil {
+			t.Fatalf("run #%d: %v", i+1, err)
+		}
+		runs[i] = store.rows
+	}
+	if !reflect.DeepEqual(runs[0], runs[1]) {
+		t.Error("runs with the same seed generated different rows")
+	}
+
+	other := testConfig()
+	other.Seed++
+	store := &recordingStore{Store: memory.NewStore(), rows: map[string][]string{}}
+	if err := datagen.Run(ctx, store, other); err != nil {
+		t.Fatalf("run with another seed: %v", err)
+	}
+	if reflect.DeepEqual(runs[0]["posts"], store.rows["posts"]) {
+		t.Error("runs with different seeds generated the same posts")
+	}
+}
@@ -0,0 +1,38 @@
+package domain
+
+import "context"
+
+type scopeContextKey struct{}
+
+// ContextWithScope returns a copy of ctx that carries the request scope.
+func ContextWithScope(ctx context.Context, scope Scope) context.Context {
+	return context.WithValue(ctx, scopeContextKey{}, scope)
+}
+
+// ScopeFromContext returns the request scope carried by ctx, if any.
+func ScopeFromContext(ctx context.Context) (Scope, bool) {
+	scope, ok := ctx.Value(scopeContextKey{}).(Scope)
+	return scope, ok
+}
+
+type planContextKey struct{}
+
+type tenantPlan struct {
+	tenantID uint64
+	plan     *Plan
+}
+
+// ContextWithPlan returns a copy of ctx that carries the plan of the tenant, so that it is loaded
+// once per request.
+func ContextWithPlan(ctx context.Context, tenantID uint64, plan *Plan) context.Context {
+	return context.WithValue(ctx, planContextKey{}, tenantPlan{tenantID: tenantID, plan: plan})
+}
+
+// PlanFromContext returns the plan of the tenant carried by ctx, if any.
+func PlanFromContext(ctx context.Context, tenantID uint64) (*Plan, bool) {
+	tp, ok := ctx.Value(planContextKey{}).(tenantPlan)
+	if !ok || tp.tenantID != tenantID {
+		return nil, false
+	}
+	return tp.plan, true
+}
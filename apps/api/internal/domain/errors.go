@@ -0,0 +1,101 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+var (
+	// ErrUnauthenticated is returned when the caller's identity cannot be established.
+	ErrUnauthenticated = errors.New("unauthenticated")
+	// ErrPermissionDenied is returned when the caller's role does not allow the operation.
+	ErrPermissionDenied = NewPermissionDeniedError("permission denied")
+	// ErrQuotaExceeded is returned when a tenant has used up one of its plan quotas.
+	ErrQuotaExceeded = errors.New("quota exceeded")
+)
+
+// NotFoundError is returned when a resource does not exist or is not visible from the caller's tenant.
+type NotFoundError struct {
+	Resource string
+	ID       string
+}
+
+func NewNotFoundError(resource string, id any) error {
+	e := &NotFoundError{Resource: resource}
+	if id != nil {
+		e.ID = fmt.Sprint(id)
+	}
+	return e
+}
+
+func (e *NotFoundError) Error() string {
+	if e.ID == "" {
+		return e.Resource + " not found"
+	}
+	return fmt.Sprintf("%s %s not found", e.Resource, e.ID)
+}
+
+// PermissionDeniedError is returned when the caller may not perform the operation.
+type PermissionDeniedError struct {
+	Reason string
+}
+
+func NewPermissionDeniedError(reason string) error {
+	return &PermissionDeniedError{Reason: reason}
+}
+
+func (e *PermissionDeniedError) Error() string {
+	return e.Reason
+}
+
+// FieldViolation describes why a single request field is invalid.
+type FieldViolation struct {
+	Field       string
+	Description string
+}
+
+// ValidationError is returned when request input fails validation.
+type ValidationError struct {
+	Violations []FieldViolation
+}
+
+func NewValidationError(field, description string) error {
+	return &ValidationError{Violations: []FieldViolation{{Field: field, Description: description}}}
+}
+
+func (e *ValidationError) Error() string {
+	msgs := make([]string, len(e.Violations))
+	for i, v := range e.Violations {
+		msgs[i] = v.Field + ": " + v.Description
+	}
+	return "invalid argument: " + strings.Join(msgs, "; ")
+}
+
+// ConflictError is returned when an operation conflicts with existing state, such as a duplicate key.
+type ConflictError struct {
+	Resource string
+	Reason   string
+}
+
+func NewConflictError(resource, reason string) error {
+	return &ConflictError{Resource: resource, Reason: reason}
+}
+
+func (e *ConflictError) Error() string {
+	return fmt.Sprintf("%s conflict: %s", e.Resource, e.Reason)
+}
+
+// FailedPreconditionError is returned when the system is not in the state an operation needs,
+// such as a domain whose ownership cannot be confirmed yet.
+type FailedPreconditionError struct {
+	Reason string
+}
+
+func NewFailedPreconditionError(reason string) error {
+	return &FailedPreconditionError{Reason: reason}
+}
+
+func (e *FailedPreconditionError) Error() string {
+	return e.Reason
+}
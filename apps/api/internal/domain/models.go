@@ -6,6 +6,30 @@ import "time"
 type Scope struct {
 	TenantID uint64
 	UserID   uint64
+	Role     string
+}
+
+// Membership roles, from most to least privileged.
+const (
+	RoleOwner  = "owner"
+	RoleAdmin  = "admin"
+	RoleMember = "member"
+)
+
+// IsValidRole reports whether role is one of the membership roles.
+func IsValidRole(role string) bool {
+	return role == RoleOwner || role == RoleAdmin || role == RoleMember
+}
+
+// IsMember reports whether the scope belongs to a member of the tenant. Users who have signed
+// in but not joined yet have no role.
+func (s Scope) IsMember() bool {
+	return s.Role != ""
+}
+
+// IsAdmin reports whether the scope belongs to an owner or admin of the tenant.
+func (s Scope) IsAdmin() bool {
+	return s.Role == RoleOwner || s.Role == RoleAdmin
 }
 
 // Post represents a post in the system.
@@ -17,6 +41,8 @@ type Post struct {
 	LikedByMe    bool
 	LikeCount    uint32
 	CommentCount uint32
+	// Author is filled in by the usecase, not the repository.
+	Author *Profile
 }
 
 // Comment represents a comment on a post.
@@ -26,6 +52,8 @@ type Comment struct {
 	AuthorUserID uint64
 	Body         string
 	CreatedAt    time.Time
+	// Author is filled in by the usecase, not the repository.
+	Author *Profile
 }
 
 // ReactionTargetType defines the type of entity a reaction can be attached to.
@@ -42,10 +70,22 @@ type Reaction struct {
 	Total  uint32
 }
 
+// ReactionRecord is one user's reaction to a target, as stored.
+type ReactionRecord struct {
+	TargetType ReactionTargetType
+	TargetID   uint64
+	UserID     uint64
+	Type       string
+	CreatedAt  time.Time
+}
+
 // User represents a user in the system.
 type User struct {
 	ID          uint64
+	AuthSub     string
 	DisplayName string
+	// Suspended users cannot sign in to any tenant.
+	Suspended   bool
 	Memberships []*TenantMembership
 }
 
@@ -56,10 +96,273 @@ type TenantMembership struct {
 	Role       string
 }
 
+// Member is a user's membership as seen from the tenant.
+type Member struct {
+	UserID      uint64
+	AuthSub     string
+	DisplayName string
+	Role        string
+	// Suspended is set if the user is suspended, or their membership in the tenant is.
+	Suspended bool
+	JoinedAt  time.Time
+}
+
+// Profile is how a user presents themselves in a tenant. The display name and avatar are the
+// tenant's override if the member set one and the user's own otherwise; the bio is the user's
+// own. The handle is unique within the tenant and empty until the user picks one.
+type Profile struct {
+	UserID      uint64
+	DisplayName string
+	Handle      string
+	Bio         string
+	AvatarURL   string
+}
+
+// ProfileOverride replaces parts of a member's profile within one tenant. Empty fields fall
+// back to the user's own profile.
+type ProfileOverride struct {
+	DisplayName string
+	AvatarURL   string
+}
+
+// ProfileSettings is a member's profile as they edit it: the profile shared by all their
+// tenants, with their handle in the tenant, and the tenant's override.
+type ProfileSettings struct {
+	Profile
+	Override ProfileOverride
+}
+
+// DirectoryMember is a member as listed in the tenant's member directory.
+type DirectoryMember struct {
+	Profile
+	Role     string
+	JoinedAt time.Time
+}
+
+// MemberFilter selects members in the directory. Zero fields match every member.
+type MemberFilter struct {
+	// Prefix matches the start of the display name or handle, ignoring case.
+	Prefix string
+	Role   string
+}
+
+// RelationKind is a relation a member sets on another member of the same tenant.
+type RelationKind string
+
+const (
+	// RelationBlock stops the two members from messaging each other and from commenting on or
+	// reacting to each other's posts, and hides the blocked member's posts from the blocker.
+	RelationBlock RelationKind = "block"
+	// RelationMute hides the muted member's posts from the muter's feed.
+	RelationMute RelationKind = "mute"
+)
+
+// ReportTargetType is the kind of content a member can report.
+type ReportTargetType string
+
+const (
+	ReportTargetPost    ReportTargetType = "post"
+	ReportTargetComment ReportTargetType = "comment"
+	ReportTargetMessage ReportTargetType = "message"
+)
+
+// Report statuses. A report is open until an admin resolves it.
+const (
+	ReportOpen     = "open"
+	ReportResolved = "resolved"
+)
+
+// IsValidReportStatus reports whether status is one of the report statuses.
+func IsValidReportStatus(status string) bool {
+	return status == ReportOpen || status == ReportResolved
+}
+
+// ModerationAction is what an admin does about reported content when resolving its reports.
+type ModerationAction string
+
+const (
+	// ModerationDismiss leaves the content as it is.
+	ModerationDismiss ModerationAction = "dismiss"
+	// ModerationHide removes the content from every list, keeping its body for the moderation queue.
+	ModerationHide ModerationAction = "hide"
+	// ModerationDelete removes the content and erases its body.
+	ModerationDelete ModerationAction = "delete"
+	// ModerationWarn records a warning against the author in the audit trail.
+	ModerationWarn ModerationAction = "warn"
+	// ModerationSuspendAuthor suspends the author's membership, so that they cannot sign in to
+	// the tenant until an admin reinstates them.
+	ModerationSuspendAuthor ModerationAction = "suspend_author"
+)
+
+// IsValidModerationAction reports whether action is one of the moderation actions.
+func IsValidModerationAction(action ModerationAction) bool {
+	switch action {
+	case ModerationDismiss, ModerationHide, ModerationDelete, ModerationWarn, ModerationSuspendAuthor:
+		return true
+	}
+	return false
+}
+
+// Report is a member's report of a post, comment or message to the tenant's admins.
+type Report struct {
+	ID             uint64
+	TargetType     ReportTargetType
+	TargetID       uint64
+	AuthorUserID   uint64
+	ReporterUserID uint64
+	Reason         string
+	// Content is the reported body as it was when reported, so that admins can judge content
+	// they cannot otherwise see, such as messages.
+	Content   string
+	Status    string
+	CreatedAt time.Time
+	// Action, ResolvedByUserID and ResolvedAt are zero while the report is open.
+	Action           ModerationAction
+	ResolvedByUserID uint64
+	ResolvedAt       time.Time
+}
+
+// Audit event actions besides the moderation actions, which are recorded under their own names.
+const (
+	AuditReinstateMember = "reinstate_member"
+)
+
+// Audit event target types besides the report target types.
+const (
+	AuditTargetUser = "user"
+)
+
+// AuditEvent records an action an admin took in a tenant.
+type AuditEvent struct {
+	ID          uint64
+	ActorUserID uint64
+	Action      string
+	TargetType  string
+	TargetID    uint64
+	// ReportID is the report the action resolved, or 0.
+	ReportID  uint64
+	Note      string
+	CreatedAt time.Time
+}
+
+// IsLastOwner reports whether userID is the only one of owners. The last owner cannot be
+// demoted or removed, as nobody could manage the tenant afterwards.
+func IsLastOwner(owners []uint64, userID uint64) bool {
+	return len(owners) == 1 && owners[0] == userID
+}
+
 // Tenant represents a tenant in the system.
 type Tenant struct {
 	ID   uint64
 	Slug string
+	Name string
+	Plan string
+	// Disabled tenants do not resolve and nobody can sign in to them.
+	Disabled   bool
+	JoinPolicy string
+	// AllowSubdomainFallback lets hosts whose first label is the slug resolve to the tenant,
+	// besides its verified domains.
+	AllowSubdomainFallback bool
+}
+
+// TenantDomain is a host name a tenant is served on. Domains added by tenant admins only
+// resolve to the tenant once they are verified: by publishing the challenge as a DNS TXT
+// record, or by an operator.
+type TenantDomain struct {
+	Host     string
+	Verified bool
+	// VerificationToken is the challenge token of a domain added by a tenant admin.
+	VerificationToken string
+	CreatedAt         time.Time
+}
+
+// ChallengeName returns the name of the TXT record that verifies the domain.
+func (d *TenantDomain) ChallengeName() string {
+	return "_sns-challenge." + d.Host
+}
+
+// ChallengeValue returns the content the TXT record must have, or "" if the domain has no token.
+func (d *TenantDomain) ChallengeValue() string {
+	if d.VerificationToken == "" {
+		return ""
+	}
+	return "sns-domain-verification=" + d.VerificationToken
+}
+
+// Join policies decide who becomes a member of a tenant by signing in to it. Other users can
+// join with an invitation or an approved join request.
+const (
+	// JoinPolicyOpen lets anyone who signs in join as a member.
+	JoinPolicyOpen = "open"
+	// JoinPolicyInvite admits nobody by signing in.
+	JoinPolicyInvite = "invite"
+	// JoinPolicyDomain lets users whose email domain is on the tenant's allowlist join as members.
+	JoinPolicyDomain = "domain"
+)
+
+// IsValidJoinPolicy reports whether policy is one of the join policies.
+func IsValidJoinPolicy(policy string) bool {
+	return policy == JoinPolicyOpen || policy == JoinPolicyInvite || policy == JoinPolicyDomain
+}
+
+// Invitation lets whoever holds its token join a tenant once, with Role, until ExpiresAt.
+type Invitation struct {
+	ID              uint64
+	Role            string
+	CreatedByUserID uint64
+	CreatedAt       time.Time
+	ExpiresAt       time.Time
+	// AcceptedByUserID is 0 while the invitation is unused.
+	AcceptedByUserID uint64
+}
+
+// JoinRequest is a user's pending request to join a tenant, awaiting an admin's decision.
+type JoinRequest struct {
+	ID          uint64
+	UserID      uint64
+	DisplayName string
+	Message     string
+	CreatedAt   time.Time
+}
+
+// Plan represents the rate limits and quotas of a subscription plan.
+// A zero limit means unlimited.
+type Plan struct {
+	Name              string
+	PostsPerMinute    uint32
+	CommentsPerMinute uint32
+	MessagesPerMinute uint32
+	DailyPosts        uint32
+	StorageBytes      uint64
+	MaxMembers        uint32
+}
+
+// TenantUsage represents a tenant's consumption of its plan quotas.
+type TenantUsage struct {
+	PostsToday   uint32
+	StorageBytes uint64
+	Members      uint32
+}
+
+// QuotaAction identifies a write that is subject to rate limits and quotas.
+type QuotaAction string
+
+const (
+	QuotaActionPost    QuotaAction = "post"
+	QuotaActionComment QuotaAction = "comment"
+	QuotaActionMessage QuotaAction = "message"
+)
+
+// IdempotencyRecord is a stored outcome of a write request made with an idempotency key.
+// Response is nil while the original request is still in progress.
+type IdempotencyRecord struct {
+	TenantID    uint64
+	UserID      uint64
+	Procedure   string
+	Key         string
+	RequestHash []byte
+	Response    []byte
+	ExpiresAt   time.Time
 }
 
 // Conversation represents a DM conversation.
@@ -76,4 +379,6 @@ type Message struct {
 	SenderUserID   uint64
 	Body           string
 	CreatedAt      time.Time
+	// Sender is filled in by the usecase, not the repository.
+	Sender *Profile
 }
@@ -0,0 +1,43 @@
+package domain
+
+import "time"
+
+// PageDirection is the direction a cursor pages in, relative to a list's natural order.
+type PageDirection uint8
+
+const (
+	// PageForward continues after the cursor in the list's natural order.
+	PageForward PageDirection = iota
+	// PageBackward returns the items before the cursor, still in the list's natural order.
+	PageBackward
+)
+
+// Cursor is a position in a list ordered by (CreatedAt, ID). The zero Cursor is the start of the list.
+type Cursor struct {
+	Time      time.Time
+	ID        uint64
+	Direction PageDirection
+}
+
+// IsZero reports whether c is the start of the list.
+func (c Cursor) IsZero() bool {
+	return c.ID == 0
+}
+
+// PageParams are the client-supplied pagination parameters of a list request.
+// A zero Size selects the default page size. IncludeTotal asks for PageInfo.Total where supported.
+type PageParams struct {
+	Token        string
+	Size         uint32
+	IncludeTotal bool
+}
+
+// PageInfo holds the cursor tokens around a returned page. An empty token means there is no such page.
+type PageInfo struct {
+	Next string
+	Prev string
+	// HasMore reports whether more items follow the page in the requested direction.
+	HasMore bool
+	// Total is the approximate number of items in the whole list, if requested and supported.
+	Total uint64
+}
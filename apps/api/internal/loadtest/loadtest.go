@@ -0,0 +1,181 @@
+// Package loadtest drives the Connect APIs of a running server with simulated users through the
+// generated v1connect clients, and reports latency percentiles and error codes per procedure.
+//
+// Users sign in with the development headers (X-Tenant, X-User), so the server must run with
+// ALLOW_DEV_HEADERS=true. Auth subjects follow cmd/datagen ("<slug>-u<i>"), so a generated data
+// set gives the scenarios existing feeds and conversations to work on.
+package loadtest
+
+import (
+	"context"
+	"fmt"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"connectrpc.com/connect"
+
+	"github.com/example/something-like-sns/apps/api/gen/sns/v1/v1connect"
+)
+
+// Config describes a load test.
+type Config struct {
+	BaseURL string
+	Tenants []string
+	// Users is the number of users per tenant the virtual users sign in as.
+	Users int
+	Mix   []Weighted
+	// Concurrency is the number of virtual users. They are spread over the tenants.
+	Concurrency int
+	Duration    time.Duration
+	// Think is the mean pause of a virtual user between scenarios.
+	Think time.Duration
+	Seed  uint64
+	// HTTPClient defaults to http.DefaultClient.
+	HTTPClient connect.HTTPClient
+}
+
+// Weighted is a scenario and how often it runs relative to the others.
+type Weighted struct {
+	Scenario string
+	Weight   int
+}
+
+// ParseMix parses a scenario mix such as "feed=6,post=1,dm=3". A scenario without a weight has
+// weight 1.
+func ParseMix(s string) ([]Weighted, error) {
+	var mix []Weighted
+	for _, part := range strings.Split(s, ",") {
+		name, weight, hasWeight := strings.Cut(strings.TrimSpace(part), "=")
+		if _, ok := scenarios[name]; !ok {
+			return nil, fmt.Errorf("unknown scenario %q (have %s)", name, strings.Join(ScenarioNames(), ", "))
+		}
+		w := 1
+		if hasWeight {
+			var err error
+			if w, err = strconv.Atoi(weight); err != nil || w < 0 {
+				return nil, fmt.Errorf("scenario %s: invalid weight %q", name, weight)
+			}
+		}
+		mix = append(mix, Weighted{Scenario: name, Weight: w})
+	}
+	return mix, nil
+}
+
+func (c Config) validate() error {
+	switch {
+	case c.BaseURL == "":
+		return fmt.Errorf("base URL is required")
+	case len(c.Tenants) == 0:
+		return fmt.Errorf("at least one tenant is required")
+	case c.Users < 1:
+		return fmt.Errorf("users must be at least 1")
+	case c.Concurrency < 1:
+		return fmt.Errorf("concurrency must be at least 1")
+	case c.Duration <= 0:
+		return fmt.Errorf("duration must be positive")
+	}
+	total := 0
+	for _, w := range c.Mix {
+		if _, ok := scenarios[w.Scenario]; !ok {
+			return fmt.Errorf("unknown scenario %q", w.Scenario)
+		}
+		total += w.Weight
+	}
+	if total == 0 {
+		return fmt.Errorf("the scenario mix is empty")
+	}
+	return nil
+}
+
+// Run runs the virtual users until cfg.Duration has passed or ctx is done, and reports the calls
+// they made.
+func Run(ctx context.Context, cfg Config) (*Report, error) {
+	if err := cfg.validate(); err != nil {
+		return nil, err
+	}
+	if cfg.HTTPClient == nil {
+		cfg.HTTPClient = http.DefaultClient
+	}
+	ctx, cancel := context.WithTimeout(ctx, cfg.Duration)
+	defer cancel()
+
+	rec := newRecorder()
+	started := time.Now()
+	var wg sync.WaitGroup
+	for i := 0; i < cfg.Concurrency; i++ {
+		tenant := cfg.Tenants[i%len(cfg.Tenants)]
+		user := fmt.Sprintf("%s-u%d", tenant, i/len(cfg.Tenants)%cfg.Users+1)
+		s := newSession(cfg, rec, tenant, user, rand.New(rand.NewPCG(cfg.Seed, uint64(i))))
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			s.loop(ctx, cfg)
+		}()
+	}
+	wg.Wait()
+	return rec.report(time.Since(started)), nil
+}
+
+// session is a virtual user signed in to one tenant.
+type session struct {
+	tenant, authSub string
+	rng             *rand.Rand
+	userID          uint64
+
+	tenants   v1connect.TenantServiceClient
+	timeline  v1connect.TimelineServiceClient
+	reactions v1connect.ReactionServiceClient
+	dms       v1connect.DMServiceClient
+}
+
+func newSession(cfg Config, rec *recorder, tenant, authSub string, rng *rand.Rand) *session {
+	opts := []connect.ClientOption{connect.WithInterceptors(rec.interceptor(), signIn(tenant, authSub))}
+	return &session{
+		tenant:    tenant,
+		authSub:   authSub,
+		rng:       rng,
+		tenants:   v1connect.NewTenantServiceClient(cfg.HTTPClient, cfg.BaseURL, opts...),
+		timeline:  v1connect.NewTimelineServiceClient(cfg.HTTPClient, cfg.BaseURL, opts...),
+		reactions: v1connect.NewReactionServiceClient(cfg.HTTPClient, cfg.BaseURL, opts...),
+		dms:       v1connect.NewDMServiceClient(cfg.HTTPClient, cfg.BaseURL, opts...),
+	}
+}
+
+// signIn sets the development auth headers on every request.
+func signIn(tenant, authSub string) connect.Interceptor {
+	return connect.UnaryInterceptorFunc(func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			req.Header().Set("X-Tenant", tenant)
+			req.Header().Set("X-User", authSub)
+			return next(ctx, req)
+		}
+	})
+}
+
+// loop runs scenarios picked from the mix until ctx is done. A failed call ends its scenario; the
+// recorder has already counted it.
+func (s *session) loop(ctx context.Context, cfg Config) {
+	total := 0
+	for _, w := range cfg.Mix {
+		total += w.Weight
+	}
+	for ctx.Err() == nil {
+		n := s.rng.IntN(total)
+		for _, w := range cfg.Mix {
+			if n -= w.Weight; n < 0 {
+				_ = scenarios[w.Scenario](ctx, s)
+				break
+			}
+		}
+		if cfg.Think > 0 {
+			select {
+			case <-ctx.Done():
+			case <-time.After(time.Duration(s.rng.ExpFloat64() * float64(cfg.Think))):
+			}
+		}
+	}
+}
@@ -0,0 +1,140 @@
+package loadtest_test
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+
+	"github.com/example/something-like-sns/apps/api/internal/adapter/cursor"
+	"github.com/example/something-like-sns/apps/api/internal/adapter/handler/rpc"
+	"github.com/example/something-like-sns/apps/api/internal/adapter/repository/memory"
+	"github.com/example/something-like-sns/apps/api/internal/application"
+	"github.com/example/something-like-sns/apps/api/internal/datagen"
+	"github.com/example/something-like-sns/apps/api/internal/domain"
+	"github.com/example/something-like-sns/apps/api/internal/loadtest"
+)
+
+// newServer serves the RPC handlers on a memory store holding a small generated tenant, whose
+// free plan has no quotas for the load to run into.
+func newServer(t *testing.T) *httptest.Server {
+	t.Helper()
+	store := memory.NewStore(memory.WithPlans(domain.Plan{Name: "free"}))
+	err := datagen.Run(context.Background(), store, datagen.Config{
+		Seed: 1, Prefix: "load", Tenants: 1, Users: 10, Posts: 100, Comments: 50, Reactions: 50, Conversations: 5, Messages: 20,
+		End: time.Now(), Span: 24 * time.Hour, ZipfS: 1.2, BatchSize: 100,
+	})
+	if err != nil {
+		t.Fatalf("datagen: %v", err)
+	}
+
+	encoder := cursor.NewHMACEncoder([]byte("load-test-secret"))
+	auth := application.NewAuthUsecase(store)
+	interceptors := []connect.Interceptor{rpc.NewErrorInterceptor(), rpc.NewAuthInterceptor(auth, true)}
+	mux := http.NewServeMux()
+	for _, h := range []interface {
+		MountHandler(...connect.Interceptor) (string, http.Handler)
+	}{
+		rpc.NewTenantHandler(auth, application.NewQuotaUsecase(store), true),
+		rpc.NewTimelineHandler(application.NewTimelineUsecase(store, encoder)),
+		rpc.NewReactionHandler(application.NewReactionUsecase(store)),
+		rpc.NewDMHandler(application.NewDMUsecase(store, encoder)),
+	} {
+		mux.Handle(h.MountHandler(interceptors...))
+	}
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestRun(t *testing.T) {
+	srv := newServer(t)
+	mix, err := loadtest.ParseMix("feed=2,post,dm=2")
+	if err != nil {
+		t.Fatalf("ParseMix: %v", err)
+	}
+	report, err := loadtest.Run(context.Background(), loadtest.Config{
+		BaseURL:     srv.URL,
+		Tenants:     []string{"load-1"},
+		Users:       10,
+		Mix:         mix,
+		Concurrency: 4,
+		Duration:    500 * time.Millisecond,
+		Seed:        1,
+		HTTPClient:  srv.Client(),
+	})
+	if err != nil {
+		t.Fatalf("Run: %v", err)
+	}
+
+	calls := map[string]int{}
+	for _, p := range report.Procedures {
+		calls[p.Procedure] = p.Calls
+		if p.Failed() > 0 {
+			t.Errorf("%s: errors %v", p.Procedure, p.Errors)
+		}
+		if p.P50 > p.P90 || p.P90 > p.P99 || p.P99 > p.Max {
+			t.Errorf("%s: percentiles out of order: %+v", p.Procedure, p)
+		}
+	}
+	for _, procedure := range []string{
+		"/sns.v1.TimelineService/ListFeed", "/sns.v1.TimelineService/CreatePost", "/sns.v1.DMService/ListConversations", "/sns.v1.DMService/SendMessage",
+	} {
+		if calls[procedure] == 0 {
+			t.Errorf("no calls to %s; got %v", procedure, calls)
+		}
+	}
+
+	var out bytes.Buffer
+	if err := report.Write(&out); err != nil {
+		t.Fatalf("Write: %v", err)
+	}
+	if !strings.Contains(out.String(), "/sns.v1.TimelineService/ListFeed") {
+		t.Errorf("report =\n%s", out.String())
+	}
+}
+
+func TestRunReportsErrorCodes(t *testing.T) {
+	srv := newServer(t)
+	report, err := loadtest.Run(context.Background(), loadtest.Config{
+		BaseURL:     srv.URL,
+		Tenants:     []string{"missing"},
+		Users:       1,
+		Mix:         []loadtest.Weighted{{Scenario: "feed", Weight: 1}},
+		Concurrency: 1,
+		Duration:    100 * time.Millisecond,
+		HTTPClient:  srv.Client(),
+	})
+	if err != nil {
+		t.Fatalf("Run: %v", err)
+	}
+	if len(report.Procedures) != 1 || report.Procedures[0].Errors["not_found"] != report.Procedures[0].Calls {
+		t.Errorf("report = %+v, want every ListFeed call not_found", report.Procedures)
+	}
+}
+
+func TestParseMix(t *testing.T) {
+	mix, err := loadtest.ParseMix("feed=6, post ,dm=0")
+	if err != nil {
+		t.Fatalf("ParseMix: %v", err)
+	}
+	want := []loadtest.Weighted{{Scenario: "feed", Weight: 6}, {Scenario: "post", Weight: 1}, {Scenario: "dm", Weight: 0}}
+	if len(mix) != len(want) {
+		t.Fatalf("mix = %v, want %v", mix, want)
+	}
+	for i := range want {
+		if mix[i] != want[i] {
+			t.Errorf("mix[%d] = %v, want %v", i, mix[i], want[i])
+		}
+	}
+	for _, bad := range []string{"scroll=1", "feed=x", "feed=-1", ""} {
+		if _, err := loadtest.ParseMix(bad); err == nil {
+			t.Errorf("ParseMix(%q) succeeded", bad)
+		}
+	}
+}
@@ -0,0 +1,139 @@
+package loadtest
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"maps"
+	"slices"
+	"strings"
+	"sync"
+	"text/tabwriter"
+	"time"
+
+	"connectrpc.com/connect"
+)
+
+// Report summarises the calls of a load test per procedure.
+type Report struct {
+	Elapsed    time.Duration
+	Procedures []ProcedureStats
+}
+
+// ProcedureStats are the calls to one procedure. Latencies include failed calls.
+type ProcedureStats struct {
+	Procedure          string
+	Calls              int
+	P50, P90, P99, Max time.Duration
+	// Errors counts failed calls by Connect code.
+	Errors map[string]int
+}
+
+// Failed returns the number of failed calls.
+func (p ProcedureStats) Failed() int {
+	n := 0
+	for _, c := range p.Errors {
+		n += c
+	}
+	return n
+}
+
+// Write prints the report as a table.
+func (r *Report) Write(w io.Writer) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "PROCEDURE\tCALLS\tRPS\tP50\tP90\tP99\tMAX\tERRORS\t")
+	for _, p := range r.Procedures {
+		var errs []string
+		for _, code := range sortedKeys(p.Errors) {
+			errs = append(errs, fmt.Sprintf("%s=%d", code, p.Errors[code]))
+		}
+		if len(errs) == 0 {
+			errs = append(errs, "-")
+		}
+		fmt.Fprintf(tw, "%s\t%d\t%.1f\t%s\t%s\t%s\t%s\t%s\t\n", p.Procedure, p.Calls, float64(p.Calls)/r.Elapsed.Seconds(),
+			ms(p.P50), ms(p.P90), ms(p.P99), ms(p.Max), strings.Join(errs, " "))
+	}
+	return tw.Flush()
+}
+
+func ms(d time.Duration) string {
+	return fmt.Sprintf("%.1fms", float64(d)/float64(time.Millisecond))
+}
+
+// recorder collects the latency and outcome of every call made through its interceptor.
+type recorder struct {
+	mu    sync.Mutex
+	calls map[string]*calls
+}
+
+type calls struct {
+	latencies []time.Duration
+	errors    map[string]int
+}
+
+func newRecorder() *recorder {
+	return &recorder{calls: map[string]*calls{}}
+}
+
+func (r *recorder) interceptor() connect.Interceptor {
+	return connect.UnaryInterceptorFunc(func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			start := time.Now()
+			res, err := next(ctx, req)
+			// Calls cut off by the end of the test say nothing about the server.
+			if ctx.Err() == nil {
+				r.observe(req.Spec().Procedure, time.Since(start), err)
+			}
+			return res, err
+		}
+	})
+}
+
+func (r *recorder) observe(procedure string, latency time.Duration, err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	c, ok := r.calls[procedure]
+	if !ok {
+		c = &calls{errors: map[string]int{}}
+		r.calls[procedure] = c
+	}
+	c.latencies = append(c.latencies, latency)
+	if err != nil {
+		c.errors[connect.CodeOf(err).String()]++
+	}
+}
+
+func (r *recorder) report(elapsed time.Duration) *Report {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	rep := &Report{Elapsed: elapsed}
+	for _, procedure := range sortedKeys(r.calls) {
+		c := r.calls[procedure]
+		slices.Sort(c.latencies)
+		rep.Procedures = append(rep.Procedures, ProcedureStats{
+			Procedure: procedure,
+			Calls:     len(c.latencies),
+			P50:       percentile(c.latencies, 50),
+			P90:       percentile(c.latencies, 90),
+			P99:       percentile(c.latencies, 99),
+			Max:       c.latencies[len(c.latencies)-1],
+			Errors:    maps.Clone(c.errors),
+		})
+	}
+	return rep
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	return keys
+}
+
+// percentile returns the p-th percentile of sorted latencies by the nearest-rank method.
+func percentile(sorted []time.Duration, p int) time.Duration {
+	rank := (len(sorted)*p + 99) / 100
+	return sorted[max(rank-1, 0)]
+}
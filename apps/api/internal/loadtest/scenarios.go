@@ -0,0 +1,135 @@
+package loadtest
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"connectrpc.com/connect"
+
+	v1 "github.com/example/something-like-sns/apps/api/gen/sns/v1"
+)
+
+// scenarios are the user behaviours a load test mixes.
+var scenarios = map[string]func(ctx context.Context, s *session) error{
+	// feed scrolls a few pages of the feed, reading comments and liking posts on the way.
+	"feed": feedScenario,
+	// post writes a burst of posts and comments.
+	"post": postScenario,
+	// dm opens a conversation with another member and chats.
+	"dm": dmScenario,
+}
+
+// ScenarioNames returns the names of the scenarios, sorted.
+func ScenarioNames() []string {
+	names := make([]string, 0, len(scenarios))
+	for name := range scenarios {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
+
+func feedScenario(ctx context.Context, s *session) error {
+	var cursor *v1.Cursor
+	for pages := 1 + s.rng.IntN(5); pages > 0; pages-- {
+		res, err := s.timeline.ListFeed(ctx, connect.NewRequest(&v1.ListFeedRequest{Cursor: cursor, PageSize: 20}))
+		if err != nil {
+			return err
+		}
+		for _, p := range res.Msg.Items {
+			if p.CommentCount > 0 && s.rng.IntN(10) < 2 {
+				if _, err := s.timeline.ListComments(ctx, connect.NewRequest(&v1.ListCommentsRequest{PostId: p.Id, PageSize: 20})); err != nil {
+					return err
+				}
+			}
+			if s.rng.IntN(10) == 0 {
+				if _, err := s.reactions.ToggleReaction(ctx, connect.NewRequest(&v1.ToggleReactionRequest{TargetType: v1.TargetType_POST, TargetId: p.Id, Type: "like"})); err != nil {
+					return err
+				}
+			}
+		}
+		if !res.Msg.HasMore {
+			return nil
+		}
+		cursor = res.Msg.Next
+	}
+	return nil
+}
+
+func postScenario(ctx context.Context, s *session) error {
+	res, err := s.timeline.ListFeed(ctx, connect.NewRequest(&v1.ListFeedRequest{PageSize: 10}))
+	if err != nil {
+		return err
+	}
+	posts := res.Msg.Items
+	for n := 1 + s.rng.IntN(5); n > 0; n-- {
+		if len(posts) > 0 && s.rng.IntN(10) < 3 {
+			p := posts[s.rng.IntN(len(posts))]
+			_, err = s.timeline.CreateComment(ctx, connect.NewRequest(&v1.CreateCommentRequest{PostId: p.Id, Body: s.text()}))
+		} else {
+			_, err = s.timeline.CreatePost(ctx, connect.NewRequest(&v1.CreatePostRequest{Body: s.text()}))
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func dmScenario(ctx context.Context, s *session) error {
+	convs, err := s.dms.ListConversations(ctx, connect.NewRequest(&v1.ListConversationsRequest{PageSize: 20}))
+	if err != nil {
+		return err
+	}
+	var convID uint64
+	if items := convs.Msg.Items; len(items) > 0 && s.rng.IntN(10) < 8 {
+		convID = items[s.rng.IntN(len(items))].Id
+	} else if convID, err = s.openConversation(ctx); err != nil || convID == 0 {
+		return err
+	}
+	if _, err := s.dms.ListMessages(ctx, connect.NewRequest(&v1.ListMessagesRequest{ConversationId: convID, PageSize: 30})); err != nil {
+		return err
+	}
+	for n := 1 + s.rng.IntN(3); n > 0; n-- {
+		if _, err := s.dms.SendMessage(ctx, connect.NewRequest(&v1.SendMessageRequest{ConversationId: convID, Body: s.text()})); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// openConversation opens a conversation with an author from the feed. It returns 0 if the feed
+// has no other authors.
+func (s *session) openConversation(ctx context.Context) (uint64, error) {
+	if s.userID == 0 {
+		me, err := s.tenants.GetMe(ctx, connect.NewRequest(&v1.GetMeRequest{}))
+		if err != nil {
+			return 0, err
+		}
+		s.userID = me.Msg.UserId
+	}
+	feed, err := s.timeline.ListFeed(ctx, connect.NewRequest(&v1.ListFeedRequest{PageSize: 20}))
+	if err != nil {
+		return 0, err
+	}
+	var others []uint64
+	for _, p := range feed.Msg.Items {
+		if p.AuthorUserId != s.userID {
+			others = append(others, p.AuthorUserId)
+		}
+	}
+	if len(others) == 0 {
+		return 0, nil
+	}
+	res, err := s.dms.GetOrCreateDM(ctx, connect.NewRequest(&v1.GetOrCreateDMRequest{OtherUserId: others[s.rng.IntN(len(others))]}))
+	if err != nil {
+		return 0, err
+	}
+	return res.Msg.ConversationId, nil
+}
+
+// text returns a short body that differs between calls.
+func (s *session) text() string {
+	return fmt.Sprintf("load test from %s #%d", s.authSub, s.rng.Uint32())
+}
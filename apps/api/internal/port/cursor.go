@@ -1,9 +1,11 @@
 package port
 
-import "time"
+import "github.com/example/something-like-sns/apps/api/internal/domain"
 
-// CursorEncoder defines an interface for encoding and decoding cursors.
+// CursorEncoder defines an interface for encoding and decoding opaque, tamper-proof cursors.
+// A token is only valid for the tenant and list kind it was encoded for.
 type CursorEncoder interface {
-	Encode(t time.Time, id uint64) string
-	Decode(token string) (time.Time, uint64, error)
+	Encode(tenantID uint64, kind string, c domain.Cursor) string
+	// Decode returns the zero Cursor for an empty token.
+	Decode(tenantID uint64, kind string, token string) (domain.Cursor, error)
 }
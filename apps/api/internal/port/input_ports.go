@@ -2,6 +2,7 @@ package port
 
 import (
 	"context"
+	"time"
 
 	v1 "github.com/example/something-like-sns/apps/api/gen/sns/v1"
 	"github.com/example/something-like-sns/apps/api/internal/domain"
@@ -10,9 +11,9 @@ import (
 // TimelineUsecase defines the input port for timeline-related operations.
 type TimelineUsecase interface {
 	CreatePost(ctx context.Context, scope domain.Scope, body string) (*domain.Post, error)
-	ListFeed(ctx context.Context, scope domain.Scope, token string) ([]*domain.Post, string, error)
+	ListFeed(ctx context.Context, scope domain.Scope, page domain.PageParams) ([]*domain.Post, *domain.PageInfo, error)
 	CreateComment(ctx context.Context, scope domain.Scope, postID uint64, body string) (*domain.Comment, error)
-    ListComments(ctx context.Context, scope domain.Scope, postID uint64, token string) ([]*domain.Comment, string, error)
+	ListComments(ctx context.Context, scope domain.Scope, postID uint64, page domain.PageParams) ([]*domain.Comment, *domain.PageInfo, error)
 }
 
 // ReactionUsecase defines the input port for reaction-related operations.
@@ -22,15 +23,127 @@ type ReactionUsecase interface {
 
 // AuthUsecase defines the input port for authentication and authorization.
 type AuthUsecase interface {
-	ResolveScope(ctx context.Context, tenantSlug, userAuthSub string) (*domain.Scope, error)
+	// ResolveScope signs the user in to the tenant, joining it if the tenant's join policy admits
+	// them. The scope of a user who is not a member has no role. email is the user's verified
+	// email address, if known.
+	ResolveScope(ctx context.Context, tenantSlug, userAuthSub, email string) (*domain.Scope, error)
 	ResolveTenant(ctx context.Context, host string) (*domain.Tenant, error)
-	GetMe(ctx context.Context, userID uint64) (*domain.User, error)
+	// GetMe returns the user with their memberships, and their profile as the scope's tenant sees
+	// it, with the tenant's display name and avatar overrides applied.
+	GetMe(ctx context.Context, scope domain.Scope) (*domain.User, *domain.Profile, error)
+}
+
+// ProfileUsecase defines the input port for user profiles and the member directory. Members see
+// each other's profiles and edit only their own.
+type ProfileUsecase interface {
+	// GetProfile returns the profile of a member of the tenant, or the caller's own if userID is 0.
+	GetProfile(ctx context.Context, scope domain.Scope, userID uint64) (*domain.Profile, error)
+	// GetProfileSettings returns the caller's own profile and their override in the tenant.
+	GetProfileSettings(ctx context.Context, scope domain.Scope) (*domain.ProfileSettings, error)
+	// UpdateProfile replaces the caller's own profile, shared by all their tenants, with p, whose
+	// UserID is ignored, and returns the profile as the tenant sees it. An empty handle removes
+	// the caller's handle in the tenant.
+	UpdateProfile(ctx context.Context, scope domain.Scope, p *domain.Profile) (*domain.Profile, error)
+	// UpdateTenantProfile replaces the caller's override in the tenant and returns the profile
+	// as the tenant sees it. Empty fields fall back to the caller's own profile.
+	UpdateTenantProfile(ctx context.Context, scope domain.Scope, o domain.ProfileOverride) (*domain.Profile, error)
+	// ListMembers pages through the tenant's member directory, in the order members joined.
+	// Suspended users, and users the caller blocks or is blocked by, are not listed.
+	ListMembers(ctx context.Context, scope domain.Scope, filter domain.MemberFilter, page domain.PageParams) ([]*domain.DirectoryMember, *domain.PageInfo, error)
+}
+
+// RelationUsecase defines the input port for the blocks and mutes members set on each other.
+// Blocking and muting apply within the caller's tenant only.
+type RelationUsecase interface {
+	BlockUser(ctx context.Context, scope domain.Scope, userID uint64) error
+	UnblockUser(ctx context.Context, scope domain.Scope, userID uint64) error
+	MuteUser(ctx context.Context, scope domain.Scope, userID uint64) error
+	UnmuteUser(ctx context.Context, scope domain.Scope, userID uint64) error
+}
+
+// ModerationUsecase defines the input port for reporting content and the tenant's moderation
+// queue. Members report posts, comments and messages they can see; admins work through the
+// reports, and every action they take is written to the tenant's audit trail.
+type ModerationUsecase interface {
+	ReportContent(ctx context.Context, scope domain.Scope, targetType domain.ReportTargetType, targetID uint64, reason string) (*domain.Report, error)
+	// ListReports pages through the reports with status, oldest first; status defaults to open.
+	ListReports(ctx context.Context, scope domain.Scope, status string, page domain.PageParams) ([]*domain.Report, *domain.PageInfo, error)
+	// ResolveReport takes action on the reported content and resolves every open report of it.
+	// It returns a FailedPreconditionError if the report is already resolved.
+	ResolveReport(ctx context.Context, scope domain.Scope, reportID uint64, action domain.ModerationAction, note string) (*domain.Report, error)
+	// ReinstateMember lifts the suspension of a member suspended by a moderation action.
+	ReinstateMember(ctx context.Context, scope domain.Scope, userID uint64) error
+	ListAuditEvents(ctx context.Context, scope domain.Scope, page domain.PageParams) ([]*domain.AuditEvent, *domain.PageInfo, error)
 }
 
 // DMUsecase defines the input port for DM-related operations.
 type DMUsecase interface {
 	GetOrCreateDM(ctx context.Context, scope domain.Scope, otherUserID uint64) (uint64, error)
-	ListConversations(ctx context.Context, scope domain.Scope, token string) ([]*domain.Conversation, string, error)
-	ListMessages(ctx context.Context, scope domain.Scope, conversationID uint64, token string) ([]*domain.Message, string, error)
+	ListConversations(ctx context.Context, scope domain.Scope, page domain.PageParams) ([]*domain.Conversation, *domain.PageInfo, error)
+	ListMessages(ctx context.Context, scope domain.Scope, conversationID uint64, page domain.PageParams) ([]*domain.Message, *domain.PageInfo, error)
 	SendMessage(ctx context.Context, scope domain.Scope, conversationID uint64, body string) (*domain.Message, error)
 }
+
+// QuotaUsecase defines the input port for plan limits and quota usage. The quotas themselves are
+// checked by the usecases that write, in the write's transaction.
+type QuotaUsecase interface {
+	GetPlan(ctx context.Context, tenantID uint64) (*domain.Plan, error)
+	GetUsage(ctx context.Context, scope domain.Scope) (*domain.Plan, *domain.TenantUsage, error)
+}
+
+// InvitationUsecase defines the input port for joining tenants: join policies, invitations and
+// join requests. Except for AcceptInvitation and RequestToJoin, which are for users who are not
+// members yet, the caller must be an admin.
+type InvitationUsecase interface {
+	// CreateInvitation returns the invitation and its token, which is not stored.
+	CreateInvitation(ctx context.Context, scope domain.Scope, role string, ttl time.Duration) (*domain.Invitation, string, error)
+	ListInvitations(ctx context.Context, scope domain.Scope) ([]*domain.Invitation, error)
+	RevokeInvitation(ctx context.Context, scope domain.Scope, invitationID uint64) error
+	// AcceptInvitation makes the caller a member with the invitation's role, which it returns.
+	AcceptInvitation(ctx context.Context, scope domain.Scope, token string) (string, error)
+
+	RequestToJoin(ctx context.Context, scope domain.Scope, message string) (*domain.JoinRequest, error)
+	ListJoinRequests(ctx context.Context, scope domain.Scope) ([]*domain.JoinRequest, error)
+	ApproveJoinRequest(ctx context.Context, scope domain.Scope, requestID uint64, role string) error
+	RejectJoinRequest(ctx context.Context, scope domain.Scope, requestID uint64) error
+
+	// GetJoinPolicy returns the tenant's join policy and allowed email domains.
+	GetJoinPolicy(ctx context.Context, scope domain.Scope) (string, []string, error)
+	UpdateJoinPolicy(ctx context.Context, scope domain.Scope, policy string, emailDomains []string) error
+}
+
+// TenantAdminUsecase defines the input port for administering a tenant: its name, its members
+// and their roles, and its custom domains. The caller must be an admin; only owners can change
+// owners or make new ones, and the last owner can be neither demoted nor removed. A tenant that
+// turns off the subdomain fallback is only served on its verified domains, so it must keep one.
+type TenantAdminUsecase interface {
+	GetTenant(ctx context.Context, scope domain.Scope) (*domain.Tenant, error)
+	UpdateTenant(ctx context.Context, scope domain.Scope, name string) (*domain.Tenant, error)
+
+	ListTenantMembers(ctx context.Context, scope domain.Scope) ([]*domain.Member, error)
+	UpdateMemberRole(ctx context.Context, scope domain.Scope, userID uint64, role string) error
+	RemoveMember(ctx context.Context, scope domain.Scope, userID uint64) error
+	// TransferOwnership makes another member an owner and the calling owner an admin.
+	TransferOwnership(ctx context.Context, scope domain.Scope, userID uint64) error
+
+	ListDomains(ctx context.Context, scope domain.Scope) ([]*domain.TenantDomain, error)
+	// AddDomain adds host unverified and returns it with the DNS TXT challenge to publish.
+	// Adding a domain of the tenant again returns it as it is.
+	AddDomain(ctx context.Context, scope domain.Scope, host string) (*domain.TenantDomain, error)
+	// VerifyDomain checks the TXT challenge of host and, once it is published, verifies the
+	// domain so that it serves the tenant. It returns a FailedPreconditionError until then.
+	VerifyDomain(ctx context.Context, scope domain.Scope, host string) (*domain.TenantDomain, error)
+	RemoveDomain(ctx context.Context, scope domain.Scope, host string) error
+	// SetSubdomainFallback sets whether the tenant is also served on <slug>.<any host>.
+	SetSubdomainFallback(ctx context.Context, scope domain.Scope, allowed bool) error
+}
+
+// IdempotencyUsecase defines the input port for replaying retried write requests.
+type IdempotencyUsecase interface {
+	// Begin claims key for a request with the given payload hash. It returns the stored response
+	// if the request already completed, or nil if the caller should execute it and then call Complete or Abort.
+	Begin(ctx context.Context, scope domain.Scope, procedure, key string, requestHash []byte) ([]byte, error)
+	Complete(ctx context.Context, scope domain.Scope, procedure, key string, response []byte) error
+	Abort(ctx context.Context, scope domain.Scope, procedure, key string) error
+	PurgeExpired(ctx context.Context) (int64, error)
+}
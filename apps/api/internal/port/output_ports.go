@@ -8,11 +8,16 @@ import (
 )
 
 // TimelineRepository defines the output port for timeline data persistence.
+// List methods return whether more items follow the page in the cursor's direction.
 type TimelineRepository interface {
 	CreatePost(ctx context.Context, tenantID, authorID uint64, body string) (*domain.Post, error)
-	FindFeed(ctx context.Context, tenantID, userID uint64, limit int, cursorTime time.Time, cursorID uint64) ([]*domain.Post, error)
+	FindPostByID(ctx context.Context, tenantID, postID uint64) (*domain.Post, error)
+	// FindFeed leaves out the posts of users that userID blocks or mutes.
+	FindFeed(ctx context.Context, tenantID, userID uint64, limit int, cursor domain.Cursor) ([]*domain.Post, bool, error)
 	CreateComment(ctx context.Context, tenantID, postID, authorID uint64, body string) (*domain.Comment, error)
-    FindCommentsByPostID(ctx context.Context, tenantID, postID uint64, limit int, cursorTime time.Time, cursorID uint64) ([]*domain.Comment, error)
+	FindCommentByID(ctx context.Context, tenantID, commentID uint64) (*domain.Comment, error)
+	FindCommentsByPostID(ctx context.Context, tenantID, postID uint64, limit int, cursor domain.Cursor) ([]*domain.Comment, bool, error)
+	CountComments(ctx context.Context, tenantID, postID uint64) (uint64, error)
 }
 
 // ReactionRepository defines the output port for reaction data persistence.
@@ -23,29 +28,234 @@ type ReactionRepository interface {
 
 // AuthRepository defines the output port for user and tenant data persistence.
 type AuthRepository interface {
+	// FindTenantByHost matches verified domains, then a tenant that allows the subdomain fallback
+	// and whose slug is the first label of host.
 	FindTenantByHost(ctx context.Context, host string) (*domain.Tenant, error)
 	FindTenantBySlug(ctx context.Context, slug string) (*domain.Tenant, error)
+	FindTenantByID(ctx context.Context, tenantID uint64) (*domain.Tenant, error)
+	// CreateTenant creates a tenant on the default plan, or returns a ConflictError if slug is taken.
+	CreateTenant(ctx context.Context, slug, name string) (*domain.Tenant, error)
+	// RenameTenant changes the tenant's display name, or returns a NotFoundError if there is no such tenant.
+	RenameTenant(ctx context.Context, tenantID uint64, name string) error
+	// SetTenantDisabled disables or re-enables the tenant, or returns a NotFoundError if there is no such tenant.
+	SetTenantDisabled(ctx context.Context, tenantID uint64, disabled bool) error
+	// SetTenantJoinPolicy returns a NotFoundError if there is no such tenant.
+	SetTenantJoinPolicy(ctx context.Context, tenantID uint64, policy string) error
+	// SetTenantSubdomainFallback returns a NotFoundError if there is no such tenant.
+	SetTenantSubdomainFallback(ctx context.Context, tenantID uint64, allowed bool) error
+	// AddTenantDomain adds d with its verification state and token, or returns a ConflictError if
+	// its host is a domain of the tenant already or d is verified and its host is a verified domain
+	// of another tenant. Any number of tenants may claim a host they have not verified.
+	AddTenantDomain(ctx context.Context, tenantID uint64, d *domain.TenantDomain) error
+	// VerifyTenantDomain marks the domain verified and drops its challenge token. It returns a
+	// NotFoundError if host is not a domain of the tenant, or a ConflictError if another tenant
+	// has verified it.
+	VerifyTenantDomain(ctx context.Context, tenantID uint64, host string) error
+	// RemoveTenantDomain returns a NotFoundError if host is not a domain of the tenant.
+	RemoveTenantDomain(ctx context.Context, tenantID uint64, host string) error
+	// FindTenantDomains returns the tenant's domains ordered by host.
+	FindTenantDomains(ctx context.Context, tenantID uint64) ([]*domain.TenantDomain, error)
+	// FindOrCreateUser returns the ID of the user with authSub, creating the user with displayName
+	// if there is none. Existing users are left as they are.
 	FindOrCreateUser(ctx context.Context, authSub, displayName string) (uint64, error)
 	FindUserByID(ctx context.Context, userID uint64) (*domain.User, error)
+	FindUserByAuthSub(ctx context.Context, authSub string) (*domain.User, error)
+	// SetUserSuspended suspends or reinstates the user, or returns a NotFoundError if there is no such user.
+	SetUserSuspended(ctx context.Context, userID uint64, suspended bool) error
+	FindMembershipRole(ctx context.Context, tenantID, userID uint64) (string, error)
+	// EnsureMembership adds the user to the tenant unless they are a member already, and clears
+	// any record of their removal.
 	EnsureMembership(ctx context.Context, tenantID, userID uint64, role string) error
+	// UpdateMembershipRole returns a NotFoundError if the user is not a member of the tenant.
+	UpdateMembershipRole(ctx context.Context, tenantID, userID uint64, role string) error
+	// RemoveMembership records the removal, or returns a NotFoundError if the user is not a member
+	// of the tenant.
+	RemoveMembership(ctx context.Context, tenantID, userID uint64) error
+	// IsMemberRemoved reports whether the user was removed from the tenant and not added back since.
+	IsMemberRemoved(ctx context.Context, tenantID, userID uint64) (bool, error)
+	// SetMembershipSuspended suspends or reinstates the user in the tenant only, or returns a
+	// NotFoundError if the user is not a member of the tenant.
+	SetMembershipSuspended(ctx context.Context, tenantID, userID uint64, suspended bool) error
+	// FindMembership returns the user's role in the tenant and whether the membership is
+	// suspended, or an empty role if the user is not a member.
+	FindMembership(ctx context.Context, tenantID, userID uint64) (role string, suspended bool, err error)
+	// FindMembers returns the tenant's members ordered by user ID.
+	FindMembers(ctx context.Context, tenantID uint64) ([]*domain.Member, error)
+	// LockOwners returns the user IDs of the tenant's owners. Inside a transaction it locks their
+	// memberships until the transaction ends, so a check on the owners holds until the write
+	// that depends on it commits.
+	LockOwners(ctx context.Context, tenantID uint64) ([]uint64, error)
 	FindUserMemberships(ctx context.Context, userID uint64) ([]*domain.TenantMembership, error)
 }
 
+// ProfileRepository defines the output port for user profiles as seen from a tenant, and the
+// tenant's member directory. List methods return whether more items follow the page in the
+// cursor's direction.
+type ProfileRepository interface {
+	// FindProfiles returns the profiles of the users in userIDs that exist, in no particular
+	// order, with their handles and overrides in the tenant. It makes a single query however
+	// many users there are.
+	FindProfiles(ctx context.Context, tenantID uint64, userIDs []uint64) ([]*domain.Profile, error)
+	// FindProfileSettings returns the member's own profile and their override in the tenant, or
+	// a NotFoundError if the user is not a member.
+	FindProfileSettings(ctx context.Context, tenantID, userID uint64) (*domain.ProfileSettings, error)
+	// UpdateProfile replaces the user's own profile and their handle in the tenant. It returns a
+	// NotFoundError if the user is not a member, or a ConflictError if the handle is taken.
+	UpdateProfile(ctx context.Context, tenantID uint64, p *domain.Profile) error
+	// UpdateProfileOverride replaces the member's override in the tenant, or returns a
+	// NotFoundError if the user is not a member.
+	UpdateProfileOverride(ctx context.Context, tenantID, userID uint64, o domain.ProfileOverride) error
+	// FindDirectoryMembers returns the tenant's members that match filter, ordered by the time
+	// they joined. Suspended users, and users that viewerID blocks or is blocked by, are left out.
+	FindDirectoryMembers(ctx context.Context, tenantID, viewerID uint64, filter domain.MemberFilter, limit int, cursor domain.Cursor) ([]*domain.DirectoryMember, bool, error)
+}
+
+// RelationRepository defines the output port for the blocks and mutes members set on each other
+// within a tenant.
+type RelationRepository interface {
+	// AddRelation records that userID blocks or mutes targetID. Adding a relation again is a no-op.
+	AddRelation(ctx context.Context, tenantID, userID, targetID uint64, kind domain.RelationKind) error
+	// RemoveRelation deletes the relation, if there is one.
+	RemoveRelation(ctx context.Context, tenantID, userID, targetID uint64, kind domain.RelationKind) error
+	// IsBlocked reports whether either of the two users blocks the other.
+	IsBlocked(ctx context.Context, tenantID, userID1, userID2 uint64) (bool, error)
+	// IsBlockedInConversation reports whether userID blocks, or is blocked by, another member of
+	// the conversation.
+	IsBlockedInConversation(ctx context.Context, tenantID, conversationID, userID uint64) (bool, error)
+}
+
+// ModerationRepository defines the output port for content reports, the removal of reported
+// content and the audit trail of admin actions. List methods return whether more items follow
+// the page in the cursor's direction.
+type ModerationRepository interface {
+	// CreateReport stores an open report, or returns a ConflictError if the reporter already
+	// reported the content.
+	CreateReport(ctx context.Context, tenantID uint64, r *domain.Report) (*domain.Report, error)
+	// FindReport returns the report, or a NotFoundError if there is none.
+	FindReport(ctx context.Context, tenantID, reportID uint64) (*domain.Report, error)
+	// FindReports returns the reports with status, oldest first.
+	FindReports(ctx context.Context, tenantID uint64, status string, limit int, cursor domain.Cursor) ([]*domain.Report, bool, error)
+	// ResolveReports resolves every open report of the content with action, as decided by
+	// userID at now.
+	ResolveReports(ctx context.Context, tenantID uint64, targetType domain.ReportTargetType, targetID, userID uint64, action domain.ModerationAction, now time.Time) error
+	// RemoveContent takes the post, comment or message out of every list and lookup, erasing its
+	// body, and the copy its reports keep, if erase is set. It returns a NotFoundError if there is
+	// no such content; removing it again is a no-op, except that it can still be erased.
+	RemoveContent(ctx context.Context, tenantID uint64, targetType domain.ReportTargetType, targetID uint64, erase bool) error
+
+	AppendAuditEvent(ctx context.Context, tenantID uint64, e *domain.AuditEvent) error
+	// FindAuditEvents returns the tenant's audit events, newest first.
+	FindAuditEvents(ctx context.Context, tenantID uint64, limit int, cursor domain.Cursor) ([]*domain.AuditEvent, bool, error)
+}
+
 // DMRepository defines the output port for DM data persistence.
+// List methods return whether more items follow the page in the cursor's direction.
 type DMRepository interface {
 	FindDMConversation(ctx context.Context, tenantID, userID1, userID2 uint64) (uint64, error)
+	IsMember(ctx context.Context, tenantID, conversationID, userID uint64) (bool, error)
 	CreateDMConversation(ctx context.Context, tenantID uint64, userIDs ...uint64) (uint64, error)
-	FindConversations(ctx context.Context, tenantID, userID uint64, limit int, cursorTime time.Time, cursorID uint64) ([]*domain.Conversation, error)
-	FindMessages(ctx context.Context, tenantID, conversationID uint64, limit int, cursorTime time.Time, cursorID uint64) ([]*domain.Message, error)
+	FindConversations(ctx context.Context, tenantID, userID uint64, limit int, cursor domain.Cursor) ([]*domain.Conversation, bool, error)
+	// FindMessageByID returns the message, or a NotFoundError if there is none or it was removed.
+	FindMessageByID(ctx context.Context, tenantID, messageID uint64) (*domain.Message, error)
+	FindMessages(ctx context.Context, tenantID, conversationID uint64, limit int, cursor domain.Cursor) ([]*domain.Message, bool, error)
+	CountMessages(ctx context.Context, tenantID, conversationID uint64) (uint64, error)
 	CreateMessage(ctx context.Context, tenantID, conversationID, senderID uint64, body string) (*domain.Message, error)
 }
 
+// PlanRepository defines the output port for plan and quota usage data.
+//
+// Usage is kept in per-tenant counters that the writes changing it update along with their own
+// rows: CreatePost, CreateComment, CreateMessage, RemoveContent, EnsureMembership,
+// RemoveMembership and the bulk inserts. Updating the counters locks them until the transaction
+// ends, so a quota check that reads them after the write, in the same transaction, cannot be
+// passed by two concurrent writes at once.
+type PlanRepository interface {
+	FindTenantPlan(ctx context.Context, tenantID uint64) (*domain.Plan, error)
+	// GetUsage returns the tenant's usage counters. PostsToday counts the posts created since
+	// midnight UTC, except for those inserted in bulk.
+	GetUsage(ctx context.Context, tenantID uint64) (*domain.TenantUsage, error)
+}
+
+// IdempotencyRepository defines the output port for idempotency key persistence.
+// Records are identified by tenant, user, procedure and key.
+type IdempotencyRepository interface {
+	// Find returns the record, or a NotFoundError if there is none.
+	Find(ctx context.Context, tenantID, userID uint64, procedure, key string) (*domain.IdempotencyRecord, error)
+	// Reserve inserts a record without a response, or returns a ConflictError if one already exists.
+	Reserve(ctx context.Context, rec *domain.IdempotencyRecord) error
+	Complete(ctx context.Context, tenantID, userID uint64, procedure, key string, response []byte) error
+	Delete(ctx context.Context, tenantID, userID uint64, procedure, key string) error
+	DeleteExpired(ctx context.Context, now time.Time) (int64, error)
+}
+
+// InvitationRepository defines the output port for invitations, join requests and the email
+// domains allowed to join a tenant. Invitations are stored and found by the SHA-256 hash of their
+// token; the token itself is only known to whoever created the invitation.
+type InvitationRepository interface {
+	CreateInvitation(ctx context.Context, tenantID uint64, inv *domain.Invitation, tokenHash []byte) (*domain.Invitation, error)
+	// FindInvitationByTokenHash returns the invitation whether or not it is still usable, or a NotFoundError.
+	FindInvitationByTokenHash(ctx context.Context, tenantID uint64, tokenHash []byte) (*domain.Invitation, error)
+	// FindPendingInvitations returns the unused invitations that have not expired at now, ordered by ID.
+	FindPendingInvitations(ctx context.Context, tenantID uint64, now time.Time) ([]*domain.Invitation, error)
+	// ClaimInvitation marks the invitation as accepted by userID, or returns a ConflictError if it
+	// was used or expired at now.
+	ClaimInvitation(ctx context.Context, tenantID, invitationID, userID uint64, now time.Time) error
+	// DeleteInvitation returns a NotFoundError if there is no such invitation.
+	DeleteInvitation(ctx context.Context, tenantID, invitationID uint64) error
+
+	// CreateJoinRequest returns a ConflictError if the user already has a pending request.
+	CreateJoinRequest(ctx context.Context, tenantID, userID uint64, message string) (*domain.JoinRequest, error)
+	FindJoinRequest(ctx context.Context, tenantID, requestID uint64) (*domain.JoinRequest, error)
+	// FindJoinRequests returns the pending requests ordered by ID.
+	FindJoinRequests(ctx context.Context, tenantID uint64) ([]*domain.JoinRequest, error)
+	// DeleteJoinRequest returns a NotFoundError if there is no such request.
+	DeleteJoinRequest(ctx context.Context, tenantID, requestID uint64) error
+
+	// FindEmailDomains returns the tenant's allowed email domains, sorted.
+	FindEmailDomains(ctx context.Context, tenantID uint64) ([]string, error)
+	// SetEmailDomains replaces the tenant's allowed email domains.
+	SetEmailDomains(ctx context.Context, tenantID uint64, domains []string) error
+}
+
+// DomainResolver defines the output port for looking up the DNS records that prove control of
+// a custom domain.
+type DomainResolver interface {
+	// LookupTXT returns the TXT records of name, or none if name does not exist.
+	LookupTXT(ctx context.Context, name string) ([]string, error)
+}
+
+// BulkRepository defines the output port for loading generated data in batches, for load and
+// pagination tests. Rows are inserted as given, with their IDs and timestamps, using multi-row
+// statements. IDs are allocated by the caller from NextID, so nothing else may write to the
+// tables while data is loaded.
+type BulkRepository interface {
+	// NextID returns one more than the highest ID of table: "users", "posts", "comments",
+	// "conversations" or "messages".
+	NextID(ctx context.Context, table string) (uint64, error)
+	InsertUsers(ctx context.Context, users []*domain.User) error
+	// InsertMemberships adds the members' users to the tenant with their roles.
+	InsertMemberships(ctx context.Context, tenantID uint64, members []*domain.Member) error
+	InsertPosts(ctx context.Context, tenantID uint64, posts []*domain.Post) error
+	InsertComments(ctx context.Context, tenantID uint64, comments []*domain.Comment) error
+	InsertReactions(ctx context.Context, tenantID uint64, reactions []*domain.ReactionRecord) error
+	// InsertConversations inserts DM conversations with their members.
+	InsertConversations(ctx context.Context, tenantID uint64, conversations []*domain.Conversation) error
+	InsertMessages(ctx context.Context, tenantID uint64, messages []*domain.Message) error
+}
+
 // Store defines the interface for accessing all repositories.
 // It also provides a method to execute operations within a database transaction.
 type Store interface {
 	AuthRepository() AuthRepository
+	ProfileRepository() ProfileRepository
+	RelationRepository() RelationRepository
+	ModerationRepository() ModerationRepository
 	TimelineRepository() TimelineRepository
 	ReactionRepository() ReactionRepository
 	DMRepository() DMRepository
+	PlanRepository() PlanRepository
+	IdempotencyRepository() IdempotencyRepository
+	InvitationRepository() InvitationRepository
+	BulkRepository() BulkRepository
 	ExecTx(ctx context.Context, fn func(Store) error) error
 }
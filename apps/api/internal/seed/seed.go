@@ -0,0 +1,123 @@
+// Package seed loads the sample tenants, users, posts and DMs used for local development.
+package seed
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/example/something-like-sns/apps/api/internal/domain"
+	"github.com/example/something-like-sns/apps/api/internal/port"
+)
+
+// Run seeds store through its repositories. It is idempotent: existing tenants, users and
+// memberships are reused, and sample posts and messages are only added where there are none.
+func Run(ctx context.Context, store port.Store) error {
+	auth := store.AuthRepository()
+
+	acme, err := ensureTenant(ctx, auth, "acme", "Acme Inc", "acme.localhost", domain.JoinPolicyInvite)
+	if err != nil {
+		return err
+	}
+	if _, err := ensureTenant(ctx, auth, "beta", "Beta LLC", "beta.localhost", domain.JoinPolicyOpen); err != nil {
+		return err
+	}
+
+	aliceID, err := auth.FindOrCreateUser(ctx, "u_alice", "Alice")
+	if err != nil {
+		return fmt.Errorf("user alice: %w", err)
+	}
+	bobID, err := auth.FindOrCreateUser(ctx, "u_bob", "Bob")
+	if err != nil {
+		return fmt.Errorf("user bob: %w", err)
+	}
+	caroID, err := auth.FindOrCreateUser(ctx, "u_caro", "Caro")
+	if err != nil {
+		return fmt.Errorf("user caro: %w", err)
+	}
+
+	for userID, role := range map[uint64]string{aliceID: "owner", bobID: "admin", caroID: "member"} {
+		if err := auth.EnsureMembership(ctx, acme.ID, userID, role); err != nil {
+			return fmt.Errorf("membership: %w", err)
+		}
+	}
+
+	if err := ensureSamplePosts(ctx, store.TimelineRepository(), acme.ID, aliceID); err != nil {
+		return fmt.Errorf("posts: %w", err)
+	}
+	if err := store.ExecTx(ctx, func(tx port.Store) error {
+		return ensureSampleDM(ctx, tx.DMRepository(), acme.ID, aliceID, bobID)
+	}); err != nil {
+		return fmt.Errorf("dm: %w", err)
+	}
+	return nil
+}
+
+// ensureTenant creates the tenant with joinPolicy, or finds it if it exists; the policy of an
+// existing tenant is left as it is.
+func ensureTenant(ctx context.Context, auth port.AuthRepository, slug, name, host, joinPolicy string) (*domain.Tenant, error) {
+	t, err := auth.CreateTenant(ctx, slug, name)
+	var conflict *domain.ConflictError
+	if errors.As(err, &conflict) {
+		t, err = auth.FindTenantBySlug(ctx, slug)
+	} else if err == nil && t.JoinPolicy != joinPolicy {
+		err = auth.SetTenantJoinPolicy(ctx, t.ID, joinPolicy)
+		t.JoinPolicy = joinPolicy
+	}
+	if err != nil {
+		return nil, fmt.Errorf("tenant %s: %w", slug, err)
+	}
+	if err := auth.AddTenantDomain(ctx, t.ID, &domain.TenantDomain{Host: host, Verified: true}); err != nil && !errors.As(err, &conflict) {
+		return nil, fmt.Errorf("tenant domain %s: %w", host, err)
+	}
+	return t, nil
+}
+
+// ensureSamplePosts adds 5 posts with 2 comments each unless the tenant already has posts.
+func ensureSamplePosts(ctx context.Context, timeline port.TimelineRepository, tenantID, authorID uint64) error {
+	existing, _, err := timeline.FindFeed(ctx, tenantID, authorID, 1, domain.Cursor{})
+	if err != nil || len(existing) > 0 {
+		return err
+	}
+	for i := 1; i <= 5; i++ {
+		p, err := timeline.CreatePost(ctx, tenantID, authorID, fmt.Sprintf("hello world %d", i))
+		if err != nil {
+			return err
+		}
+		for j := 1; j <= 2; j++ {
+			if _, err := timeline.CreateComment(ctx, tenantID, p.ID, authorID, fmt.Sprintf("comment %d-%d", i, j)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// ensureSampleDM creates the DM between alice and bob and adds messages if it has none.
+func ensureSampleDM(ctx context.Context, dm port.DMRepository, tenantID, aliceID, bobID uint64) error {
+	convID, err := dm.FindDMConversation(ctx, tenantID, aliceID, bobID)
+	if err != nil {
+		return err
+	}
+	if convID == 0 {
+		if convID, err = dm.CreateDMConversation(ctx, tenantID, aliceID, bobID); err != nil {
+			return err
+		}
+	}
+	existing, _, err := dm.FindMessages(ctx, tenantID, convID, 1, domain.Cursor{})
+	if err != nil || len(existing) > 0 {
+		return err
+	}
+	msgs := []struct {
+		from uint64
+		body string
+	}{
+		{aliceID, "Hey Bob"}, {bobID, "Hey Alice"}, {aliceID, "How are you?"},
+	}
+	for _, m := range msgs {
+		if _, err := dm.CreateMessage(ctx, tenantID, convID, m.from, m.body); err != nil {
+			return err
+		}
+	}
+	return nil
+}
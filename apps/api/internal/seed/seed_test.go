@@ -0,0 +1,44 @@
+package seed_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/example/something-like-sns/apps/api/internal/adapter/repository/memory"
+	"github.com/example/something-like-sns/apps/api/internal/domain"
+	"github.com/example/something-like-sns/apps/api/internal/seed"
+)
+
+func TestRunIsIdempotent(t *testing.T) {
+	ctx := context.Background()
+	store := memory.NewStore()
+	for i := 0; i < 2; i++ {
+		if err := seed.Run(ctx, store); err != nil {
+			t.Fatalf("run #%d: %v", i+1, err)
+		}
+	}
+
+	acme, err := store.AuthRepository().FindTenantByHost(ctx, "acme.localhost")
+	if err != nil {
+		t.Fatalf("find acme: %v", err)
+	}
+	alice, err := store.AuthRepository().FindOrCreateUser(ctx, "u_alice", "Alice")
+	if err != nil {
+		t.Fatalf("find alice: %v", err)
+	}
+	if role, err := store.AuthRepository().FindMembershipRole(ctx, acme.ID, alice); err != nil || role != "owner" {
+		t.Fatalf("alice role = %q, %v; want owner", role, err)
+	}
+	posts, _, err := store.TimelineRepository().FindFeed(ctx, acme.ID, alice, 10, domain.Cursor{})
+	if err != nil || len(posts) != 5 {
+		t.Fatalf("feed = %d posts, %v; want 5", len(posts), err)
+	}
+	convs, _, err := store.DMRepository().FindConversations(ctx, acme.ID, alice, 10, domain.Cursor{})
+	if err != nil || len(convs) != 1 {
+		t.Fatalf("conversations = %d, %v; want 1", len(convs), err)
+	}
+	msgs, _, err := store.DMRepository().FindMessages(ctx, acme.ID, convs[0].ID, 10, domain.Cursor{})
+	if err != nil || len(msgs) != 3 {
+		t.Fatalf("messages = %d, %v; want 3", len(msgs), err)
+	}
+}
@@ -4,6 +4,7 @@ import { createHeaders, rpc } from "app/_lib/server/api";
 type Post = {
   id: number;
   authorUserId: number | string;
+  author?: { displayName: string; handle?: string };
   body: string;
   createdAt: string;
   likedByMe: boolean;
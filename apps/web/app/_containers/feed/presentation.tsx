@@ -9,6 +9,7 @@ import {
 type Post = {
   id: number;
   authorUserId: number | string;
+  author?: { displayName: string; handle?: string };
   body: string;
   createdAt: string;
   likedByMe: boolean;
@@ -79,7 +80,7 @@ export default function FeedView({ initialPosts }: Props) {
               // Suppress hydration warning for timestamp mismatch between server and client.
               suppressHydrationWarning
             >
-              by {String(p.authorUserId)} at{" "}
+              by {p.author?.displayName ?? String(p.authorUserId)} at{" "}
               {new Date(p.createdAt).toLocaleString()}
             </div>
             <div style={{ margin: "8px 0" }}>{p.body}</div>
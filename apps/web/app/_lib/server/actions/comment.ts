@@ -7,6 +7,7 @@ type CreateCommentResponse = {
     id: number;
     postId: number;
     authorUserId: number;
+    author?: { displayName: string; handle?: string };
     body: string;
     createdAt: string;
   };
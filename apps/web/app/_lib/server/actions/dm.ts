@@ -7,6 +7,7 @@ type SendMessageResponse = {
     id: number;
     conversationId: number;
     senderUserId: number;
+    sender?: { displayName: string; handle?: string };
     body: string;
     createdAt: string;
   };
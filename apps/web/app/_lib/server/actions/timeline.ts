@@ -9,6 +9,7 @@ type CreatePostResponse = {
     likeCount: number;
     commentCount: number;
     authorUserId: number | string;
+    author?: { displayName: string; handle?: string };
     body: string;
     createdAt: string;
   };
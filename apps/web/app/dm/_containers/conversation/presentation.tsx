@@ -58,7 +58,8 @@ export default function ConversationView({
             }}
           >
             <div style={{ fontSize: 12, color: "#666" }}>
-              {m.senderUserId} at {new Date(m.createdAt).toLocaleString()}
+              {m.sender?.displayName ?? m.senderUserId} at{" "}
+              {new Date(m.createdAt).toLocaleString()}
             </div>
             <div>{m.body}</div>
           </div>
@@ -8,6 +8,7 @@ export type Message = {
   id: number;
   conversationId: number;
   senderUserId: number;
+  sender?: { displayName: string; handle?: string };
   body: string;
   createdAt: string;
 };
@@ -36,7 +36,8 @@ export default function PostDetailView({ postId, initialComments }: Props) {
         {comments.map((c) => (
           <li key={c.id} style={{ border: "1px solid #ddd", padding: 8 }}>
             <div style={{ fontSize: 12, color: "#666" }}>
-              by {c.authorUserId} at {new Date(c.createdAt).toLocaleString()}
+              by {c.author?.displayName ?? c.authorUserId} at{" "}
+              {new Date(c.createdAt).toLocaleString()}
             </div>
             <div>{c.body}</div>
           </li>
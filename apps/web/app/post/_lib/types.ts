@@ -2,6 +2,7 @@ export type Comment = {
   id: number;
   postId: number;
   authorUserId: number;
+  author?: { displayName: string; handle?: string };
   body: string;
   createdAt: string;
 };
@@ -0,0 +1,3 @@
+-- The API connects as a regular role: row-level security does not apply to superusers.
+CREATE ROLE app LOGIN PASSWORD 'pass';
+CREATE DATABASE sns OWNER app;
@@ -129,10 +129,6 @@ resource "aws_codebuild_project" "main" {
       name  = "API_ECR_URI"
       value = aws_ecr_repository.api.repository_url
     }
-    environment_variable {
-      name  = "MIGRATE_ECR_URI"
-      value = aws_ecr_repository.db_migration.repository_url
-    }
     environment_variable {
       name  = "API_CONTAINER_NAME"
       value = var.api_container_name
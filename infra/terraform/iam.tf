@@ -102,8 +102,7 @@ resource "aws_iam_policy" "codebuild_policy" {
           "ecr:PutImage"
         ],
         Resource = [
-          aws_ecr_repository.api.arn,
-          aws_ecr_repository.db_migration.arn
+          aws_ecr_repository.api.arn
         ]
       },
       {
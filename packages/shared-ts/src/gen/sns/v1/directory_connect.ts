@@ -0,0 +1,26 @@
+// @generated by protoc-gen-connect-es v1.5.0 with parameter "target=ts,import_extension=.ts"
+// @generated from file sns/v1/directory.proto (package sns.v1, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import { ListMembersRequest, ListMembersResponse } from "./directory_pb.ts";
+import { MethodKind } from "@bufbuild/protobuf";
+
+/**
+ * @generated from service sns.v1.DirectoryService
+ */
+export const DirectoryService = {
+  typeName: "sns.v1.DirectoryService",
+  methods: {
+    /**
+     * @generated from rpc sns.v1.DirectoryService.ListMembers
+     */
+    listMembers: {
+      name: "ListMembers",
+      I: ListMembersRequest,
+      O: ListMembersResponse,
+      kind: MethodKind.Unary,
+    },
+  }
+} as const;
+
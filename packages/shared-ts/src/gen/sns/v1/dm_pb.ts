@@ -5,6 +5,7 @@
 
 import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
 import { Message as Message$1, proto3, protoInt64 } from "@bufbuild/protobuf";
+import { Profile } from "./profile_pb.ts";
 import { Cursor } from "./timeline_pb.ts";
 
 /**
@@ -85,6 +86,11 @@ export class Message extends Message$1<Message> {
    */
   createdAt = "";
 
+  /**
+   * @generated from field: sns.v1.Profile sender = 6;
+   */
+  sender?: Profile;
+
   constructor(data?: PartialMessage<Message>) {
     super();
     proto3.util.initPartial(data, this);
@@ -98,6 +104,7 @@ export class Message extends Message$1<Message> {
     { no: 3, name: "sender_user_id", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
     { no: 4, name: "body", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 5, name: "created_at", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "sender", kind: "message", T: Profile },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Message {
@@ -200,6 +207,11 @@ export class ListConversationsRequest extends Message$1<ListConversationsRequest
    */
   cursor?: Cursor;
 
+  /**
+   * @generated from field: uint32 page_size = 2;
+   */
+  pageSize = 0;
+
   constructor(data?: PartialMessage<ListConversationsRequest>) {
     super();
     proto3.util.initPartial(data, this);
@@ -209,6 +221,7 @@ export class ListConversationsRequest extends Message$1<ListConversationsRequest
   static readonly typeName = "sns.v1.ListConversationsRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "cursor", kind: "message", T: Cursor },
+    { no: 2, name: "page_size", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListConversationsRequest {
@@ -242,6 +255,16 @@ export class ListConversationsResponse extends Message$1<ListConversationsRespon
    */
   next?: Cursor;
 
+  /**
+   * @generated from field: sns.v1.Cursor prev = 3;
+   */
+  prev?: Cursor;
+
+  /**
+   * @generated from field: bool has_more = 4;
+   */
+  hasMore = false;
+
   constructor(data?: PartialMessage<ListConversationsResponse>) {
     super();
     proto3.util.initPartial(data, this);
@@ -252,6 +275,8 @@ export class ListConversationsResponse extends Message$1<ListConversationsRespon
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "items", kind: "message", T: Conversation, repeated: true },
     { no: 2, name: "next", kind: "message", T: Cursor },
+    { no: 3, name: "prev", kind: "message", T: Cursor },
+    { no: 4, name: "has_more", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListConversationsResponse {
@@ -285,6 +310,16 @@ export class ListMessagesRequest extends Message$1<ListMessagesRequest> {
    */
   cursor?: Cursor;
 
+  /**
+   * @generated from field: uint32 page_size = 3;
+   */
+  pageSize = 0;
+
+  /**
+   * @generated from field: bool include_total = 4;
+   */
+  includeTotal = false;
+
   constructor(data?: PartialMessage<ListMessagesRequest>) {
     super();
     proto3.util.initPartial(data, this);
@@ -295,6 +330,8 @@ export class ListMessagesRequest extends Message$1<ListMessagesRequest> {
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "conversation_id", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
     { no: 2, name: "cursor", kind: "message", T: Cursor },
+    { no: 3, name: "page_size", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 4, name: "include_total", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListMessagesRequest {
@@ -328,6 +365,21 @@ export class ListMessagesResponse extends Message$1<ListMessagesResponse> {
    */
   next?: Cursor;
 
+  /**
+   * @generated from field: sns.v1.Cursor prev = 3;
+   */
+  prev?: Cursor;
+
+  /**
+   * @generated from field: bool has_more = 4;
+   */
+  hasMore = false;
+
+  /**
+   * @generated from field: uint64 total = 5;
+   */
+  total = protoInt64.zero;
+
   constructor(data?: PartialMessage<ListMessagesResponse>) {
     super();
     proto3.util.initPartial(data, this);
@@ -338,6 +390,9 @@ export class ListMessagesResponse extends Message$1<ListMessagesResponse> {
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "items", kind: "message", T: Message, repeated: true },
     { no: 2, name: "next", kind: "message", T: Cursor },
+    { no: 3, name: "prev", kind: "message", T: Cursor },
+    { no: 4, name: "has_more", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 5, name: "total", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListMessagesResponse {
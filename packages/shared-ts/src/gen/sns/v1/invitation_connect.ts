@@ -0,0 +1,107 @@
+// @generated by protoc-gen-connect-es v1.5.0 with parameter "target=ts,import_extension=.ts"
+// @generated from file sns/v1/invitation.proto (package sns.v1, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import { AcceptInvitationRequest, AcceptInvitationResponse, ApproveJoinRequestRequest, ApproveJoinRequestResponse, CreateInvitationRequest, CreateInvitationResponse, GetJoinPolicyRequest, GetJoinPolicyResponse, ListInvitationsRequest, ListInvitationsResponse, ListJoinRequestsRequest, ListJoinRequestsResponse, RejectJoinRequestRequest, RejectJoinRequestResponse, RequestToJoinRequest, RequestToJoinResponse, RevokeInvitationRequest, RevokeInvitationResponse, UpdateJoinPolicyRequest, UpdateJoinPolicyResponse } from "./invitation_pb.ts";
+import { MethodKind } from "@bufbuild/protobuf";
+
+/**
+ * @generated from service sns.v1.InvitationService
+ */
+export const InvitationService = {
+  typeName: "sns.v1.InvitationService",
+  methods: {
+    /**
+     * @generated from rpc sns.v1.InvitationService.CreateInvitation
+     */
+    createInvitation: {
+      name: "CreateInvitation",
+      I: CreateInvitationRequest,
+      O: CreateInvitationResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc sns.v1.InvitationService.ListInvitations
+     */
+    listInvitations: {
+      name: "ListInvitations",
+      I: ListInvitationsRequest,
+      O: ListInvitationsResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc sns.v1.InvitationService.RevokeInvitation
+     */
+    revokeInvitation: {
+      name: "RevokeInvitation",
+      I: RevokeInvitationRequest,
+      O: RevokeInvitationResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc sns.v1.InvitationService.AcceptInvitation
+     */
+    acceptInvitation: {
+      name: "AcceptInvitation",
+      I: AcceptInvitationRequest,
+      O: AcceptInvitationResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc sns.v1.InvitationService.RequestToJoin
+     */
+    requestToJoin: {
+      name: "RequestToJoin",
+      I: RequestToJoinRequest,
+      O: RequestToJoinResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc sns.v1.InvitationService.ListJoinRequests
+     */
+    listJoinRequests: {
+      name: "ListJoinRequests",
+      I: ListJoinRequestsRequest,
+      O: ListJoinRequestsResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc sns.v1.InvitationService.ApproveJoinRequest
+     */
+    approveJoinRequest: {
+      name: "ApproveJoinRequest",
+      I: ApproveJoinRequestRequest,
+      O: ApproveJoinRequestResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc sns.v1.InvitationService.RejectJoinRequest
+     */
+    rejectJoinRequest: {
+      name: "RejectJoinRequest",
+      I: RejectJoinRequestRequest,
+      O: RejectJoinRequestResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc sns.v1.InvitationService.GetJoinPolicy
+     */
+    getJoinPolicy: {
+      name: "GetJoinPolicy",
+      I: GetJoinPolicyRequest,
+      O: GetJoinPolicyResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc sns.v1.InvitationService.UpdateJoinPolicy
+     */
+    updateJoinPolicy: {
+      name: "UpdateJoinPolicy",
+      I: UpdateJoinPolicyRequest,
+      O: UpdateJoinPolicyResponse,
+      kind: MethodKind.Unary,
+    },
+  }
+} as const;
+
@@ -0,0 +1,858 @@
+// @generated by protoc-gen-es v1.10.0 with parameter "target=ts,import_extension=.ts"
+// @generated from file sns/v1/invitation.proto (package sns.v1, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
+import { Message, proto3, protoInt64 } from "@bufbuild/protobuf";
+
+/**
+ * @generated from message sns.v1.Invitation
+ */
+export class Invitation extends Message<Invitation> {
+  /**
+   * @generated from field: uint64 id = 1;
+   */
+  id = protoInt64.zero;
+
+  /**
+   * @generated from field: string role = 2;
+   */
+  role = "";
+
+  /**
+   * @generated from field: uint64 created_by_user_id = 3;
+   */
+  createdByUserId = protoInt64.zero;
+
+  /**
+   * @generated from field: string created_at = 4;
+   */
+  createdAt = "";
+
+  /**
+   * @generated from field: string expires_at = 5;
+   */
+  expiresAt = "";
+
+  constructor(data?: PartialMessage<Invitation>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "sns.v1.Invitation";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 2, name: "role", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "created_by_user_id", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 4, name: "created_at", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "expires_at", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Invitation {
+    return new Invitation().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Invitation {
+    return new Invitation().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Invitation {
+    return new Invitation().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: Invitation | PlainMessage<Invitation> | undefined, b: Invitation | PlainMessage<Invitation> | undefined): boolean {
+    return proto3.util.equals(Invitation, a, b);
+  }
+}
+
+/**
+ * @generated from message sns.v1.JoinRequest
+ */
+export class JoinRequest extends Message<JoinRequest> {
+  /**
+   * @generated from field: uint64 id = 1;
+   */
+  id = protoInt64.zero;
+
+  /**
+   * @generated from field: uint64 user_id = 2;
+   */
+  userId = protoInt64.zero;
+
+  /**
+   * @generated from field: string display_name = 3;
+   */
+  displayName = "";
+
+  /**
+   * @generated from field: string message = 4;
+   */
+  message = "";
+
+  /**
+   * @generated from field: string created_at = 5;
+   */
+  createdAt = "";
+
+  constructor(data?: PartialMessage<JoinRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "sns.v1.JoinRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 2, name: "user_id", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 3, name: "display_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "message", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "created_at", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): JoinRequest {
+    return new JoinRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): JoinRequest {
+    return new JoinRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): JoinRequest {
+    return new JoinRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: JoinRequest | PlainMessage<JoinRequest> | undefined, b: JoinRequest | PlainMessage<JoinRequest> | undefined): boolean {
+    return proto3.util.equals(JoinRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message sns.v1.CreateInvitationRequest
+ */
+export class CreateInvitationRequest extends Message<CreateInvitationRequest> {
+  /**
+   * @generated from field: string role = 1;
+   */
+  role = "";
+
+  /**
+   * @generated from field: uint32 ttl_hours = 2;
+   */
+  ttlHours = 0;
+
+  constructor(data?: PartialMessage<CreateInvitationRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "sns.v1.CreateInvitationRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "role", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "ttl_hours", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateInvitationRequest {
+    return new CreateInvitationRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateInvitationRequest {
+    return new CreateInvitationRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateInvitationRequest {
+    return new CreateInvitationRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CreateInvitationRequest | PlainMessage<CreateInvitationRequest> | undefined, b: CreateInvitationRequest | PlainMessage<CreateInvitationRequest> | undefined): boolean {
+    return proto3.util.equals(CreateInvitationRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message sns.v1.CreateInvitationResponse
+ */
+export class CreateInvitationResponse extends Message<CreateInvitationResponse> {
+  /**
+   * @generated from field: sns.v1.Invitation invitation = 1;
+   */
+  invitation?: Invitation;
+
+  /**
+   * @generated from field: string token = 2;
+   */
+  token = "";
+
+  constructor(data?: PartialMessage<CreateInvitationResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "sns.v1.CreateInvitationResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "invitation", kind: "message", T: Invitation },
+    { no: 2, name: "token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateInvitationResponse {
+    return new CreateInvitationResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateInvitationResponse {
+    return new CreateInvitationResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateInvitationResponse {
+    return new CreateInvitationResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CreateInvitationResponse | PlainMessage<CreateInvitationResponse> | undefined, b: CreateInvitationResponse | PlainMessage<CreateInvitationResponse> | undefined): boolean {
+    return proto3.util.equals(CreateInvitationResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message sns.v1.ListInvitationsRequest
+ */
+export class ListInvitationsRequest extends Message<ListInvitationsRequest> {
+  constructor(data?: PartialMessage<ListInvitationsRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "sns.v1.ListInvitationsRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListInvitationsRequest {
+    return new ListInvitationsRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListInvitationsRequest {
+    return new ListInvitationsRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListInvitationsRequest {
+    return new ListInvitationsRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListInvitationsRequest | PlainMessage<ListInvitationsRequest> | undefined, b: ListInvitationsRequest | PlainMessage<ListInvitationsRequest> | undefined): boolean {
+    return proto3.util.equals(ListInvitationsRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message sns.v1.ListInvitationsResponse
+ */
+export class ListInvitationsResponse extends Message<ListInvitationsResponse> {
+  /**
+   * @generated from field: repeated sns.v1.Invitation items = 1;
+   */
+  items: Invitation[] = [];
+
+  constructor(data?: PartialMessage<ListInvitationsResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "sns.v1.ListInvitationsResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "items", kind: "message", T: Invitation, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListInvitationsResponse {
+    return new ListInvitationsResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListInvitationsResponse {
+    return new ListInvitationsResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListInvitationsResponse {
+    return new ListInvitationsResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListInvitationsResponse | PlainMessage<ListInvitationsResponse> | undefined, b: ListInvitationsResponse | PlainMessage<ListInvitationsResponse> | undefined): boolean {
+    return proto3.util.equals(ListInvitationsResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message sns.v1.RevokeInvitationRequest
+ */
+export class RevokeInvitationRequest extends Message<RevokeInvitationRequest> {
+  /**
+   * @generated from field: uint64 invitation_id = 1;
+   */
+  invitationId = protoInt64.zero;
+
+  constructor(data?: PartialMessage<RevokeInvitationRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "sns.v1.RevokeInvitationRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "invitation_id", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RevokeInvitationRequest {
+    return new RevokeInvitationRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RevokeInvitationRequest {
+    return new RevokeInvitationRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RevokeInvitationRequest {
+    return new RevokeInvitationRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RevokeInvitationRequest | PlainMessage<RevokeInvitationRequest> | undefined, b: RevokeInvitationRequest | PlainMessage<RevokeInvitationRequest> | undefined): boolean {
+    return proto3.util.equals(RevokeInvitationRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message sns.v1.RevokeInvitationResponse
+ */
+export class RevokeInvitationResponse extends Message<RevokeInvitationResponse> {
+  constructor(data?: PartialMessage<RevokeInvitationResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "sns.v1.RevokeInvitationResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RevokeInvitationResponse {
+    return new RevokeInvitationResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RevokeInvitationResponse {
+    return new RevokeInvitationResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RevokeInvitationResponse {
+    return new RevokeInvitationResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RevokeInvitationResponse | PlainMessage<RevokeInvitationResponse> | undefined, b: RevokeInvitationResponse | PlainMessage<RevokeInvitationResponse> | undefined): boolean {
+    return proto3.util.equals(RevokeInvitationResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message sns.v1.AcceptInvitationRequest
+ */
+export class AcceptInvitationRequest extends Message<AcceptInvitationRequest> {
+  /**
+   * @generated from field: string token = 1;
+   */
+  token = "";
+
+  constructor(data?: PartialMessage<AcceptInvitationRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "sns.v1.AcceptInvitationRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AcceptInvitationRequest {
+    return new AcceptInvitationRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AcceptInvitationRequest {
+    return new AcceptInvitationRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AcceptInvitationRequest {
+    return new AcceptInvitationRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: AcceptInvitationRequest | PlainMessage<AcceptInvitationRequest> | undefined, b: AcceptInvitationRequest | PlainMessage<AcceptInvitationRequest> | undefined): boolean {
+    return proto3.util.equals(AcceptInvitationRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message sns.v1.AcceptInvitationResponse
+ */
+export class AcceptInvitationResponse extends Message<AcceptInvitationResponse> {
+  /**
+   * @generated from field: string role = 1;
+   */
+  role = "";
+
+  constructor(data?: PartialMessage<AcceptInvitationResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "sns.v1.AcceptInvitationResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "role", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AcceptInvitationResponse {
+    return new AcceptInvitationResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AcceptInvitationResponse {
+    return new AcceptInvitationResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AcceptInvitationResponse {
+    return new AcceptInvitationResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: AcceptInvitationResponse | PlainMessage<AcceptInvitationResponse> | undefined, b: AcceptInvitationResponse | PlainMessage<AcceptInvitationResponse> | undefined): boolean {
+    return proto3.util.equals(AcceptInvitationResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message sns.v1.RequestToJoinRequest
+ */
+export class RequestToJoinRequest extends Message<RequestToJoinRequest> {
+  /**
+   * @generated from field: string message = 1;
+   */
+  message = "";
+
+  constructor(data?: PartialMessage<RequestToJoinRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "sns.v1.RequestToJoinRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "message", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RequestToJoinRequest {
+    return new RequestToJoinRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RequestToJoinRequest {
+    return new RequestToJoinRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RequestToJoinRequest {
+    return new RequestToJoinRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RequestToJoinRequest | PlainMessage<RequestToJoinRequest> | undefined, b: RequestToJoinRequest | PlainMessage<RequestToJoinRequest> | undefined): boolean {
+    return proto3.util.equals(RequestToJoinRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message sns.v1.RequestToJoinResponse
+ */
+export class RequestToJoinResponse extends Message<RequestToJoinResponse> {
+  /**
+   * @generated from field: sns.v1.JoinRequest join_request = 1;
+   */
+  joinRequest?: JoinRequest;
+
+  constructor(data?: PartialMessage<RequestToJoinResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "sns.v1.RequestToJoinResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "join_request", kind: "message", T: JoinRequest },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RequestToJoinResponse {
+    return new RequestToJoinResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RequestToJoinResponse {
+    return new RequestToJoinResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RequestToJoinResponse {
+    return new RequestToJoinResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RequestToJoinResponse | PlainMessage<RequestToJoinResponse> | undefined, b: RequestToJoinResponse | PlainMessage<RequestToJoinResponse> | undefined): boolean {
+    return proto3.util.equals(RequestToJoinResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message sns.v1.ListJoinRequestsRequest
+ */
+export class ListJoinRequestsRequest extends Message<ListJoinRequestsRequest> {
+  constructor(data?: PartialMessage<ListJoinRequestsRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "sns.v1.ListJoinRequestsRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListJoinRequestsRequest {
+    return new ListJoinRequestsRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListJoinRequestsRequest {
+    return new ListJoinRequestsRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListJoinRequestsRequest {
+    return new ListJoinRequestsRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListJoinRequestsRequest | PlainMessage<ListJoinRequestsRequest> | undefined, b: ListJoinRequestsRequest | PlainMessage<ListJoinRequestsRequest> | undefined): boolean {
+    return proto3.util.equals(ListJoinRequestsRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message sns.v1.ListJoinRequestsResponse
+ */
+export class ListJoinRequestsResponse extends Message<ListJoinRequestsResponse> {
+  /**
+   * @generated from field: repeated sns.v1.JoinRequest items = 1;
+   */
+  items: JoinRequest[] = [];
+
+  constructor(data?: PartialMessage<ListJoinRequestsResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "sns.v1.ListJoinRequestsResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "items", kind: "message", T: JoinRequest, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListJoinRequestsResponse {
+    return new ListJoinRequestsResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListJoinRequestsResponse {
+    return new ListJoinRequestsResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListJoinRequestsResponse {
+    return new ListJoinRequestsResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListJoinRequestsResponse | PlainMessage<ListJoinRequestsResponse> | undefined, b: ListJoinRequestsResponse | PlainMessage<ListJoinRequestsResponse> | undefined): boolean {
+    return proto3.util.equals(ListJoinRequestsResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message sns.v1.ApproveJoinRequestRequest
+ */
+export class ApproveJoinRequestRequest extends Message<ApproveJoinRequestRequest> {
+  /**
+   * @generated from field: uint64 join_request_id = 1;
+   */
+  joinRequestId = protoInt64.zero;
+
+  /**
+   * @generated from field: string role = 2;
+   */
+  role = "";
+
+  constructor(data?: PartialMessage<ApproveJoinRequestRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "sns.v1.ApproveJoinRequestRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "join_request_id", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 2, name: "role", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ApproveJoinRequestRequest {
+    return new ApproveJoinRequestRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ApproveJoinRequestRequest {
+    return new ApproveJoinRequestRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ApproveJoinRequestRequest {
+    return new ApproveJoinRequestRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ApproveJoinRequestRequest | PlainMessage<ApproveJoinRequestRequest> | undefined, b: ApproveJoinRequestRequest | PlainMessage<ApproveJoinRequestRequest> | undefined): boolean {
+    return proto3.util.equals(ApproveJoinRequestRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message sns.v1.ApproveJoinRequestResponse
+ */
+export class ApproveJoinRequestResponse extends Message<ApproveJoinRequestResponse> {
+  constructor(data?: PartialMessage<ApproveJoinRequestResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "sns.v1.ApproveJoinRequestResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ApproveJoinRequestResponse {
+    return new ApproveJoinRequestResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ApproveJoinRequestResponse {
+    return new ApproveJoinRequestResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ApproveJoinRequestResponse {
+    return new ApproveJoinRequestResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ApproveJoinRequestResponse | PlainMessage<ApproveJoinRequestResponse> | undefined, b: ApproveJoinRequestResponse | PlainMessage<ApproveJoinRequestResponse> | undefined): boolean {
+    return proto3.util.equals(ApproveJoinRequestResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message sns.v1.RejectJoinRequestRequest
+ */
+export class RejectJoinRequestRequest extends Message<RejectJoinRequestRequest> {
+  /**
+   * @generated from field: uint64 join_request_id = 1;
+   */
+  joinRequestId = protoInt64.zero;
+
+  constructor(data?: PartialMessage<RejectJoinRequestRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "sns.v1.RejectJoinRequestRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "join_request_id", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RejectJoinRequestRequest {
+    return new RejectJoinRequestRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RejectJoinRequestRequest {
+    return new RejectJoinRequestRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RejectJoinRequestRequest {
+    return new RejectJoinRequestRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RejectJoinRequestRequest | PlainMessage<RejectJoinRequestRequest> | undefined, b: RejectJoinRequestRequest | PlainMessage<RejectJoinRequestRequest> | undefined): boolean {
+    return proto3.util.equals(RejectJoinRequestRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message sns.v1.RejectJoinRequestResponse
+ */
+export class RejectJoinRequestResponse extends Message<RejectJoinRequestResponse> {
+  constructor(data?: PartialMessage<RejectJoinRequestResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "sns.v1.RejectJoinRequestResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RejectJoinRequestResponse {
+    return new RejectJoinRequestResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RejectJoinRequestResponse {
+    return new RejectJoinRequestResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RejectJoinRequestResponse {
+    return new RejectJoinRequestResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RejectJoinRequestResponse | PlainMessage<RejectJoinRequestResponse> | undefined, b: RejectJoinRequestResponse | PlainMessage<RejectJoinRequestResponse> | undefined): boolean {
+    return proto3.util.equals(RejectJoinRequestResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message sns.v1.GetJoinPolicyRequest
+ */
+export class GetJoinPolicyRequest extends Message<GetJoinPolicyRequest> {
+  constructor(data?: PartialMessage<GetJoinPolicyRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "sns.v1.GetJoinPolicyRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetJoinPolicyRequest {
+    return new GetJoinPolicyRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetJoinPolicyRequest {
+    return new GetJoinPolicyRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetJoinPolicyRequest {
+    return new GetJoinPolicyRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetJoinPolicyRequest | PlainMessage<GetJoinPolicyRequest> | undefined, b: GetJoinPolicyRequest | PlainMessage<GetJoinPolicyRequest> | undefined): boolean {
+    return proto3.util.equals(GetJoinPolicyRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message sns.v1.GetJoinPolicyResponse
+ */
+export class GetJoinPolicyResponse extends Message<GetJoinPolicyResponse> {
+  /**
+   * @generated from field: string policy = 1;
+   */
+  policy = "";
+
+  /**
+   * @generated from field: repeated string allowed_email_domains = 2;
+   */
+  allowedEmailDomains: string[] = [];
+
+  constructor(data?: PartialMessage<GetJoinPolicyResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "sns.v1.GetJoinPolicyResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "policy", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "allowed_email_domains", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetJoinPolicyResponse {
+    return new GetJoinPolicyResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetJoinPolicyResponse {
+    return new GetJoinPolicyResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetJoinPolicyResponse {
+    return new GetJoinPolicyResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetJoinPolicyResponse | PlainMessage<GetJoinPolicyResponse> | undefined, b: GetJoinPolicyResponse | PlainMessage<GetJoinPolicyResponse> | undefined): boolean {
+    return proto3.util.equals(GetJoinPolicyResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message sns.v1.UpdateJoinPolicyRequest
+ */
+export class UpdateJoinPolicyRequest extends Message<UpdateJoinPolicyRequest> {
+  /**
+   * @generated from field: string policy = 1;
+   */
+  policy = "";
+
+  /**
+   * @generated from field: repeated string allowed_email_domains = 2;
+   */
+  allowedEmailDomains: string[] = [];
+
+  constructor(data?: PartialMessage<UpdateJoinPolicyRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "sns.v1.UpdateJoinPolicyRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "policy", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "allowed_email_domains", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateJoinPolicyRequest {
+    return new UpdateJoinPolicyRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateJoinPolicyRequest {
+    return new UpdateJoinPolicyRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateJoinPolicyRequest {
+    return new UpdateJoinPolicyRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UpdateJoinPolicyRequest | PlainMessage<UpdateJoinPolicyRequest> | undefined, b: UpdateJoinPolicyRequest | PlainMessage<UpdateJoinPolicyRequest> | undefined): boolean {
+    return proto3.util.equals(UpdateJoinPolicyRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message sns.v1.UpdateJoinPolicyResponse
+ */
+export class UpdateJoinPolicyResponse extends Message<UpdateJoinPolicyResponse> {
+  constructor(data?: PartialMessage<UpdateJoinPolicyResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "sns.v1.UpdateJoinPolicyResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateJoinPolicyResponse {
+    return new UpdateJoinPolicyResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateJoinPolicyResponse {
+    return new UpdateJoinPolicyResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateJoinPolicyResponse {
+    return new UpdateJoinPolicyResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UpdateJoinPolicyResponse | PlainMessage<UpdateJoinPolicyResponse> | undefined, b: UpdateJoinPolicyResponse | PlainMessage<UpdateJoinPolicyResponse> | undefined): boolean {
+    return proto3.util.equals(UpdateJoinPolicyResponse, a, b);
+  }
+}
+
@@ -0,0 +1,62 @@
+// @generated by protoc-gen-connect-es v1.5.0 with parameter "target=ts,import_extension=.ts"
+// @generated from file sns/v1/moderation.proto (package sns.v1, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import { ListAuditEventsRequest, ListAuditEventsResponse, ListReportsRequest, ListReportsResponse, ReinstateMemberRequest, ReinstateMemberResponse, ReportContentRequest, ReportContentResponse, ResolveReportRequest, ResolveReportResponse } from "./moderation_pb.ts";
+import { MethodKind } from "@bufbuild/protobuf";
+
+/**
+ * @generated from service sns.v1.ModerationService
+ */
+export const ModerationService = {
+  typeName: "sns.v1.ModerationService",
+  methods: {
+    /**
+     * @generated from rpc sns.v1.ModerationService.ReportContent
+     */
+    reportContent: {
+      name: "ReportContent",
+      I: ReportContentRequest,
+      O: ReportContentResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc sns.v1.ModerationService.ListReports
+     */
+    listReports: {
+      name: "ListReports",
+      I: ListReportsRequest,
+      O: ListReportsResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc sns.v1.ModerationService.ResolveReport
+     */
+    resolveReport: {
+      name: "ResolveReport",
+      I: ResolveReportRequest,
+      O: ResolveReportResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc sns.v1.ModerationService.ReinstateMember
+     */
+    reinstateMember: {
+      name: "ReinstateMember",
+      I: ReinstateMemberRequest,
+      O: ReinstateMemberResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc sns.v1.ModerationService.ListAuditEvents
+     */
+    listAuditEvents: {
+      name: "ListAuditEvents",
+      I: ListAuditEventsRequest,
+      O: ListAuditEventsResponse,
+      kind: MethodKind.Unary,
+    },
+  }
+} as const;
+
@@ -0,0 +1,665 @@
+// @generated by protoc-gen-es v1.10.0 with parameter "target=ts,import_extension=.ts"
+// @generated from file sns/v1/moderation.proto (package sns.v1, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
+import { Message, proto3, protoInt64 } from "@bufbuild/protobuf";
+import { Cursor } from "./timeline_pb.ts";
+
+/**
+ * @generated from enum sns.v1.ReportTargetType
+ */
+export enum ReportTargetType {
+  /**
+   * @generated from enum value: REPORT_TARGET_TYPE_UNSPECIFIED = 0;
+   */
+  REPORT_TARGET_TYPE_UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: REPORT_TARGET_POST = 1;
+   */
+  REPORT_TARGET_POST = 1,
+
+  /**
+   * @generated from enum value: REPORT_TARGET_COMMENT = 2;
+   */
+  REPORT_TARGET_COMMENT = 2,
+
+  /**
+   * @generated from enum value: REPORT_TARGET_MESSAGE = 3;
+   */
+  REPORT_TARGET_MESSAGE = 3,
+}
+// Retrieve enum metadata with: proto3.getEnumType(ReportTargetType)
+proto3.util.setEnumType(ReportTargetType, "sns.v1.ReportTargetType", [
+  { no: 0, name: "REPORT_TARGET_TYPE_UNSPECIFIED" },
+  { no: 1, name: "REPORT_TARGET_POST" },
+  { no: 2, name: "REPORT_TARGET_COMMENT" },
+  { no: 3, name: "REPORT_TARGET_MESSAGE" },
+]);
+
+/**
+ * @generated from message sns.v1.Report
+ */
+export class Report extends Message<Report> {
+  /**
+   * @generated from field: uint64 id = 1;
+   */
+  id = protoInt64.zero;
+
+  /**
+   * @generated from field: sns.v1.ReportTargetType target_type = 2;
+   */
+  targetType = ReportTargetType.REPORT_TARGET_TYPE_UNSPECIFIED;
+
+  /**
+   * @generated from field: uint64 target_id = 3;
+   */
+  targetId = protoInt64.zero;
+
+  /**
+   * @generated from field: uint64 author_user_id = 4;
+   */
+  authorUserId = protoInt64.zero;
+
+  /**
+   * @generated from field: uint64 reporter_user_id = 5;
+   */
+  reporterUserId = protoInt64.zero;
+
+  /**
+   * @generated from field: string reason = 6;
+   */
+  reason = "";
+
+  /**
+   * @generated from field: string content = 7;
+   */
+  content = "";
+
+  /**
+   * @generated from field: string status = 8;
+   */
+  status = "";
+
+  /**
+   * @generated from field: string created_at = 9;
+   */
+  createdAt = "";
+
+  /**
+   * @generated from field: string action = 10;
+   */
+  action = "";
+
+  /**
+   * @generated from field: uint64 resolved_by_user_id = 11;
+   */
+  resolvedByUserId = protoInt64.zero;
+
+  /**
+   * @generated from field: string resolved_at = 12;
+   */
+  resolvedAt = "";
+
+  constructor(data?: PartialMessage<Report>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "sns.v1.Report";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 2, name: "target_type", kind: "enum", T: proto3.getEnumType(ReportTargetType) },
+    { no: 3, name: "target_id", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 4, name: "author_user_id", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 5, name: "reporter_user_id", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 6, name: "reason", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 7, name: "content", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 8, name: "status", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 9, name: "created_at", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 10, name: "action", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 11, name: "resolved_by_user_id", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 12, name: "resolved_at", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Report {
+    return new Report().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Report {
+    return new Report().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Report {
+    return new Report().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: Report | PlainMessage<Report> | undefined, b: Report | PlainMessage<Report> | undefined): boolean {
+    return proto3.util.equals(Report, a, b);
+  }
+}
+
+/**
+ * @generated from message sns.v1.AuditEvent
+ */
+export class AuditEvent extends Message<AuditEvent> {
+  /**
+   * @generated from field: uint64 id = 1;
+   */
+  id = protoInt64.zero;
+
+  /**
+   * @generated from field: uint64 actor_user_id = 2;
+   */
+  actorUserId = protoInt64.zero;
+
+  /**
+   * @generated from field: string action = 3;
+   */
+  action = "";
+
+  /**
+   * @generated from field: string target_type = 4;
+   */
+  targetType = "";
+
+  /**
+   * @generated from field: uint64 target_id = 5;
+   */
+  targetId = protoInt64.zero;
+
+  /**
+   * @generated from field: uint64 report_id = 6;
+   */
+  reportId = protoInt64.zero;
+
+  /**
+   * @generated from field: string note = 7;
+   */
+  note = "";
+
+  /**
+   * @generated from field: string created_at = 8;
+   */
+  createdAt = "";
+
+  constructor(data?: PartialMessage<AuditEvent>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "sns.v1.AuditEvent";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 2, name: "actor_user_id", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 3, name: "action", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "target_type", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "target_id", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 6, name: "report_id", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 7, name: "note", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 8, name: "created_at", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AuditEvent {
+    return new AuditEvent().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AuditEvent {
+    return new AuditEvent().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AuditEvent {
+    return new AuditEvent().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: AuditEvent | PlainMessage<AuditEvent> | undefined, b: AuditEvent | PlainMessage<AuditEvent> | undefined): boolean {
+    return proto3.util.equals(AuditEvent, a, b);
+  }
+}
+
+/**
+ * @generated from message sns.v1.ReportContentRequest
+ */
+export class ReportContentRequest extends Message<ReportContentRequest> {
+  /**
+   * @generated from field: sns.v1.ReportTargetType target_type = 1;
+   */
+  targetType = ReportTargetType.REPORT_TARGET_TYPE_UNSPECIFIED;
+
+  /**
+   * @generated from field: uint64 target_id = 2;
+   */
+  targetId = protoInt64.zero;
+
+  /**
+   * @generated from field: string reason = 3;
+   */
+  reason = "";
+
+  constructor(data?: PartialMessage<ReportContentRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "sns.v1.ReportContentRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "target_type", kind: "enum", T: proto3.getEnumType(ReportTargetType) },
+    { no: 2, name: "target_id", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 3, name: "reason", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ReportContentRequest {
+    return new ReportContentRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ReportContentRequest {
+    return new ReportContentRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ReportContentRequest {
+    return new ReportContentRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ReportContentRequest | PlainMessage<ReportContentRequest> | undefined, b: ReportContentRequest | PlainMessage<ReportContentRequest> | undefined): boolean {
+    return proto3.util.equals(ReportContentRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message sns.v1.ReportContentResponse
+ */
+export class ReportContentResponse extends Message<ReportContentResponse> {
+  /**
+   * @generated from field: sns.v1.Report report = 1;
+   */
+  report?: Report;
+
+  constructor(data?: PartialMessage<ReportContentResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "sns.v1.ReportContentResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "report", kind: "message", T: Report },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ReportContentResponse {
+    return new ReportContentResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ReportContentResponse {
+    return new ReportContentResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ReportContentResponse {
+    return new ReportContentResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ReportContentResponse | PlainMessage<ReportContentResponse> | undefined, b: ReportContentResponse | PlainMessage<ReportContentResponse> | undefined): boolean {
+    return proto3.util.equals(ReportContentResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message sns.v1.ListReportsRequest
+ */
+export class ListReportsRequest extends Message<ListReportsRequest> {
+  /**
+   * @generated from field: string status = 1;
+   */
+  status = "";
+
+  /**
+   * @generated from field: sns.v1.Cursor cursor = 2;
+   */
+  cursor?: Cursor;
+
+  /**
+   * @generated from field: uint32 page_size = 3;
+   */
+  pageSize = 0;
+
+  constructor(data?: PartialMessage<ListReportsRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "sns.v1.ListReportsRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "status", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "cursor", kind: "message", T: Cursor },
+    { no: 3, name: "page_size", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListReportsRequest {
+    return new ListReportsRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListReportsRequest {
+    return new ListReportsRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListReportsRequest {
+    return new ListReportsRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListReportsRequest | PlainMessage<ListReportsRequest> | undefined, b: ListReportsRequest | PlainMessage<ListReportsRequest> | undefined): boolean {
+    return proto3.util.equals(ListReportsRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message sns.v1.ListReportsResponse
+ */
+export class ListReportsResponse extends Message<ListReportsResponse> {
+  /**
+   * @generated from field: repeated sns.v1.Report items = 1;
+   */
+  items: Report[] = [];
+
+  /**
+   * @generated from field: sns.v1.Cursor next = 2;
+   */
+  next?: Cursor;
+
+  /**
+   * @generated from field: sns.v1.Cursor prev = 3;
+   */
+  prev?: Cursor;
+
+  /**
+   * @generated from field: bool has_more = 4;
+   */
+  hasMore = false;
+
+  constructor(data?: PartialMessage<ListReportsResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "sns.v1.ListReportsResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "items", kind: "message", T: Report, repeated: true },
+    { no: 2, name: "next", kind: "message", T: Cursor },
+    { no: 3, name: "prev", kind: "message", T: Cursor },
+    { no: 4, name: "has_more", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListReportsResponse {
+    return new ListReportsResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListReportsResponse {
+    return new ListReportsResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListReportsResponse {
+    return new ListReportsResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListReportsResponse | PlainMessage<ListReportsResponse> | undefined, b: ListReportsResponse | PlainMessage<ListReportsResponse> | undefined): boolean {
+    return proto3.util.equals(ListReportsResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message sns.v1.ResolveReportRequest
+ */
+export class ResolveReportRequest extends Message<ResolveReportRequest> {
+  /**
+   * @generated from field: uint64 report_id = 1;
+   */
+  reportId = protoInt64.zero;
+
+  /**
+   * @generated from field: string action = 2;
+   */
+  action = "";
+
+  /**
+   * @generated from field: string note = 3;
+   */
+  note = "";
+
+  constructor(data?: PartialMessage<ResolveReportRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "sns.v1.ResolveReportRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "report_id", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 2, name: "action", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "note", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ResolveReportRequest {
+    return new ResolveReportRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ResolveReportRequest {
+    return new ResolveReportRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ResolveReportRequest {
+    return new ResolveReportRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ResolveReportRequest | PlainMessage<ResolveReportRequest> | undefined, b: ResolveReportRequest | PlainMessage<ResolveReportRequest> | undefined): boolean {
+    return proto3.util.equals(ResolveReportRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message sns.v1.ResolveReportResponse
+ */
+export class ResolveReportResponse extends Message<ResolveReportResponse> {
+  /**
+   * @generated from field: sns.v1.Report report = 1;
+   */
+  report?: Report;
+
+  constructor(data?: PartialMessage<ResolveReportResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "sns.v1.ResolveReportResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "report", kind: "message", T: Report },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ResolveReportResponse {
+    return new ResolveReportResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ResolveReportResponse {
+    return new ResolveReportResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ResolveReportResponse {
+    return new ResolveReportResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ResolveReportResponse | PlainMessage<ResolveReportResponse> | undefined, b: ResolveReportResponse | PlainMessage<ResolveReportResponse> | undefined): boolean {
+    return proto3.util.equals(ResolveReportResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message sns.v1.ReinstateMemberRequest
+ */
+export class ReinstateMemberRequest extends Message<ReinstateMemberRequest> {
+  /**
+   * @generated from field: uint64 user_id = 1;
+   */
+  userId = protoInt64.zero;
+
+  constructor(data?: PartialMessage<ReinstateMemberRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "sns.v1.ReinstateMemberRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "user_id", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ReinstateMemberRequest {
+    return new ReinstateMemberRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ReinstateMemberRequest {
+    return new ReinstateMemberRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ReinstateMemberRequest {
+    return new ReinstateMemberRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ReinstateMemberRequest | PlainMessage<ReinstateMemberRequest> | undefined, b: ReinstateMemberRequest | PlainMessage<ReinstateMemberRequest> | undefined): boolean {
+    return proto3.util.equals(ReinstateMemberRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message sns.v1.ReinstateMemberResponse
+ */
+export class ReinstateMemberResponse extends Message<ReinstateMemberResponse> {
+  constructor(data?: PartialMessage<ReinstateMemberResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "sns.v1.ReinstateMemberResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ReinstateMemberResponse {
+    return new ReinstateMemberResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ReinstateMemberResponse {
+    return new ReinstateMemberResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ReinstateMemberResponse {
+    return new ReinstateMemberResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ReinstateMemberResponse | PlainMessage<ReinstateMemberResponse> | undefined, b: ReinstateMemberResponse | PlainMessage<ReinstateMemberResponse> | undefined): boolean {
+    return proto3.util.equals(ReinstateMemberResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message sns.v1.ListAuditEventsRequest
+ */
+export class ListAuditEventsRequest extends Message<ListAuditEventsRequest> {
+  /**
+   * @generated from field: sns.v1.Cursor cursor = 1;
+   */
+  cursor?: Cursor;
+
+  /**
+   * @generated from field: uint32 page_size = 2;
+   */
+  pageSize = 0;
+
+  constructor(data?: PartialMessage<ListAuditEventsRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "sns.v1.ListAuditEventsRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "cursor", kind: "message", T: Cursor },
+    { no: 2, name: "page_size", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListAuditEventsRequest {
+    return new ListAuditEventsRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListAuditEventsRequest {
+    return new ListAuditEventsRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListAuditEventsRequest {
+    return new ListAuditEventsRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListAuditEventsRequest | PlainMessage<ListAuditEventsRequest> | undefined, b: ListAuditEventsRequest | PlainMessage<ListAuditEventsRequest> | undefined): boolean {
+    return proto3.util.equals(ListAuditEventsRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message sns.v1.ListAuditEventsResponse
+ */
+export class ListAuditEventsResponse extends Message<ListAuditEventsResponse> {
+  /**
+   * @generated from field: repeated sns.v1.AuditEvent items = 1;
+   */
+  items: AuditEvent[] = [];
+
+  /**
+   * @generated from field: sns.v1.Cursor next = 2;
+   */
+  next?: Cursor;
+
+  /**
+   * @generated from field: sns.v1.Cursor prev = 3;
+   */
+  prev?: Cursor;
+
+  /**
+   * @generated from field: bool has_more = 4;
+   */
+  hasMore = false;
+
+  constructor(data?: PartialMessage<ListAuditEventsResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "sns.v1.ListAuditEventsResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "items", kind: "message", T: AuditEvent, repeated: true },
+    { no: 2, name: "next", kind: "message", T: Cursor },
+    { no: 3, name: "prev", kind: "message", T: Cursor },
+    { no: 4, name: "has_more", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListAuditEventsResponse {
+    return new ListAuditEventsResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListAuditEventsResponse {
+    return new ListAuditEventsResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListAuditEventsResponse {
+    return new ListAuditEventsResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListAuditEventsResponse | PlainMessage<ListAuditEventsResponse> | undefined, b: ListAuditEventsResponse | PlainMessage<ListAuditEventsResponse> | undefined): boolean {
+    return proto3.util.equals(ListAuditEventsResponse, a, b);
+  }
+}
+
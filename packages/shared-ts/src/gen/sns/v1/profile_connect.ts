@@ -0,0 +1,53 @@
+// @generated by protoc-gen-connect-es v1.5.0 with parameter "target=ts,import_extension=.ts"
+// @generated from file sns/v1/profile.proto (package sns.v1, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import { GetProfileRequest, GetProfileResponse, GetProfileSettingsRequest, GetProfileSettingsResponse, UpdateProfileRequest, UpdateProfileResponse, UpdateTenantProfileRequest, UpdateTenantProfileResponse } from "./profile_pb.ts";
+import { MethodKind } from "@bufbuild/protobuf";
+
+/**
+ * @generated from service sns.v1.ProfileService
+ */
+export const ProfileService = {
+  typeName: "sns.v1.ProfileService",
+  methods: {
+    /**
+     * @generated from rpc sns.v1.ProfileService.GetProfile
+     */
+    getProfile: {
+      name: "GetProfile",
+      I: GetProfileRequest,
+      O: GetProfileResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc sns.v1.ProfileService.UpdateProfile
+     */
+    updateProfile: {
+      name: "UpdateProfile",
+      I: UpdateProfileRequest,
+      O: UpdateProfileResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc sns.v1.ProfileService.GetProfileSettings
+     */
+    getProfileSettings: {
+      name: "GetProfileSettings",
+      I: GetProfileSettingsRequest,
+      O: GetProfileSettingsResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc sns.v1.ProfileService.UpdateTenantProfile
+     */
+    updateTenantProfile: {
+      name: "UpdateTenantProfile",
+      I: UpdateTenantProfileRequest,
+      O: UpdateTenantProfileResponse,
+      kind: MethodKind.Unary,
+    },
+  }
+} as const;
+
@@ -0,0 +1,432 @@
+// @generated by protoc-gen-es v1.10.0 with parameter "target=ts,import_extension=.ts"
+// @generated from file sns/v1/profile.proto (package sns.v1, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
+import { Message, proto3, protoInt64 } from "@bufbuild/protobuf";
+
+/**
+ * @generated from message sns.v1.Profile
+ */
+export class Profile extends Message<Profile> {
+  /**
+   * @generated from field: uint64 user_id = 1;
+   */
+  userId = protoInt64.zero;
+
+  /**
+   * @generated from field: string display_name = 2;
+   */
+  displayName = "";
+
+  /**
+   * @generated from field: string handle = 3;
+   */
+  handle = "";
+
+  /**
+   * @generated from field: string bio = 4;
+   */
+  bio = "";
+
+  /**
+   * @generated from field: string avatar_url = 5;
+   */
+  avatarUrl = "";
+
+  constructor(data?: PartialMessage<Profile>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "sns.v1.Profile";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "user_id", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 2, name: "display_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "handle", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "bio", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "avatar_url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Profile {
+    return new Profile().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Profile {
+    return new Profile().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Profile {
+    return new Profile().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: Profile | PlainMessage<Profile> | undefined, b: Profile | PlainMessage<Profile> | undefined): boolean {
+    return proto3.util.equals(Profile, a, b);
+  }
+}
+
+/**
+ * @generated from message sns.v1.TenantProfile
+ */
+export class TenantProfile extends Message<TenantProfile> {
+  /**
+   * @generated from field: string display_name = 1;
+   */
+  displayName = "";
+
+  /**
+   * @generated from field: string avatar_url = 2;
+   */
+  avatarUrl = "";
+
+  constructor(data?: PartialMessage<TenantProfile>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "sns.v1.TenantProfile";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "display_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "avatar_url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): TenantProfile {
+    return new TenantProfile().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): TenantProfile {
+    return new TenantProfile().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): TenantProfile {
+    return new TenantProfile().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: TenantProfile | PlainMessage<TenantProfile> | undefined, b: TenantProfile | PlainMessage<TenantProfile> | undefined): boolean {
+    return proto3.util.equals(TenantProfile, a, b);
+  }
+}
+
+/**
+ * @generated from message sns.v1.GetProfileRequest
+ */
+export class GetProfileRequest extends Message<GetProfileRequest> {
+  /**
+   * @generated from field: uint64 user_id = 1;
+   */
+  userId = protoInt64.zero;
+
+  constructor(data?: PartialMessage<GetProfileRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "sns.v1.GetProfileRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "user_id", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetProfileRequest {
+    return new GetProfileRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetProfileRequest {
+    return new GetProfileRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetProfileRequest {
+    return new GetProfileRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetProfileRequest | PlainMessage<GetProfileRequest> | undefined, b: GetProfileRequest | PlainMessage<GetProfileRequest> | undefined): boolean {
+    return proto3.util.equals(GetProfileRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message sns.v1.GetProfileResponse
+ */
+export class GetProfileResponse extends Message<GetProfileResponse> {
+  /**
+   * @generated from field: sns.v1.Profile profile = 1;
+   */
+  profile?: Profile;
+
+  constructor(data?: PartialMessage<GetProfileResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "sns.v1.GetProfileResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "profile", kind: "message", T: Profile },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetProfileResponse {
+    return new GetProfileResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetProfileResponse {
+    return new GetProfileResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetProfileResponse {
+    return new GetProfileResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetProfileResponse | PlainMessage<GetProfileResponse> | undefined, b: GetProfileResponse | PlainMessage<GetProfileResponse> | undefined): boolean {
+    return proto3.util.equals(GetProfileResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message sns.v1.UpdateProfileRequest
+ */
+export class UpdateProfileRequest extends Message<UpdateProfileRequest> {
+  /**
+   * @generated from field: string display_name = 1;
+   */
+  displayName = "";
+
+  /**
+   * @generated from field: string handle = 2;
+   */
+  handle = "";
+
+  /**
+   * @generated from field: string bio = 3;
+   */
+  bio = "";
+
+  /**
+   * @generated from field: string avatar_url = 4;
+   */
+  avatarUrl = "";
+
+  constructor(data?: PartialMessage<UpdateProfileRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "sns.v1.UpdateProfileRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "display_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "handle", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "bio", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "avatar_url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateProfileRequest {
+    return new UpdateProfileRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateProfileRequest {
+    return new UpdateProfileRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateProfileRequest {
+    return new UpdateProfileRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UpdateProfileRequest | PlainMessage<UpdateProfileRequest> | undefined, b: UpdateProfileRequest | PlainMessage<UpdateProfileRequest> | undefined): boolean {
+    return proto3.util.equals(UpdateProfileRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message sns.v1.UpdateProfileResponse
+ */
+export class UpdateProfileResponse extends Message<UpdateProfileResponse> {
+  /**
+   * @generated from field: sns.v1.Profile profile = 1;
+   */
+  profile?: Profile;
+
+  constructor(data?: PartialMessage<UpdateProfileResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "sns.v1.UpdateProfileResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "profile", kind: "message", T: Profile },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateProfileResponse {
+    return new UpdateProfileResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateProfileResponse {
+    return new UpdateProfileResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateProfileResponse {
+    return new UpdateProfileResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UpdateProfileResponse | PlainMessage<UpdateProfileResponse> | undefined, b: UpdateProfileResponse | PlainMessage<UpdateProfileResponse> | undefined): boolean {
+    return proto3.util.equals(UpdateProfileResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message sns.v1.GetProfileSettingsRequest
+ */
+export class GetProfileSettingsRequest extends Message<GetProfileSettingsRequest> {
+  constructor(data?: PartialMessage<GetProfileSettingsRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "sns.v1.GetProfileSettingsRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetProfileSettingsRequest {
+    return new GetProfileSettingsRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetProfileSettingsRequest {
+    return new GetProfileSettingsRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetProfileSettingsRequest {
+    return new GetProfileSettingsRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetProfileSettingsRequest | PlainMessage<GetProfileSettingsRequest> | undefined, b: GetProfileSettingsRequest | PlainMessage<GetProfileSettingsRequest> | undefined): boolean {
+    return proto3.util.equals(GetProfileSettingsRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message sns.v1.GetProfileSettingsResponse
+ */
+export class GetProfileSettingsResponse extends Message<GetProfileSettingsResponse> {
+  /**
+   * @generated from field: sns.v1.Profile profile = 1;
+   */
+  profile?: Profile;
+
+  /**
+   * @generated from field: sns.v1.TenantProfile tenant_profile = 2;
+   */
+  tenantProfile?: TenantProfile;
+
+  constructor(data?: PartialMessage<GetProfileSettingsResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "sns.v1.GetProfileSettingsResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "profile", kind: "message", T: Profile },
+    { no: 2, name: "tenant_profile", kind: "message", T: TenantProfile },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetProfileSettingsResponse {
+    return new GetProfileSettingsResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetProfileSettingsResponse {
+    return new GetProfileSettingsResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetProfileSettingsResponse {
+    return new GetProfileSettingsResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetProfileSettingsResponse | PlainMessage<GetProfileSettingsResponse> | undefined, b: GetProfileSettingsResponse | PlainMessage<GetProfileSettingsResponse> | undefined): boolean {
+    return proto3.util.equals(GetProfileSettingsResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message sns.v1.UpdateTenantProfileRequest
+ */
+export class UpdateTenantProfileRequest extends Message<UpdateTenantProfileRequest> {
+  /**
+   * @generated from field: string display_name = 1;
+   */
+  displayName = "";
+
+  /**
+   * @generated from field: string avatar_url = 2;
+   */
+  avatarUrl = "";
+
+  constructor(data?: PartialMessage<UpdateTenantProfileRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "sns.v1.UpdateTenantProfileRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "display_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "avatar_url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateTenantProfileRequest {
+    return new UpdateTenantProfileRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateTenantProfileRequest {
+    return new UpdateTenantProfileRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateTenantProfileRequest {
+    return new UpdateTenantProfileRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UpdateTenantProfileRequest | PlainMessage<UpdateTenantProfileRequest> | undefined, b: UpdateTenantProfileRequest | PlainMessage<UpdateTenantProfileRequest> | undefined): boolean {
+    return proto3.util.equals(UpdateTenantProfileRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message sns.v1.UpdateTenantProfileResponse
+ */
+export class UpdateTenantProfileResponse extends Message<UpdateTenantProfileResponse> {
+  /**
+   * @generated from field: sns.v1.Profile profile = 1;
+   */
+  profile?: Profile;
+
+  constructor(data?: PartialMessage<UpdateTenantProfileResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "sns.v1.UpdateTenantProfileResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "profile", kind: "message", T: Profile },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateTenantProfileResponse {
+    return new UpdateTenantProfileResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateTenantProfileResponse {
+    return new UpdateTenantProfileResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateTenantProfileResponse {
+    return new UpdateTenantProfileResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UpdateTenantProfileResponse | PlainMessage<UpdateTenantProfileResponse> | undefined, b: UpdateTenantProfileResponse | PlainMessage<UpdateTenantProfileResponse> | undefined): boolean {
+    return proto3.util.equals(UpdateTenantProfileResponse, a, b);
+  }
+}
+
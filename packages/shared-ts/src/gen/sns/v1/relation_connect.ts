@@ -0,0 +1,53 @@
+// @generated by protoc-gen-connect-es v1.5.0 with parameter "target=ts,import_extension=.ts"
+// @generated from file sns/v1/relation.proto (package sns.v1, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import { BlockUserRequest, BlockUserResponse, MuteUserRequest, MuteUserResponse, UnblockUserRequest, UnblockUserResponse, UnmuteUserRequest, UnmuteUserResponse } from "./relation_pb.ts";
+import { MethodKind } from "@bufbuild/protobuf";
+
+/**
+ * @generated from service sns.v1.RelationService
+ */
+export const RelationService = {
+  typeName: "sns.v1.RelationService",
+  methods: {
+    /**
+     * @generated from rpc sns.v1.RelationService.BlockUser
+     */
+    blockUser: {
+      name: "BlockUser",
+      I: BlockUserRequest,
+      O: BlockUserResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc sns.v1.RelationService.UnblockUser
+     */
+    unblockUser: {
+      name: "UnblockUser",
+      I: UnblockUserRequest,
+      O: UnblockUserResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc sns.v1.RelationService.MuteUser
+     */
+    muteUser: {
+      name: "MuteUser",
+      I: MuteUserRequest,
+      O: MuteUserResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc sns.v1.RelationService.UnmuteUser
+     */
+    unmuteUser: {
+      name: "UnmuteUser",
+      I: UnmuteUserRequest,
+      O: UnmuteUserResponse,
+      kind: MethodKind.Unary,
+    },
+  }
+} as const;
+
@@ -0,0 +1,280 @@
+// @generated by protoc-gen-es v1.10.0 with parameter "target=ts,import_extension=.ts"
+// @generated from file sns/v1/relation.proto (package sns.v1, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
+import { Message, proto3, protoInt64 } from "@bufbuild/protobuf";
+
+/**
+ * @generated from message sns.v1.BlockUserRequest
+ */
+export class BlockUserRequest extends Message<BlockUserRequest> {
+  /**
+   * @generated from field: uint64 user_id = 1;
+   */
+  userId = protoInt64.zero;
+
+  constructor(data?: PartialMessage<BlockUserRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "sns.v1.BlockUserRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "user_id", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): BlockUserRequest {
+    return new BlockUserRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): BlockUserRequest {
+    return new BlockUserRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): BlockUserRequest {
+    return new BlockUserRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: BlockUserRequest | PlainMessage<BlockUserRequest> | undefined, b: BlockUserRequest | PlainMessage<BlockUserRequest> | undefined): boolean {
+    return proto3.util.equals(BlockUserRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message sns.v1.BlockUserResponse
+ */
+export class BlockUserResponse extends Message<BlockUserResponse> {
+  constructor(data?: PartialMessage<BlockUserResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "sns.v1.BlockUserResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): BlockUserResponse {
+    return new BlockUserResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): BlockUserResponse {
+    return new BlockUserResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): BlockUserResponse {
+    return new BlockUserResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: BlockUserResponse | PlainMessage<BlockUserResponse> | undefined, b: BlockUserResponse | PlainMessage<BlockUserResponse> | undefined): boolean {
+    return proto3.util.equals(BlockUserResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message sns.v1.UnblockUserRequest
+ */
+export class UnblockUserRequest extends Message<UnblockUserRequest> {
+  /**
+   * @generated from field: uint64 user_id = 1;
+   */
+  userId = protoInt64.zero;
+
+  constructor(data?: PartialMessage<UnblockUserRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "sns.v1.UnblockUserRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "user_id", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UnblockUserRequest {
+    return new UnblockUserRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UnblockUserRequest {
+    return new UnblockUserRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UnblockUserRequest {
+    return new UnblockUserRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UnblockUserRequest | PlainMessage<UnblockUserRequest> | undefined, b: UnblockUserRequest | PlainMessage<UnblockUserRequest> | undefined): boolean {
+    return proto3.util.equals(UnblockUserRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message sns.v1.UnblockUserResponse
+ */
+export class UnblockUserResponse extends Message<UnblockUserResponse> {
+  constructor(data?: PartialMessage<UnblockUserResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "sns.v1.UnblockUserResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UnblockUserResponse {
+    return new UnblockUserResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UnblockUserResponse {
+    return new UnblockUserResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UnblockUserResponse {
+    return new UnblockUserResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UnblockUserResponse | PlainMessage<UnblockUserResponse> | undefined, b: UnblockUserResponse | PlainMessage<UnblockUserResponse> | undefined): boolean {
+    return proto3.util.equals(UnblockUserResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message sns.v1.MuteUserRequest
+ */
+export class MuteUserRequest extends Message<MuteUserRequest> {
+  /**
+   * @generated from field: uint64 user_id = 1;
+   */
+  userId = protoInt64.zero;
+
+  constructor(data?: PartialMessage<MuteUserRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "sns.v1.MuteUserRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "user_id", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MuteUserRequest {
+    return new MuteUserRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MuteUserRequest {
+    return new MuteUserRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MuteUserRequest {
+    return new MuteUserRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: MuteUserRequest | PlainMessage<MuteUserRequest> | undefined, b: MuteUserRequest | PlainMessage<MuteUserRequest> | undefined): boolean {
+    return proto3.util.equals(MuteUserRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message sns.v1.MuteUserResponse
+ */
+export class MuteUserResponse extends Message<MuteUserResponse> {
+  constructor(data?: PartialMessage<MuteUserResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "sns.v1.MuteUserResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MuteUserResponse {
+    return new MuteUserResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MuteUserResponse {
+    return new MuteUserResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MuteUserResponse {
+    return new MuteUserResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: MuteUserResponse | PlainMessage<MuteUserResponse> | undefined, b: MuteUserResponse | PlainMessage<MuteUserResponse> | undefined): boolean {
+    return proto3.util.equals(MuteUserResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message sns.v1.UnmuteUserRequest
+ */
+export class UnmuteUserRequest extends Message<UnmuteUserRequest> {
+  /**
+   * @generated from field: uint64 user_id = 1;
+   */
+  userId = protoInt64.zero;
+
+  constructor(data?: PartialMessage<UnmuteUserRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "sns.v1.UnmuteUserRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "user_id", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UnmuteUserRequest {
+    return new UnmuteUserRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UnmuteUserRequest {
+    return new UnmuteUserRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UnmuteUserRequest {
+    return new UnmuteUserRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UnmuteUserRequest | PlainMessage<UnmuteUserRequest> | undefined, b: UnmuteUserRequest | PlainMessage<UnmuteUserRequest> | undefined): boolean {
+    return proto3.util.equals(UnmuteUserRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message sns.v1.UnmuteUserResponse
+ */
+export class UnmuteUserResponse extends Message<UnmuteUserResponse> {
+  constructor(data?: PartialMessage<UnmuteUserResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "sns.v1.UnmuteUserResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UnmuteUserResponse {
+    return new UnmuteUserResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UnmuteUserResponse {
+    return new UnmuteUserResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UnmuteUserResponse {
+    return new UnmuteUserResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UnmuteUserResponse | PlainMessage<UnmuteUserResponse> | undefined, b: UnmuteUserResponse | PlainMessage<UnmuteUserResponse> | undefined): boolean {
+    return proto3.util.equals(UnmuteUserResponse, a, b);
+  }
+}
+
@@ -0,0 +1,116 @@
+// @generated by protoc-gen-connect-es v1.5.0 with parameter "target=ts,import_extension=.ts"
+// @generated from file sns/v1/tenant_admin.proto (package sns.v1, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import { AddDomainRequest, AddDomainResponse, GetTenantRequest, GetTenantResponse, ListDomainsRequest, ListDomainsResponse, ListTenantMembersRequest, ListTenantMembersResponse, RemoveDomainRequest, RemoveDomainResponse, RemoveMemberRequest, RemoveMemberResponse, SetSubdomainFallbackRequest, SetSubdomainFallbackResponse, TransferOwnershipRequest, TransferOwnershipResponse, UpdateMemberRoleRequest, UpdateMemberRoleResponse, UpdateTenantRequest, UpdateTenantResponse, VerifyDomainRequest, VerifyDomainResponse } from "./tenant_admin_pb.ts";
+import { MethodKind } from "@bufbuild/protobuf";
+
+/**
+ * @generated from service sns.v1.TenantAdminService
+ */
+export const TenantAdminService = {
+  typeName: "sns.v1.TenantAdminService",
+  methods: {
+    /**
+     * @generated from rpc sns.v1.TenantAdminService.GetTenant
+     */
+    getTenant: {
+      name: "GetTenant",
+      I: GetTenantRequest,
+      O: GetTenantResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc sns.v1.TenantAdminService.UpdateTenant
+     */
+    updateTenant: {
+      name: "UpdateTenant",
+      I: UpdateTenantRequest,
+      O: UpdateTenantResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc sns.v1.TenantAdminService.ListTenantMembers
+     */
+    listTenantMembers: {
+      name: "ListTenantMembers",
+      I: ListTenantMembersRequest,
+      O: ListTenantMembersResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc sns.v1.TenantAdminService.UpdateMemberRole
+     */
+    updateMemberRole: {
+      name: "UpdateMemberRole",
+      I: UpdateMemberRoleRequest,
+      O: UpdateMemberRoleResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc sns.v1.TenantAdminService.RemoveMember
+     */
+    removeMember: {
+      name: "RemoveMember",
+      I: RemoveMemberRequest,
+      O: RemoveMemberResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc sns.v1.TenantAdminService.TransferOwnership
+     */
+    transferOwnership: {
+      name: "TransferOwnership",
+      I: TransferOwnershipRequest,
+      O: TransferOwnershipResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc sns.v1.TenantAdminService.ListDomains
+     */
+    listDomains: {
+      name: "ListDomains",
+      I: ListDomainsRequest,
+      O: ListDomainsResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc sns.v1.TenantAdminService.AddDomain
+     */
+    addDomain: {
+      name: "AddDomain",
+      I: AddDomainRequest,
+      O: AddDomainResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc sns.v1.TenantAdminService.VerifyDomain
+     */
+    verifyDomain: {
+      name: "VerifyDomain",
+      I: VerifyDomainRequest,
+      O: VerifyDomainResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc sns.v1.TenantAdminService.RemoveDomain
+     */
+    removeDomain: {
+      name: "RemoveDomain",
+      I: RemoveDomainRequest,
+      O: RemoveDomainResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc sns.v1.TenantAdminService.SetSubdomainFallback
+     */
+    setSubdomainFallback: {
+      name: "SetSubdomainFallback",
+      I: SetSubdomainFallbackRequest,
+      O: SetSubdomainFallbackResponse,
+      kind: MethodKind.Unary,
+    },
+  }
+} as const;
+
@@ -0,0 +1,969 @@
+// @generated by protoc-gen-es v1.10.0 with parameter "target=ts,import_extension=.ts"
+// @generated from file sns/v1/tenant_admin.proto (package sns.v1, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
+import { Message, proto3, protoInt64 } from "@bufbuild/protobuf";
+
+/**
+ * @generated from message sns.v1.TenantSettings
+ */
+export class TenantSettings extends Message<TenantSettings> {
+  /**
+   * @generated from field: uint64 id = 1;
+   */
+  id = protoInt64.zero;
+
+  /**
+   * @generated from field: string slug = 2;
+   */
+  slug = "";
+
+  /**
+   * @generated from field: string name = 3;
+   */
+  name = "";
+
+  /**
+   * @generated from field: string plan = 4;
+   */
+  plan = "";
+
+  /**
+   * @generated from field: string join_policy = 5;
+   */
+  joinPolicy = "";
+
+  /**
+   * @generated from field: bool allow_subdomain_fallback = 6;
+   */
+  allowSubdomainFallback = false;
+
+  constructor(data?: PartialMessage<TenantSettings>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "sns.v1.TenantSettings";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 2, name: "slug", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "plan", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "join_policy", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "allow_subdomain_fallback", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): TenantSettings {
+    return new TenantSettings().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): TenantSettings {
+    return new TenantSettings().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): TenantSettings {
+    return new TenantSettings().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: TenantSettings | PlainMessage<TenantSettings> | undefined, b: TenantSettings | PlainMessage<TenantSettings> | undefined): boolean {
+    return proto3.util.equals(TenantSettings, a, b);
+  }
+}
+
+/**
+ * @generated from message sns.v1.TenantMember
+ */
+export class TenantMember extends Message<TenantMember> {
+  /**
+   * @generated from field: uint64 user_id = 1;
+   */
+  userId = protoInt64.zero;
+
+  /**
+   * @generated from field: string display_name = 2;
+   */
+  displayName = "";
+
+  /**
+   * @generated from field: string role = 3;
+   */
+  role = "";
+
+  /**
+   * @generated from field: string joined_at = 4;
+   */
+  joinedAt = "";
+
+  /**
+   * @generated from field: bool suspended = 5;
+   */
+  suspended = false;
+
+  constructor(data?: PartialMessage<TenantMember>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "sns.v1.TenantMember";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "user_id", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 2, name: "display_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "role", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "joined_at", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "suspended", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): TenantMember {
+    return new TenantMember().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): TenantMember {
+    return new TenantMember().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): TenantMember {
+    return new TenantMember().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: TenantMember | PlainMessage<TenantMember> | undefined, b: TenantMember | PlainMessage<TenantMember> | undefined): boolean {
+    return proto3.util.equals(TenantMember, a, b);
+  }
+}
+
+/**
+ * @generated from message sns.v1.TenantDomain
+ */
+export class TenantDomain extends Message<TenantDomain> {
+  /**
+   * @generated from field: string host = 1;
+   */
+  host = "";
+
+  /**
+   * @generated from field: bool verified = 2;
+   */
+  verified = false;
+
+  /**
+   * @generated from field: string created_at = 3;
+   */
+  createdAt = "";
+
+  /**
+   * @generated from field: string challenge_name = 4;
+   */
+  challengeName = "";
+
+  /**
+   * @generated from field: string challenge_value = 5;
+   */
+  challengeValue = "";
+
+  constructor(data?: PartialMessage<TenantDomain>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "sns.v1.TenantDomain";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "host", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "verified", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 3, name: "created_at", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "challenge_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "challenge_value", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): TenantDomain {
+    return new TenantDomain().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): TenantDomain {
+    return new TenantDomain().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): TenantDomain {
+    return new TenantDomain().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: TenantDomain | PlainMessage<TenantDomain> | undefined, b: TenantDomain | PlainMessage<TenantDomain> | undefined): boolean {
+    return proto3.util.equals(TenantDomain, a, b);
+  }
+}
+
+/**
+ * @generated from message sns.v1.GetTenantRequest
+ */
+export class GetTenantRequest extends Message<GetTenantRequest> {
+  constructor(data?: PartialMessage<GetTenantRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "sns.v1.GetTenantRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetTenantRequest {
+    return new GetTenantRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetTenantRequest {
+    return new GetTenantRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetTenantRequest {
+    return new GetTenantRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetTenantRequest | PlainMessage<GetTenantRequest> | undefined, b: GetTenantRequest | PlainMessage<GetTenantRequest> | undefined): boolean {
+    return proto3.util.equals(GetTenantRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message sns.v1.GetTenantResponse
+ */
+export class GetTenantResponse extends Message<GetTenantResponse> {
+  /**
+   * @generated from field: sns.v1.TenantSettings tenant = 1;
+   */
+  tenant?: TenantSettings;
+
+  constructor(data?: PartialMessage<GetTenantResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "sns.v1.GetTenantResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "tenant", kind: "message", T: TenantSettings },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetTenantResponse {
+    return new GetTenantResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetTenantResponse {
+    return new GetTenantResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetTenantResponse {
+    return new GetTenantResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetTenantResponse | PlainMessage<GetTenantResponse> | undefined, b: GetTenantResponse | PlainMessage<GetTenantResponse> | undefined): boolean {
+    return proto3.util.equals(GetTenantResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message sns.v1.UpdateTenantRequest
+ */
+export class UpdateTenantRequest extends Message<UpdateTenantRequest> {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name = "";
+
+  constructor(data?: PartialMessage<UpdateTenantRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "sns.v1.UpdateTenantRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateTenantRequest {
+    return new UpdateTenantRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateTenantRequest {
+    return new UpdateTenantRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateTenantRequest {
+    return new UpdateTenantRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UpdateTenantRequest | PlainMessage<UpdateTenantRequest> | undefined, b: UpdateTenantRequest | PlainMessage<UpdateTenantRequest> | undefined): boolean {
+    return proto3.util.equals(UpdateTenantRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message sns.v1.UpdateTenantResponse
+ */
+export class UpdateTenantResponse extends Message<UpdateTenantResponse> {
+  /**
+   * @generated from field: sns.v1.TenantSettings tenant = 1;
+   */
+  tenant?: TenantSettings;
+
+  constructor(data?: PartialMessage<UpdateTenantResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "sns.v1.UpdateTenantResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "tenant", kind: "message", T: TenantSettings },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateTenantResponse {
+    return new UpdateTenantResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateTenantResponse {
+    return new UpdateTenantResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateTenantResponse {
+    return new UpdateTenantResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UpdateTenantResponse | PlainMessage<UpdateTenantResponse> | undefined, b: UpdateTenantResponse | PlainMessage<UpdateTenantResponse> | undefined): boolean {
+    return proto3.util.equals(UpdateTenantResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message sns.v1.ListTenantMembersRequest
+ */
+export class ListTenantMembersRequest extends Message<ListTenantMembersRequest> {
+  constructor(data?: PartialMessage<ListTenantMembersRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "sns.v1.ListTenantMembersRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListTenantMembersRequest {
+    return new ListTenantMembersRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListTenantMembersRequest {
+    return new ListTenantMembersRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListTenantMembersRequest {
+    return new ListTenantMembersRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListTenantMembersRequest | PlainMessage<ListTenantMembersRequest> | undefined, b: ListTenantMembersRequest | PlainMessage<ListTenantMembersRequest> | undefined): boolean {
+    return proto3.util.equals(ListTenantMembersRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message sns.v1.ListTenantMembersResponse
+ */
+export class ListTenantMembersResponse extends Message<ListTenantMembersResponse> {
+  /**
+   * @generated from field: repeated sns.v1.TenantMember items = 1;
+   */
+  items: TenantMember[] = [];
+
+  constructor(data?: PartialMessage<ListTenantMembersResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "sns.v1.ListTenantMembersResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "items", kind: "message", T: TenantMember, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListTenantMembersResponse {
+    return new ListTenantMembersResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListTenantMembersResponse {
+    return new ListTenantMembersResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListTenantMembersResponse {
+    return new ListTenantMembersResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListTenantMembersResponse | PlainMessage<ListTenantMembersResponse> | undefined, b: ListTenantMembersResponse | PlainMessage<ListTenantMembersResponse> | undefined): boolean {
+    return proto3.util.equals(ListTenantMembersResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message sns.v1.UpdateMemberRoleRequest
+ */
+export class UpdateMemberRoleRequest extends Message<UpdateMemberRoleRequest> {
+  /**
+   * @generated from field: uint64 user_id = 1;
+   */
+  userId = protoInt64.zero;
+
+  /**
+   * @generated from field: string role = 2;
+   */
+  role = "";
+
+  constructor(data?: PartialMessage<UpdateMemberRoleRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "sns.v1.UpdateMemberRoleRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "user_id", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 2, name: "role", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateMemberRoleRequest {
+    return new UpdateMemberRoleRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateMemberRoleRequest {
+    return new UpdateMemberRoleRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateMemberRoleRequest {
+    return new UpdateMemberRoleRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UpdateMemberRoleRequest | PlainMessage<UpdateMemberRoleRequest> | undefined, b: UpdateMemberRoleRequest | PlainMessage<UpdateMemberRoleRequest> | undefined): boolean {
+    return proto3.util.equals(UpdateMemberRoleRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message sns.v1.UpdateMemberRoleResponse
+ */
+export class UpdateMemberRoleResponse extends Message<UpdateMemberRoleResponse> {
+  constructor(data?: PartialMessage<UpdateMemberRoleResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "sns.v1.UpdateMemberRoleResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateMemberRoleResponse {
+    return new UpdateMemberRoleResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateMemberRoleResponse {
+    return new UpdateMemberRoleResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateMemberRoleResponse {
+    return new UpdateMemberRoleResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UpdateMemberRoleResponse | PlainMessage<UpdateMemberRoleResponse> | undefined, b: UpdateMemberRoleResponse | PlainMessage<UpdateMemberRoleResponse> | undefined): boolean {
+    return proto3.util.equals(UpdateMemberRoleResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message sns.v1.RemoveMemberRequest
+ */
+export class RemoveMemberRequest extends Message<RemoveMemberRequest> {
+  /**
+   * @generated from field: uint64 user_id = 1;
+   */
+  userId = protoInt64.zero;
+
+  constructor(data?: PartialMessage<RemoveMemberRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "sns.v1.RemoveMemberRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "user_id", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RemoveMemberRequest {
+    return new RemoveMemberRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RemoveMemberRequest {
+    return new RemoveMemberRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RemoveMemberRequest {
+    return new RemoveMemberRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RemoveMemberRequest | PlainMessage<RemoveMemberRequest> | undefined, b: RemoveMemberRequest | PlainMessage<RemoveMemberRequest> | undefined): boolean {
+    return proto3.util.equals(RemoveMemberRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message sns.v1.RemoveMemberResponse
+ */
+export class RemoveMemberResponse extends Message<RemoveMemberResponse> {
+  constructor(data?: PartialMessage<RemoveMemberResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "sns.v1.RemoveMemberResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RemoveMemberResponse {
+    return new RemoveMemberResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RemoveMemberResponse {
+    return new RemoveMemberResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RemoveMemberResponse {
+    return new RemoveMemberResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RemoveMemberResponse | PlainMessage<RemoveMemberResponse> | undefined, b: RemoveMemberResponse | PlainMessage<RemoveMemberResponse> | undefined): boolean {
+    return proto3.util.equals(RemoveMemberResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message sns.v1.TransferOwnershipRequest
+ */
+export class TransferOwnershipRequest extends Message<TransferOwnershipRequest> {
+  /**
+   * @generated from field: uint64 user_id = 1;
+   */
+  userId = protoInt64.zero;
+
+  constructor(data?: PartialMessage<TransferOwnershipRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "sns.v1.TransferOwnershipRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "user_id", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): TransferOwnershipRequest {
+    return new TransferOwnershipRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): TransferOwnershipRequest {
+    return new TransferOwnershipRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): TransferOwnershipRequest {
+    return new TransferOwnershipRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: TransferOwnershipRequest | PlainMessage<TransferOwnershipRequest> | undefined, b: TransferOwnershipRequest | PlainMessage<TransferOwnershipRequest> | undefined): boolean {
+    return proto3.util.equals(TransferOwnershipRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message sns.v1.TransferOwnershipResponse
+ */
+export class TransferOwnershipResponse extends Message<TransferOwnershipResponse> {
+  constructor(data?: PartialMessage<TransferOwnershipResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "sns.v1.TransferOwnershipResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): TransferOwnershipResponse {
+    return new TransferOwnershipResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): TransferOwnershipResponse {
+    return new TransferOwnershipResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): TransferOwnershipResponse {
+    return new TransferOwnershipResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: TransferOwnershipResponse | PlainMessage<TransferOwnershipResponse> | undefined, b: TransferOwnershipResponse | PlainMessage<TransferOwnershipResponse> | undefined): boolean {
+    return proto3.util.equals(TransferOwnershipResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message sns.v1.ListDomainsRequest
+ */
+export class ListDomainsRequest extends Message<ListDomainsRequest> {
+  constructor(data?: PartialMessage<ListDomainsRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "sns.v1.ListDomainsRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListDomainsRequest {
+    return new ListDomainsRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListDomainsRequest {
+    return new ListDomainsRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListDomainsRequest {
+    return new ListDomainsRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListDomainsRequest | PlainMessage<ListDomainsRequest> | undefined, b: ListDomainsRequest | PlainMessage<ListDomainsRequest> | undefined): boolean {
+    return proto3.util.equals(ListDomainsRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message sns.v1.ListDomainsResponse
+ */
+export class ListDomainsResponse extends Message<ListDomainsResponse> {
+  /**
+   * @generated from field: repeated sns.v1.TenantDomain items = 1;
+   */
+  items: TenantDomain[] = [];
+
+  constructor(data?: PartialMessage<ListDomainsResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "sns.v1.ListDomainsResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "items", kind: "message", T: TenantDomain, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListDomainsResponse {
+    return new ListDomainsResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListDomainsResponse {
+    return new ListDomainsResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListDomainsResponse {
+    return new ListDomainsResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListDomainsResponse | PlainMessage<ListDomainsResponse> | undefined, b: ListDomainsResponse | PlainMessage<ListDomainsResponse> | undefined): boolean {
+    return proto3.util.equals(ListDomainsResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message sns.v1.AddDomainRequest
+ */
+export class AddDomainRequest extends Message<AddDomainRequest> {
+  /**
+   * @generated from field: string host = 1;
+   */
+  host = "";
+
+  constructor(data?: PartialMessage<AddDomainRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "sns.v1.AddDomainRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "host", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AddDomainRequest {
+    return new AddDomainRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AddDomainRequest {
+    return new AddDomainRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AddDomainRequest {
+    return new AddDomainRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: AddDomainRequest | PlainMessage<AddDomainRequest> | undefined, b: AddDomainRequest | PlainMessage<AddDomainRequest> | undefined): boolean {
+    return proto3.util.equals(AddDomainRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message sns.v1.AddDomainResponse
+ */
+export class AddDomainResponse extends Message<AddDomainResponse> {
+  /**
+   * @generated from field: sns.v1.TenantDomain domain = 1;
+   */
+  domain?: TenantDomain;
+
+  constructor(data?: PartialMessage<AddDomainResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "sns.v1.AddDomainResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "domain", kind: "message", T: TenantDomain },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AddDomainResponse {
+    return new AddDomainResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AddDomainResponse {
+    return new AddDomainResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AddDomainResponse {
+    return new AddDomainResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: AddDomainResponse | PlainMessage<AddDomainResponse> | undefined, b: AddDomainResponse | PlainMessage<AddDomainResponse> | undefined): boolean {
+    return proto3.util.equals(AddDomainResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message sns.v1.VerifyDomainRequest
+ */
+export class VerifyDomainRequest extends Message<VerifyDomainRequest> {
+  /**
+   * @generated from field: string host = 1;
+   */
+  host = "";
+
+  constructor(data?: PartialMessage<VerifyDomainRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "sns.v1.VerifyDomainRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "host", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): VerifyDomainRequest {
+    return new VerifyDomainRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): VerifyDomainRequest {
+    return new VerifyDomainRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): VerifyDomainRequest {
+    return new VerifyDomainRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: VerifyDomainRequest | PlainMessage<VerifyDomainRequest> | undefined, b: VerifyDomainRequest | PlainMessage<VerifyDomainRequest> | undefined): boolean {
+    return proto3.util.equals(VerifyDomainRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message sns.v1.VerifyDomainResponse
+ */
+export class VerifyDomainResponse extends Message<VerifyDomainResponse> {
+  /**
+   * @generated from field: sns.v1.TenantDomain domain = 1;
+   */
+  domain?: TenantDomain;
+
+  constructor(data?: PartialMessage<VerifyDomainResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "sns.v1.VerifyDomainResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "domain", kind: "message", T: TenantDomain },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): VerifyDomainResponse {
+    return new VerifyDomainResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): VerifyDomainResponse {
+    return new VerifyDomainResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): VerifyDomainResponse {
+    return new VerifyDomainResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: VerifyDomainResponse | PlainMessage<VerifyDomainResponse> | undefined, b: VerifyDomainResponse | PlainMessage<VerifyDomainResponse> | undefined): boolean {
+    return proto3.util.equals(VerifyDomainResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message sns.v1.RemoveDomainRequest
+ */
+export class RemoveDomainRequest extends Message<RemoveDomainRequest> {
+  /**
+   * @generated from field: string host = 1;
+   */
+  host = "";
+
+  constructor(data?: PartialMessage<RemoveDomainRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "sns.v1.RemoveDomainRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "host", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RemoveDomainRequest {
+    return new RemoveDomainRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RemoveDomainRequest {
+    return new RemoveDomainRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RemoveDomainRequest {
+    return new RemoveDomainRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RemoveDomainRequest | PlainMessage<RemoveDomainRequest> | undefined, b: RemoveDomainRequest | PlainMessage<RemoveDomainRequest> | undefined): boolean {
+    return proto3.util.equals(RemoveDomainRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message sns.v1.RemoveDomainResponse
+ */
+export class RemoveDomainResponse extends Message<RemoveDomainResponse> {
+  constructor(data?: PartialMessage<RemoveDomainResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "sns.v1.RemoveDomainResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RemoveDomainResponse {
+    return new RemoveDomainResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RemoveDomainResponse {
+    return new RemoveDomainResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RemoveDomainResponse {
+    return new RemoveDomainResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RemoveDomainResponse | PlainMessage<RemoveDomainResponse> | undefined, b: RemoveDomainResponse | PlainMessage<RemoveDomainResponse> | undefined): boolean {
+    return proto3.util.equals(RemoveDomainResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message sns.v1.SetSubdomainFallbackRequest
+ */
+export class SetSubdomainFallbackRequest extends Message<SetSubdomainFallbackRequest> {
+  /**
+   * @generated from field: bool allowed = 1;
+   */
+  allowed = false;
+
+  constructor(data?: PartialMessage<SetSubdomainFallbackRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "sns.v1.SetSubdomainFallbackRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "allowed", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SetSubdomainFallbackRequest {
+    return new SetSubdomainFallbackRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SetSubdomainFallbackRequest {
+    return new SetSubdomainFallbackRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SetSubdomainFallbackRequest {
+    return new SetSubdomainFallbackRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SetSubdomainFallbackRequest | PlainMessage<SetSubdomainFallbackRequest> | undefined, b: SetSubdomainFallbackRequest | PlainMessage<SetSubdomainFallbackRequest> | undefined): boolean {
+    return proto3.util.equals(SetSubdomainFallbackRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message sns.v1.SetSubdomainFallbackResponse
+ */
+export class SetSubdomainFallbackResponse extends Message<SetSubdomainFallbackResponse> {
+  constructor(data?: PartialMessage<SetSubdomainFallbackResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "sns.v1.SetSubdomainFallbackResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SetSubdomainFallbackResponse {
+    return new SetSubdomainFallbackResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SetSubdomainFallbackResponse {
+    return new SetSubdomainFallbackResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SetSubdomainFallbackResponse {
+    return new SetSubdomainFallbackResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SetSubdomainFallbackResponse | PlainMessage<SetSubdomainFallbackResponse> | undefined, b: SetSubdomainFallbackResponse | PlainMessage<SetSubdomainFallbackResponse> | undefined): boolean {
+    return proto3.util.equals(SetSubdomainFallbackResponse, a, b);
+  }
+}
+
@@ -3,7 +3,7 @@
 /* eslint-disable */
 // @ts-nocheck
 
-import { GetMeRequest, GetMeResponse, ResolveTenantRequest, ResolveTenantResponse } from "./tenant_pb.ts";
+import { GetMeRequest, GetMeResponse, GetTenantUsageRequest, GetTenantUsageResponse, ResolveTenantRequest, ResolveTenantResponse } from "./tenant_pb.ts";
 import { MethodKind } from "@bufbuild/protobuf";
 
 /**
@@ -30,6 +30,15 @@ export const TenantService = {
       O: GetMeResponse,
       kind: MethodKind.Unary,
     },
+    /**
+     * @generated from rpc sns.v1.TenantService.GetTenantUsage
+     */
+    getTenantUsage: {
+      name: "GetTenantUsage",
+      I: GetTenantUsageRequest,
+      O: GetTenantUsageResponse,
+      kind: MethodKind.Unary,
+    },
   }
 } as const;
 
@@ -136,6 +136,11 @@ export class GetMeResponse extends Message<GetMeResponse> {
    */
   memberships: TenantMembership[] = [];
 
+  /**
+   * @generated from field: string avatar_url = 4;
+   */
+  avatarUrl = "";
+
   constructor(data?: PartialMessage<GetMeResponse>) {
     super();
     proto3.util.initPartial(data, this);
@@ -147,6 +152,7 @@ export class GetMeResponse extends Message<GetMeResponse> {
     { no: 1, name: "user_id", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
     { no: 2, name: "display_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 3, name: "memberships", kind: "message", T: TenantMembership, repeated: true },
+    { no: 4, name: "avatar_url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetMeResponse {
@@ -215,3 +221,199 @@ export class TenantMembership extends Message<TenantMembership> {
   }
 }
 
+/**
+ * @generated from message sns.v1.PlanLimits
+ */
+export class PlanLimits extends Message<PlanLimits> {
+  /**
+   * @generated from field: uint32 posts_per_minute = 1;
+   */
+  postsPerMinute = 0;
+
+  /**
+   * @generated from field: uint32 comments_per_minute = 2;
+   */
+  commentsPerMinute = 0;
+
+  /**
+   * @generated from field: uint32 messages_per_minute = 3;
+   */
+  messagesPerMinute = 0;
+
+  /**
+   * @generated from field: uint32 daily_posts = 4;
+   */
+  dailyPosts = 0;
+
+  /**
+   * @generated from field: uint64 storage_bytes = 5;
+   */
+  storageBytes = protoInt64.zero;
+
+  /**
+   * @generated from field: uint32 max_members = 6;
+   */
+  maxMembers = 0;
+
+  constructor(data?: PartialMessage<PlanLimits>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "sns.v1.PlanLimits";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "posts_per_minute", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 2, name: "comments_per_minute", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 3, name: "messages_per_minute", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 4, name: "daily_posts", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 5, name: "storage_bytes", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 6, name: "max_members", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PlanLimits {
+    return new PlanLimits().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PlanLimits {
+    return new PlanLimits().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PlanLimits {
+    return new PlanLimits().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: PlanLimits | PlainMessage<PlanLimits> | undefined, b: PlanLimits | PlainMessage<PlanLimits> | undefined): boolean {
+    return proto3.util.equals(PlanLimits, a, b);
+  }
+}
+
+/**
+ * @generated from message sns.v1.TenantUsage
+ */
+export class TenantUsage extends Message<TenantUsage> {
+  /**
+   * @generated from field: uint32 posts_today = 1;
+   */
+  postsToday = 0;
+
+  /**
+   * @generated from field: uint64 storage_bytes = 2;
+   */
+  storageBytes = protoInt64.zero;
+
+  /**
+   * @generated from field: uint32 members = 3;
+   */
+  members = 0;
+
+  constructor(data?: PartialMessage<TenantUsage>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "sns.v1.TenantUsage";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "posts_today", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 2, name: "storage_bytes", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 3, name: "members", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): TenantUsage {
+    return new TenantUsage().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): TenantUsage {
+    return new TenantUsage().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): TenantUsage {
+    return new TenantUsage().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: TenantUsage | PlainMessage<TenantUsage> | undefined, b: TenantUsage | PlainMessage<TenantUsage> | undefined): boolean {
+    return proto3.util.equals(TenantUsage, a, b);
+  }
+}
+
+/**
+ * @generated from message sns.v1.GetTenantUsageRequest
+ */
+export class GetTenantUsageRequest extends Message<GetTenantUsageRequest> {
+  constructor(data?: PartialMessage<GetTenantUsageRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "sns.v1.GetTenantUsageRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetTenantUsageRequest {
+    return new GetTenantUsageRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetTenantUsageRequest {
+    return new GetTenantUsageRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetTenantUsageRequest {
+    return new GetTenantUsageRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetTenantUsageRequest | PlainMessage<GetTenantUsageRequest> | undefined, b: GetTenantUsageRequest | PlainMessage<GetTenantUsageRequest> | undefined): boolean {
+    return proto3.util.equals(GetTenantUsageRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message sns.v1.GetTenantUsageResponse
+ */
+export class GetTenantUsageResponse extends Message<GetTenantUsageResponse> {
+  /**
+   * @generated from field: string plan = 1;
+   */
+  plan = "";
+
+  /**
+   * @generated from field: sns.v1.PlanLimits limits = 2;
+   */
+  limits?: PlanLimits;
+
+  /**
+   * @generated from field: sns.v1.TenantUsage usage = 3;
+   */
+  usage?: TenantUsage;
+
+  constructor(data?: PartialMessage<GetTenantUsageResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "sns.v1.GetTenantUsageResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "plan", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "limits", kind: "message", T: PlanLimits },
+    { no: 3, name: "usage", kind: "message", T: TenantUsage },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetTenantUsageResponse {
+    return new GetTenantUsageResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetTenantUsageResponse {
+    return new GetTenantUsageResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetTenantUsageResponse {
+    return new GetTenantUsageResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetTenantUsageResponse | PlainMessage<GetTenantUsageResponse> | undefined, b: GetTenantUsageResponse | PlainMessage<GetTenantUsageResponse> | undefined): boolean {
+    return proto3.util.equals(GetTenantUsageResponse, a, b);
+  }
+}
+
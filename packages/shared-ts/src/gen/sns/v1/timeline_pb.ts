@@ -5,6 +5,7 @@
 
 import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
 import { Message, proto3, protoInt64 } from "@bufbuild/protobuf";
+import { Profile } from "./profile_pb.ts";
 
 /**
  * @generated from message sns.v1.Cursor
@@ -82,6 +83,11 @@ export class Post extends Message<Post> {
    */
   commentCount = 0;
 
+  /**
+   * @generated from field: sns.v1.Profile author = 8;
+   */
+  author?: Profile;
+
   constructor(data?: PartialMessage<Post>) {
     super();
     proto3.util.initPartial(data, this);
@@ -97,6 +103,7 @@ export class Post extends Message<Post> {
     { no: 5, name: "liked_by_me", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
     { no: 6, name: "like_count", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
     { no: 7, name: "comment_count", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 8, name: "author", kind: "message", T: Profile },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Post {
@@ -145,6 +152,11 @@ export class Comment extends Message<Comment> {
    */
   createdAt = "";
 
+  /**
+   * @generated from field: sns.v1.Profile author = 6;
+   */
+  author?: Profile;
+
   constructor(data?: PartialMessage<Comment>) {
     super();
     proto3.util.initPartial(data, this);
@@ -158,6 +170,7 @@ export class Comment extends Message<Comment> {
     { no: 3, name: "author_user_id", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
     { no: 4, name: "body", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 5, name: "created_at", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "author", kind: "message", T: Profile },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Comment {
@@ -186,6 +199,11 @@ export class ListFeedRequest extends Message<ListFeedRequest> {
    */
   cursor?: Cursor;
 
+  /**
+   * @generated from field: uint32 page_size = 2;
+   */
+  pageSize = 0;
+
   constructor(data?: PartialMessage<ListFeedRequest>) {
     super();
     proto3.util.initPartial(data, this);
@@ -195,6 +213,7 @@ export class ListFeedRequest extends Message<ListFeedRequest> {
   static readonly typeName = "sns.v1.ListFeedRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "cursor", kind: "message", T: Cursor },
+    { no: 2, name: "page_size", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListFeedRequest {
@@ -228,6 +247,16 @@ export class ListFeedResponse extends Message<ListFeedResponse> {
    */
   next?: Cursor;
 
+  /**
+   * @generated from field: sns.v1.Cursor prev = 3;
+   */
+  prev?: Cursor;
+
+  /**
+   * @generated from field: bool has_more = 4;
+   */
+  hasMore = false;
+
   constructor(data?: PartialMessage<ListFeedResponse>) {
     super();
     proto3.util.initPartial(data, this);
@@ -238,6 +267,8 @@ export class ListFeedResponse extends Message<ListFeedResponse> {
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "items", kind: "message", T: Post, repeated: true },
     { no: 2, name: "next", kind: "message", T: Cursor },
+    { no: 3, name: "prev", kind: "message", T: Cursor },
+    { no: 4, name: "has_more", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListFeedResponse {
@@ -345,6 +376,16 @@ export class ListCommentsRequest extends Message<ListCommentsRequest> {
    */
   cursor?: Cursor;
 
+  /**
+   * @generated from field: uint32 page_size = 3;
+   */
+  pageSize = 0;
+
+  /**
+   * @generated from field: bool include_total = 4;
+   */
+  includeTotal = false;
+
   constructor(data?: PartialMessage<ListCommentsRequest>) {
     super();
     proto3.util.initPartial(data, this);
@@ -355,6 +396,8 @@ export class ListCommentsRequest extends Message<ListCommentsRequest> {
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "post_id", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
     { no: 2, name: "cursor", kind: "message", T: Cursor },
+    { no: 3, name: "page_size", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 4, name: "include_total", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListCommentsRequest {
@@ -388,6 +431,21 @@ export class ListCommentsResponse extends Message<ListCommentsResponse> {
    */
   next?: Cursor;
 
+  /**
+   * @generated from field: sns.v1.Cursor prev = 3;
+   */
+  prev?: Cursor;
+
+  /**
+   * @generated from field: bool has_more = 4;
+   */
+  hasMore = false;
+
+  /**
+   * @generated from field: uint64 total = 5;
+   */
+  total = protoInt64.zero;
+
   constructor(data?: PartialMessage<ListCommentsResponse>) {
     super();
     proto3.util.initPartial(data, this);
@@ -398,6 +456,9 @@ export class ListCommentsResponse extends Message<ListCommentsResponse> {
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "items", kind: "message", T: Comment, repeated: true },
     { no: 2, name: "next", kind: "message", T: Cursor },
+    { no: 3, name: "prev", kind: "message", T: Cursor },
+    { no: 4, name: "has_more", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 5, name: "total", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListCommentsResponse {